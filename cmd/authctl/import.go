@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+)
+
+// importColumns are the fields of an import record, as JSONL keys and CSV header names
+var importColumns = []string{"email", "email_verified", "password_hash", "password_salt", "hash_format"}
+
+// importLine is one JSONL import record
+type importLine struct {
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	PasswordHash  string `json:"password_hash"`
+	PasswordSalt  string `json:"password_salt"`
+	HashFormat    string `json:"hash_format"`
+}
+
+func importUsers(ctx context.Context, client *mongodb.Client, cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("import-users", flag.ExitOnError)
+	flags.Usage = usage
+	format := flags.String("format", "", "jsonl or csv (default: from the file extension)")
+	flags.Parse(args)
+	requireArgs(flags.Args(), 1)
+
+	path := flags.Arg(0)
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var records []usecase.ImportUserRecord
+	var lines []int // Input line of each record, for reporting failures
+	switch strings.ToLower(*format) {
+	case "jsonl":
+		records, lines, err = readImportJSONL(file)
+	case "csv":
+		records, lines, err = readImportCSV(file)
+	default:
+		return fmt.Errorf("unknown import format %q (use -format jsonl or csv)", *format)
+	}
+	if err != nil {
+		return err
+	}
+
+	// WHY: The server's hasher - only hashes it can verify are imported
+	passwordHasher, err := security.NewPasswordHasherFromConfig(cfg.Password)
+	if err != nil {
+		return err
+	}
+
+	importUC := auth.NewImportUsersUseCase(
+		mongodb.NewUserRepository(client.Database()),
+		mongodb.NewOrganizationRepository(client.Database()),
+		passwordHasher,
+		auth.NewAuditLog(mongodb.NewAuditLogRepository(client.Database())),
+	)
+	result, err := importUC.Execute(ctx, records)
+	if result != nil {
+		for _, failure := range result.Failed {
+			fmt.Fprintf(os.Stderr, "line %d (%s): %s\n", lines[failure.Index], failure.Email, failure.Reason)
+		}
+		fmt.Printf("Imported %d of %d users\n", result.Imported, len(records))
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("%d users not imported", len(result.Failed))
+	}
+	return nil
+}
+
+// readImportJSONL reads one JSON object per line, skipping blank lines
+func readImportJSONL(r io.Reader) ([]usecase.ImportUserRecord, []int, error) {
+	var records []usecase.ImportUserRecord
+	var lines []int
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var line importLine
+		decoder := json.NewDecoder(strings.NewReader(text))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&line); err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", n, err)
+		}
+
+		records = append(records, usecase.ImportUserRecord(line))
+		lines = append(lines, n)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return records, lines, nil
+}
+
+// readImportCSV reads records with a header row naming the columns
+// NOTE: Only email and password_hash are required; columns can be in any order
+func readImportCSV(r io.Reader) ([]usecase.ImportUserRecord, []int, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	column := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(importColumns, name) {
+			return nil, nil, fmt.Errorf("unknown column %q", name)
+		}
+		column[name] = i
+	}
+	for _, required := range []string{"email", "password_hash"} {
+		if _, ok := column[required]; !ok {
+			return nil, nil, fmt.Errorf("missing column %q", required)
+		}
+	}
+
+	field := func(row []string, name string) string {
+		if i, ok := column[name]; ok {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	var records []usecase.ImportUserRecord
+	var lines []int
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		var emailVerified bool
+		if v := field(row, "email_verified"); v != "" {
+			emailVerified, err = strconv.ParseBool(v)
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: invalid email_verified %q", line, v)
+			}
+		}
+
+		records = append(records, usecase.ImportUserRecord{
+			Email:         field(row, "email"),
+			EmailVerified: emailVerified,
+			PasswordHash:  field(row, "password_hash"),
+			PasswordSalt:  field(row, "password_salt"),
+			HashFormat:    field(row, "hash_format"),
+		})
+		lines = append(lines, line)
+	}
+
+	return records, lines, nil
+}
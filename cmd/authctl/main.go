@@ -0,0 +1,274 @@
+// Command authctl runs account administration tasks against the auth database.
+//
+// Usage:
+//
+//	authctl [-tenant <id>] unlock <email>
+//	authctl [-tenant <id>] grant-role <email> <role>
+//	authctl [-tenant <id>] revoke-role <email> <role>
+//	authctl create-org <id> <name>
+//	authctl [-tenant <id>] create-client [-confidential] -scope <scopes> <name> <redirect-uri>...
+//	authctl [-tenant <id>] create-service-client -scope <scopes> <name>
+//	authctl [-tenant <id>] enable-service-client <client-id>
+//	authctl [-tenant <id>] disable-service-client <client-id>
+//	authctl [-tenant <id>] [-timeout <duration>] import-users [-format jsonl|csv] <file>
+//
+// -tenant selects the organization the account belongs to (default "default").
+// -timeout bounds the whole command (default 30s); raise it for large imports.
+//
+// unlock clears the failed-login counters for an account in the
+// organization, lifting any backoff or lockout from every IP. Admins can do
+// the same over the API.
+//
+// grant-role and revoke-role change an account's roles. Use grant-role to
+// create the first admin; later admins can be managed over the API.
+//
+// create-org registers a new organization (tenant). Users then sign up
+// into it by sending its ID in the X-Tenant-ID header.
+//
+// create-client registers an OAuth client for the organization's users.
+// -scope is the space-separated list of scopes it may request. Clients are
+// public (PKCE only) unless -confidential is given, in which case a client
+// secret is printed once and can't be shown again.
+//
+// create-service-client registers a machine identity for a backend service,
+// which gets tokens for itself with the client_credentials grant. -scope is
+// the space-separated list of scopes it may request. Its secret is printed
+// once. disable-service-client stops it getting tokens and invalidates the
+// ones it holds; enable-service-client reverses that.
+//
+// import-users creates accounts migrated from another system, keeping their
+// password hashes: native bcrypt and Argon2id, Django's pbkdf2_sha256, bcrypt
+// and bcrypt_sha256, and Firebase scrypt (needs PASSWORD_FIREBASE_SIGNER_KEY).
+// Each user's hash is checked in its original format on first login, then
+// replaced with the configured algorithm. The file has one JSON object per
+// line, or a CSV header row, with the fields email, password_hash and
+// optionally email_verified, password_salt and hash_format. Firebase records
+// set hash_format to firebase-scrypt and password_salt to the exported salt.
+// Records that can't be imported are reported by line and skipped, so the
+// file can be fixed and imported again.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	tenant := flag.String("tenant", valueobject.DefaultTenant, "organization the account belongs to")
+	timeout := flag.Duration("timeout", 30*time.Second, "time limit for the command")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() < 1 {
+		usage()
+	}
+
+	tenantID, err := valueobject.NewTenantID(*tenant)
+	if err != nil {
+		log.Fatalf("Invalid -tenant: %v", err)
+	}
+
+	// Same configuration as the server
+	_ = godotenv.Load()
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	ctx = usecase.WithTenant(ctx, tenantID)
+
+	client, err := mongodb.NewClient(ctx, cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	defer client.Close(context.Background())
+
+	command, args := flag.Arg(0), flag.Args()[1:]
+	switch command {
+	case "unlock":
+		requireArgs(args, 1)
+		err = unlock(ctx, client, args[0])
+	case "grant-role":
+		requireArgs(args, 2)
+		err = changeRole(ctx, client, args[0], args[1], auth.GrantRole)
+	case "revoke-role":
+		requireArgs(args, 2)
+		err = changeRole(ctx, client, args[0], args[1], auth.RevokeRole)
+	case "create-org":
+		requireArgs(args, 2)
+		err = createOrg(ctx, client, args[0], strings.Join(args[1:], " "))
+	case "create-client":
+		err = createClient(ctx, client, args)
+	case "create-service-client":
+		err = createServiceClient(ctx, client, args)
+	case "enable-service-client":
+		requireArgs(args, 1)
+		err = setServiceClientActive(ctx, client, args[0], true)
+	case "disable-service-client":
+		requireArgs(args, 1)
+		err = setServiceClientActive(ctx, client, args[0], false)
+	case "import-users":
+		err = importUsers(ctx, client, cfg, args)
+	default:
+		usage()
+	}
+
+	if err != nil {
+		log.Fatalf("%s failed: %v", command, err)
+	}
+}
+
+func unlock(ctx context.Context, client *mongodb.Client, emailAddress string) error {
+	userRepo := mongodb.NewUserRepository(client.Database())
+
+	email, err := valueobject.NewEmail(emailAddress)
+	if err != nil {
+		return err
+	}
+	user, err := userRepo.FindByEmail(ctx, usecase.TenantFromContext(ctx), email)
+	if err != nil {
+		return err
+	}
+
+	// Recorded with the system actor - the CLI has no admin identity
+	auditLog := auth.NewAuditLog(mongodb.NewAuditLogRepository(client.Database()))
+	unlockUC := auth.NewUnlockAccountUseCase(userRepo, mongodb.NewLoginAttemptRepository(client.Database()), auditLog)
+	if err := unlockUC.Execute(ctx, usecase.AdminUserRequest{UserID: user.ID().String()}); err != nil {
+		return err
+	}
+
+	fmt.Printf("Unlocked %s\n", email)
+	return nil
+}
+
+func changeRole(ctx context.Context, client *mongodb.Client, emailAddress, role string, change auth.AccessChange) error {
+	userRepo := mongodb.NewUserRepository(client.Database())
+
+	email, err := valueobject.NewEmail(emailAddress)
+	if err != nil {
+		return err
+	}
+	user, err := userRepo.FindByEmail(ctx, usecase.TenantFromContext(ctx), email)
+	if err != nil {
+		return err
+	}
+
+	// Recorded with the system actor - the CLI has no admin identity
+	auditLog := auth.NewAuditLog(mongodb.NewAuditLogRepository(client.Database()))
+	updateUC := auth.NewUpdateUserAccessUseCase(userRepo, auditLog)
+	access, err := updateUC.Execute(ctx, usecase.UserAccessRequest{
+		UserID: user.ID().String(),
+		Name:   role,
+	}, change)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s roles: %s\n", email, strings.Join(access.Roles, ", "))
+	return nil
+}
+
+func createOrg(ctx context.Context, client *mongodb.Client, id, name string) error {
+	createUC := auth.NewCreateOrganizationUseCase(mongodb.NewOrganizationRepository(client.Database()))
+	org, err := createUC.Execute(ctx, id, name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created organization %s (%s)\n", org.ID(), org.Name())
+	return nil
+}
+
+func createClient(ctx context.Context, client *mongodb.Client, args []string) error {
+	flags := flag.NewFlagSet("create-client", flag.ExitOnError)
+	flags.Usage = usage
+	confidential := flags.Bool("confidential", false, "issue a client secret")
+	scope := flags.String("scope", "", "space-separated scopes the client may request")
+	flags.Parse(args)
+	requireArgs(flags.Args(), 2)
+
+	createUC := auth.NewCreateOAuthClientUseCase(
+		mongodb.NewOrganizationRepository(client.Database()),
+		mongodb.NewOAuthClientRepository(client.Database()),
+	)
+	oauthClient, secret, err := createUC.Execute(ctx, flags.Arg(0), flags.Args()[1:], entity.ParseScope(*scope), *confidential)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created OAuth client %s (%s)\n", oauthClient.ID(), oauthClient.Name())
+	if secret != "" {
+		fmt.Printf("Client secret (shown once): %s\n", secret)
+	}
+	return nil
+}
+
+func createServiceClient(ctx context.Context, client *mongodb.Client, args []string) error {
+	flags := flag.NewFlagSet("create-service-client", flag.ExitOnError)
+	flags.Usage = usage
+	scope := flags.String("scope", "", "space-separated scopes the client may request")
+	flags.Parse(args)
+	requireArgs(flags.Args(), 1)
+
+	createUC := auth.NewCreateServiceClientUseCase(
+		mongodb.NewOrganizationRepository(client.Database()),
+		mongodb.NewServiceClientRepository(client.Database()),
+	)
+	serviceClient, secret, err := createUC.Execute(ctx, strings.Join(flags.Args(), " "), entity.ParseScope(*scope))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created service client %s (%s)\n", serviceClient.ID(), serviceClient.Name())
+	fmt.Printf("Client secret (shown once): %s\n", secret)
+	return nil
+}
+
+func setServiceClientActive(ctx context.Context, client *mongodb.Client, clientID string, active bool) error {
+	setActiveUC := auth.NewSetServiceClientActiveUseCase(mongodb.NewServiceClientRepository(client.Database()))
+	serviceClient, err := setActiveUC.Execute(ctx, clientID, active)
+	if err != nil {
+		return err
+	}
+
+	state := "disabled"
+	if serviceClient.IsActive() {
+		state = "enabled"
+	}
+	fmt.Printf("Service client %s (%s) %s\n", serviceClient.ID(), serviceClient.Name(), state)
+	return nil
+}
+
+func requireArgs(args []string, n int) {
+	if len(args) < n {
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage:
+  authctl [-tenant <id>] unlock <email>
+  authctl [-tenant <id>] grant-role <email> <role>
+  authctl [-tenant <id>] revoke-role <email> <role>
+  authctl create-org <id> <name>
+  authctl [-tenant <id>] create-client [-confidential] -scope <scopes> <name> <redirect-uri>...
+  authctl [-tenant <id>] create-service-client -scope <scopes> <name>
+  authctl [-tenant <id>] enable-service-client <client-id>
+  authctl [-tenant <id>] disable-service-client <client-id>
+  authctl [-tenant <id>] [-timeout <duration>] import-users [-format jsonl|csv] <file>`)
+	os.Exit(2)
+}
@@ -0,0 +1,164 @@
+// Command keyctl manages the JWT signing key ring.
+//
+// Usage:
+//
+//	keyctl list
+//	keyctl generate <HS256|RS256|ES256|EdDSA>
+//	keyctl import <RS256|ES256|EdDSA> <private-key.pem> [kid]
+//	keyctl promote <kid>
+//	keyctl retire <kid>
+//
+// Rotation: generate a key, wait for verifiers to fetch the new JWKS,
+// promote it, then retire the old key once its tokens have expired.
+// Running servers pick up changes on their next reload (JWT_KEY_RELOAD_INTERVAL).
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	// Same configuration as the server
+	_ = godotenv.Load()
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	store, closeStore, err := openKeyStore(ctx, cfg)
+	if err != nil {
+		log.Fatalf("Failed to open key store: %v", err)
+	}
+	defer closeStore()
+
+	args := os.Args[2:]
+	switch os.Args[1] {
+	case "list":
+		err = list(ctx, store)
+	case "generate":
+		requireArgs(args, 1)
+		err = generate(ctx, store, args[0])
+	case "import":
+		requireArgs(args, 2)
+		kid := ""
+		if len(args) > 2 {
+			kid = args[2]
+		}
+		err = importKey(ctx, store, args[0], args[1], kid)
+	case "promote":
+		requireArgs(args, 1)
+		err = store.Promote(ctx, args[0])
+	case "retire":
+		requireArgs(args, 1)
+		err = store.Retire(ctx, args[0])
+	default:
+		usage()
+	}
+
+	if err != nil {
+		log.Fatalf("%s failed: %v", os.Args[1], err)
+	}
+}
+
+// openKeyStore opens the store configured for the server
+func openKeyStore(ctx context.Context, cfg *config.Config) (security.KeyStore, func(), error) {
+	switch cfg.JWT.KeyStore {
+	case "file":
+		return security.NewFileKeyStore(cfg.JWT.KeyStorePath), func() {}, nil
+	case "mongo":
+		// Same key the server decrypts with
+		cipher, err := security.NewAESCipherFromBase64(cfg.MFA.EncryptionKey)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		client, err := mongodb.NewClient(ctx, cfg.Database)
+		if err != nil {
+			return nil, nil, err
+		}
+		closeFn := func() { client.Close(context.Background()) }
+		return mongodb.NewSigningKeyStore(client.Database(), cipher), closeFn, nil
+	default:
+		return nil, nil, fmt.Errorf("JWT_KEY_STORE is not set - the server uses a static key")
+	}
+}
+
+func list(ctx context.Context, store security.KeyStore) error {
+	keys, err := store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KID\tALG\tSTATUS\tCREATED")
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", key.ID, key.Algorithm, key.Status, key.CreatedAt.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+func generate(ctx context.Context, store security.KeyStore, algorithm string) error {
+	key, err := security.GenerateStoredKey(algorithm)
+	if err != nil {
+		return err
+	}
+
+	if err := store.Add(ctx, key); err != nil {
+		return err
+	}
+
+	fmt.Printf("Added %s key %s (verify only - promote it to start signing)\n", key.Algorithm, key.ID)
+	return nil
+}
+
+func importKey(ctx context.Context, store security.KeyStore, algorithm, path, kid string) error {
+	material, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	key, err := security.NewStoredKey(kid, algorithm, material)
+	if err != nil {
+		return err
+	}
+
+	if err := store.Add(ctx, key); err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported %s key %s (verify only - promote it to start signing)\n", key.Algorithm, key.ID)
+	return nil
+}
+
+func requireArgs(args []string, n int) {
+	if len(args) < n {
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage:
+  keyctl list
+  keyctl generate <HS256|RS256|ES256|EdDSA>
+  keyctl import <RS256|ES256|EdDSA> <private-key.pem> [kid]
+  keyctl promote <kid>
+  keyctl retire <kid>`)
+	os.Exit(2)
+}
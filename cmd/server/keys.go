@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// newKeyStore opens the configured signing key store (nil for a static key)
+func newKeyStore(cfg config.JWTConfig, db *mongo.Database, cipher security.SecretCipher) security.KeyStore {
+	switch cfg.KeyStore {
+	case "mongo":
+		return mongodb.NewSigningKeyStore(db, cipher)
+	case "file":
+		return security.NewFileKeyStore(cfg.KeyStorePath)
+	default:
+		return nil
+	}
+}
+
+// setupKeyRing builds the signing key ring, bootstrapping the store if empty
+func setupKeyRing(ctx context.Context, cfg config.JWTConfig, store security.KeyStore) (*security.KeyRing, error) {
+	// Static key - rotation requires a restart
+	if store == nil {
+		signingKey, err := security.NewSigningKeyFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return security.NewKeyRing(signingKey), nil
+	}
+
+	bootstrapKey, err := security.StoredKeyFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := security.BootstrapKeyStore(ctx, store, bootstrapKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bootstrap key store: %w", err)
+	}
+	if created {
+		log.Printf("✓ Key store bootstrapped with configured key (kid=%s)", bootstrapKey.ID)
+	}
+
+	// Seed the ring with the configured key, then replace with the store's view
+	signingKey, err := security.NewSigningKeyFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	keyRing := security.NewKeyRing(signingKey)
+
+	if err := security.ReloadKeyRing(ctx, keyRing, store); err != nil {
+		return nil, err
+	}
+
+	return keyRing, nil
+}
+
+// watchKeyStore reloads the key ring until ctx is cancelled
+// WHY: Keys added, promoted or retired with keyctl take effect without a restart
+func watchKeyStore(ctx context.Context, keyRing *security.KeyRing, store security.KeyStore, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloadCtx, cancel := context.WithTimeout(ctx, interval)
+			if err := security.ReloadKeyRing(reloadCtx, keyRing, store); err != nil {
+				log.Printf("⚠ Failed to reload signing keys (keeping current keys): %v", err)
+			}
+			cancel()
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Ruseigha/LabukaAuth/internal/config"
 	grpcdelivery "github.com/Ruseigha/LabukaAuth/internal/delivery/grpc"
 	httpdelivery "github.com/Ruseigha/LabukaAuth/internal/delivery/http"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
 	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
 	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
 	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
@@ -50,23 +51,180 @@ func main() {
 		log.Fatalf("Failed to create indexes: %v", err)
 	}
 
+	if err := mongodb.CreateRefreshTokenIndexes(ctx, mongoClient.Collection("refresh_tokens")); err != nil {
+		log.Fatalf("Failed to create refresh token indexes: %v", err)
+	}
+
+	if err := mongodb.CreateRevokedTokenIndexes(ctx, mongoClient.Collection("revoked_tokens")); err != nil {
+		log.Fatalf("Failed to create revoked token indexes: %v", err)
+	}
+
+	if err := mongodb.CreatePasswordResetTokenIndexes(ctx, mongoClient.Collection("password_reset_tokens")); err != nil {
+		log.Fatalf("Failed to create password reset token indexes: %v", err)
+	}
+
+	if err := mongodb.CreateLoginAttemptIndexes(ctx, mongoClient.Collection("login_attempts")); err != nil {
+		log.Fatalf("Failed to create login attempt indexes: %v", err)
+	}
+
+	if err := mongodb.CreatePasskeyIndexes(ctx, mongoClient.Collection("passkeys")); err != nil {
+		log.Fatalf("Failed to create passkey indexes: %v", err)
+	}
+
+	if err := mongodb.CreatePasskeyChallengeIndexes(ctx, mongoClient.Collection("passkey_challenges")); err != nil {
+		log.Fatalf("Failed to create passkey challenge indexes: %v", err)
+	}
+
+	if err := mongodb.CreatePersonalAccessTokenIndexes(ctx, mongoClient.Collection("personal_access_tokens")); err != nil {
+		log.Fatalf("Failed to create personal access token indexes: %v", err)
+	}
+
+	if err := mongodb.CreateSessionIndexes(ctx, mongoClient.Collection("sessions")); err != nil {
+		log.Fatalf("Failed to create session indexes: %v", err)
+	}
+
+	if err := mongodb.CreateLoginCodeIndexes(ctx, mongoClient.Collection("login_codes")); err != nil {
+		log.Fatalf("Failed to create login code indexes: %v", err)
+	}
+
+	if err := mongodb.CreateOrganizationIndexes(ctx, mongoClient.Collection("organizations")); err != nil {
+		log.Fatalf("Failed to create organization indexes: %v", err)
+	}
+
+	if err := mongodb.CreateInvitationIndexes(ctx, mongoClient.Collection("invitations")); err != nil {
+		log.Fatalf("Failed to create invitation indexes: %v", err)
+	}
+
+	if err := mongodb.CreateAuthorizationCodeIndexes(ctx, mongoClient.Collection("authorization_codes")); err != nil {
+		log.Fatalf("Failed to create authorization code indexes: %v", err)
+	}
+
+	if err := mongodb.CreateAuditLogIndexes(ctx, mongoClient.Collection("audit_log")); err != nil {
+		log.Fatalf("Failed to create audit log indexes: %v", err)
+	}
+
 	log.Println("✓ Database indexes created")
 
 	// Initialize infrastructure
 	userRepo := mongodb.NewUserRepository(mongoClient.Database())
-	passwordHasher := security.NewBcryptHasher(10) // Cost factor 10
+	orgRepo := mongodb.NewOrganizationRepository(mongoClient.Database())
+	refreshTokenRepo := mongodb.NewRefreshTokenRepository(mongoClient.Database())
+	revokedTokenRepo := mongodb.NewRevokedTokenRepository(mongoClient.Database())
+	passwordResetTokenRepo := mongodb.NewPasswordResetTokenRepository(mongoClient.Database())
+	loginAttemptRepo := mongodb.NewLoginAttemptRepository(mongoClient.Database())
+	passkeyRepo := mongodb.NewPasskeyRepository(mongoClient.Database())
+	passkeyChallengeRepo := mongodb.NewPasskeyChallengeRepository(mongoClient.Database())
+	personalAccessTokenRepo := mongodb.NewPersonalAccessTokenRepository(mongoClient.Database())
+	sessionRepo := mongodb.NewSessionRepository(mongoClient.Database())
+	loginCodeRepo := mongodb.NewLoginCodeRepository(mongoClient.Database())
+	auditLogRepo := mongodb.NewAuditLogRepository(mongoClient.Database())
+	invitationRepo := mongodb.NewInvitationRepository(mongoClient.Database())
+	oauthClientRepo := mongodb.NewOAuthClientRepository(mongoClient.Database())
+	serviceClientRepo := mongodb.NewServiceClientRepository(mongoClient.Database())
+	authorizationCodeRepo := mongodb.NewAuthorizationCodeRepository(mongoClient.Database())
+	passwordHasher, err := security.NewPasswordHasherFromConfig(cfg.Password)
+	if err != nil {
+		log.Fatalf("Failed to create password hasher: %v", err)
+	}
+	log.Printf("✓ Password hashing ready (algorithm=%s, pepper version=%d)", cfg.Password.Algorithm, cfg.Password.PepperVersion)
+
+	// Existing users were migrated into the default tenant - make sure it exists
+	if err := auth.NewCreateOrganizationUseCase(orgRepo).EnsureDefault(ctx); err != nil {
+		log.Fatalf("Failed to create default organization: %v", err)
+	}
+
+	// SECURITY: TOTP secrets and stored signing keys are encrypted at rest
+	// with a key kept outside MongoDB
+	secretCipher, err := security.NewAESCipherFromBase64(cfg.MFA.EncryptionKey)
+	if err != nil {
+		log.Fatalf("Failed to initialize MFA cipher: %v", err)
+	}
+
+	keyStore := newKeyStore(cfg.JWT, mongoClient.Database(), secretCipher)
+	keyRing, err := setupKeyRing(ctx, cfg.JWT, keyStore)
+	if err != nil {
+		log.Fatalf("Failed to load JWT signing keys: %v", err)
+	}
+	log.Printf("✓ JWT signing keys loaded (active kid=%q, alg=%s)", keyRing.Active().ID(), keyRing.Active().Algorithm())
+
+	// Pick up key rotations without a restart
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	if keyStore != nil {
+		go watchKeyStore(watchCtx, keyRing, keyStore, cfg.JWT.KeyReloadInterval)
+	}
+
 	jwtGenerator := security.NewJWTGenerator(
-		cfg.JWT.SecretKey,
+		keyRing,
 		cfg.JWT.AccessTokenExpiry,
 		cfg.JWT.RefreshTokenExpiry,
 		cfg.JWT.Issuer,
 	)
 
+	passkeyVerifier, err := security.NewWebAuthnVerifier(
+		cfg.WebAuthn.RPID,
+		cfg.WebAuthn.RPDisplayName,
+		cfg.WebAuthn.RPOrigins,
+		cfg.WebAuthn.Timeout,
+	)
+	if err != nil {
+		log.Fatalf("Failed to initialize WebAuthn: %v", err)
+	}
+
+	mailer, err := mail.NewMailerFromConfig(cfg.Mail)
+	if err != nil {
+		log.Fatalf("Failed to initialize mailer: %v", err)
+	}
+	log.Printf("✓ Mailer ready (transport=%s)", cfg.Mail.Transport)
+
 	// Initialize use cases
-	authService := auth.NewAuthService(userRepo, passwordHasher, jwtGenerator)
+	authService := auth.NewAuthService(
+		userRepo,
+		orgRepo,
+		passwordHasher,
+		jwtGenerator,
+		refreshTokenRepo,
+		revokedTokenRepo,
+		passwordResetTokenRepo,
+		loginAttemptRepo,
+		passkeyRepo,
+		passkeyChallengeRepo,
+		personalAccessTokenRepo,
+		sessionRepo,
+		loginCodeRepo,
+		auditLogRepo,
+		invitationRepo,
+		oauthClientRepo,
+		serviceClientRepo,
+		authorizationCodeRepo,
+		mailer,
+		secretCipher,
+		passkeyVerifier,
+		auth.Config{
+			AccessTokenExpiry:              cfg.JWT.AccessTokenExpiry,
+			RefreshTokenExpiry:             cfg.JWT.RefreshTokenExpiry,
+			RequireVerifiedEmail:           cfg.Auth.RequireVerifiedEmail,
+			EmailVerificationExpiry:        cfg.Auth.EmailVerificationExpiry,
+			EmailVerificationURL:           cfg.Auth.EmailVerificationURL,
+			PasswordResetExpiry:            cfg.Auth.PasswordResetExpiry,
+			PasswordResetURL:               cfg.Auth.PasswordResetURL,
+			LoginCodeExpiry:                cfg.Auth.LoginCodeExpiry,
+			MagicLinkURL:                   cfg.Auth.MagicLinkURL,
+			Lockout:                        auth.LockoutPolicy(cfg.Lockout),
+			MFAIssuer:                      cfg.MFA.Issuer,
+			MFAChallengeExpiry:             cfg.MFA.ChallengeExpiry,
+			PasskeyChallengeExpiry:         cfg.WebAuthn.Timeout,
+			InviteOnlySignup:               cfg.Auth.InviteOnlySignup,
+			InvitationExpiry:               cfg.Auth.InvitationExpiry,
+			InvitationURL:                  cfg.Auth.InvitationURL,
+			PersonalAccessTokenMaxLifetime: cfg.Auth.PersonalAccessTokenMaxLifetime,
+			OAuthCodeExpiry:                cfg.OAuth.CodeExpiry,
+			OIDCIssuer:                     cfg.OAuth.Issuer,
+		},
+	)
 
 	// Setup HTTP router
-	router := httpdelivery.SetupRouter(authService, cfg.App.Version)
+	router := httpdelivery.SetupRouter(authService, jwtGenerator, cfg.App.Version, cfg.OAuth.ConsentURL, cfg.OAuth.Issuer)
 
 	// Create HTTP server
 	server := &http.Server{
@@ -114,5 +272,8 @@ func main() {
 	// Shutdown gRPC
 	grpcServer.GracefulStop()
 
+	// Finish sending queued email
+	authService.WaitForMail()
+
 	log.Println("✓ Server stopped gracefully")
 }
@@ -15,6 +15,27 @@ type Config struct {
 	// JWT contains authentication settings
 	JWT JWTConfig
 
+	// Auth contains account policy settings
+	Auth AuthConfig
+
+	// Password contains password hashing settings
+	Password PasswordConfig
+
+	// Lockout contains failed-login throttling settings
+	Lockout LockoutConfig
+
+	// MFA contains multi-factor authentication settings
+	MFA MFAConfig
+
+	// WebAuthn contains passkey relying party settings
+	WebAuthn WebAuthnConfig
+
+	// OAuth contains authorization server settings
+	OAuth OAuthConfig
+
+	// Mail contains outbound email settings
+	Mail MailConfig
+
 	// Logger contains logging configuration
 	Logger LoggerConfig
 }
@@ -49,6 +70,134 @@ type JWTConfig struct {
 	AccessTokenExpiry  time.Duration
 	RefreshTokenExpiry time.Duration
 	Issuer             string
+
+	// SigningAlgorithm is HS256, RS256, ES256 or EdDSA
+	// WHY: Asymmetric algorithms let other services verify tokens via JWKS
+	SigningAlgorithm string
+	PrivateKeyPath   string // PEM private key (asymmetric algorithms only)
+	KeyID            string // kid header (derived from the key if empty)
+
+	// KeyStore holds the rotating key ring: "" (static key above), "mongo" or "file"
+	// NOTE: With a store, the key above only bootstraps an empty store
+	KeyStore          string
+	KeyStorePath      string        // Key file for the "file" store
+	KeyReloadInterval time.Duration // How often to pick up rotations
+}
+
+type AuthConfig struct {
+	RequireVerifiedEmail    bool          // Block login until the email is verified
+	EmailVerificationExpiry time.Duration // Verification link lifetime
+	EmailVerificationURL    string        // Page that submits the token to /auth/verify-email
+	PasswordResetExpiry     time.Duration // Reset link lifetime (keep short)
+	PasswordResetURL        string        // Page that submits the token to /auth/password/reset
+	LoginCodeExpiry         time.Duration // Passwordless code and magic link lifetime (keep short)
+	MagicLinkURL            string        // Page that submits the token to /auth/login/magic-link
+	InviteOnlySignup        bool          // Disable open signup - accounts are created by accepting invitations
+	InvitationExpiry        time.Duration // Invitation link lifetime
+	InvitationURL           string        // Page that submits the token to /auth/invitations/accept
+
+	// PersonalAccessTokenMaxLifetime caps the expiry users may choose for a
+	// personal access token
+	PersonalAccessTokenMaxLifetime time.Duration
+}
+
+// PasswordConfig controls how passwords are hashed
+// NOTE: Changes apply to new hashes; existing ones are upgraded when their
+// owner next logs in
+type PasswordConfig struct {
+	Algorithm  string // argon2id (recommended) or bcrypt
+	BcryptCost int    // 4-31; each step doubles the work
+
+	Argon2Memory      uint32 // KiB of memory per hash
+	Argon2Time        uint32 // Passes over the memory
+	Argon2Parallelism uint8  // Lanes (threads) per hash
+
+	// Firebase project hash parameters, for users imported from Firebase
+	// SECURITY: The signer key is a secret - keep it out of version control
+	FirebaseSignerKey     string // base64; empty = Firebase hashes not accepted
+	FirebaseSaltSeparator string // base64
+	FirebaseRounds        int    // 1-8
+	FirebaseMemCost       int    // 1-14
+
+	// Pepper keys are HMAC keys applied to passwords before hashing, each
+	// written <version>:<base64 key>. PepperVersion picks the key for new
+	// hashes; the others only verify, and their hashes are upgraded on login
+	// SECURITY: Held outside the database, so a dump alone can't be cracked
+	Peppers       string // Comma-separated keys (prefer PepperFile in production)
+	PepperFile    string // File with one key per line
+	PepperVersion int    // 0 = no pepper
+}
+
+// LockoutConfig controls brute-force protection on login
+// WHY: The per-IP rate limiter is easy to spread across IPs; these
+// counters follow the targeted account instead
+type LockoutConfig struct {
+	Enabled          bool
+	FreeAttempts     int           // Failures allowed before backoff starts
+	BaseDelay        time.Duration // First backoff delay (doubles per failure)
+	MaxDelay         time.Duration // Backoff cap
+	AccountThreshold int           // Failures from any IP before the account locks
+	ClientThreshold  int           // Failures from one IP before that IP is locked out of the account
+	Duration         time.Duration // How long a lockout lasts
+	Window           time.Duration // Counters reset after this long without failures
+}
+
+// MFAConfig controls TOTP multi-factor authentication
+type MFAConfig struct {
+	Issuer string // Account issuer shown in authenticator apps
+
+	// EncryptionKey is a base64 AES-256 key (32 bytes) for stored TOTP
+	// secrets and signing keys (JWT_KEY_STORE=mongo)
+	// SECURITY: Keep it out of the database - a dump alone must not yield secrets
+	EncryptionKey string
+
+	ChallengeExpiry time.Duration // Time allowed for the second login step
+}
+
+// WebAuthnConfig identifies this service as a WebAuthn relying party
+type WebAuthnConfig struct {
+	// RPID is the domain passkeys are bound to (e.g. "example.com")
+	// NOTE: Changing it orphans every registered passkey
+	RPID string
+
+	RPDisplayName string        // Name shown by the browser's passkey prompt
+	RPOrigins     []string      // Frontend origins allowed to use passkeys
+	Timeout       time.Duration // Time allowed to answer a challenge
+}
+
+// OAuthConfig controls the OAuth 2.0 authorization server
+type OAuthConfig struct {
+	// ConsentURL is the login and consent page /oauth/authorize redirects to
+	// NOTE: It receives the authorization request's query parameters unchanged
+	ConsentURL string
+
+	CodeExpiry time.Duration // Authorization code lifetime (keep short)
+
+	// Issuer is this server's public base URL, used as the iss of ID tokens
+	// and to build the discovery document. Empty disables OpenID Connect.
+	// NOTE: Requires an asymmetric JWT signing algorithm
+	Issuer string
+}
+
+// MailConfig controls how outbound email is delivered
+type MailConfig struct {
+	// Transport is log (development only), smtp or file
+	// WHY: file writes .eml files to OutboxDir for tests and local runs
+	Transport string
+
+	From string // Sender, e.g. "LabukaAuth <no-reply@example.com>"
+
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string // Empty disables SMTP AUTH
+	SMTPPassword string
+	SMTPTLS      string        // starttls, tls (implicit) or none
+	SMTPTimeout  time.Duration // Limit for delivering one message
+
+	OutboxDir string // Directory for the file transport
+
+	TemplateDir   string // Overrides the built-in templates (<locale>/<name>.html)
+	DefaultLocale string // Used when the request's locale has no template
 }
 
 type LoggerConfig struct {
@@ -86,6 +235,69 @@ func DefaultConfig() *Config {
 			AccessTokenExpiry:  15 * time.Minute,
 			RefreshTokenExpiry: 7 * 24 * time.Hour, // 7 days
 			Issuer:             "auth-service",
+			SigningAlgorithm:   "HS256",
+			KeyReloadInterval:  time.Minute,
+		},
+		Auth: AuthConfig{
+			RequireVerifiedEmail:    false,
+			EmailVerificationExpiry: 24 * time.Hour,
+			EmailVerificationURL:    "http://localhost:3000/verify-email",
+			PasswordResetExpiry:     30 * time.Minute,
+			PasswordResetURL:        "http://localhost:3000/reset-password",
+			LoginCodeExpiry:         10 * time.Minute,
+			MagicLinkURL:            "http://localhost:3000/magic-link",
+			InviteOnlySignup:        false,
+			InvitationExpiry:        7 * 24 * time.Hour,
+			InvitationURL:           "http://localhost:3000/accept-invitation",
+
+			PersonalAccessTokenMaxLifetime: 365 * 24 * time.Hour, // 1 year
+		},
+		Password: PasswordConfig{
+			Algorithm:  "argon2id",
+			BcryptCost: 10,
+
+			// OWASP minimum for Argon2id: 19 MiB, 2 passes, 1 lane
+			Argon2Memory:      19 * 1024,
+			Argon2Time:        2,
+			Argon2Parallelism: 1,
+
+			// Firebase's defaults
+			FirebaseSaltSeparator: "Bw==",
+			FirebaseRounds:        8,
+			FirebaseMemCost:       14,
+		},
+		Lockout: LockoutConfig{
+			Enabled:          true,
+			FreeAttempts:     3,
+			BaseDelay:        time.Second,
+			MaxDelay:         5 * time.Minute,
+			AccountThreshold: 20,
+			ClientThreshold:  10,
+			Duration:         15 * time.Minute,
+			Window:           time.Hour,
+		},
+		MFA: MFAConfig{
+			Issuer:          "LabukaAuth",
+			ChallengeExpiry: 5 * time.Minute,
+		},
+		WebAuthn: WebAuthnConfig{
+			RPID:          "localhost",
+			RPDisplayName: "LabukaAuth",
+			RPOrigins:     []string{"http://localhost:3000"},
+			Timeout:       5 * time.Minute,
+		},
+		OAuth: OAuthConfig{
+			ConsentURL: "http://localhost:3000/oauth/consent",
+			CodeExpiry: time.Minute,
+		},
+		Mail: MailConfig{
+			Transport:     "log",
+			From:          "LabukaAuth <no-reply@localhost>",
+			SMTPPort:      "587",
+			SMTPTLS:       "starttls",
+			SMTPTimeout:   10 * time.Second,
+			OutboxDir:     "./tmp/outbox",
+			DefaultLocale: "en",
 		},
 		Logger: LoggerConfig{
 			Level:  "debug",
@@ -100,6 +100,242 @@ func Load() (*Config, error) {
 	if v := os.Getenv("JWT_ISSUER"); v != "" {
 		cfg.JWT.Issuer = v
 	}
+	if v := os.Getenv("JWT_SIGNING_ALGORITHM"); v != "" {
+		cfg.JWT.SigningAlgorithm = v
+	}
+	if v := os.Getenv("JWT_PRIVATE_KEY_PATH"); v != "" {
+		cfg.JWT.PrivateKeyPath = v
+	}
+	if v := os.Getenv("JWT_KEY_ID"); v != "" {
+		cfg.JWT.KeyID = v
+	}
+	if v := os.Getenv("JWT_KEY_STORE"); v != "" {
+		cfg.JWT.KeyStore = v
+	}
+	if v := os.Getenv("JWT_KEY_STORE_PATH"); v != "" {
+		cfg.JWT.KeyStorePath = v
+	}
+	if v := os.Getenv("JWT_KEY_RELOAD_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.JWT.KeyReloadInterval = d
+		}
+	}
+
+	// Auth config
+	if v := os.Getenv("AUTH_REQUIRE_VERIFIED_EMAIL"); v != "" {
+		cfg.Auth.RequireVerifiedEmail = parseBool(v)
+	}
+	if v := os.Getenv("AUTH_EMAIL_VERIFICATION_EXPIRY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Auth.EmailVerificationExpiry = d
+		}
+	}
+	if v := os.Getenv("AUTH_EMAIL_VERIFICATION_URL"); v != "" {
+		cfg.Auth.EmailVerificationURL = v
+	}
+	if v := os.Getenv("AUTH_PASSWORD_RESET_EXPIRY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Auth.PasswordResetExpiry = d
+		}
+	}
+	if v := os.Getenv("AUTH_PASSWORD_RESET_URL"); v != "" {
+		cfg.Auth.PasswordResetURL = v
+	}
+	if v := os.Getenv("AUTH_LOGIN_CODE_EXPIRY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Auth.LoginCodeExpiry = d
+		}
+	}
+	if v := os.Getenv("AUTH_MAGIC_LINK_URL"); v != "" {
+		cfg.Auth.MagicLinkURL = v
+	}
+	if v := os.Getenv("AUTH_INVITE_ONLY_SIGNUP"); v != "" {
+		cfg.Auth.InviteOnlySignup = parseBool(v)
+	}
+	if v := os.Getenv("AUTH_INVITATION_EXPIRY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Auth.InvitationExpiry = d
+		}
+	}
+	if v := os.Getenv("AUTH_INVITATION_URL"); v != "" {
+		cfg.Auth.InvitationURL = v
+	}
+	if v := os.Getenv("AUTH_PAT_MAX_LIFETIME"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Auth.PersonalAccessTokenMaxLifetime = d
+		}
+	}
+
+	// Password config
+	if v := os.Getenv("PASSWORD_HASH_ALGORITHM"); v != "" {
+		cfg.Password.Algorithm = strings.ToLower(v)
+	}
+	if v := os.Getenv("PASSWORD_BCRYPT_COST"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Password.BcryptCost = n
+		}
+	}
+	if v := os.Getenv("PASSWORD_ARGON2_MEMORY"); v != "" {
+		if n, err := strconv.ParseUint(v, 10, 32); err == nil {
+			cfg.Password.Argon2Memory = uint32(n)
+		}
+	}
+	if v := os.Getenv("PASSWORD_ARGON2_TIME"); v != "" {
+		if n, err := strconv.ParseUint(v, 10, 32); err == nil {
+			cfg.Password.Argon2Time = uint32(n)
+		}
+	}
+	if v := os.Getenv("PASSWORD_ARGON2_PARALLELISM"); v != "" {
+		if n, err := strconv.ParseUint(v, 10, 8); err == nil {
+			cfg.Password.Argon2Parallelism = uint8(n)
+		}
+	}
+	if v := os.Getenv("PASSWORD_FIREBASE_SIGNER_KEY"); v != "" {
+		cfg.Password.FirebaseSignerKey = v
+	}
+	if v := os.Getenv("PASSWORD_FIREBASE_SALT_SEPARATOR"); v != "" {
+		cfg.Password.FirebaseSaltSeparator = v
+	}
+	if v := os.Getenv("PASSWORD_FIREBASE_ROUNDS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Password.FirebaseRounds = n
+		}
+	}
+	if v := os.Getenv("PASSWORD_FIREBASE_MEM_COST"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Password.FirebaseMemCost = n
+		}
+	}
+	if v := os.Getenv("PASSWORD_PEPPERS"); v != "" {
+		cfg.Password.Peppers = v
+	}
+	if v := os.Getenv("PASSWORD_PEPPER_FILE"); v != "" {
+		cfg.Password.PepperFile = v
+	}
+	if v := os.Getenv("PASSWORD_PEPPER_VERSION"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Password.PepperVersion = n
+		}
+	}
+
+	// Lockout config
+	if v := os.Getenv("LOCKOUT_ENABLED"); v != "" {
+		cfg.Lockout.Enabled = parseBool(v)
+	}
+	if v := os.Getenv("LOCKOUT_FREE_ATTEMPTS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Lockout.FreeAttempts = n
+		}
+	}
+	if v := os.Getenv("LOCKOUT_BASE_DELAY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Lockout.BaseDelay = d
+		}
+	}
+	if v := os.Getenv("LOCKOUT_MAX_DELAY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Lockout.MaxDelay = d
+		}
+	}
+	if v := os.Getenv("LOCKOUT_ACCOUNT_THRESHOLD"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Lockout.AccountThreshold = n
+		}
+	}
+	if v := os.Getenv("LOCKOUT_CLIENT_THRESHOLD"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Lockout.ClientThreshold = n
+		}
+	}
+	if v := os.Getenv("LOCKOUT_DURATION"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Lockout.Duration = d
+		}
+	}
+	if v := os.Getenv("LOCKOUT_WINDOW"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Lockout.Window = d
+		}
+	}
+
+	// MFA config
+	if v := os.Getenv("MFA_ISSUER"); v != "" {
+		cfg.MFA.Issuer = v
+	}
+	if v := os.Getenv("MFA_ENCRYPTION_KEY"); v != "" {
+		cfg.MFA.EncryptionKey = v
+	}
+	if v := os.Getenv("MFA_CHALLENGE_EXPIRY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.MFA.ChallengeExpiry = d
+		}
+	}
+
+	// WebAuthn config
+	if v := os.Getenv("WEBAUTHN_RP_ID"); v != "" {
+		cfg.WebAuthn.RPID = v
+	}
+	if v := os.Getenv("WEBAUTHN_RP_NAME"); v != "" {
+		cfg.WebAuthn.RPDisplayName = v
+	}
+	if v := os.Getenv("WEBAUTHN_RP_ORIGINS"); v != "" {
+		cfg.WebAuthn.RPOrigins = parseList(v)
+	}
+	if v := os.Getenv("WEBAUTHN_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.WebAuthn.Timeout = d
+		}
+	}
+
+	// OAuth config
+	if v := os.Getenv("OAUTH_CONSENT_URL"); v != "" {
+		cfg.OAuth.ConsentURL = v
+	}
+	if v := os.Getenv("OAUTH_CODE_EXPIRY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.OAuth.CodeExpiry = d
+		}
+	}
+	if v := os.Getenv("OAUTH_ISSUER"); v != "" {
+		cfg.OAuth.Issuer = v
+	}
+
+	// Mail config
+	if v := os.Getenv("MAIL_TRANSPORT"); v != "" {
+		cfg.Mail.Transport = v
+	}
+	if v := os.Getenv("MAIL_FROM"); v != "" {
+		cfg.Mail.From = v
+	}
+	if v := os.Getenv("MAIL_SMTP_HOST"); v != "" {
+		cfg.Mail.SMTPHost = v
+	}
+	if v := os.Getenv("MAIL_SMTP_PORT"); v != "" {
+		cfg.Mail.SMTPPort = v
+	}
+	if v := os.Getenv("MAIL_SMTP_USERNAME"); v != "" {
+		cfg.Mail.SMTPUsername = v
+	}
+	if v := os.Getenv("MAIL_SMTP_PASSWORD"); v != "" {
+		cfg.Mail.SMTPPassword = v
+	}
+	if v := os.Getenv("MAIL_SMTP_TLS"); v != "" {
+		cfg.Mail.SMTPTLS = v
+	}
+	if v := os.Getenv("MAIL_SMTP_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Mail.SMTPTimeout = d
+		}
+	}
+	if v := os.Getenv("MAIL_OUTBOX_DIR"); v != "" {
+		cfg.Mail.OutboxDir = v
+	}
+	if v := os.Getenv("MAIL_TEMPLATE_DIR"); v != "" {
+		cfg.Mail.TemplateDir = v
+	}
+	if v := os.Getenv("MAIL_DEFAULT_LOCALE"); v != "" {
+		cfg.Mail.DefaultLocale = v
+	}
 
 	// Logger config
 	if v := os.Getenv("LOG_LEVEL"); v != "" {
@@ -175,6 +411,17 @@ func parseBool(s string) bool {
 	return s == "true" || s == "1" || s == "yes"
 }
 
+// parseList splits a comma-separated value, dropping empty entries
+func parseList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // MustLoad loads configuration and panics on error
 func MustLoad() *Config {
 	cfg, err := Load()
@@ -1,9 +1,15 @@
 package config
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Validate checks if configuration is valid
@@ -31,6 +37,46 @@ func Validate(cfg *Config) error {
 		errs = append(errs, err)
 	}
 
+	// Validate Auth config
+	if err := validateAuth(&cfg.Auth); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Validate Password config
+	if err := validatePassword(&cfg.Password); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Validate Lockout config
+	if err := validateLockout(&cfg.Lockout); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Validate MFA config
+	if err := validateMFA(&cfg.MFA); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Validate WebAuthn config
+	if err := validateWebAuthn(&cfg.WebAuthn); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Validate OAuth config
+	if err := validateOAuth(&cfg.OAuth); err != nil {
+		errs = append(errs, err)
+	}
+
+	// OpenID Connect clients verify ID tokens with the published keys
+	if cfg.OAuth.Issuer != "" && cfg.JWT.SigningAlgorithm == "HS256" {
+		errs = append(errs, errors.New("OAuth issuer requires an asymmetric JWT signing algorithm (RS256, ES256 or EdDSA)"))
+	}
+
+	// Validate Mail config
+	if err := validateMail(&cfg.Mail); err != nil {
+		errs = append(errs, err)
+	}
+
 	// Validate Logger config
 	if err := validateLogger(&cfg.Logger); err != nil {
 		errs = append(errs, err)
@@ -137,19 +183,46 @@ func validateDatabase(cfg *DatabaseConfig) error {
 func validateJWT(cfg *JWTConfig) error {
 	var errs []error
 
-	if cfg.SecretKey == "" {
-		errs = append(errs, errors.New("JWT secret key is required"))
+	// Validate signing algorithm
+	// NOTE: Case-sensitive - the value is used as the JWT alg header
+	validAlgorithms := []string{"HS256", "RS256", "ES256", "EdDSA"}
+	if !slices.Contains(validAlgorithms, cfg.SigningAlgorithm) {
+		errs = append(errs, fmt.Errorf("invalid JWT signing algorithm: %s (must be one of: %s)",
+			cfg.SigningAlgorithm, strings.Join(validAlgorithms, ", ")))
 	}
 
-	// Warn about insecure secret in production
-	if cfg.SecretKey == "change-me-in-production" {
-		errs = append(errs, errors.New("JWT secret key must be changed from default value"))
+	if cfg.SigningAlgorithm == "HS256" {
+		if cfg.SecretKey == "" {
+			errs = append(errs, errors.New("JWT secret key is required"))
+		}
+
+		// Warn about insecure secret in production
+		if cfg.SecretKey == "change-me-in-production" {
+			errs = append(errs, errors.New("JWT secret key must be changed from default value"))
+		}
+
+		// Enforce minimum secret length
+		if len(cfg.SecretKey) < 32 {
+			errs = append(errs, fmt.Errorf("JWT secret key too short (got %d characters, need at least 32)",
+				len(cfg.SecretKey)))
+		}
+	} else if cfg.PrivateKeyPath == "" {
+		// Asymmetric algorithms sign with a private key, not the secret
+		errs = append(errs, fmt.Errorf("JWT private key path is required for %s", cfg.SigningAlgorithm))
+	}
+
+	// Validate key store
+	validKeyStores := []string{"", "mongo", "file"}
+	if !slices.Contains(validKeyStores, cfg.KeyStore) {
+		errs = append(errs, fmt.Errorf("invalid JWT key store: %s (must be empty, mongo or file)", cfg.KeyStore))
+	}
+
+	if cfg.KeyStore == "file" && cfg.KeyStorePath == "" {
+		errs = append(errs, errors.New("JWT key store path is required for the file key store"))
 	}
 
-	// Enforce minimum secret length
-	if len(cfg.SecretKey) < 32 {
-		errs = append(errs, fmt.Errorf("JWT secret key too short (got %d characters, need at least 32)",
-			len(cfg.SecretKey)))
+	if cfg.KeyStore != "" && cfg.KeyReloadInterval <= 0 {
+		errs = append(errs, errors.New("JWT key reload interval must be positive"))
 	}
 
 	if cfg.AccessTokenExpiry <= 0 {
@@ -175,6 +248,324 @@ func validateJWT(cfg *JWTConfig) error {
 	return nil
 }
 
+// validateAuth validates account policy configuration
+func validateAuth(cfg *AuthConfig) error {
+	var errs []error
+
+	if cfg.EmailVerificationExpiry <= 0 {
+		errs = append(errs, errors.New("email verification expiry must be positive"))
+	}
+
+	if cfg.EmailVerificationURL == "" {
+		errs = append(errs, errors.New("email verification URL is required"))
+	}
+
+	if cfg.PasswordResetExpiry <= 0 {
+		errs = append(errs, errors.New("password reset expiry must be positive"))
+	}
+
+	// SECURITY: Reset links grant account takeover - keep them short-lived
+	if cfg.PasswordResetExpiry > 24*time.Hour {
+		errs = append(errs, fmt.Errorf("password reset expiry too long (got %s, max 24h)", cfg.PasswordResetExpiry))
+	}
+
+	if cfg.PasswordResetURL == "" {
+		errs = append(errs, errors.New("password reset URL is required"))
+	}
+
+	if cfg.LoginCodeExpiry <= 0 {
+		errs = append(errs, errors.New("login code expiry must be positive"))
+	}
+
+	// SECURITY: Login codes are short - a long lifetime gives guessing more time
+	if cfg.LoginCodeExpiry > time.Hour {
+		errs = append(errs, fmt.Errorf("login code expiry too long (got %s, max 1h)", cfg.LoginCodeExpiry))
+	}
+
+	if cfg.MagicLinkURL == "" {
+		errs = append(errs, errors.New("magic link URL is required"))
+	}
+
+	if cfg.InvitationExpiry <= 0 {
+		errs = append(errs, errors.New("invitation expiry must be positive"))
+	}
+
+	// SECURITY: An invitation grants a role - don't leave links valid for months
+	if cfg.InvitationExpiry > 30*24*time.Hour {
+		errs = append(errs, fmt.Errorf("invitation expiry too long (got %s, max 720h)", cfg.InvitationExpiry))
+	}
+
+	if cfg.InvitationURL == "" {
+		errs = append(errs, errors.New("invitation URL is required"))
+	}
+
+	// NOTE: At least a day - shorter-lived automation should use the login flow
+	if cfg.PersonalAccessTokenMaxLifetime < 24*time.Hour {
+		errs = append(errs, fmt.Errorf("personal access token max lifetime too short (got %s, min 24h)", cfg.PersonalAccessTokenMaxLifetime))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// validatePassword validates password hashing configuration
+func validatePassword(cfg *PasswordConfig) error {
+	var errs []error
+
+	switch cfg.Algorithm {
+	case "argon2id":
+		// SECURITY: Below these, GPUs crack Argon2id faster than bcrypt
+		if cfg.Argon2Memory < 8*1024 {
+			errs = append(errs, fmt.Errorf("argon2 memory too low (got %d KiB, min 8192)", cfg.Argon2Memory))
+		}
+		if cfg.Argon2Time < 1 {
+			errs = append(errs, errors.New("argon2 time must be at least 1"))
+		}
+		if cfg.Argon2Parallelism < 1 {
+			errs = append(errs, errors.New("argon2 parallelism must be at least 1"))
+		}
+	case "bcrypt":
+	default:
+		errs = append(errs, fmt.Errorf("invalid password hash algorithm: %s (must be argon2id or bcrypt)", cfg.Algorithm))
+	}
+
+	// NOTE: Checked for either algorithm - bcrypt hashes are still verified
+	// (and upgraded) when argon2id is selected
+	if cfg.BcryptCost < 4 || cfg.BcryptCost > 31 {
+		errs = append(errs, fmt.Errorf("bcrypt cost out of range (got %d, must be 4-31)", cfg.BcryptCost))
+	}
+
+	// Firebase scrypt parameters come from the project's password hash settings
+	if cfg.FirebaseSignerKey != "" {
+		if _, err := base64.StdEncoding.DecodeString(cfg.FirebaseSignerKey); err != nil {
+			errs = append(errs, errors.New("firebase signer key must be base64"))
+		}
+		if _, err := base64.StdEncoding.DecodeString(cfg.FirebaseSaltSeparator); err != nil {
+			errs = append(errs, errors.New("firebase salt separator must be base64"))
+		}
+		if cfg.FirebaseRounds < 1 || cfg.FirebaseRounds > 8 {
+			errs = append(errs, fmt.Errorf("firebase rounds out of range (got %d, must be 1-8)", cfg.FirebaseRounds))
+		}
+		if cfg.FirebaseMemCost < 1 || cfg.FirebaseMemCost > 14 {
+			errs = append(errs, fmt.Errorf("firebase mem cost out of range (got %d, must be 1-14)", cfg.FirebaseMemCost))
+		}
+	}
+
+	// NOTE: The keys themselves are checked when they're loaded at startup
+	peppered := cfg.Peppers != "" || cfg.PepperFile != ""
+	if cfg.Peppers != "" && cfg.PepperFile != "" {
+		errs = append(errs, errors.New("set password peppers or a pepper file, not both"))
+	}
+	if cfg.PepperVersion < 0 {
+		errs = append(errs, fmt.Errorf("invalid pepper version: %d", cfg.PepperVersion))
+	}
+	if peppered && cfg.PepperVersion == 0 {
+		errs = append(errs, errors.New("pepper version is required with password peppers"))
+	}
+	if !peppered && cfg.PepperVersion > 0 {
+		errs = append(errs, errors.New("password peppers or a pepper file are required with a pepper version"))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// validateLockout validates brute-force protection settings
+func validateLockout(cfg *LockoutConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var errs []error
+
+	if cfg.FreeAttempts < 0 {
+		errs = append(errs, errors.New("lockout free attempts cannot be negative"))
+	}
+
+	if cfg.BaseDelay <= 0 || cfg.MaxDelay < cfg.BaseDelay {
+		errs = append(errs, fmt.Errorf("lockout delays invalid (base %s, max %s)", cfg.BaseDelay, cfg.MaxDelay))
+	}
+
+	if cfg.AccountThreshold <= cfg.FreeAttempts || cfg.ClientThreshold <= cfg.FreeAttempts {
+		errs = append(errs, errors.New("lockout thresholds must be greater than free attempts"))
+	}
+
+	// WHY: Otherwise the account locks before any single client is stopped
+	if cfg.ClientThreshold > cfg.AccountThreshold {
+		errs = append(errs, errors.New("lockout client threshold cannot exceed account threshold"))
+	}
+
+	if cfg.Duration <= 0 {
+		errs = append(errs, errors.New("lockout duration must be positive"))
+	}
+
+	// Counters must outlive the lockout and backoff they drive
+	if cfg.Window < cfg.Duration || cfg.Window < cfg.MaxDelay {
+		errs = append(errs, fmt.Errorf("lockout window (%s) must cover lockout duration and max delay", cfg.Window))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// validateMFA validates multi-factor authentication settings
+func validateMFA(cfg *MFAConfig) error {
+	var errs []error
+
+	if cfg.Issuer == "" {
+		errs = append(errs, errors.New("MFA issuer is required"))
+	}
+
+	if cfg.EncryptionKey == "" {
+		errs = append(errs, errors.New("MFA encryption key is required (base64, 32 bytes)"))
+	} else if key, err := base64.StdEncoding.DecodeString(cfg.EncryptionKey); err != nil || len(key) != 32 {
+		errs = append(errs, errors.New("MFA encryption key must be 32 bytes, base64 encoded"))
+	}
+
+	if cfg.ChallengeExpiry <= 0 {
+		errs = append(errs, errors.New("MFA challenge expiry must be positive"))
+	}
+
+	// SECURITY: The challenge stands in for a verified password - keep it short-lived
+	if cfg.ChallengeExpiry > 15*time.Minute {
+		errs = append(errs, fmt.Errorf("MFA challenge expiry too long (got %s, max 15m)", cfg.ChallengeExpiry))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// validateWebAuthn validates passkey relying party settings
+func validateWebAuthn(cfg *WebAuthnConfig) error {
+	var errs []error
+
+	if cfg.RPID == "" {
+		errs = append(errs, errors.New("WebAuthn RP ID is required"))
+	}
+
+	if cfg.RPDisplayName == "" {
+		errs = append(errs, errors.New("WebAuthn RP display name is required"))
+	}
+
+	if len(cfg.RPOrigins) == 0 {
+		errs = append(errs, errors.New("at least one WebAuthn origin is required"))
+	}
+	for _, origin := range cfg.RPOrigins {
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("invalid WebAuthn origin %q (expected scheme://host[:port])", origin))
+		}
+	}
+
+	if cfg.Timeout <= 0 {
+		errs = append(errs, errors.New("WebAuthn timeout must be positive"))
+	}
+
+	if cfg.Timeout > 10*time.Minute {
+		errs = append(errs, fmt.Errorf("WebAuthn timeout too long (got %s, max 10m)", cfg.Timeout))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// validateOAuth validates authorization server settings
+func validateOAuth(cfg *OAuthConfig) error {
+	var errs []error
+
+	u, err := url.Parse(cfg.ConsentURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("invalid OAuth consent URL %q", cfg.ConsentURL))
+	}
+
+	if cfg.CodeExpiry <= 0 {
+		errs = append(errs, errors.New("OAuth code expiry must be positive"))
+	}
+
+	// WHY: RFC 6749 section 4.1.2 recommends at most 10 minutes
+	if cfg.CodeExpiry > 10*time.Minute {
+		errs = append(errs, fmt.Errorf("OAuth code expiry too long (got %s, max 10m)", cfg.CodeExpiry))
+	}
+
+	// WHY: Clients compare iss to the issuer byte for byte, so no query,
+	// fragment or trailing slash (OpenID Connect Discovery section 3)
+	if cfg.Issuer != "" {
+		u, err := url.Parse(cfg.Issuer)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" ||
+			u.RawQuery != "" || u.Fragment != "" || strings.HasSuffix(cfg.Issuer, "/") {
+			errs = append(errs, fmt.Errorf("invalid OAuth issuer %q (absolute URL without query, fragment or trailing slash)", cfg.Issuer))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// validateMail validates outbound email settings
+func validateMail(cfg *MailConfig) error {
+	var errs []error
+
+	validTransports := []string{"log", "smtp", "file"}
+	if !slices.Contains(validTransports, cfg.Transport) {
+		errs = append(errs, fmt.Errorf("invalid mail transport: %s (must be one of: %s)",
+			cfg.Transport, strings.Join(validTransports, ", ")))
+	}
+
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		errs = append(errs, fmt.Errorf("invalid mail sender %q: %v", cfg.From, err))
+	}
+
+	if cfg.Transport == "smtp" {
+		if cfg.SMTPHost == "" {
+			errs = append(errs, errors.New("SMTP host is required for the smtp mail transport"))
+		}
+
+		if port, err := strconv.Atoi(cfg.SMTPPort); err != nil || port <= 0 || port > 65535 {
+			errs = append(errs, fmt.Errorf("invalid SMTP port: %s", cfg.SMTPPort))
+		}
+
+		validTLSModes := []string{"starttls", "tls", "none"}
+		if !slices.Contains(validTLSModes, cfg.SMTPTLS) {
+			errs = append(errs, fmt.Errorf("invalid SMTP TLS mode: %s (must be one of: %s)",
+				cfg.SMTPTLS, strings.Join(validTLSModes, ", ")))
+		}
+
+		// SECURITY: Don't send relay credentials in the clear
+		if cfg.SMTPTLS == "none" && cfg.SMTPUsername != "" {
+			errs = append(errs, errors.New("SMTP credentials require TLS (starttls or tls)"))
+		}
+
+		if cfg.SMTPTimeout <= 0 {
+			errs = append(errs, errors.New("SMTP timeout must be positive"))
+		}
+	}
+
+	if cfg.Transport == "file" && cfg.OutboxDir == "" {
+		errs = append(errs, errors.New("mail outbox directory is required for the file mail transport"))
+	}
+
+	if cfg.DefaultLocale == "" {
+		errs = append(errs, errors.New("mail default locale is required"))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
 // validateLogger validates logger configuration
 func validateLogger(cfg *LoggerConfig) error {
 	var errs []error
@@ -0,0 +1,354 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/delivery/grpc/interceptor"
+	"github.com/Ruseigha/LabukaAuth/internal/delivery/grpc/proto/proto"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NOTE: The admin RPCs rely on interceptor.Authorize for authentication
+// and the admin role check
+
+// GetUserAccess implements gRPC GetUserAccess RPC
+func (h *AuthHandler) GetUserAccess(ctx context.Context, req *proto.GetUserAccessRequest) (*proto.UserAccessResponse, error) {
+	// Validate
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Call use case
+	access, err := h.authService.GetUserAccess(ctx, req.UserId)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return toUserAccessResponse(access), nil
+}
+
+// GrantRole implements gRPC GrantRole RPC
+func (h *AuthHandler) GrantRole(ctx context.Context, req *proto.UserAccessRequest) (*proto.UserAccessResponse, error) {
+	return h.changeAccess(ctx, req, h.authService.GrantRole)
+}
+
+// RevokeRole implements gRPC RevokeRole RPC
+func (h *AuthHandler) RevokeRole(ctx context.Context, req *proto.UserAccessRequest) (*proto.UserAccessResponse, error) {
+	return h.changeAccess(ctx, req, h.authService.RevokeRole)
+}
+
+// GrantPermission implements gRPC GrantPermission RPC
+func (h *AuthHandler) GrantPermission(ctx context.Context, req *proto.UserAccessRequest) (*proto.UserAccessResponse, error) {
+	return h.changeAccess(ctx, req, h.authService.GrantPermission)
+}
+
+// RevokePermission implements gRPC RevokePermission RPC
+func (h *AuthHandler) RevokePermission(ctx context.Context, req *proto.UserAccessRequest) (*proto.UserAccessResponse, error) {
+	return h.changeAccess(ctx, req, h.authService.RevokePermission)
+}
+
+// changeAccess validates an access change and applies it as the calling admin
+func (h *AuthHandler) changeAccess(
+	ctx context.Context,
+	req *proto.UserAccessRequest,
+	apply func(context.Context, usecase.UserAccessRequest) (*usecase.UserAccess, error),
+) (*proto.UserAccessResponse, error) {
+	// Validate
+	if req.UserId == "" || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and name are required")
+	}
+
+	actorID, err := adminActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	access, err := apply(ctx, usecase.UserAccessRequest{
+		ActorID: actorID,
+		UserID:  req.UserId,
+		Name:    req.Name,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return toUserAccessResponse(access), nil
+}
+
+// ListUsers implements gRPC ListUsers RPC
+func (h *AuthHandler) ListUsers(ctx context.Context, req *proto.ListUsersRequest) (*proto.ListUsersResponse, error) {
+	actorID, err := adminActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	list, err := h.authService.ListUsers(ctx, usecase.ListUsersRequest{
+		ActorID: actorID,
+		Offset:  int(req.Offset),
+		Limit:   int(req.Limit),
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	resp := &proto.ListUsersResponse{
+		Users:  make([]*proto.UserResponse, len(list.Users)),
+		Total:  list.Total,
+		Offset: int32(list.Offset),
+		Limit:  int32(list.Limit),
+	}
+	for i := range list.Users {
+		resp.Users[i] = toUserResponse(&list.Users[i])
+	}
+	return resp, nil
+}
+
+// GetUser implements gRPC GetUser RPC
+func (h *AuthHandler) GetUser(ctx context.Context, req *proto.GetUserRequest) (*proto.UserResponse, error) {
+	// Validate
+	if (req.UserId == "") == (req.Email == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of user_id and email is required")
+	}
+
+	actorID, err := adminActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	user, err := h.authService.GetUser(ctx, usecase.GetUserRequest{
+		ActorID: actorID,
+		UserID:  req.UserId,
+		Email:   req.Email,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return toUserResponse(user), nil
+}
+
+// ActivateUser implements gRPC ActivateUser RPC
+func (h *AuthHandler) ActivateUser(ctx context.Context, req *proto.AdminUserRequest) (*proto.UserResponse, error) {
+	return h.setUserActive(ctx, req, h.authService.ActivateUser)
+}
+
+// DeactivateUser implements gRPC DeactivateUser RPC
+func (h *AuthHandler) DeactivateUser(ctx context.Context, req *proto.AdminUserRequest) (*proto.UserResponse, error) {
+	return h.setUserActive(ctx, req, h.authService.DeactivateUser)
+}
+
+// ForcePasswordReset implements gRPC ForcePasswordReset RPC
+func (h *AuthHandler) ForcePasswordReset(ctx context.Context, req *proto.AdminUserRequest) (*proto.ForcePasswordResetResponse, error) {
+	adminReq, err := toAdminUserRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.authService.ForcePasswordReset(ctx, adminReq); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.ForcePasswordResetResponse{Success: true}, nil
+}
+
+// RevokeUserSessions implements gRPC RevokeUserSessions RPC
+func (h *AuthHandler) RevokeUserSessions(ctx context.Context, req *proto.AdminUserRequest) (*proto.RevokeUserSessionsResponse, error) {
+	adminReq, err := toAdminUserRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.authService.RevokeUserSessions(ctx, adminReq); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.RevokeUserSessionsResponse{Success: true}, nil
+}
+
+// UnlockAccount implements gRPC UnlockAccount RPC
+func (h *AuthHandler) UnlockAccount(ctx context.Context, req *proto.AdminUserRequest) (*proto.UnlockAccountResponse, error) {
+	adminReq, err := toAdminUserRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.authService.UnlockAccount(ctx, adminReq); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.UnlockAccountResponse{Success: true}, nil
+}
+
+// DeleteUser implements gRPC DeleteUser RPC
+func (h *AuthHandler) DeleteUser(ctx context.Context, req *proto.AdminUserRequest) (*proto.DeleteUserResponse, error) {
+	adminReq, err := toAdminUserRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.authService.DeleteUser(ctx, adminReq); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.DeleteUserResponse{Success: true}, nil
+}
+
+// CreateInvitation implements gRPC CreateInvitation RPC
+func (h *AuthHandler) CreateInvitation(ctx context.Context, req *proto.CreateInvitationRequest) (*proto.InvitationResponse, error) {
+	// Validate
+	if req.Email == "" || req.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "email and role are required")
+	}
+
+	actorID, err := adminActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	invitation, err := h.authService.CreateInvitation(ctx, usecase.CreateInvitationRequest{
+		ActorID: actorID,
+		Email:   req.Email,
+		Role:    req.Role,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return toInvitationResponse(invitation), nil
+}
+
+// ListInvitations implements gRPC ListInvitations RPC
+func (h *AuthHandler) ListInvitations(ctx context.Context, req *proto.ListInvitationsRequest) (*proto.ListInvitationsResponse, error) {
+	actorID, err := adminActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	invitations, err := h.authService.ListInvitations(ctx, actorID)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	resp := &proto.ListInvitationsResponse{
+		Invitations: make([]*proto.InvitationResponse, len(invitations)),
+	}
+	for i := range invitations {
+		resp.Invitations[i] = toInvitationResponse(&invitations[i])
+	}
+	return resp, nil
+}
+
+// RevokeInvitation implements gRPC RevokeInvitation RPC
+func (h *AuthHandler) RevokeInvitation(ctx context.Context, req *proto.RevokeInvitationRequest) (*proto.InvitationResponse, error) {
+	// Validate
+	if req.InvitationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invitation_id is required")
+	}
+
+	actorID, err := adminActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	invitation, err := h.authService.RevokeInvitation(ctx, usecase.RevokeInvitationRequest{
+		ActorID:      actorID,
+		InvitationID: req.InvitationId,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return toInvitationResponse(invitation), nil
+}
+
+// setUserActive activates or deactivates a user as the calling admin
+func (h *AuthHandler) setUserActive(
+	ctx context.Context,
+	req *proto.AdminUserRequest,
+	apply func(context.Context, usecase.AdminUserRequest) (*usecase.UserDetails, error),
+) (*proto.UserResponse, error) {
+	adminReq, err := toAdminUserRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := apply(ctx, adminReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return toUserResponse(user), nil
+}
+
+// toAdminUserRequest validates req and targets its user as the calling admin
+func toAdminUserRequest(ctx context.Context, req *proto.AdminUserRequest) (usecase.AdminUserRequest, error) {
+	if req.UserId == "" {
+		return usecase.AdminUserRequest{}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	actorID, err := adminActor(ctx)
+	if err != nil {
+		return usecase.AdminUserRequest{}, err
+	}
+
+	return usecase.AdminUserRequest{ActorID: actorID, UserID: req.UserId}, nil
+}
+
+// adminActor returns the calling admin's user ID (set by the authorize interceptor)
+func adminActor(ctx context.Context) (string, error) {
+	caller := interceptor.ClaimsFromContext(ctx)
+	if caller == nil {
+		return "", status.Error(codes.Unauthenticated, "not authenticated")
+	}
+	return caller.UserID, nil
+}
+
+func toUserResponse(user *usecase.UserDetails) *proto.UserResponse {
+	return &proto.UserResponse{
+		Id:                    user.ID,
+		TenantId:              user.TenantID,
+		Email:                 user.Email,
+		IsActive:              user.IsActive,
+		EmailVerified:         user.EmailVerified,
+		MfaEnabled:            user.MFAEnabled,
+		PasswordResetRequired: user.PasswordResetRequired,
+		Roles:                 user.Roles,
+		Permissions:           user.Permissions,
+		CreatedAt:             user.CreatedAt.Unix(),
+		UpdatedAt:             user.UpdatedAt.Unix(),
+	}
+}
+
+func toInvitationResponse(invitation *usecase.InvitationDetails) *proto.InvitationResponse {
+	resp := &proto.InvitationResponse{
+		Id:         invitation.ID,
+		TenantId:   invitation.TenantID,
+		Email:      invitation.Email,
+		Role:       invitation.Role,
+		Status:     invitation.Status,
+		InvitedBy:  invitation.InvitedBy,
+		CreatedAt:  invitation.CreatedAt.Unix(),
+		ExpiresAt:  invitation.ExpiresAt.Unix(),
+		AcceptedBy: invitation.AcceptedBy,
+	}
+	if invitation.AcceptedAt != nil {
+		resp.AcceptedAt = invitation.AcceptedAt.Unix()
+	}
+	return resp
+}
+
+func toUserAccessResponse(access *usecase.UserAccess) *proto.UserAccessResponse {
+	return &proto.UserAccessResponse{
+		UserId:      access.UserID,
+		Roles:       access.Roles,
+		Permissions: access.Permissions,
+	}
+}
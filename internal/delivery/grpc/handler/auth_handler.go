@@ -3,12 +3,16 @@ package handler
 import (
 	"context"
 	"errors"
+	"net"
 
 	"github.com/Ruseigha/LabukaAuth/internal/delivery/grpc/proto/proto"
 	domainerrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
 	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // AuthHandler implements gRPC AuthServiceServer
@@ -47,10 +51,11 @@ func (h *AuthHandler) Signup(ctx context.Context, req *proto.SignupRequest) (*pr
 
 	// Return response
 	return &proto.AuthResponse{
-		UserId:       resp.UserID,
-		Email:        resp.Email,
-		AccessToken:  resp.AccessToken,
-		RefreshToken: resp.RefreshToken,
+		UserId:               resp.UserID,
+		Email:                resp.Email,
+		AccessToken:          resp.AccessToken,
+		RefreshToken:         resp.RefreshToken,
+		VerificationRequired: resp.VerificationRequired,
 	}, nil
 }
 
@@ -67,14 +72,42 @@ func (h *AuthHandler) Login(ctx context.Context, req *proto.LoginRequest) (*prot
 
 	// Call use case
 	resp, err := h.authService.Login(ctx, usecase.LoginRequest{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     req.Email,
+		Password:  req.Password,
+		IPAddress: peerIP(ctx),
 	})
 
 	if err != nil {
 		return nil, mapDomainErrorToGRPC(err)
 	}
 
+	return &proto.AuthResponse{
+		UserId:       resp.UserID,
+		Email:        resp.Email,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		MfaRequired:  resp.MFARequired,
+		MfaToken:     resp.MFAToken,
+	}, nil
+}
+
+// VerifyMFA implements gRPC VerifyMFA RPC
+func (h *AuthHandler) VerifyMFA(ctx context.Context, req *proto.VerifyMFARequest) (*proto.AuthResponse, error) {
+	// Validate
+	if req.MfaToken == "" || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "mfa_token and code are required")
+	}
+
+	// Call use case
+	resp, err := h.authService.VerifyMFA(ctx, usecase.VerifyMFARequest{
+		MFAToken:  req.MfaToken,
+		Code:      req.Code,
+		IPAddress: peerIP(ctx),
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
 	return &proto.AuthResponse{
 		UserId:       resp.UserID,
 		Email:        resp.Email,
@@ -118,12 +151,309 @@ func (h *AuthHandler) ValidateToken(ctx context.Context, req *proto.ValidateToke
 	}
 
 	return &proto.ValidateTokenResponse{
-		Valid:  true,
-		UserId: claims.UserID,
-		Email:  claims.Email,
+		Valid:       true,
+		UserId:      claims.UserID,
+		TenantId:    claims.TenantID,
+		Email:       claims.Email,
+		Roles:       claims.Roles,
+		Permissions: claims.Permissions,
+		ClientId:    claims.ClientID,
+		Scopes:      claims.Scopes,
+	}, nil
+}
+
+// Logout implements gRPC Logout RPC
+func (h *AuthHandler) Logout(ctx context.Context, req *proto.LogoutRequest) (*proto.LogoutResponse, error) {
+	// Validate
+	if req.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "access_token is required")
+	}
+
+	// Call use case
+	err := h.authService.Logout(ctx, usecase.LogoutRequest{
+		AccessToken:  req.AccessToken,
+		RefreshToken: req.RefreshToken,
+	})
+
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.LogoutResponse{Success: true}, nil
+}
+
+// RevokeToken implements gRPC RevokeToken RPC
+func (h *AuthHandler) RevokeToken(ctx context.Context, req *proto.RevokeTokenRequest) (*proto.RevokeTokenResponse, error) {
+	// Validate
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	// Call use case
+	if err := h.authService.RevokeToken(ctx, req.Token); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.RevokeTokenResponse{Revoked: true}, nil
+}
+
+// SendVerification implements gRPC SendVerification RPC
+func (h *AuthHandler) SendVerification(ctx context.Context, req *proto.SendVerificationRequest) (*proto.SendVerificationResponse, error) {
+	// Validate
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	// Call use case
+	if err := h.authService.SendVerification(ctx, req.Email); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.SendVerificationResponse{Accepted: true}, nil
+}
+
+// VerifyEmail implements gRPC VerifyEmail RPC
+func (h *AuthHandler) VerifyEmail(ctx context.Context, req *proto.VerifyEmailRequest) (*proto.VerifyEmailResponse, error) {
+	// Validate
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	// Call use case
+	if err := h.authService.VerifyEmail(ctx, req.Token); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.VerifyEmailResponse{Verified: true}, nil
+}
+
+// RequestPasswordReset implements gRPC RequestPasswordReset RPC
+func (h *AuthHandler) RequestPasswordReset(ctx context.Context, req *proto.RequestPasswordResetRequest) (*proto.RequestPasswordResetResponse, error) {
+	// Validate
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	// Call use case
+	if err := h.authService.RequestPasswordReset(ctx, req.Email); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.RequestPasswordResetResponse{Accepted: true}, nil
+}
+
+// ResetPassword implements gRPC ResetPassword RPC
+func (h *AuthHandler) ResetPassword(ctx context.Context, req *proto.ResetPasswordRequest) (*proto.ResetPasswordResponse, error) {
+	// Validate
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+
+	// Call use case
+	err := h.authService.ResetPassword(ctx, usecase.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.ResetPasswordResponse{PasswordReset: true}, nil
+}
+
+// ChangePassword implements gRPC ChangePassword RPC
+func (h *AuthHandler) ChangePassword(ctx context.Context, req *proto.ChangePasswordRequest) (*proto.AuthResponse, error) {
+	// Validate
+	if req.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "access_token is required")
+	}
+	if req.CurrentPassword == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "current_password and new_password are required")
+	}
+
+	// Authenticate caller
+	claims, err := h.authenticateUser(ctx, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	resp, err := h.authService.ChangePassword(ctx, usecase.ChangePasswordRequest{
+		UserID:          claims.UserID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.AuthResponse{
+		UserId:       claims.UserID,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	}, nil
+}
+
+// ChangeEmail implements gRPC ChangeEmail RPC
+func (h *AuthHandler) ChangeEmail(ctx context.Context, req *proto.ChangeEmailRequest) (*proto.ChangeEmailResponse, error) {
+	// Validate
+	if req.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "access_token is required")
+	}
+	if req.CurrentPassword == "" || req.NewEmail == "" {
+		return nil, status.Error(codes.InvalidArgument, "current_password and new_email are required")
+	}
+
+	// Authenticate caller
+	claims, err := h.authenticateUser(ctx, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	resp, err := h.authService.ChangeEmail(ctx, usecase.ChangeEmailRequest{
+		UserID:          claims.UserID,
+		CurrentPassword: req.CurrentPassword,
+		NewEmail:        req.NewEmail,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.ChangeEmailResponse{
+		Email:        resp.Email,
+		PendingEmail: resp.PendingEmail,
+	}, nil
+}
+
+// EnrollMFA implements gRPC EnrollMFA RPC
+func (h *AuthHandler) EnrollMFA(ctx context.Context, req *proto.EnrollMFARequest) (*proto.EnrollMFAResponse, error) {
+	// Validate
+	if req.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "access_token is required")
+	}
+	if req.CurrentPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "current_password is required")
+	}
+
+	// Authenticate caller
+	claims, err := h.authenticateUser(ctx, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	resp, err := h.authService.EnrollMFA(ctx, usecase.EnrollMFARequest{
+		UserID:          claims.UserID,
+		CurrentPassword: req.CurrentPassword,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.EnrollMFAResponse{
+		Secret:     resp.Secret,
+		OtpauthUri: resp.URI,
 	}, nil
 }
 
+// ConfirmMFA implements gRPC ConfirmMFA RPC
+func (h *AuthHandler) ConfirmMFA(ctx context.Context, req *proto.ConfirmMFARequest) (*proto.ConfirmMFAResponse, error) {
+	// Validate
+	if req.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "access_token is required")
+	}
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	// Authenticate caller
+	claims, err := h.authenticateUser(ctx, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	resp, err := h.authService.ConfirmMFA(ctx, usecase.ConfirmMFARequest{
+		UserID: claims.UserID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.ConfirmMFAResponse{
+		RecoveryCodes: resp.RecoveryCodes,
+	}, nil
+}
+
+// DisableMFA implements gRPC DisableMFA RPC
+func (h *AuthHandler) DisableMFA(ctx context.Context, req *proto.DisableMFARequest) (*proto.DisableMFAResponse, error) {
+	// Validate
+	if req.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "access_token is required")
+	}
+	if req.CurrentPassword == "" || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "current_password and code are required")
+	}
+
+	// Authenticate caller
+	claims, err := h.authenticateUser(ctx, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	err = h.authService.DisableMFA(ctx, usecase.DisableMFARequest{
+		UserID:          claims.UserID,
+		CurrentPassword: req.CurrentPassword,
+		Code:            req.Code,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.DisableMFAResponse{Disabled: true}, nil
+}
+
+// authenticateUser validates an access token for a call the user makes themselves
+// SECURITY: Tokens issued to OAuth clients and personal access tokens are
+// refused, as on the HTTP routes - delegated access never extends to
+// account security operations
+func (h *AuthHandler) authenticateUser(ctx context.Context, accessToken string) (*usecase.TokenClaims, error) {
+	claims, err := h.authService.ValidateToken(ctx, accessToken)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	if claims.ClientID != "" {
+		return nil, status.Error(codes.PermissionDenied, "token was issued to an OAuth client")
+	}
+
+	if claims.PersonalAccessTokenID != "" {
+		return nil, status.Error(codes.PermissionDenied, "personal access tokens are not accepted here")
+	}
+
+	return claims, nil
+}
+
+// peerIP returns the caller's IP address, or "" if unknown
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
 // mapDomainErrorToGRPC maps domain errors to gRPC status codes
 func mapDomainErrorToGRPC(err error) error {
 	// Map domain errors to gRPC codes
@@ -143,7 +473,101 @@ func mapDomainErrorToGRPC(err error) error {
 	case errors.Is(err, domainerrors.ErrConflict):
 		return status.Error(codes.AlreadyExists, err.Error())
 
+	case errors.Is(err, domainerrors.ErrTooManyAttempts), errors.Is(err, domainerrors.ErrAccountLocked):
+		st := status.New(codes.ResourceExhausted, err.Error())
+		if retryAfter, ok := domainerrors.RetryAfter(err); ok {
+			// Standard RetryInfo detail - gRPC clients read it for backoff
+			if detailed, detailErr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); detailErr == nil {
+				st = detailed
+			}
+		}
+		return st.Err()
+
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
 }
+
+// RequestLoginCode implements gRPC RequestLoginCode RPC
+func (h *AuthHandler) RequestLoginCode(ctx context.Context, req *proto.RequestLoginCodeRequest) (*proto.RequestLoginCodeResponse, error) {
+	// Validate
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	// Call use case
+	if err := h.authService.RequestLoginCode(ctx, req.Email); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.RequestLoginCodeResponse{Accepted: true}, nil
+}
+
+// RequestMagicLink implements gRPC RequestMagicLink RPC
+func (h *AuthHandler) RequestMagicLink(ctx context.Context, req *proto.RequestMagicLinkRequest) (*proto.RequestMagicLinkResponse, error) {
+	// Validate
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	// Call use case
+	if err := h.authService.RequestMagicLink(ctx, req.Email); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.RequestMagicLinkResponse{Accepted: true}, nil
+}
+
+// VerifyLoginCode implements gRPC VerifyLoginCode RPC
+func (h *AuthHandler) VerifyLoginCode(ctx context.Context, req *proto.VerifyLoginCodeRequest) (*proto.AuthResponse, error) {
+	// Validate
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	// Call use case
+	resp, err := h.authService.VerifyLoginCode(ctx, usecase.VerifyLoginCodeRequest{
+		Email:     req.Email,
+		Code:      req.Code,
+		IPAddress: peerIP(ctx),
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.AuthResponse{
+		UserId:       resp.UserID,
+		Email:        resp.Email,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		MfaRequired:  resp.MFARequired,
+		MfaToken:     resp.MFAToken,
+	}, nil
+}
+
+// AcceptInvitation implements gRPC AcceptInvitation RPC
+func (h *AuthHandler) AcceptInvitation(ctx context.Context, req *proto.AcceptInvitationRequest) (*proto.AcceptInvitationResponse, error) {
+	// Validate
+	if req.Token == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and password are required")
+	}
+
+	// Call use case
+	resp, err := h.authService.AcceptInvitation(ctx, usecase.AcceptInvitationRequest{
+		Token:     req.Token,
+		Password:  req.Password,
+		IPAddress: peerIP(ctx),
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &proto.AcceptInvitationResponse{
+		UserId:         resp.UserID,
+		Email:          resp.Email,
+		Role:           resp.Role,
+		AccountCreated: resp.AccountCreated,
+		AccessToken:    resp.AccessToken,
+		RefreshToken:   resp.RefreshToken,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	domainerrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Policy is the access a gRPC method requires
+type Policy struct {
+	Roles       []string // Caller needs at least one (none = any role)
+	Permissions []string // Caller needs all
+}
+
+// RequireRole returns a policy allowing callers with at least one of roles
+// WHY: Mirrors the HTTP middleware of the same name
+func RequireRole(roles ...string) Policy {
+	return Policy{Roles: roles}
+}
+
+// RequirePermission returns a policy allowing callers with all of permissions
+func RequirePermission(permissions ...string) Policy {
+	return Policy{Permissions: permissions}
+}
+
+// allows reports whether claims satisfy the policy
+func (p Policy) allows(claims *usecase.TokenClaims) bool {
+	if len(p.Roles) > 0 && !slices.ContainsFunc(p.Roles, func(role string) bool { return slices.Contains(claims.Roles, role) }) {
+		return false
+	}
+	for _, permission := range p.Permissions {
+		if !slices.Contains(claims.Permissions, permission) {
+			return false
+		}
+	}
+	return true
+}
+
+type claimsKey struct{}
+
+// Authorize authenticates calls to the methods in policies and enforces them
+// Callers send "authorization: Bearer <access token>" metadata; methods not
+// in policies pass through untouched
+func Authorize(authService usecase.AuthUseCase, policies map[string]Policy) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		policy, ok := policies[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		token := bearerToken(ctx)
+		if token == "" {
+			return nil, status.Error(codes.Unauthenticated, "missing authorization metadata")
+		}
+
+		claims, err := authService.ValidateToken(ctx, token)
+		if err != nil {
+			if errors.Is(err, domainerrors.ErrForbidden) {
+				return nil, status.Error(codes.PermissionDenied, err.Error())
+			}
+			if errors.Is(err, domainerrors.ErrUnauthorized) {
+				return nil, status.Error(codes.Unauthenticated, "invalid token")
+			}
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		// SECURITY: Tokens issued to OAuth clients never reach admin methods
+		if claims.ClientID != "" {
+			return nil, status.Error(codes.PermissionDenied, "token was issued to an OAuth client")
+		}
+
+		if !policy.allows(claims) {
+			return nil, status.Error(codes.PermissionDenied, "missing required role or permission")
+		}
+
+		return handler(context.WithValue(ctx, claimsKey{}, claims), req)
+	}
+}
+
+// ClaimsFromContext returns the caller's claims set by Authorize (nil if none)
+func ClaimsFromContext(ctx context.Context) *usecase.TokenClaims {
+	claims, _ := ctx.Value(claimsKey{}).(*usecase.TokenClaims)
+	return claims
+}
+
+// bearerToken extracts the token from authorization metadata
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return ""
+	}
+
+	const bearerPrefix = "Bearer "
+	if !strings.HasPrefix(values[0], bearerPrefix) {
+		return ""
+	}
+	return values[0][len(bearerPrefix):]
+}
@@ -0,0 +1,40 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientInfo records the peer address and user-agent metadata on the request context
+// WHY: Sessions show users where they are signed in
+func ClientInfo() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		var client usecase.ClientInfo
+
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			host, _, err := net.SplitHostPort(p.Addr.String())
+			if err != nil {
+				host = p.Addr.String()
+			}
+			client.IPAddress = host
+		}
+
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("user-agent"); len(values) > 0 {
+				client.UserAgent = values[0]
+			}
+		}
+
+		return handler(usecase.WithClientInfo(ctx, client), req)
+	}
+}
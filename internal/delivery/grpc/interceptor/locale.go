@@ -0,0 +1,30 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Locale records the accept-language metadata on the request context
+// WHY: Emails triggered by the call use it to pick a template translation
+func Locale() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("accept-language"); len(values) > 0 {
+				if locale := mail.PreferredLocale(values[0]); locale != "" {
+					ctx = mail.WithLocale(ctx, locale)
+				}
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,38 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TenantMetadataKey names the organization a call is for
+const TenantMetadataKey = "x-tenant-id"
+
+// Tenant records the x-tenant-id metadata on the request context
+// NOTE: Must run before Authorize - token checks compare against it
+func Tenant() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(TenantMetadataKey); len(values) > 0 && values[0] != "" {
+				tenantID, err := valueobject.NewTenantID(values[0])
+				if err != nil {
+					return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+				}
+				ctx = usecase.WithTenant(ctx, tenantID)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
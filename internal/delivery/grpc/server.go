@@ -7,6 +7,7 @@ import (
 	"github.com/Ruseigha/LabukaAuth/internal/delivery/grpc/handler"
 	"github.com/Ruseigha/LabukaAuth/internal/delivery/grpc/interceptor"
 	"github.com/Ruseigha/LabukaAuth/internal/delivery/grpc/proto/proto"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
 	"github.com/Ruseigha/LabukaAuth/internal/usecase"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -17,8 +18,12 @@ func SetupServer(authService usecase.AuthUseCase) *grpc.Server {
 	// Create server with interceptors (middleware)
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
-			interceptor.Recovery(), // First: catch panics
-			interceptor.Logger(),   // Second: log requests
+			interceptor.Recovery(),   // First: catch panics
+			interceptor.Logger(),     // Second: log requests
+			interceptor.Locale(),     // Third: language for outgoing mail
+			interceptor.Tenant(),     // Fourth: organization (before any token check)
+			interceptor.ClientInfo(), // Fifth: device, recorded on sessions
+			interceptor.Authorize(authService, adminPolicies()),
 		),
 	)
 
@@ -32,6 +37,29 @@ func SetupServer(authService usecase.AuthUseCase) *grpc.Server {
 	return server
 }
 
+// adminPolicies restricts the admin RPCs to users with the admin role
+func adminPolicies() map[string]interceptor.Policy {
+	admin := interceptor.RequireRole(entity.RoleAdmin)
+	return map[string]interceptor.Policy{
+		proto.AuthService_GetUserAccess_FullMethodName:      admin,
+		proto.AuthService_GrantRole_FullMethodName:          admin,
+		proto.AuthService_RevokeRole_FullMethodName:         admin,
+		proto.AuthService_GrantPermission_FullMethodName:    admin,
+		proto.AuthService_RevokePermission_FullMethodName:   admin,
+		proto.AuthService_ListUsers_FullMethodName:          admin,
+		proto.AuthService_GetUser_FullMethodName:            admin,
+		proto.AuthService_ActivateUser_FullMethodName:       admin,
+		proto.AuthService_DeactivateUser_FullMethodName:     admin,
+		proto.AuthService_ForcePasswordReset_FullMethodName: admin,
+		proto.AuthService_RevokeUserSessions_FullMethodName: admin,
+		proto.AuthService_UnlockAccount_FullMethodName:      admin,
+		proto.AuthService_DeleteUser_FullMethodName:         admin,
+		proto.AuthService_CreateInvitation_FullMethodName:   admin,
+		proto.AuthService_ListInvitations_FullMethodName:    admin,
+		proto.AuthService_RevokeInvitation_FullMethodName:   admin,
+	}
+}
+
 // StartServer starts gRPC server on specified port
 func StartServer(server *grpc.Server, port string) error {
 	// Listen on TCP port
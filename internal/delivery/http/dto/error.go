@@ -48,6 +48,10 @@ func MapDomainErrorToHTTP(err error) int {
 		return http.StatusConflict // 409
 	}
 
+	if errors.Is(err, domainerrors.ErrTooManyAttempts) || errors.Is(err, domainerrors.ErrAccountLocked) {
+		return http.StatusTooManyRequests // 429
+	}
+
 	// Default to internal server error
 	return http.StatusInternalServerError // 500
 }
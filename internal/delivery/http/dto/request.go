@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"encoding/json"
 	"errors"
 	"strings"
 )
@@ -63,3 +64,467 @@ func (r *RefreshTokenRequest) Validate() error {
 
 	return nil
 }
+
+// LogoutRequest represents logout HTTP request
+// NOTE: Access token comes from the Authorization header
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"` // Optional
+}
+
+// Validate validates logout request
+func (r *LogoutRequest) Validate() error {
+	r.RefreshToken = strings.TrimSpace(r.RefreshToken)
+	return nil
+}
+
+// RevokeTokenRequest represents token revocation HTTP request
+type RevokeTokenRequest struct {
+	Token string `json:"token"`
+}
+
+// Validate validates revoke token request
+func (r *RevokeTokenRequest) Validate() error {
+	r.Token = strings.TrimSpace(r.Token)
+
+	if r.Token == "" {
+		return errors.New("token is required")
+	}
+
+	return nil
+}
+
+// SendVerificationRequest represents a verification email (re)send request
+type SendVerificationRequest struct {
+	Email string `json:"email"`
+}
+
+// Validate validates send verification request
+func (r *SendVerificationRequest) Validate() error {
+	r.Email = strings.TrimSpace(r.Email)
+
+	if r.Email == "" {
+		return errors.New("email is required")
+	}
+
+	return nil
+}
+
+// VerifyEmailRequest represents email verification HTTP request
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// Validate validates verify email request
+func (r *VerifyEmailRequest) Validate() error {
+	r.Token = strings.TrimSpace(r.Token)
+
+	if r.Token == "" {
+		return errors.New("token is required")
+	}
+
+	return nil
+}
+
+// ForgotPasswordRequest represents a password reset link request
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+// Validate validates forgot password request
+func (r *ForgotPasswordRequest) Validate() error {
+	r.Email = strings.TrimSpace(r.Email)
+
+	if r.Email == "" {
+		return errors.New("email is required")
+	}
+
+	return nil
+}
+
+// ResetPasswordRequest represents password reset HTTP request
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+// Validate validates reset password request
+func (r *ResetPasswordRequest) Validate() error {
+	r.Token = strings.TrimSpace(r.Token)
+	r.NewPassword = strings.TrimSpace(r.NewPassword)
+
+	if r.Token == "" {
+		return errors.New("token is required")
+	}
+
+	if r.NewPassword == "" {
+		return errors.New("new password is required")
+	}
+
+	return nil
+}
+
+// ChangePasswordRequest represents an authenticated password change request
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// Validate validates change password request
+func (r *ChangePasswordRequest) Validate() error {
+	r.CurrentPassword = strings.TrimSpace(r.CurrentPassword)
+	r.NewPassword = strings.TrimSpace(r.NewPassword)
+
+	if r.CurrentPassword == "" {
+		return errors.New("current password is required")
+	}
+
+	if r.NewPassword == "" {
+		return errors.New("new password is required")
+	}
+
+	return nil
+}
+
+// ChangeEmailRequest represents an authenticated email change request
+type ChangeEmailRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewEmail        string `json:"new_email"`
+}
+
+// Validate validates change email request
+func (r *ChangeEmailRequest) Validate() error {
+	r.CurrentPassword = strings.TrimSpace(r.CurrentPassword)
+	r.NewEmail = strings.TrimSpace(r.NewEmail)
+
+	if r.CurrentPassword == "" {
+		return errors.New("current password is required")
+	}
+
+	if r.NewEmail == "" {
+		return errors.New("new email is required")
+	}
+
+	return nil
+}
+
+// VerifyMFARequest represents the second login step
+type VerifyMFARequest struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code"`
+}
+
+// Validate validates verify MFA request
+func (r *VerifyMFARequest) Validate() error {
+	r.MFAToken = strings.TrimSpace(r.MFAToken)
+	r.Code = strings.TrimSpace(r.Code)
+
+	if r.MFAToken == "" {
+		return errors.New("mfa token is required")
+	}
+
+	if r.Code == "" {
+		return errors.New("code is required")
+	}
+
+	return nil
+}
+
+// EnrollMFARequest represents a request to start TOTP enrollment
+type EnrollMFARequest struct {
+	CurrentPassword string `json:"current_password"`
+}
+
+// Validate validates enroll MFA request
+func (r *EnrollMFARequest) Validate() error {
+	r.CurrentPassword = strings.TrimSpace(r.CurrentPassword)
+
+	if r.CurrentPassword == "" {
+		return errors.New("current password is required")
+	}
+
+	return nil
+}
+
+// ConfirmMFARequest represents a code confirming TOTP enrollment
+type ConfirmMFARequest struct {
+	Code string `json:"code"`
+}
+
+// Validate validates confirm MFA request
+func (r *ConfirmMFARequest) Validate() error {
+	r.Code = strings.TrimSpace(r.Code)
+
+	if r.Code == "" {
+		return errors.New("code is required")
+	}
+
+	return nil
+}
+
+// DisableMFARequest represents a request to turn MFA off
+type DisableMFARequest struct {
+	CurrentPassword string `json:"current_password"`
+	Code            string `json:"code"`
+}
+
+// Validate validates disable MFA request
+func (r *DisableMFARequest) Validate() error {
+	r.CurrentPassword = strings.TrimSpace(r.CurrentPassword)
+	r.Code = strings.TrimSpace(r.Code)
+
+	if r.CurrentPassword == "" {
+		return errors.New("current password is required")
+	}
+
+	if r.Code == "" {
+		return errors.New("code is required")
+	}
+
+	return nil
+}
+
+// BeginPasskeyRegistrationRequest represents a request to add a passkey
+type BeginPasskeyRegistrationRequest struct {
+	CurrentPassword string `json:"current_password"`
+}
+
+// Validate validates begin passkey registration request
+func (r *BeginPasskeyRegistrationRequest) Validate() error {
+	r.CurrentPassword = strings.TrimSpace(r.CurrentPassword)
+
+	if r.CurrentPassword == "" {
+		return errors.New("current password is required")
+	}
+
+	return nil
+}
+
+// FinishPasskeyRegistrationRequest carries the credential from navigator.credentials.create()
+type FinishPasskeyRegistrationRequest struct {
+	ChallengeID string          `json:"challenge_id"`
+	Name        string          `json:"name"`
+	Credential  json.RawMessage `json:"credential"` // Passed to the WebAuthn verifier as-is
+}
+
+// Validate validates finish passkey registration request
+func (r *FinishPasskeyRegistrationRequest) Validate() error {
+	r.ChallengeID = strings.TrimSpace(r.ChallengeID)
+	r.Name = strings.TrimSpace(r.Name)
+
+	if r.ChallengeID == "" {
+		return errors.New("challenge id is required")
+	}
+
+	if len(r.Credential) == 0 {
+		return errors.New("credential is required")
+	}
+
+	return nil
+}
+
+// FinishPasskeyLoginRequest carries the assertion from navigator.credentials.get()
+type FinishPasskeyLoginRequest struct {
+	ChallengeID string          `json:"challenge_id"`
+	Credential  json.RawMessage `json:"credential"` // Passed to the WebAuthn verifier as-is
+}
+
+// Validate validates finish passkey login request
+func (r *FinishPasskeyLoginRequest) Validate() error {
+	r.ChallengeID = strings.TrimSpace(r.ChallengeID)
+
+	if r.ChallengeID == "" {
+		return errors.New("challenge id is required")
+	}
+
+	if len(r.Credential) == 0 {
+		return errors.New("credential is required")
+	}
+
+	return nil
+}
+
+// PasswordlessLoginRequest represents a login code or magic link request
+type PasswordlessLoginRequest struct {
+	Email string `json:"email"`
+}
+
+// Validate validates passwordless login request
+func (r *PasswordlessLoginRequest) Validate() error {
+	r.Email = strings.TrimSpace(r.Email)
+
+	if r.Email == "" {
+		return errors.New("email is required")
+	}
+
+	return nil
+}
+
+// VerifyLoginCodeRequest represents a login with an emailed code
+type VerifyLoginCodeRequest struct {
+	Email string `json:"email"`
+	Code  string `json:"code"`
+}
+
+// Validate validates verify login code request
+func (r *VerifyLoginCodeRequest) Validate() error {
+	r.Email = strings.TrimSpace(r.Email)
+	r.Code = strings.TrimSpace(r.Code)
+
+	if r.Email == "" {
+		return errors.New("email is required")
+	}
+
+	if r.Code == "" {
+		return errors.New("code is required")
+	}
+
+	return nil
+}
+
+// MagicLinkLoginRequest represents a login with a magic link token
+type MagicLinkLoginRequest struct {
+	Token string `json:"token"`
+}
+
+// Validate validates magic link login request
+func (r *MagicLinkLoginRequest) Validate() error {
+	r.Token = strings.TrimSpace(r.Token)
+
+	if r.Token == "" {
+		return errors.New("token is required")
+	}
+
+	return nil
+}
+
+// GrantRoleRequest represents an admin granting a role
+type GrantRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// Validate validates grant role request
+func (r *GrantRoleRequest) Validate() error {
+	r.Role = strings.TrimSpace(r.Role)
+
+	if r.Role == "" {
+		return errors.New("role is required")
+	}
+
+	return nil
+}
+
+// GrantPermissionRequest represents an admin granting a permission
+type GrantPermissionRequest struct {
+	Permission string `json:"permission"`
+}
+
+// Validate validates grant permission request
+func (r *GrantPermissionRequest) Validate() error {
+	r.Permission = strings.TrimSpace(r.Permission)
+
+	if r.Permission == "" {
+		return errors.New("permission is required")
+	}
+
+	return nil
+}
+
+// CreatePersonalAccessTokenRequest represents a request for a personal access token
+type CreatePersonalAccessTokenRequest struct {
+	Name            string   `json:"name"`
+	Scopes          []string `json:"scopes"`          // Roles and permissions of yours the token carries (none = identity only)
+	ExpiresInDays   int      `json:"expires_in_days"` // Omit for the default (30 days)
+	CurrentPassword string   `json:"current_password"`
+}
+
+// Validate validates create personal access token request
+func (r *CreatePersonalAccessTokenRequest) Validate() error {
+	r.Name = strings.TrimSpace(r.Name)
+	r.CurrentPassword = strings.TrimSpace(r.CurrentPassword)
+
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if r.ExpiresInDays < 0 {
+		return errors.New("expires_in_days must be positive")
+	}
+
+	if r.CurrentPassword == "" {
+		return errors.New("current password is required")
+	}
+
+	return nil
+}
+
+// CreateInvitationRequest represents an admin inviting someone into the organization
+type CreateInvitationRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// Validate validates create invitation request
+func (r *CreateInvitationRequest) Validate() error {
+	r.Email = strings.TrimSpace(r.Email)
+	r.Role = strings.TrimSpace(r.Role)
+
+	if r.Email == "" {
+		return errors.New("email is required")
+	}
+
+	if r.Role == "" {
+		return errors.New("role is required")
+	}
+
+	return nil
+}
+
+// AcceptInvitationRequest represents accepting an invitation link
+// NOTE: Password is the new account's password, or the current password
+// of the existing account being linked
+type AcceptInvitationRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// Validate validates accept invitation request
+func (r *AcceptInvitationRequest) Validate() error {
+	r.Token = strings.TrimSpace(r.Token)
+	r.Password = strings.TrimSpace(r.Password)
+
+	if r.Token == "" {
+		return errors.New("token is required")
+	}
+
+	if r.Password == "" {
+		return errors.New("password is required")
+	}
+
+	return nil
+}
+
+// ConsentRequest represents the user's answer on the OAuth consent screen
+// NOTE: The authorization request parameters are sent back unchanged from
+// the /oauth/authorize redirect; Approve false denies the client
+type ConsentRequest struct {
+	ResponseType        string `json:"response_type"`
+	ClientID            string `json:"client_id"`
+	RedirectURI         string `json:"redirect_uri"`
+	Scope               string `json:"scope"`
+	State               string `json:"state"`
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+	Nonce               string `json:"nonce"`
+	Approve             bool   `json:"approve"`
+}
+
+// Validate validates consent request
+func (r *ConsentRequest) Validate() error {
+	r.ClientID = strings.TrimSpace(r.ClientID)
+
+	if r.ClientID == "" {
+		return errors.New("client_id is required")
+	}
+
+	return nil
+}
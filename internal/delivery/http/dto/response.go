@@ -1,5 +1,10 @@
 package dto
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // AuthResponse represents authentication response with tokens
 // WHY: Consistent response structure for signup/login/refresh
 type AuthResponse struct {
@@ -7,13 +12,214 @@ type AuthResponse struct {
 	Email        string `json:"email,omitempty"` // Omit in refresh response
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
+
+	// VerificationRequired is set on signup when tokens are withheld
+	// until the email is verified
+	VerificationRequired bool `json:"verification_required,omitempty"`
+
+	// MFARequired is set on login when a second factor is needed;
+	// tokens are withheld until MFAToken and a code are sent to /auth/login/mfa
+	MFARequired bool   `json:"mfa_required,omitempty"`
+	MFAToken    string `json:"mfa_token,omitempty"`
 }
 
 // ValidateTokenResponse represents token validation response
 type ValidateTokenResponse struct {
-	Valid  bool   `json:"valid"`
-	UserID string `json:"user_id,omitempty"`
-	Email  string `json:"email,omitempty"`
+	Valid       bool     `json:"valid"`
+	UserID      string   `json:"user_id,omitempty"`
+	TenantID    string   `json:"tenant_id,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	ClientID    string   `json:"client_id,omitempty"` // Set for tokens issued to an OAuth or service client (service tokens have no user_id)
+	Scopes      []string `json:"scopes,omitempty"`
+}
+
+// EnrollMFAResponse represents a new TOTP secret
+type EnrollMFAResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+// RecoveryCodesResponse represents one-time MFA recovery codes
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// PasskeyChallengeResponse represents options for a WebAuthn browser call
+// NOTE: Options go to navigator.credentials.create()/get() unchanged;
+// echo ChallengeID back with the result
+type PasskeyChallengeResponse struct {
+	ChallengeID string          `json:"challenge_id"`
+	Options     json.RawMessage `json:"options"`
+}
+
+// PasskeyResponse represents a registered passkey
+type PasskeyResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// PasskeyListResponse represents the caller's passkeys
+type PasskeyListResponse struct {
+	Passkeys []PasskeyResponse `json:"passkeys"`
+}
+
+// PersonalAccessTokenResponse represents a personal access token
+// NOTE: Token is only set in the response that creates it
+type PersonalAccessTokenResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Scopes    []string  `json:"scopes"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Token     string    `json:"token,omitempty"`
+}
+
+// PersonalAccessTokenListResponse represents the caller's personal access tokens
+type PersonalAccessTokenListResponse struct {
+	Tokens []PersonalAccessTokenResponse `json:"tokens"`
+}
+
+// SessionResponse represents a device the caller is signed in on
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	ClientID   string    `json:"client_id,omitempty"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+// SessionListResponse represents the caller's sessions
+type SessionListResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+// UserAccessResponse represents a user's roles and permissions
+type UserAccessResponse struct {
+	UserID      string   `json:"user_id"`
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
+}
+
+// UserResponse represents an account as seen by admins
+type UserResponse struct {
+	ID                    string    `json:"id"`
+	TenantID              string    `json:"tenant_id"`
+	Email                 string    `json:"email"`
+	IsActive              bool      `json:"is_active"`
+	EmailVerified         bool      `json:"email_verified"`
+	MFAEnabled            bool      `json:"mfa_enabled"`
+	PasswordResetRequired bool      `json:"password_reset_required"`
+	Roles                 []string  `json:"roles"`
+	Permissions           []string  `json:"permissions"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// UserListResponse represents one page of users
+type UserListResponse struct {
+	Users  []UserResponse `json:"users"`
+	Total  int64          `json:"total"`
+	Offset int            `json:"offset"`
+	Limit  int            `json:"limit"`
+}
+
+// InvitationResponse represents an invitation as seen by admins
+type InvitationResponse struct {
+	ID         string     `json:"id"`
+	TenantID   string     `json:"tenant_id"`
+	Email      string     `json:"email"`
+	Role       string     `json:"role"`
+	Status     string     `json:"status"`
+	InvitedBy  string     `json:"invited_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	AcceptedBy string     `json:"accepted_by,omitempty"`
+}
+
+// InvitationListResponse represents the organization's invitations
+type InvitationListResponse struct {
+	Invitations []InvitationResponse `json:"invitations"`
+}
+
+// AcceptInvitationResponse represents the result of accepting an invitation
+// NOTE: Tokens are only set when a new account was created; existing
+// accounts log in as usual
+type AcceptInvitationResponse struct {
+	UserID         string `json:"user_id"`
+	Email          string `json:"email"`
+	Role           string `json:"role"`
+	AccountCreated bool   `json:"account_created"`
+	AccessToken    string `json:"access_token,omitempty"`
+	RefreshToken   string `json:"refresh_token,omitempty"`
+}
+
+// AuthorizationDetailsResponse describes an OAuth request for the consent screen
+type AuthorizationDetailsResponse struct {
+	ClientID    string   `json:"client_id"`
+	ClientName  string   `json:"client_name"`
+	RedirectURI string   `json:"redirect_uri"`
+	Scopes      []string `json:"scopes"`
+}
+
+// AuthorizeResponse tells the consent screen where to send the user
+type AuthorizeResponse struct {
+	RedirectURI string `json:"redirect_uri"`
+}
+
+// OAuthTokenResponse is an OAuth token endpoint response (RFC 6749 section 5.1)
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	IDToken      string `json:"id_token,omitempty"` // OpenID Connect
+}
+
+// UserInfoResponse is an OpenID Connect UserInfo response (OIDC Core section 5.3)
+type UserInfoResponse struct {
+	Sub           string `json:"sub"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+	UpdatedAt     int64  `json:"updated_at,omitempty"` // Seconds since the epoch
+}
+
+// OpenIDConfigurationResponse is the OpenID Connect discovery document
+// (OpenID Connect Discovery section 3)
+type OpenIDConfigurationResponse struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// OAuthErrorResponse is an OAuth token endpoint error (RFC 6749 section 5.2)
+// NOTE: OAuth clients expect this shape, not ErrorResponse
+type OAuthErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// MessageResponse represents a response with no data beyond a status message
+type MessageResponse struct {
+	Message string `json:"message"`
 }
 
 // HealthResponse represents health check response
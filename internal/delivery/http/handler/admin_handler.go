@@ -0,0 +1,394 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Ruseigha/LabukaAuth/internal/delivery/http/dto"
+	"github.com/Ruseigha/LabukaAuth/internal/delivery/http/middleware"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/gorilla/mux"
+)
+
+// AdminHandler serves user administration endpoints
+// NOTE: Routes must be wrapped in middleware.Auth and middleware.RequireRole
+type AdminHandler struct {
+	authService usecase.AuthUseCase
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(authService usecase.AuthUseCase) *AdminHandler {
+	return &AdminHandler{
+		authService: authService,
+	}
+}
+
+func (h *AdminHandler) GetUserAccess(w http.ResponseWriter, r *http.Request) {
+	access, err := h.authService.GetUserAccess(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to get user access", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserAccessResponse(access))
+}
+
+func (h *AdminHandler) GrantRole(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.GrantRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	access, err := h.authService.GrantRole(r.Context(), accessRequest(r, req.Role))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to grant role", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserAccessResponse(access))
+}
+
+func (h *AdminHandler) RevokeRole(w http.ResponseWriter, r *http.Request) {
+	access, err := h.authService.RevokeRole(r.Context(), accessRequest(r, mux.Vars(r)["role"]))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to revoke role", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserAccessResponse(access))
+}
+
+func (h *AdminHandler) GrantPermission(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.GrantPermissionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	access, err := h.authService.GrantPermission(r.Context(), accessRequest(r, req.Permission))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to grant permission", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserAccessResponse(access))
+}
+
+func (h *AdminHandler) RevokePermission(w http.ResponseWriter, r *http.Request) {
+	access, err := h.authService.RevokePermission(r.Context(), accessRequest(r, mux.Vars(r)["permission"]))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to revoke permission", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserAccessResponse(access))
+}
+
+func (h *AdminHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	// Parse paging (?offset=&limit=, both optional)
+	offset, err := queryInt(r, "offset")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid offset", err)
+		return
+	}
+	limit, err := queryInt(r, "limit")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid limit", err)
+		return
+	}
+
+	// Call use case
+	list, err := h.authService.ListUsers(r.Context(), usecase.ListUsersRequest{
+		ActorID: middleware.GetUserIDFromContext(r.Context()),
+		Offset:  offset,
+		Limit:   limit,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to list users", err)
+		return
+	}
+
+	resp := dto.UserListResponse{
+		Users:  make([]dto.UserResponse, len(list.Users)),
+		Total:  list.Total,
+		Offset: list.Offset,
+		Limit:  list.Limit,
+	}
+	for i := range list.Users {
+		resp.Users[i] = toUserResponse(&list.Users[i])
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *AdminHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.authService.GetUser(r.Context(), usecase.GetUserRequest{
+		ActorID: middleware.GetUserIDFromContext(r.Context()),
+		UserID:  mux.Vars(r)["id"],
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to get user", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserResponse(user))
+}
+
+func (h *AdminHandler) GetUserByEmail(w http.ResponseWriter, r *http.Request) {
+	email := r.URL.Query().Get("email")
+	if email == "" {
+		respondError(w, http.StatusBadRequest, "validation failed", errors.New("email is required"))
+		return
+	}
+
+	user, err := h.authService.GetUser(r.Context(), usecase.GetUserRequest{
+		ActorID: middleware.GetUserIDFromContext(r.Context()),
+		Email:   email,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to get user", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserResponse(user))
+}
+
+func (h *AdminHandler) ActivateUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.authService.ActivateUser(r.Context(), adminUserRequest(r))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to activate user", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserResponse(user))
+}
+
+func (h *AdminHandler) DeactivateUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.authService.DeactivateUser(r.Context(), adminUserRequest(r))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to deactivate user", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserResponse(user))
+}
+
+func (h *AdminHandler) ForcePasswordReset(w http.ResponseWriter, r *http.Request) {
+	if err := h.authService.ForcePasswordReset(r.Context(), adminUserRequest(r)); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to force password reset", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "password reset required; reset link sent",
+	})
+}
+
+func (h *AdminHandler) RevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	if err := h.authService.RevokeUserSessions(r.Context(), adminUserRequest(r)); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to revoke sessions", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "all sessions revoked",
+	})
+}
+
+func (h *AdminHandler) UnlockAccount(w http.ResponseWriter, r *http.Request) {
+	if err := h.authService.UnlockAccount(r.Context(), adminUserRequest(r)); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to unlock account", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "account unlocked",
+	})
+}
+
+func (h *AdminHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	if err := h.authService.DeleteUser(r.Context(), adminUserRequest(r)); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to delete user", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "user deleted",
+	})
+}
+
+func (h *AdminHandler) CreateInvitation(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.CreateInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	invitation, err := h.authService.CreateInvitation(r.Context(), usecase.CreateInvitationRequest{
+		ActorID: middleware.GetUserIDFromContext(r.Context()),
+		Email:   req.Email,
+		Role:    req.Role,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to create invitation", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toInvitationResponse(invitation))
+}
+
+func (h *AdminHandler) ListInvitations(w http.ResponseWriter, r *http.Request) {
+	invitations, err := h.authService.ListInvitations(r.Context(), middleware.GetUserIDFromContext(r.Context()))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to list invitations", err)
+		return
+	}
+
+	resp := dto.InvitationListResponse{
+		Invitations: make([]dto.InvitationResponse, len(invitations)),
+	}
+	for i := range invitations {
+		resp.Invitations[i] = toInvitationResponse(&invitations[i])
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *AdminHandler) RevokeInvitation(w http.ResponseWriter, r *http.Request) {
+	invitation, err := h.authService.RevokeInvitation(r.Context(), usecase.RevokeInvitationRequest{
+		ActorID:      middleware.GetUserIDFromContext(r.Context()),
+		InvitationID: mux.Vars(r)["id"],
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to revoke invitation", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toInvitationResponse(invitation))
+}
+
+// adminUserRequest targets the {id} user (actor set by auth middleware)
+func adminUserRequest(r *http.Request) usecase.AdminUserRequest {
+	return usecase.AdminUserRequest{
+		ActorID: middleware.GetUserIDFromContext(r.Context()),
+		UserID:  mux.Vars(r)["id"],
+	}
+}
+
+// queryInt parses an optional integer query parameter (0 if absent)
+func queryInt(r *http.Request, name string) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
+
+// accessRequest builds an access change for the {id} user (actor set by auth middleware)
+func accessRequest(r *http.Request, name string) usecase.UserAccessRequest {
+	return usecase.UserAccessRequest{
+		ActorID: middleware.GetUserIDFromContext(r.Context()),
+		UserID:  mux.Vars(r)["id"],
+		Name:    name,
+	}
+}
+
+// toUserAccessResponse converts user access to its JSON form
+// NOTE: Empty lists are sent as [] rather than null
+func toUserAccessResponse(access *usecase.UserAccess) dto.UserAccessResponse {
+	resp := dto.UserAccessResponse{
+		UserID:      access.UserID,
+		Roles:       access.Roles,
+		Permissions: access.Permissions,
+	}
+	if resp.Roles == nil {
+		resp.Roles = []string{}
+	}
+	if resp.Permissions == nil {
+		resp.Permissions = []string{}
+	}
+	return resp
+}
+
+// toUserResponse converts user details to their JSON form
+// NOTE: Empty lists are sent as [] rather than null
+func toUserResponse(user *usecase.UserDetails) dto.UserResponse {
+	resp := dto.UserResponse{
+		ID:                    user.ID,
+		TenantID:              user.TenantID,
+		Email:                 user.Email,
+		IsActive:              user.IsActive,
+		EmailVerified:         user.EmailVerified,
+		MFAEnabled:            user.MFAEnabled,
+		PasswordResetRequired: user.PasswordResetRequired,
+		Roles:                 user.Roles,
+		Permissions:           user.Permissions,
+		CreatedAt:             user.CreatedAt,
+		UpdatedAt:             user.UpdatedAt,
+	}
+	if resp.Roles == nil {
+		resp.Roles = []string{}
+	}
+	if resp.Permissions == nil {
+		resp.Permissions = []string{}
+	}
+	return resp
+}
+
+// toInvitationResponse converts invitation details to their JSON form
+func toInvitationResponse(invitation *usecase.InvitationDetails) dto.InvitationResponse {
+	return dto.InvitationResponse{
+		ID:         invitation.ID,
+		TenantID:   invitation.TenantID,
+		Email:      invitation.Email,
+		Role:       invitation.Role,
+		Status:     invitation.Status,
+		InvitedBy:  invitation.InvitedBy,
+		CreatedAt:  invitation.CreatedAt,
+		ExpiresAt:  invitation.ExpiresAt,
+		AcceptedAt: invitation.AcceptedAt,
+		AcceptedBy: invitation.AcceptedBy,
+	}
+}
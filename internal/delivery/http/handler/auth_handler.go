@@ -3,11 +3,17 @@ package handler
 import (
 	"encoding/json"
 	"errors"
-
+	"io"
+	"net"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/Ruseigha/LabukaAuth/internal/delivery/http/dto"
+	"github.com/Ruseigha/LabukaAuth/internal/delivery/http/middleware"
+	domainerrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
 	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/gorilla/mux"
 )
 
 type AuthHandler struct {
@@ -31,12 +37,26 @@ func respondJSON(w http.ResponseWriter, statusCode int, data any) {
 // Helper: respondError sends error response
 func respondError(w http.ResponseWriter, statusCode int, message string, err error) {
 	w.Header().Set("Content-Type", "application/json")
+	if retryAfter, ok := domainerrors.RetryAfter(err); ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+	}
 	w.WriteHeader(statusCode)
 
 	errResp := dto.NewErrorResponse(err, message, statusCode)
 	json.NewEncoder(w).Encode(errResp)
 }
 
+// Helper: clientIP returns the request's remote IP without the port
+// NOTE: Uses the connection address, like middleware.RateLimit - forwarded
+// headers are client-controlled and would let attackers pick their own counter
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
 // Helper: extractBearerToken extracts JWT from Authorization header
 func extractBearerToken(r *http.Request) string {
 	authHeader := r.Header.Get("Authorization")
@@ -86,10 +106,11 @@ func (h *AuthHandler) Signup(w http.ResponseWriter, r *http.Request) {
 
 	// Step 4: Return success response
 	respondJSON(w, http.StatusCreated, dto.AuthResponse{
-		UserID:       resp.UserID,
-		Email:        resp.Email,
-		AccessToken:  resp.AccessToken,
-		RefreshToken: resp.RefreshToken,
+		UserID:               resp.UserID,
+		Email:                resp.Email,
+		AccessToken:          resp.AccessToken,
+		RefreshToken:         resp.RefreshToken,
+		VerificationRequired: resp.VerificationRequired,
 	})
 }
 
@@ -109,8 +130,9 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 
 	// Call use case
 	resp, err := h.authService.Login(r.Context(), usecase.LoginRequest{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     req.Email,
+		Password:  req.Password,
+		IPAddress: clientIP(r),
 	})
 
 	if err != nil {
@@ -125,6 +147,167 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		Email:        resp.Email,
 		AccessToken:  resp.AccessToken,
 		RefreshToken: resp.RefreshToken,
+		MFARequired:  resp.MFARequired,
+		MFAToken:     resp.MFAToken,
+	})
+}
+
+func (h *AuthHandler) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.VerifyMFARequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	resp, err := h.authService.VerifyMFA(r.Context(), usecase.VerifyMFARequest{
+		MFAToken:  req.MFAToken,
+		Code:      req.Code,
+		IPAddress: clientIP(r),
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "mfa verification failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.AuthResponse{
+		UserID:       resp.UserID,
+		Email:        resp.Email,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	})
+}
+
+func (h *AuthHandler) RequestLoginCode(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.PasswordlessLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	if err := h.authService.RequestLoginCode(r.Context(), req.Email); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "login code request failed", err)
+		return
+	}
+
+	// SECURITY: Same response whether or not the email is registered
+	respondJSON(w, http.StatusAccepted, dto.MessageResponse{
+		Message: "if an account exists for that address, a sign-in code has been sent",
+	})
+}
+
+func (h *AuthHandler) VerifyLoginCode(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.VerifyLoginCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	resp, err := h.authService.VerifyLoginCode(r.Context(), usecase.VerifyLoginCodeRequest{
+		Email:     req.Email,
+		Code:      req.Code,
+		IPAddress: clientIP(r),
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "login failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.AuthResponse{
+		UserID:       resp.UserID,
+		Email:        resp.Email,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		MFARequired:  resp.MFARequired,
+		MFAToken:     resp.MFAToken,
+	})
+}
+
+func (h *AuthHandler) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.PasswordlessLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	if err := h.authService.RequestMagicLink(r.Context(), req.Email); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "magic link request failed", err)
+		return
+	}
+
+	// SECURITY: Same response whether or not the email is registered
+	respondJSON(w, http.StatusAccepted, dto.MessageResponse{
+		Message: "if an account exists for that address, a sign-in link has been sent",
+	})
+}
+
+func (h *AuthHandler) VerifyMagicLink(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.MagicLinkLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	resp, err := h.authService.VerifyLoginCode(r.Context(), usecase.VerifyLoginCodeRequest{
+		Code:      req.Token,
+		IPAddress: clientIP(r),
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "login failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.AuthResponse{
+		UserID:       resp.UserID,
+		Email:        resp.Email,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		MFARequired:  resp.MFARequired,
+		MFAToken:     resp.MFAToken,
 	})
 }
 
@@ -177,8 +360,687 @@ func (h *AuthHandler) ValidateToken(w http.ResponseWriter, r *http.Request) {
 
 	// Return validation result
 	respondJSON(w, http.StatusOK, dto.ValidateTokenResponse{
-		Valid:  true,
-		UserID: claims.UserID,
-		Email:  claims.Email,
+		Valid:       true,
+		UserID:      claims.UserID,
+		TenantID:    claims.TenantID,
+		Email:       claims.Email,
+		Roles:       claims.Roles,
+		Permissions: claims.Permissions,
+		ClientID:    claims.ClientID,
+		Scopes:      claims.Scopes,
+	})
+}
+
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	// Extract access token from Authorization header
+	token := extractBearerToken(r)
+	if token == "" {
+		respondError(w, http.StatusUnauthorized, "missing authorization header", errors.New("no token provided"))
+		return
+	}
+
+	// Parse request (body is optional)
+	var req dto.LogoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	err := h.authService.Logout(r.Context(), usecase.LogoutRequest{
+		AccessToken:  token,
+		RefreshToken: req.RefreshToken,
+	})
+
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "logout failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "logged out",
+	})
+}
+
+func (h *AuthHandler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.RevokeTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	if err := h.authService.RevokeToken(r.Context(), req.Token); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "token revocation failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "token revoked",
+	})
+}
+
+func (h *AuthHandler) SendVerification(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.SendVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	if err := h.authService.SendVerification(r.Context(), req.Email); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "sending verification failed", err)
+		return
+	}
+
+	// SECURITY: Same response whether or not the email is registered
+	respondJSON(w, http.StatusAccepted, dto.MessageResponse{
+		Message: "if the address needs verifying, a verification email has been sent",
+	})
+}
+
+func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	if err := h.authService.VerifyEmail(r.Context(), req.Token); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "email verification failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "email verified",
+	})
+}
+
+func (h *AuthHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	if err := h.authService.RequestPasswordReset(r.Context(), req.Email); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "password reset request failed", err)
+		return
+	}
+
+	// SECURITY: Same response whether or not the email is registered
+	respondJSON(w, http.StatusAccepted, dto.MessageResponse{
+		Message: "if an account exists for that address, a password reset email has been sent",
 	})
 }
+
+func (h *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	err := h.authService.ResetPassword(r.Context(), usecase.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "password reset failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "password has been reset",
+	})
+}
+
+func (h *AuthHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.AcceptInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	resp, err := h.authService.AcceptInvitation(r.Context(), usecase.AcceptInvitationRequest{
+		Token:     req.Token,
+		Password:  req.Password,
+		IPAddress: clientIP(r),
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to accept invitation", err)
+		return
+	}
+
+	// Created when a new account was registered
+	statusCode := http.StatusOK
+	if resp.AccountCreated {
+		statusCode = http.StatusCreated
+	}
+
+	respondJSON(w, statusCode, dto.AcceptInvitationResponse{
+		UserID:         resp.UserID,
+		Email:          resp.Email,
+		Role:           resp.Role,
+		AccountCreated: resp.AccountCreated,
+		AccessToken:    resp.AccessToken,
+		RefreshToken:   resp.RefreshToken,
+	})
+}
+
+func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case (user ID set by auth middleware)
+	userID := middleware.GetUserIDFromContext(r.Context())
+	resp, err := h.authService.ChangePassword(r.Context(), usecase.ChangePasswordRequest{
+		UserID:          userID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "password change failed", err)
+		return
+	}
+
+	// Other sessions are signed out - return the caller's new tokens
+	respondJSON(w, http.StatusOK, dto.AuthResponse{
+		UserID:       userID,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	})
+}
+
+func (h *AuthHandler) ChangeEmail(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.ChangeEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case (user ID set by auth middleware)
+	userID := middleware.GetUserIDFromContext(r.Context())
+	_, err := h.authService.ChangeEmail(r.Context(), usecase.ChangeEmailRequest{
+		UserID:          userID,
+		CurrentPassword: req.CurrentPassword,
+		NewEmail:        req.NewEmail,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "email change failed", err)
+		return
+	}
+
+	// The address changes once the link sent to it is opened
+	respondJSON(w, http.StatusAccepted, dto.MessageResponse{
+		Message: "a link to confirm the change has been sent to the new address",
+	})
+}
+
+func (h *AuthHandler) EnrollMFA(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.EnrollMFARequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case (user ID set by auth middleware)
+	resp, err := h.authService.EnrollMFA(r.Context(), usecase.EnrollMFARequest{
+		UserID:          middleware.GetUserIDFromContext(r.Context()),
+		CurrentPassword: req.CurrentPassword,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "mfa enrollment failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.EnrollMFAResponse{
+		Secret:     resp.Secret,
+		OTPAuthURI: resp.URI,
+	})
+}
+
+func (h *AuthHandler) ConfirmMFA(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.ConfirmMFARequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case (user ID set by auth middleware)
+	resp, err := h.authService.ConfirmMFA(r.Context(), usecase.ConfirmMFARequest{
+		UserID: middleware.GetUserIDFromContext(r.Context()),
+		Code:   req.Code,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "mfa confirmation failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.RecoveryCodesResponse{
+		RecoveryCodes: resp.RecoveryCodes,
+	})
+}
+
+func (h *AuthHandler) DisableMFA(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.DisableMFARequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case (user ID set by auth middleware)
+	err := h.authService.DisableMFA(r.Context(), usecase.DisableMFARequest{
+		UserID:          middleware.GetUserIDFromContext(r.Context()),
+		CurrentPassword: req.CurrentPassword,
+		Code:            req.Code,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "mfa disable failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "multi-factor authentication disabled",
+	})
+}
+
+func (h *AuthHandler) BeginPasskeyRegistration(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.BeginPasskeyRegistrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case (user ID set by auth middleware)
+	resp, err := h.authService.BeginPasskeyRegistration(r.Context(), usecase.BeginPasskeyRegistrationRequest{
+		UserID:          middleware.GetUserIDFromContext(r.Context()),
+		CurrentPassword: req.CurrentPassword,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "passkey registration failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.PasskeyChallengeResponse{
+		ChallengeID: resp.ChallengeID,
+		Options:     resp.Options,
+	})
+}
+
+func (h *AuthHandler) FinishPasskeyRegistration(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.FinishPasskeyRegistrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case (user ID set by auth middleware)
+	passkey, err := h.authService.FinishPasskeyRegistration(r.Context(), usecase.FinishPasskeyRegistrationRequest{
+		UserID:      middleware.GetUserIDFromContext(r.Context()),
+		ChallengeID: req.ChallengeID,
+		Name:        req.Name,
+		Credential:  req.Credential,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "passkey registration failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toPasskeyResponse(*passkey))
+}
+
+func (h *AuthHandler) BeginPasskeyLogin(w http.ResponseWriter, r *http.Request) {
+	// Call use case
+	resp, err := h.authService.BeginPasskeyLogin(r.Context())
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "passkey login failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.PasskeyChallengeResponse{
+		ChallengeID: resp.ChallengeID,
+		Options:     resp.Options,
+	})
+}
+
+func (h *AuthHandler) FinishPasskeyLogin(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.FinishPasskeyLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	resp, err := h.authService.FinishPasskeyLogin(r.Context(), usecase.FinishPasskeyLoginRequest{
+		ChallengeID: req.ChallengeID,
+		Credential:  req.Credential,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "passkey login failed", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.AuthResponse{
+		UserID:       resp.UserID,
+		Email:        resp.Email,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	})
+}
+
+func (h *AuthHandler) ListPasskeys(w http.ResponseWriter, r *http.Request) {
+	// Call use case (user ID set by auth middleware)
+	passkeys, err := h.authService.ListPasskeys(r.Context(), middleware.GetUserIDFromContext(r.Context()))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to list passkeys", err)
+		return
+	}
+
+	resp := dto.PasskeyListResponse{Passkeys: make([]dto.PasskeyResponse, len(passkeys))}
+	for i, p := range passkeys {
+		resp.Passkeys[i] = toPasskeyResponse(p)
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) DeletePasskey(w http.ResponseWriter, r *http.Request) {
+	// Call use case (user ID set by auth middleware)
+	err := h.authService.DeletePasskey(r.Context(), usecase.DeletePasskeyRequest{
+		UserID:    middleware.GetUserIDFromContext(r.Context()),
+		PasskeyID: mux.Vars(r)["id"],
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to delete passkey", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "passkey deleted",
+	})
+}
+
+func (h *AuthHandler) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.CreatePersonalAccessTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case (user ID set by auth middleware)
+	resp, err := h.authService.CreatePersonalAccessToken(r.Context(), usecase.CreatePersonalAccessTokenRequest{
+		UserID:          middleware.GetUserIDFromContext(r.Context()),
+		CurrentPassword: req.CurrentPassword,
+		Name:            req.Name,
+		Scopes:          req.Scopes,
+		ExpiresIn:       time.Duration(req.ExpiresInDays) * 24 * time.Hour,
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to create token", err)
+		return
+	}
+
+	// SECURITY: The only time the token is shown
+	tokenResp := toPersonalAccessTokenResponse(resp.PersonalAccessTokenInfo)
+	tokenResp.Token = resp.Token
+	respondJSON(w, http.StatusCreated, tokenResp)
+}
+
+func (h *AuthHandler) ListPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+	// Call use case (user ID set by auth middleware)
+	tokens, err := h.authService.ListPersonalAccessTokens(r.Context(), middleware.GetUserIDFromContext(r.Context()))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to list tokens", err)
+		return
+	}
+
+	resp := dto.PersonalAccessTokenListResponse{Tokens: make([]dto.PersonalAccessTokenResponse, len(tokens))}
+	for i, t := range tokens {
+		resp.Tokens[i] = toPersonalAccessTokenResponse(t)
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) RevokePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	// Call use case (user ID set by auth middleware)
+	err := h.authService.RevokePersonalAccessToken(r.Context(), usecase.RevokePersonalAccessTokenRequest{
+		UserID:  middleware.GetUserIDFromContext(r.Context()),
+		TokenID: mux.Vars(r)["id"],
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to revoke token", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "token revoked",
+	})
+}
+
+func (h *AuthHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	// Call use case (user and session IDs set by auth middleware)
+	sessions, err := h.authService.ListSessions(r.Context(), sessionsRequest(r))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to list sessions", err)
+		return
+	}
+
+	resp := dto.SessionListResponse{Sessions: make([]dto.SessionResponse, len(sessions))}
+	for i, s := range sessions {
+		resp.Sessions[i] = toSessionResponse(s)
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func (h *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	// Call use case (user ID set by auth middleware)
+	err := h.authService.RevokeSession(r.Context(), usecase.RevokeSessionRequest{
+		UserID:    middleware.GetUserIDFromContext(r.Context()),
+		SessionID: mux.Vars(r)["id"],
+	})
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to revoke session", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "session revoked",
+	})
+}
+
+func (h *AuthHandler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	// Call use case (user and session IDs set by auth middleware)
+	if err := h.authService.RevokeOtherSessions(r.Context(), sessionsRequest(r)); err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to revoke sessions", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.MessageResponse{
+		Message: "signed out of all other sessions",
+	})
+}
+
+// sessionsRequest identifies the caller and their current session
+func sessionsRequest(r *http.Request) usecase.ListSessionsRequest {
+	return usecase.ListSessionsRequest{
+		UserID:           middleware.GetUserIDFromContext(r.Context()),
+		CurrentSessionID: middleware.GetSessionIDFromContext(r.Context()),
+	}
+}
+
+// toPasskeyResponse converts passkey info to its JSON form
+func toPasskeyResponse(p usecase.PasskeyInfo) dto.PasskeyResponse {
+	return dto.PasskeyResponse{
+		ID:         p.ID,
+		Name:       p.Name,
+		CreatedAt:  p.CreatedAt,
+		LastUsedAt: p.LastUsedAt,
+	}
+}
+
+// toPersonalAccessTokenResponse converts token info to its JSON form
+func toPersonalAccessTokenResponse(t usecase.PersonalAccessTokenInfo) dto.PersonalAccessTokenResponse {
+	return dto.PersonalAccessTokenResponse{
+		ID:        t.ID,
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		CreatedAt: t.CreatedAt,
+		ExpiresAt: t.ExpiresAt,
+	}
+}
+
+// toSessionResponse converts session info to its JSON form
+func toSessionResponse(s usecase.SessionInfo) dto.SessionResponse {
+	return dto.SessionResponse{
+		ID:         s.ID,
+		ClientID:   s.ClientID,
+		UserAgent:  s.UserAgent,
+		IPAddress:  s.IPAddress,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		ExpiresAt:  s.ExpiresAt,
+		Current:    s.Current,
+	}
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+)
+
+// JWKSHandler serves the public keys that verify our tokens
+type JWKSHandler struct {
+	keySet security.KeySetProvider
+}
+
+// NewJWKSHandler creates a new JWKS handler
+func NewJWKSHandler(keySet security.KeySetProvider) *JWKSHandler {
+	return &JWKSHandler{
+		keySet: keySet,
+	}
+}
+
+// JWKS handles GET /.well-known/jwks.json
+func (h *JWKSHandler) JWKS(w http.ResponseWriter, r *http.Request) {
+	// WHY: Verifiers poll this - let them cache, but not for so long that
+	// a newly added key goes unseen
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	respondJSON(w, http.StatusOK, h.keySet.JWKS())
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/Ruseigha/LabukaAuth/internal/delivery/http/dto"
+	"github.com/Ruseigha/LabukaAuth/internal/delivery/http/middleware"
+	domainerrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// OAuthHandler serves the OAuth 2.0 authorization and token endpoints
+// NOTE: The consent screen itself is a frontend page at consentURL; it
+// signs the user in as usual and answers through the consent API
+type OAuthHandler struct {
+	authService usecase.AuthUseCase
+	consentURL  string
+}
+
+// NewOAuthHandler creates a new OAuth handler
+func NewOAuthHandler(authService usecase.AuthUseCase, consentURL string) *OAuthHandler {
+	return &OAuthHandler{
+		authService: authService,
+		consentURL:  consentURL,
+	}
+}
+
+// authorizeRequestFromQuery reads the RFC 6749 authorization parameters
+func authorizeRequestFromQuery(query url.Values) usecase.AuthorizeRequest {
+	return usecase.AuthorizeRequest{
+		ResponseType:        query.Get("response_type"),
+		ClientID:            query.Get("client_id"),
+		RedirectURI:         query.Get("redirect_uri"),
+		Scope:               query.Get("scope"),
+		State:               query.Get("state"),
+		CodeChallenge:       query.Get("code_challenge"),
+		CodeChallengeMethod: query.Get("code_challenge_method"),
+		Nonce:               query.Get("nonce"),
+	}
+}
+
+// Authorize is the authorization endpoint (GET /oauth/authorize)
+// Valid requests go to the consent screen with the query unchanged
+func (h *OAuthHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	// Validate request
+	_, err := h.authService.CheckAuthorization(r.Context(), authorizeRequestFromQuery(r.URL.Query()))
+	if err != nil {
+		// Errors go back to the client once its redirect URI is verified
+		if location, ok := domainerrors.OAuthRedirect(err); ok {
+			http.Redirect(w, r, location, http.StatusFound)
+			return
+		}
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "invalid authorization request", err)
+		return
+	}
+
+	// Hand over to the consent screen
+	consent, err := url.Parse(h.consentURL)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "invalid consent URL", err)
+		return
+	}
+	consent.RawQuery = r.URL.RawQuery
+
+	http.Redirect(w, r, consent.String(), http.StatusFound)
+}
+
+// ConsentDetails describes an authorization request for the consent screen
+// (GET /api/v1/oauth/consent, same query as /oauth/authorize)
+func (h *OAuthHandler) ConsentDetails(w http.ResponseWriter, r *http.Request) {
+	details, err := h.authService.CheckAuthorization(r.Context(), authorizeRequestFromQuery(r.URL.Query()))
+	if err != nil {
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "invalid authorization request", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.AuthorizationDetailsResponse{
+		ClientID:    details.ClientID,
+		ClientName:  details.ClientName,
+		RedirectURI: details.RedirectURI,
+		Scopes:      details.Scopes,
+	})
+}
+
+// Consent records the signed-in user's answer (POST /api/v1/oauth/consent)
+// The response says where to send the browser next
+func (h *OAuthHandler) Consent(w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	var req dto.ConsentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	// Validate
+	if err := req.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "validation failed", err)
+		return
+	}
+
+	// Call use case
+	resp, err := h.authService.Authorize(r.Context(), usecase.ConsentRequest{
+		UserID:   middleware.GetUserIDFromContext(r.Context()),
+		AuthTime: middleware.GetAuthTimeFromContext(r.Context()),
+		Approve:  req.Approve,
+		AuthorizeRequest: usecase.AuthorizeRequest{
+			ResponseType:        req.ResponseType,
+			ClientID:            req.ClientID,
+			RedirectURI:         req.RedirectURI,
+			Scope:               req.Scope,
+			State:               req.State,
+			CodeChallenge:       req.CodeChallenge,
+			CodeChallengeMethod: req.CodeChallengeMethod,
+			Nonce:               req.Nonce,
+		},
+	})
+	if err != nil {
+		// The client gets the error; the screen just follows the redirect
+		if location, ok := domainerrors.OAuthRedirect(err); ok {
+			respondJSON(w, http.StatusOK, dto.AuthorizeResponse{RedirectURI: location})
+			return
+		}
+		statusCode := dto.MapDomainErrorToHTTP(err)
+		respondError(w, statusCode, "failed to authorize client", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.AuthorizeResponse{RedirectURI: resp.RedirectURI})
+}
+
+// Token is the token endpoint (POST /oauth/token, form-encoded)
+// NOTE: Responds in RFC 6749 format rather than the API's usual one
+func (h *OAuthHandler) Token(w http.ResponseWriter, r *http.Request) {
+	// SECURITY: Tokens must never be cached (RFC 6749 section 5.1)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+
+	if err := r.ParseForm(); err != nil {
+		respondOAuthError(w, http.StatusBadRequest, domainerrors.OAuthInvalidRequest, "invalid form body")
+		return
+	}
+
+	// Client credentials: HTTP Basic, or client_id/client_secret in the body
+	// NOTE: Basic credentials are form-urlencoded first (RFC 6749 section 2.3.1)
+	clientID := r.PostForm.Get("client_id")
+	clientSecret := r.PostForm.Get("client_secret")
+	basicID, basicSecret, usedBasic := r.BasicAuth()
+	if usedBasic {
+		var errID, errSecret error
+		clientID, errID = url.QueryUnescape(basicID)
+		clientSecret, errSecret = url.QueryUnescape(basicSecret)
+		if errID != nil || errSecret != nil {
+			respondOAuthError(w, http.StatusBadRequest, domainerrors.OAuthInvalidRequest, "invalid client credentials encoding")
+			return
+		}
+	}
+
+	// Call use case
+	resp, err := h.authService.OAuthToken(r.Context(), usecase.OAuthTokenRequest{
+		GrantType:    r.PostForm.Get("grant_type"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+		Scope:        r.PostForm.Get("scope"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	})
+	if err != nil {
+		code := domainerrors.OAuthCode(err)
+		statusCode := http.StatusBadRequest
+		switch code {
+		case domainerrors.OAuthInvalidClient:
+			statusCode = http.StatusUnauthorized
+			if usedBasic {
+				w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+			}
+		case "server_error":
+			// SECURITY: Internal errors stay in the logs
+			respondOAuthError(w, http.StatusInternalServerError, code, "")
+			return
+		}
+
+		var description string
+		var domainErr *domainerrors.DomainError
+		if errors.As(err, &domainErr) {
+			description = domainErr.Message
+		}
+		respondOAuthError(w, statusCode, code, description)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.OAuthTokenResponse{
+		AccessToken:  resp.AccessToken,
+		TokenType:    resp.TokenType,
+		ExpiresIn:    resp.ExpiresIn,
+		RefreshToken: resp.RefreshToken,
+		Scope:        resp.Scope,
+		IDToken:      resp.IDToken,
+	})
+}
+
+// Helper: respondOAuthError sends an RFC 6749 error response
+func respondOAuthError(w http.ResponseWriter, statusCode int, code string, description string) {
+	respondJSON(w, statusCode, dto.OAuthErrorResponse{
+		Error:            code,
+		ErrorDescription: description,
+	})
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/Ruseigha/LabukaAuth/internal/delivery/http/dto"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainerrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// OIDCHandler serves the OpenID Connect discovery and UserInfo endpoints
+// NOTE: ID tokens themselves come from the OAuth token endpoint
+type OIDCHandler struct {
+	authService usecase.AuthUseCase
+	keySet      security.KeySetProvider
+	issuer      string // Public base URL; every endpoint is advertised under it
+}
+
+// NewOIDCHandler creates a new OpenID Connect handler
+func NewOIDCHandler(authService usecase.AuthUseCase, keySet security.KeySetProvider, issuer string) *OIDCHandler {
+	return &OIDCHandler{
+		authService: authService,
+		keySet:      keySet,
+		issuer:      issuer,
+	}
+}
+
+// Discovery handles GET /.well-known/openid-configuration
+func (h *OIDCHandler) Discovery(w http.ResponseWriter, r *http.Request) {
+	// Advertise the algorithms of the published keys
+	// WHY: Follows key rotation to a new algorithm without a config change
+	var algorithms []string
+	for _, key := range h.keySet.JWKS().Keys {
+		if key.Alg != "" && !slices.Contains(algorithms, key.Alg) {
+			algorithms = append(algorithms, key.Alg)
+		}
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	respondJSON(w, http.StatusOK, dto.OpenIDConfigurationResponse{
+		Issuer:                            h.issuer,
+		AuthorizationEndpoint:             h.issuer + "/oauth/authorize",
+		TokenEndpoint:                     h.issuer + "/oauth/token",
+		UserInfoEndpoint:                  h.issuer + "/userinfo",
+		JWKSURI:                           h.issuer + "/.well-known/jwks.json",
+		ScopesSupported:                   []string{entity.ScopeOpenID, entity.ScopeEmail, entity.ScopeProfile},
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "refresh_token", "client_credentials"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  algorithms,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{security.PKCEMethodS256},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "at_hash",
+			"email", "email_verified", "updated_at",
+		},
+	})
+}
+
+// UserInfo handles GET and POST /userinfo (Authorization: Bearer)
+// NOTE: Errors follow RFC 6750 (WWW-Authenticate), not the API's usual body
+func (h *OIDCHandler) UserInfo(w http.ResponseWriter, r *http.Request) {
+	token := extractBearerToken(r)
+	if token == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="userinfo"`)
+		respondOAuthError(w, http.StatusUnauthorized, "invalid_request", "missing bearer token")
+		return
+	}
+
+	// Call use case
+	info, err := h.authService.UserInfo(r.Context(), token)
+	if err != nil {
+		var domainErr *domainerrors.DomainError
+		switch {
+		case errors.As(err, &domainErr) && errors.Is(err, domainerrors.ErrUnauthorized):
+			w.Header().Set("WWW-Authenticate", `Bearer realm="userinfo", error="invalid_token"`)
+			respondOAuthError(w, http.StatusUnauthorized, "invalid_token", domainErr.Message)
+		case errors.As(err, &domainErr) && errors.Is(err, domainerrors.ErrForbidden):
+			w.Header().Set("WWW-Authenticate", `Bearer realm="userinfo", error="insufficient_scope", scope="openid"`)
+			respondOAuthError(w, http.StatusForbidden, "insufficient_scope", domainErr.Message)
+		default:
+			// SECURITY: Internal errors stay in the logs
+			respondOAuthError(w, http.StatusInternalServerError, "server_error", "")
+		}
+		return
+	}
+
+	resp := dto.UserInfoResponse{
+		Sub:           info.Subject,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+	}
+	if info.UpdatedAt != nil {
+		resp.UpdatedAt = info.UpdatedAt.Unix()
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	respondJSON(w, http.StatusOK, resp)
+}
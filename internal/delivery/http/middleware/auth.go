@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/Ruseigha/LabukaAuth/internal/usecase"
 )
@@ -18,11 +19,45 @@ const (
 
 	// EmailKey is the context key for email
 	EmailKey contextKey = "email"
+
+	// RolesKey is the context key for the user's roles
+	RolesKey contextKey = "roles"
+
+	// PermissionsKey is the context key for the user's permissions
+	PermissionsKey contextKey = "permissions"
+
+	// AuthTimeKey is the context key for when the user signed in
+	AuthTimeKey contextKey = "auth_time"
+
+	// SessionIDKey is the context key for the session the token belongs to
+	SessionIDKey contextKey = "session_id"
 )
 
 // Auth validates JWT tokens
 // WHY: Protect endpoints that require authentication
+// SECURITY: Tokens issued to OAuth clients are rejected - they give a third
+// party scoped access for other services, not use of this service's account
+// and admin APIs. Personal access tokens are rejected too, so a script's
+// token can't manage the account (credentials, sessions, OAuth consent)
 func Auth(authService usecase.AuthUseCase) func(http.Handler) http.Handler {
+	return authenticate(authService, false, false)
+}
+
+// AuthAllowPersonalTokens is Auth that also accepts personal access tokens
+// WHY: For routes behind RequireRole or RequirePermission, where the token's
+// scopes already narrow what it can do
+func AuthAllowPersonalTokens(authService usecase.AuthUseCase) func(http.Handler) http.Handler {
+	return authenticate(authService, false, true)
+}
+
+// AuthAllowClients is Auth that also accepts tokens issued to OAuth clients
+// and personal access tokens
+// WHY: Resource servers validate those tokens at /auth/validate
+func AuthAllowClients(authService usecase.AuthUseCase) func(http.Handler) http.Handler {
+	return authenticate(authService, true, true)
+}
+
+func authenticate(authService usecase.AuthUseCase, allowClients, allowPersonalTokens bool) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Extract token from Authorization header
@@ -53,9 +88,23 @@ func Auth(authService usecase.AuthUseCase) func(http.Handler) http.Handler {
 				return
 			}
 
+			if claims.ClientID != "" && !allowClients {
+				respondUnauthorized(w, "token was issued to an OAuth client")
+				return
+			}
+
+			if claims.PersonalAccessTokenID != "" && !allowPersonalTokens {
+				respondUnauthorized(w, "personal access tokens are not accepted here")
+				return
+			}
+
 			// Add user info to context
 			ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
 			ctx = context.WithValue(ctx, EmailKey, claims.Email)
+			ctx = context.WithValue(ctx, RolesKey, claims.Roles)
+			ctx = context.WithValue(ctx, PermissionsKey, claims.Permissions)
+			ctx = context.WithValue(ctx, AuthTimeKey, claims.AuthTime)
+			ctx = context.WithValue(ctx, SessionIDKey, claims.SessionID)
 
 			// Call next handler with enriched context
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -82,3 +131,27 @@ func GetEmailFromContext(ctx context.Context) string {
 	email, _ := ctx.Value(EmailKey).(string)
 	return email
 }
+
+// GetRolesFromContext extracts the user's roles from context
+func GetRolesFromContext(ctx context.Context) []string {
+	roles, _ := ctx.Value(RolesKey).([]string)
+	return roles
+}
+
+// GetPermissionsFromContext extracts the user's permissions from context
+func GetPermissionsFromContext(ctx context.Context) []string {
+	permissions, _ := ctx.Value(PermissionsKey).([]string)
+	return permissions
+}
+
+// GetAuthTimeFromContext extracts when the user signed in (zero if unknown)
+func GetAuthTimeFromContext(ctx context.Context) time.Time {
+	authTime, _ := ctx.Value(AuthTimeKey).(time.Time)
+	return authTime
+}
+
+// GetSessionIDFromContext extracts the caller's session ID (empty for tokens without one)
+func GetSessionIDFromContext(ctx context.Context) string {
+	sessionID, _ := ctx.Value(SessionIDKey).(string)
+	return sessionID
+}
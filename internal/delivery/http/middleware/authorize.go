@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+)
+
+// RequireRole allows requests from users with at least one of roles
+// NOTE: Must run after Auth, which puts the user's roles in the context
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userRoles := GetRolesFromContext(r.Context())
+			if !slices.ContainsFunc(roles, func(role string) bool { return slices.Contains(userRoles, role) }) {
+				respondForbidden(w, "missing required role")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequirePermission allows requests from users with all of permissions
+// NOTE: Must run after Auth, which puts the user's permissions in the context
+func RequirePermission(permissions ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userPermissions := GetPermissionsFromContext(r.Context())
+			for _, permission := range permissions {
+				if !slices.Contains(userPermissions, permission) {
+					respondForbidden(w, "missing required permission")
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// respondForbidden sends 403 response
+func respondForbidden(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	w.Write([]byte(`{"error":"forbidden","message":"` + message + `","code":403}`))
+}
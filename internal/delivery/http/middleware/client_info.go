@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ClientInfo records the client address and User-Agent on the request context
+// WHY: Sessions show users where they are signed in
+// NOTE: Uses the connection's address; behind a proxy that is the proxy's
+func ClientInfo(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+
+		r = r.WithContext(usecase.WithClientInfo(r.Context(), usecase.ClientInfo{
+			IPAddress: ip,
+			UserAgent: r.UserAgent(),
+		}))
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+)
+
+// Locale records the Accept-Language preference on the request context
+// WHY: Emails triggered by the request (verification, reset, ...) use it
+// to pick a template translation
+func Locale(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if locale := mail.PreferredLocale(r.Header.Get("Accept-Language")); locale != "" {
+			r = r.WithContext(mail.WithLocale(r.Context(), locale))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
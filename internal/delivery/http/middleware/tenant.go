@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// TenantHeader names the organization a request is for
+const TenantHeader = "X-Tenant-ID"
+
+// Tenant records the X-Tenant-ID header on the request context
+// NOTE: Requests without the header use the default tenant
+func Tenant(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if header := r.Header.Get(TenantHeader); header != "" {
+			tenantID, err := valueobject.NewTenantID(header)
+			if err != nil {
+				respondBadRequest(w, "invalid tenant ID")
+				return
+			}
+			r = r.WithContext(usecase.WithTenant(r.Context(), tenantID))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// respondBadRequest sends 400 response
+func respondBadRequest(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	w.Write([]byte(`{"error":"bad_request","message":"` + message + `","code":400}`))
+}
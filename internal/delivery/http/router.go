@@ -5,40 +5,121 @@ import (
 
 	"github.com/Ruseigha/LabukaAuth/internal/delivery/http/handler"
 	"github.com/Ruseigha/LabukaAuth/internal/delivery/http/middleware"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
 	"github.com/Ruseigha/LabukaAuth/internal/usecase"
 	"github.com/gorilla/mux"
 )
 
 // SetupRouter creates and configures the HTTP router
-func SetupRouter(authService usecase.AuthUseCase, version string) http.Handler {
+// issuer enables OpenID Connect discovery and /userinfo (empty = disabled)
+func SetupRouter(authService usecase.AuthUseCase, keySet security.KeySetProvider, version string, consentURL string, issuer string) http.Handler {
 	// Create router
 	r := mux.NewRouter()
 
 	// Create handlers
 	authHandler := handler.NewAuthHandler(authService)
+	adminHandler := handler.NewAdminHandler(authService)
 	healthHandler := handler.NewHealthHandler(version)
+	jwksHandler := handler.NewJWKSHandler(keySet)
+	oauthHandler := handler.NewOAuthHandler(authService, consentURL)
 
 	// Health check routes (no auth required)
 	r.HandleFunc("/health", healthHandler.Health).Methods(http.MethodGet)
 	r.HandleFunc("/ready", healthHandler.Ready).Methods(http.MethodGet)
 
+	// Public signing keys (no auth required)
+	r.HandleFunc("/.well-known/jwks.json", jwksHandler.JWKS).Methods(http.MethodGet)
+
+	// OAuth 2.0 endpoints (clients authenticate at /oauth/token themselves)
+	r.HandleFunc("/oauth/authorize", oauthHandler.Authorize).Methods(http.MethodGet)
+	r.HandleFunc("/oauth/token", oauthHandler.Token).Methods(http.MethodPost)
+
+	// OpenID Connect endpoints (UserInfo checks its bearer token itself)
+	if issuer != "" {
+		oidcHandler := handler.NewOIDCHandler(authService, keySet, issuer)
+		r.HandleFunc("/.well-known/openid-configuration", oidcHandler.Discovery).Methods(http.MethodGet)
+		r.HandleFunc("/userinfo", oidcHandler.UserInfo).Methods(http.MethodGet, http.MethodPost)
+	}
+
 	// API v1 routes
 	api := r.PathPrefix("/api/v1").Subrouter()
 
 	// Auth routes (public - no auth required)
 	api.HandleFunc("/auth/signup", authHandler.Signup).Methods(http.MethodPost)
 	api.HandleFunc("/auth/login", authHandler.Login).Methods(http.MethodPost)
+	api.HandleFunc("/auth/login/mfa", authHandler.VerifyMFA).Methods(http.MethodPost)
+	api.HandleFunc("/auth/login/code/send", authHandler.RequestLoginCode).Methods(http.MethodPost)
+	api.HandleFunc("/auth/login/code", authHandler.VerifyLoginCode).Methods(http.MethodPost)
+	api.HandleFunc("/auth/login/magic-link/send", authHandler.RequestMagicLink).Methods(http.MethodPost)
+	api.HandleFunc("/auth/login/magic-link", authHandler.VerifyMagicLink).Methods(http.MethodPost)
 	api.HandleFunc("/auth/refresh", authHandler.RefreshToken).Methods(http.MethodPost)
+	api.HandleFunc("/auth/revoke", authHandler.RevokeToken).Methods(http.MethodPost)
+	api.HandleFunc("/auth/verify-email/send", authHandler.SendVerification).Methods(http.MethodPost)
+	api.HandleFunc("/auth/verify-email", authHandler.VerifyEmail).Methods(http.MethodPost)
+	api.HandleFunc("/auth/password/forgot", authHandler.ForgotPassword).Methods(http.MethodPost)
+	api.HandleFunc("/auth/password/reset", authHandler.ResetPassword).Methods(http.MethodPost)
+	api.HandleFunc("/auth/passkeys/login/begin", authHandler.BeginPasskeyLogin).Methods(http.MethodPost)
+	api.HandleFunc("/auth/passkeys/login/finish", authHandler.FinishPasskeyLogin).Methods(http.MethodPost)
+	api.HandleFunc("/auth/invitations/accept", authHandler.AcceptInvitation).Methods(http.MethodPost)
+	api.HandleFunc("/oauth/consent", oauthHandler.ConsentDetails).Methods(http.MethodGet)
 
-	// Protected routes (require authentication)
+	// Token validation for resource servers (also accepts OAuth client tokens)
+	validate := api.PathPrefix("").Subrouter()
+	validate.Use(middleware.AuthAllowClients(authService))
+	validate.HandleFunc("/auth/validate", authHandler.ValidateToken).Methods(http.MethodGet)
+
+	// Protected routes (require a user's own session)
 	protected := api.PathPrefix("").Subrouter()
 	protected.Use(middleware.Auth(authService)) // Apply auth middleware
-	protected.HandleFunc("/auth/validate", authHandler.ValidateToken).Methods(http.MethodGet)
+	protected.HandleFunc("/auth/logout", authHandler.Logout).Methods(http.MethodPost)
+	protected.HandleFunc("/auth/password/change", authHandler.ChangePassword).Methods(http.MethodPost)
+	protected.HandleFunc("/auth/email/change", authHandler.ChangeEmail).Methods(http.MethodPost)
+	protected.HandleFunc("/auth/mfa/enroll", authHandler.EnrollMFA).Methods(http.MethodPost)
+	protected.HandleFunc("/auth/mfa/enroll/verify", authHandler.ConfirmMFA).Methods(http.MethodPost)
+	protected.HandleFunc("/auth/mfa/disable", authHandler.DisableMFA).Methods(http.MethodPost)
+	protected.HandleFunc("/auth/passkeys", authHandler.ListPasskeys).Methods(http.MethodGet)
+	protected.HandleFunc("/auth/passkeys/register/begin", authHandler.BeginPasskeyRegistration).Methods(http.MethodPost)
+	protected.HandleFunc("/auth/passkeys/register/finish", authHandler.FinishPasskeyRegistration).Methods(http.MethodPost)
+	protected.HandleFunc("/auth/passkeys/{id}", authHandler.DeletePasskey).Methods(http.MethodDelete)
+	protected.HandleFunc("/auth/tokens", authHandler.ListPersonalAccessTokens).Methods(http.MethodGet)
+	protected.HandleFunc("/auth/tokens", authHandler.CreatePersonalAccessToken).Methods(http.MethodPost)
+	protected.HandleFunc("/auth/tokens/{id}", authHandler.RevokePersonalAccessToken).Methods(http.MethodDelete)
+	protected.HandleFunc("/auth/sessions", authHandler.ListSessions).Methods(http.MethodGet)
+	protected.HandleFunc("/auth/sessions/revoke-others", authHandler.RevokeOtherSessions).Methods(http.MethodPost)
+	protected.HandleFunc("/auth/sessions/{id}", authHandler.RevokeSession).Methods(http.MethodDelete)
+	protected.HandleFunc("/oauth/consent", oauthHandler.Consent).Methods(http.MethodPost)
+
+	// Admin routes (require the admin role; personal access tokens scoped to it work too)
+	resources := api.PathPrefix("").Subrouter()
+	resources.Use(middleware.AuthAllowPersonalTokens(authService))
+	admin := resources.PathPrefix("/admin").Subrouter()
+	admin.Use(middleware.RequireRole(entity.RoleAdmin))
+	admin.HandleFunc("/users", adminHandler.ListUsers).Methods(http.MethodGet)
+	admin.HandleFunc("/users/by-email", adminHandler.GetUserByEmail).Methods(http.MethodGet) // Before /users/{id}
+	admin.HandleFunc("/users/{id}", adminHandler.GetUser).Methods(http.MethodGet)
+	admin.HandleFunc("/users/{id}", adminHandler.DeleteUser).Methods(http.MethodDelete)
+	admin.HandleFunc("/users/{id}/activate", adminHandler.ActivateUser).Methods(http.MethodPost)
+	admin.HandleFunc("/users/{id}/deactivate", adminHandler.DeactivateUser).Methods(http.MethodPost)
+	admin.HandleFunc("/users/{id}/force-password-reset", adminHandler.ForcePasswordReset).Methods(http.MethodPost)
+	admin.HandleFunc("/users/{id}/revoke-sessions", adminHandler.RevokeUserSessions).Methods(http.MethodPost)
+	admin.HandleFunc("/users/{id}/unlock", adminHandler.UnlockAccount).Methods(http.MethodPost)
+	admin.HandleFunc("/users/{id}/access", adminHandler.GetUserAccess).Methods(http.MethodGet)
+	admin.HandleFunc("/users/{id}/roles", adminHandler.GrantRole).Methods(http.MethodPost)
+	admin.HandleFunc("/users/{id}/roles/{role}", adminHandler.RevokeRole).Methods(http.MethodDelete)
+	admin.HandleFunc("/users/{id}/permissions", adminHandler.GrantPermission).Methods(http.MethodPost)
+	admin.HandleFunc("/users/{id}/permissions/{permission}", adminHandler.RevokePermission).Methods(http.MethodDelete)
+	admin.HandleFunc("/invitations", adminHandler.CreateInvitation).Methods(http.MethodPost)
+	admin.HandleFunc("/invitations", adminHandler.ListInvitations).Methods(http.MethodGet)
+	admin.HandleFunc("/invitations/{id}", adminHandler.RevokeInvitation).Methods(http.MethodDelete)
 
 	// Apply global middleware (in order)
 	handler := middleware.Recovery(r)                // Outermost: catch panics
 	handler = middleware.Logger(handler)             // Log all requests
 	handler = middleware.CORS(handler)               // Add CORS headers
+	handler = middleware.Locale(handler)             // Language for outgoing mail
+	handler = middleware.Tenant(handler)             // Organization the request is for
+	handler = middleware.ClientInfo(handler)         // Device, recorded on sessions
 	handler = middleware.RateLimit(100, 20)(handler) // 100 req/min, burst 20
 
 	return handler
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+)
+
+// RoleAdmin may grant and revoke roles and permissions
+const RoleAdmin = "admin"
+
+// accessNamePattern allows names like "admin", "billing_viewer", "orders:write"
+var accessNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_.:-]{0,63}$`)
+
+// Access is a user's authorization grants
+// NOTE: Both are free-form names - services that trust our tokens decide what
+// they mean; only RoleAdmin means something to this service
+type Access struct {
+	Roles       []string
+	Permissions []string
+}
+
+// ValidateAccessName checks a role or permission name
+func ValidateAccessName(name string) error {
+	if !accessNamePattern.MatchString(name) {
+		return errors.New("must be 1-64 lowercase letters, digits or _.:- and start with a letter")
+	}
+	return nil
+}
+
+// grant adds name to list, returning false if it was already there
+func grant(list []string, name string) ([]string, bool, error) {
+	if err := ValidateAccessName(name); err != nil {
+		return list, false, err
+	}
+	if slices.Contains(list, name) {
+		return list, false, nil
+	}
+	return append(list, name), true, nil
+}
+
+// revoke removes name from list, returning false if it wasn't there
+func revoke(list []string, name string) ([]string, bool) {
+	i := slices.Index(list, name)
+	if i < 0 {
+		return list, false
+	}
+	return slices.Delete(list, i, i+1), true
+}
@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"errors"
+	"maps"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// AuditAction names an administrative action
+type AuditAction string
+
+const (
+	AuditActionListUsers          AuditAction = "user.list"
+	AuditActionViewUser           AuditAction = "user.view"
+	AuditActionActivateUser       AuditAction = "user.activate"
+	AuditActionDeactivateUser     AuditAction = "user.deactivate"
+	AuditActionForcePasswordReset AuditAction = "user.force_password_reset"
+	AuditActionRevokeSessions     AuditAction = "user.revoke_sessions"
+	AuditActionUnlockUser         AuditAction = "user.unlock"
+	AuditActionDeleteUser         AuditAction = "user.delete"
+	AuditActionImportUsers        AuditAction = "user.import"
+	AuditActionGrantRole          AuditAction = "access.grant_role"
+	AuditActionRevokeRole         AuditAction = "access.revoke_role"
+	AuditActionGrantPermission    AuditAction = "access.grant_permission"
+	AuditActionRevokePermission   AuditAction = "access.revoke_permission"
+	AuditActionCreateInvitation   AuditAction = "invitation.create"
+	AuditActionListInvitations    AuditAction = "invitation.list"
+	AuditActionRevokeInvitation   AuditAction = "invitation.revoke"
+	AuditActionAcceptInvitation   AuditAction = "invitation.accept"
+)
+
+// AuditActorSystem is recorded when no user performed the action (e.g. the CLI)
+const AuditActorSystem = "system"
+
+// AuditEvent records who did what to which account
+// NOTE: Append-only - events are never updated or deleted by the service
+type AuditEvent struct {
+	id           string
+	tenantID     valueobject.TenantID // Organization the action happened in
+	actorID      string               // Admin user ID, or AuditActorSystem
+	action       AuditAction          // What was done
+	targetUserID string               // Account acted on (empty for listings)
+	targetEmail  string               // Its email at the time - survives deletion
+	details      map[string]string    // Action specifics (role name, page, ...)
+	occurredAt   time.Time
+}
+
+func NewAuditEvent(
+	tenantID valueobject.TenantID,
+	actorID string,
+	action AuditAction,
+	targetUserID string,
+	targetEmail string,
+	details map[string]string,
+) (*AuditEvent, error) {
+	if tenantID.IsEmpty() {
+		return nil, errors.New("audit tenant is required")
+	}
+
+	if action == "" {
+		return nil, errors.New("audit action is required")
+	}
+
+	if actorID == "" {
+		actorID = AuditActorSystem
+	}
+
+	return &AuditEvent{
+		id:           uuid.New().String(),
+		tenantID:     tenantID,
+		actorID:      actorID,
+		action:       action,
+		targetUserID: targetUserID,
+		targetEmail:  targetEmail,
+		details:      maps.Clone(details),
+		occurredAt:   time.Now().UTC(),
+	}, nil
+}
+
+func (e *AuditEvent) ID() string {
+	return e.id
+}
+
+func (e *AuditEvent) TenantID() valueobject.TenantID {
+	return e.tenantID
+}
+
+func (e *AuditEvent) ActorID() string {
+	return e.actorID
+}
+
+func (e *AuditEvent) Action() AuditAction {
+	return e.action
+}
+
+func (e *AuditEvent) TargetUserID() string {
+	return e.targetUserID
+}
+
+func (e *AuditEvent) TargetEmail() string {
+	return e.targetEmail
+}
+
+// Details returns a copy of the action specifics
+func (e *AuditEvent) Details() map[string]string {
+	return maps.Clone(e.details)
+}
+
+func (e *AuditEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
@@ -0,0 +1,205 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+// AuthorizationCode is a one-time code from the OAuth authorization endpoint
+// WHY: Binds the user's consent to one client, redirect URI, scope set and
+// PKCE challenge, so only the party that started the flow can redeem it
+type AuthorizationCode struct {
+	codeHash        string // SHA-256 of the code (never store the raw code)
+	clientID        string
+	userID          valueobject.UserID
+	tenantID        valueobject.TenantID
+	redirectURI     string    // Where the code was sent
+	redirectURISent bool      // If sent, it must be repeated verbatim at the token endpoint
+	scopes          []string  // Granted scopes
+	codeChallenge   string    // PKCE S256 challenge
+	nonce           string    // OIDC nonce, echoed in the ID token
+	authTime        time.Time // When the user signed in before consenting (zero if unknown)
+	createdAt       time.Time
+	expiresAt       time.Time
+	usedAt          *time.Time
+	familyID        string // Refresh token family issued for the code
+}
+
+func NewAuthorizationCode(
+	codeHash string,
+	clientID string,
+	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
+	redirectURI string,
+	redirectURISent bool,
+	scopes []string,
+	codeChallenge string,
+	nonce string,
+	authTime time.Time,
+	expiresAt time.Time,
+) (*AuthorizationCode, error) {
+	if codeHash == "" {
+		return nil, errors.New("code hash is required")
+	}
+
+	if clientID == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID is required")
+	}
+
+	if tenantID.IsEmpty() {
+		return nil, errors.New("tenant is required")
+	}
+
+	if redirectURI == "" {
+		return nil, errors.New("redirect URI is required")
+	}
+
+	if codeChallenge == "" {
+		return nil, errors.New("code challenge is required")
+	}
+
+	now := time.Now().UTC()
+	if !expiresAt.After(now) {
+		return nil, errors.New("expiry must be in the future")
+	}
+
+	return &AuthorizationCode{
+		codeHash:        codeHash,
+		clientID:        clientID,
+		userID:          userID,
+		tenantID:        tenantID,
+		redirectURI:     redirectURI,
+		redirectURISent: redirectURISent,
+		scopes:          slices.Clone(scopes),
+		codeChallenge:   codeChallenge,
+		nonce:           nonce,
+		authTime:        authTime.UTC(),
+		createdAt:       now,
+		expiresAt:       expiresAt.UTC(),
+	}, nil
+}
+
+// ReconstructAuthorizationCode recreates a code from stored data
+func ReconstructAuthorizationCode(
+	codeHash string,
+	clientID string,
+	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
+	redirectURI string,
+	redirectURISent bool,
+	scopes []string,
+	codeChallenge string,
+	nonce string,
+	authTime time.Time,
+	createdAt time.Time,
+	expiresAt time.Time,
+	usedAt *time.Time,
+	familyID string,
+) *AuthorizationCode {
+	return &AuthorizationCode{
+		codeHash:        codeHash,
+		clientID:        clientID,
+		userID:          userID,
+		tenantID:        tenantID,
+		redirectURI:     redirectURI,
+		redirectURISent: redirectURISent,
+		scopes:          scopes,
+		codeChallenge:   codeChallenge,
+		nonce:           nonce,
+		authTime:        authTime,
+		createdAt:       createdAt,
+		expiresAt:       expiresAt,
+		usedAt:          usedAt,
+		familyID:        familyID,
+	}
+}
+
+func (c *AuthorizationCode) CodeHash() string {
+	return c.codeHash
+}
+
+func (c *AuthorizationCode) ClientID() string {
+	return c.clientID
+}
+
+func (c *AuthorizationCode) UserID() valueobject.UserID {
+	return c.userID
+}
+
+func (c *AuthorizationCode) TenantID() valueobject.TenantID {
+	return c.tenantID
+}
+
+func (c *AuthorizationCode) RedirectURI() string {
+	return c.redirectURI
+}
+
+// RedirectURISent reports whether the authorization request named the
+// redirect URI, rather than it being the client's only registered one
+func (c *AuthorizationCode) RedirectURISent() bool {
+	return c.redirectURISent
+}
+
+func (c *AuthorizationCode) Scopes() []string {
+	return slices.Clone(c.scopes)
+}
+
+func (c *AuthorizationCode) CodeChallenge() string {
+	return c.codeChallenge
+}
+
+func (c *AuthorizationCode) Nonce() string {
+	return c.nonce
+}
+
+func (c *AuthorizationCode) AuthTime() time.Time {
+	return c.authTime
+}
+
+func (c *AuthorizationCode) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *AuthorizationCode) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+func (c *AuthorizationCode) UsedAt() *time.Time {
+	return c.usedAt
+}
+
+func (c *AuthorizationCode) FamilyID() string {
+	return c.familyID
+}
+
+func (c *AuthorizationCode) IsExpired() bool {
+	return !time.Now().UTC().Before(c.expiresAt)
+}
+
+func (c *AuthorizationCode) IsUsed() bool {
+	return c.usedAt != nil
+}
+
+// MarkUsed redeems the code, remembering the token family it produced
+// WHY: If the code is replayed, that family is revoked (RFC 6749 section 4.1.2)
+func (c *AuthorizationCode) MarkUsed(familyID string) error {
+	if c.IsUsed() {
+		return errors.New("code already used")
+	}
+
+	if c.IsExpired() {
+		return errors.New("code expired")
+	}
+
+	now := time.Now().UTC()
+	c.usedAt = &now
+	c.familyID = familyID
+	return nil
+}
@@ -0,0 +1,202 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// InvitationStatus is where an invitation is in its lifecycle
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationRevoked  InvitationStatus = "revoked"
+	InvitationExpired  InvitationStatus = "expired"
+)
+
+// Invitation lets one email address join an organization with a role
+// WHY: Stored server-side (like reset tokens) so it can be listed,
+// revoked and accepted exactly once
+type Invitation struct {
+	id         string
+	tenantID   valueobject.TenantID // Organization being joined
+	email      valueobject.Email    // Who may accept
+	role       string               // Granted on acceptance
+	tokenHash  string               // SHA-256 of the token (never store the raw token)
+	invitedBy  string               // Admin user ID, or AuditActorSystem
+	createdAt  time.Time
+	expiresAt  time.Time
+	acceptedAt *time.Time
+	acceptedBy string // User who accepted (new or existing account)
+	revokedAt  *time.Time
+}
+
+func NewInvitation(
+	tenantID valueobject.TenantID,
+	email valueobject.Email,
+	role string,
+	tokenHash string,
+	invitedBy string,
+	expiresAt time.Time,
+) (*Invitation, error) {
+	if tenantID.IsEmpty() {
+		return nil, errors.New("tenant is required")
+	}
+
+	if email.IsEmpty() {
+		return nil, errors.New("email is required")
+	}
+
+	if err := ValidateAccessName(role); err != nil {
+		return nil, err
+	}
+
+	if tokenHash == "" {
+		return nil, errors.New("token hash is required")
+	}
+
+	if invitedBy == "" {
+		invitedBy = AuditActorSystem
+	}
+
+	now := time.Now().UTC()
+	if !expiresAt.After(now) {
+		return nil, errors.New("expiry must be in the future")
+	}
+
+	return &Invitation{
+		id:        uuid.New().String(),
+		tenantID:  tenantID,
+		email:     email,
+		role:      role,
+		tokenHash: tokenHash,
+		invitedBy: invitedBy,
+		createdAt: now,
+		expiresAt: expiresAt.UTC(),
+	}, nil
+}
+
+// ReconstructInvitation recreates an invitation from stored data
+func ReconstructInvitation(
+	id string,
+	tenantID valueobject.TenantID,
+	email valueobject.Email,
+	role string,
+	tokenHash string,
+	invitedBy string,
+	createdAt time.Time,
+	expiresAt time.Time,
+	acceptedAt *time.Time,
+	acceptedBy string,
+	revokedAt *time.Time,
+) *Invitation {
+	return &Invitation{
+		id:         id,
+		tenantID:   tenantID,
+		email:      email,
+		role:       role,
+		tokenHash:  tokenHash,
+		invitedBy:  invitedBy,
+		createdAt:  createdAt,
+		expiresAt:  expiresAt,
+		acceptedAt: acceptedAt,
+		acceptedBy: acceptedBy,
+		revokedAt:  revokedAt,
+	}
+}
+
+func (i *Invitation) ID() string {
+	return i.id
+}
+
+func (i *Invitation) TenantID() valueobject.TenantID {
+	return i.tenantID
+}
+
+func (i *Invitation) Email() valueobject.Email {
+	return i.email
+}
+
+func (i *Invitation) Role() string {
+	return i.role
+}
+
+func (i *Invitation) TokenHash() string {
+	return i.tokenHash
+}
+
+func (i *Invitation) InvitedBy() string {
+	return i.invitedBy
+}
+
+func (i *Invitation) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+func (i *Invitation) ExpiresAt() time.Time {
+	return i.expiresAt
+}
+
+func (i *Invitation) AcceptedAt() *time.Time {
+	return i.acceptedAt
+}
+
+func (i *Invitation) AcceptedBy() string {
+	return i.acceptedBy
+}
+
+func (i *Invitation) RevokedAt() *time.Time {
+	return i.revokedAt
+}
+
+func (i *Invitation) IsExpired() bool {
+	return !time.Now().UTC().Before(i.expiresAt)
+}
+
+// Status reports the lifecycle state (accepted and revoked win over expired)
+func (i *Invitation) Status() InvitationStatus {
+	switch {
+	case i.acceptedAt != nil:
+		return InvitationAccepted
+	case i.revokedAt != nil:
+		return InvitationRevoked
+	case i.IsExpired():
+		return InvitationExpired
+	default:
+		return InvitationPending
+	}
+}
+
+// CanBeAccepted reports whether the invitation still works
+func (i *Invitation) CanBeAccepted() bool {
+	return i.Status() == InvitationPending
+}
+
+// Revoke cancels a pending invitation
+func (i *Invitation) Revoke() error {
+	if i.acceptedAt != nil {
+		return errors.New("invitation already accepted")
+	}
+
+	if i.revokedAt == nil {
+		now := time.Now().UTC()
+		i.revokedAt = &now
+	}
+	return nil
+}
+
+// MarkAccepted records who accepted the invitation
+func (i *Invitation) MarkAccepted(userID valueobject.UserID) error {
+	if !i.CanBeAccepted() {
+		return errors.New("invitation can't be accepted")
+	}
+
+	now := time.Now().UTC()
+	i.acceptedAt = &now
+	i.acceptedBy = userID.String()
+	return nil
+}
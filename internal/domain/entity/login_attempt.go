@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+// LoginAttempt counts recent failed logins for one key
+// WHY: Two scopes - per account (stops attacks spread across IPs) and
+// per account+IP (slows one client without locking out everyone else)
+type LoginAttempt struct {
+	key           string               // AccountAttemptKey or ClientAttemptKey
+	tenantID      valueobject.TenantID // Organization the login was for
+	email         string               // Account the counter belongs to (for unlock)
+	failures      int                  // Failed logins since the counter was last reset
+	lastFailureAt time.Time            // Most recent failure
+	expiresAt     time.Time            // Counter is forgotten after this (quiet window)
+}
+
+// AccountAttemptKey is the counter key for every login to email in tenantID
+// NOTE: Keyed by email, not user ID, so unknown emails are throttled the
+// same way and lockouts don't reveal which accounts exist
+// WHY: Tenant-scoped - the same address in another organization is a
+// different account and must not share its lockout
+func AccountAttemptKey(tenantID valueobject.TenantID, email valueobject.Email) string {
+	return "account:" + tenantID.String() + ":" + email.String()
+}
+
+// ClientAttemptKey is the counter key for logins to email in tenantID from one IP
+func ClientAttemptKey(tenantID valueobject.TenantID, email valueobject.Email, ip string) string {
+	return "client:" + tenantID.String() + ":" + email.String() + "|" + ip
+}
+
+// ReconstructLoginAttempt recreates a counter from stored data
+func ReconstructLoginAttempt(
+	key string,
+	tenantID valueobject.TenantID,
+	email string,
+	failures int,
+	lastFailureAt time.Time,
+	expiresAt time.Time,
+) *LoginAttempt {
+	return &LoginAttempt{
+		key:           key,
+		tenantID:      tenantID,
+		email:         email,
+		failures:      failures,
+		lastFailureAt: lastFailureAt,
+		expiresAt:     expiresAt,
+	}
+}
+
+func (a *LoginAttempt) Key() string {
+	return a.key
+}
+
+func (a *LoginAttempt) TenantID() valueobject.TenantID {
+	return a.tenantID
+}
+
+func (a *LoginAttempt) Email() string {
+	return a.email
+}
+
+func (a *LoginAttempt) Failures() int {
+	return a.failures
+}
+
+func (a *LoginAttempt) LastFailureAt() time.Time {
+	return a.lastFailureAt
+}
+
+func (a *LoginAttempt) ExpiresAt() time.Time {
+	return a.expiresAt
+}
@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+// LoginCodeKind says how a passwordless login code was delivered
+type LoginCodeKind string
+
+const (
+	LoginCodeKindOTP       LoginCodeKind = "otp"        // Short numeric code typed by the user
+	LoginCodeKindMagicLink LoginCodeKind = "magic_link" // Long token embedded in a link
+)
+
+// LoginCode is the server-side record of an emailed passwordless login code
+// WHY: Stored (not signed) so it can be consumed exactly once
+type LoginCode struct {
+	codeHash  string             // SHA-256 of the code (never store the raw code)
+	kind      LoginCodeKind      // OTP or magic link
+	userID    valueobject.UserID // Account signing in
+	email     valueobject.Email  // Address the code was sent to
+	createdAt time.Time
+	expiresAt time.Time
+}
+
+func NewLoginCode(
+	codeHash string,
+	kind LoginCodeKind,
+	userID valueobject.UserID,
+	email valueobject.Email,
+	expiresAt time.Time,
+) (*LoginCode, error) {
+	if codeHash == "" {
+		return nil, errors.New("code hash is required")
+	}
+
+	if kind != LoginCodeKindOTP && kind != LoginCodeKindMagicLink {
+		return nil, errors.New("unknown login code kind")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID is required")
+	}
+
+	now := time.Now().UTC()
+	if !expiresAt.After(now) {
+		return nil, errors.New("expiry must be in the future")
+	}
+
+	return &LoginCode{
+		codeHash:  codeHash,
+		kind:      kind,
+		userID:    userID,
+		email:     email,
+		createdAt: now,
+		expiresAt: expiresAt.UTC(),
+	}, nil
+}
+
+// ReconstructLoginCode recreates a login code from stored data
+func ReconstructLoginCode(
+	codeHash string,
+	kind LoginCodeKind,
+	userID valueobject.UserID,
+	email valueobject.Email,
+	createdAt time.Time,
+	expiresAt time.Time,
+) *LoginCode {
+	return &LoginCode{
+		codeHash:  codeHash,
+		kind:      kind,
+		userID:    userID,
+		email:     email,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+	}
+}
+
+func (c *LoginCode) CodeHash() string {
+	return c.codeHash
+}
+
+func (c *LoginCode) Kind() LoginCodeKind {
+	return c.kind
+}
+
+func (c *LoginCode) UserID() valueobject.UserID {
+	return c.userID
+}
+
+func (c *LoginCode) Email() valueobject.Email {
+	return c.email
+}
+
+func (c *LoginCode) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *LoginCode) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+func (c *LoginCode) IsExpired() bool {
+	return !time.Now().UTC().Before(c.expiresAt)
+}
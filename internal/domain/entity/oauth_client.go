@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// OpenID Connect scopes (OIDC Core section 5.4)
+// NOTE: Clients register them like any other scope; openid gets the client
+// an ID token, email and profile decide which user claims it may read
+const (
+	ScopeOpenID  = "openid"
+	ScopeEmail   = "email"
+	ScopeProfile = "profile"
+)
+
+// scopeTokenPattern is RFC 6749 section 3.3: printable ASCII except space, " and \
+var scopeTokenPattern = regexp.MustCompile(`^[\x21\x23-\x5B\x5D-\x7E]+$`)
+
+// OAuthClient is an application allowed to request tokens on a user's behalf
+// NOTE: A client with no secret is public (SPA, mobile app) and relies on
+// PKCE alone; a client with a secret must also authenticate at /oauth/token
+type OAuthClient struct {
+	id           string               // client_id
+	tenantID     valueobject.TenantID // Organization whose users the client serves
+	name         string               // Shown on the consent screen
+	secretHash   string               // SHA-256 of the client secret ("" = public client)
+	redirectURIs []string             // Exact-match allowlist
+	scopes       []string             // Scopes the client may request
+	createdAt    time.Time
+}
+
+func NewOAuthClient(
+	tenantID valueobject.TenantID,
+	name string,
+	secretHash string,
+	redirectURIs []string,
+	scopes []string,
+) (*OAuthClient, error) {
+	if tenantID.IsEmpty() {
+		return nil, errors.New("tenant is required")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("client name is required")
+	}
+
+	if len(redirectURIs) == 0 {
+		return nil, errors.New("at least one redirect URI is required")
+	}
+	for _, redirectURI := range redirectURIs {
+		if err := validateRedirectURI(redirectURI); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if err := ValidateScope(scope); err != nil {
+			return nil, err
+		}
+	}
+
+	return &OAuthClient{
+		id:           uuid.New().String(),
+		tenantID:     tenantID,
+		name:         name,
+		secretHash:   secretHash,
+		redirectURIs: slices.Clone(redirectURIs),
+		scopes:       slices.Compact(slices.Sorted(slices.Values(scopes))),
+		createdAt:    time.Now().UTC(),
+	}, nil
+}
+
+// ReconstructOAuthClient recreates a client from stored data
+func ReconstructOAuthClient(
+	id string,
+	tenantID valueobject.TenantID,
+	name string,
+	secretHash string,
+	redirectURIs []string,
+	scopes []string,
+	createdAt time.Time,
+) *OAuthClient {
+	return &OAuthClient{
+		id:           id,
+		tenantID:     tenantID,
+		name:         name,
+		secretHash:   secretHash,
+		redirectURIs: redirectURIs,
+		scopes:       scopes,
+		createdAt:    createdAt,
+	}
+}
+
+func (c *OAuthClient) ID() string {
+	return c.id
+}
+
+func (c *OAuthClient) TenantID() valueobject.TenantID {
+	return c.tenantID
+}
+
+func (c *OAuthClient) Name() string {
+	return c.name
+}
+
+func (c *OAuthClient) SecretHash() string {
+	return c.secretHash
+}
+
+func (c *OAuthClient) RedirectURIs() []string {
+	return slices.Clone(c.redirectURIs)
+}
+
+func (c *OAuthClient) Scopes() []string {
+	return slices.Clone(c.scopes)
+}
+
+func (c *OAuthClient) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// IsConfidential reports whether the client has a secret
+func (c *OAuthClient) IsConfidential() bool {
+	return c.secretHash != ""
+}
+
+// HasRedirectURI reports whether redirectURI is registered
+// SECURITY: Exact string match - prefix or wildcard matching has enabled
+// code theft via open redirects on the client's domain
+func (c *OAuthClient) HasRedirectURI(redirectURI string) bool {
+	return slices.Contains(c.redirectURIs, redirectURI)
+}
+
+// ResolveScopes returns the scopes to grant for requested
+// An empty request means every scope the client is registered for
+func (c *OAuthClient) ResolveScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return c.Scopes(), nil
+	}
+
+	for _, scope := range requested {
+		if !slices.Contains(c.scopes, scope) {
+			return nil, fmt.Errorf("scope %q is not allowed for this client", scope)
+		}
+	}
+	return slices.Clone(requested), nil
+}
+
+// ValidateScope checks a single scope token
+func ValidateScope(scope string) error {
+	if !scopeTokenPattern.MatchString(scope) {
+		return fmt.Errorf("invalid scope %q", scope)
+	}
+	return nil
+}
+
+// ParseScope splits a space-delimited scope parameter, dropping duplicates
+func ParseScope(scope string) []string {
+	var scopes []string
+	for _, s := range strings.Fields(scope) {
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+// FormatScope joins scopes into a scope parameter
+func FormatScope(scopes []string) string {
+	return strings.Join(scopes, " ")
+}
+
+// validateRedirectURI checks a redirect URI is absolute and has no fragment
+// (RFC 6749 section 3.1.2)
+func validateRedirectURI(redirectURI string) error {
+	u, err := url.Parse(redirectURI)
+	if err != nil || u.Scheme == "" {
+		return fmt.Errorf("redirect URI %q must be absolute", redirectURI)
+	}
+	if u.Fragment != "" || strings.Contains(redirectURI, "#") {
+		return fmt.Errorf("redirect URI %q must not have a fragment", redirectURI)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+// Organization is a tenant: a product or customer with its own user namespace
+// NOTE: The same email can sign up separately in each organization
+type Organization struct {
+	id        valueobject.TenantID
+	name      string // Display name
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewOrganization(id valueobject.TenantID, name string) (*Organization, error) {
+	if id.IsEmpty() {
+		return nil, errors.New("organization ID is required")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("organization name is required")
+	}
+
+	now := time.Now().UTC()
+
+	return &Organization{
+		id:        id,
+		name:      name,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ReconstructOrganization recreates an organization from stored data
+func ReconstructOrganization(
+	id valueobject.TenantID,
+	name string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Organization {
+	return &Organization{
+		id:        id,
+		name:      name,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (o *Organization) ID() valueobject.TenantID {
+	return o.id
+}
+
+func (o *Organization) Name() string {
+	return o.name
+}
+
+func (o *Organization) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+func (o *Organization) UpdatedAt() time.Time {
+	return o.updatedAt
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+// maxPasskeyNameLength bounds user-chosen labels ("MacBook", "YubiKey 5")
+const maxPasskeyNameLength = 64
+
+// PasskeyCredential is the WebAuthn credential record
+// NOTE: Holds only a public key - nothing here lets anyone sign in
+type PasskeyCredential struct {
+	ID              []byte   // Credential ID chosen by the authenticator
+	PublicKey       []byte   // COSE-encoded public key
+	AttestationType string   // Attestation format ("none", "packed", ...)
+	AAGUID          []byte   // Authenticator model
+	SignCount       uint32   // Signature counter (clone detection)
+	Transports      []string // usb, nfc, ble, internal, hybrid
+	BackupEligible  bool     // Credential can be synced (must never change)
+	BackupState     bool     // Credential is currently synced
+}
+
+// Passkey is a WebAuthn credential registered to a user
+type Passkey struct {
+	credential PasskeyCredential
+	userID     valueobject.UserID
+	name       string
+	createdAt  time.Time
+	lastUsedAt *time.Time // nil = never used to sign in
+}
+
+func NewPasskey(userID valueobject.UserID, name string, credential PasskeyCredential) (*Passkey, error) {
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID is required")
+	}
+
+	if len(credential.ID) == 0 || len(credential.PublicKey) == 0 {
+		return nil, errors.New("credential ID and public key are required")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "Passkey"
+	}
+	if len(name) > maxPasskeyNameLength {
+		return nil, errors.New("passkey name too long")
+	}
+
+	return &Passkey{
+		credential: credential,
+		userID:     userID,
+		name:       name,
+		createdAt:  time.Now().UTC(),
+	}, nil
+}
+
+// ReconstructPasskey recreates a passkey from stored data
+func ReconstructPasskey(
+	credential PasskeyCredential,
+	userID valueobject.UserID,
+	name string,
+	createdAt time.Time,
+	lastUsedAt *time.Time,
+) *Passkey {
+	return &Passkey{
+		credential: credential,
+		userID:     userID,
+		name:       name,
+		createdAt:  createdAt,
+		lastUsedAt: lastUsedAt,
+	}
+}
+
+// ID returns the credential ID as unpadded base64url (as browsers report it)
+func (p *Passkey) ID() string {
+	return EncodePasskeyID(p.credential.ID)
+}
+
+func (p *Passkey) Credential() PasskeyCredential {
+	return p.credential
+}
+
+func (p *Passkey) UserID() valueobject.UserID {
+	return p.userID
+}
+
+func (p *Passkey) Name() string {
+	return p.name
+}
+
+func (p *Passkey) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Passkey) LastUsedAt() *time.Time {
+	return p.lastUsedAt
+}
+
+// RecordUse stores the counter and backup state from a verified sign-in
+func (p *Passkey) RecordUse(signCount uint32, backupState bool) {
+	now := time.Now().UTC()
+	p.credential.SignCount = signCount
+	p.credential.BackupState = backupState
+	p.lastUsedAt = &now
+}
+
+// EncodePasskeyID encodes a credential ID the way browsers report it
+func EncodePasskeyID(id []byte) string {
+	return base64.RawURLEncoding.EncodeToString(id)
+}
+
+// DecodePasskeyID parses a base64url credential ID (padding optional)
+func DecodePasskeyID(id string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(id, "="))
+}
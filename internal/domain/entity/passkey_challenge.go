@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+// PasskeyCeremony says which WebAuthn ceremony a challenge belongs to
+type PasskeyCeremony string
+
+const (
+	PasskeyCeremonyRegistration PasskeyCeremony = "registration"
+	PasskeyCeremonyLogin        PasskeyCeremony = "login"
+)
+
+// PasskeyChallenge is the server-side state of a WebAuthn ceremony
+// WHY: The challenge must be checked on finish and only work once
+type PasskeyChallenge struct {
+	idHash    string             // SHA-256 of the challenge ID handed to the client
+	ceremony  PasskeyCeremony    // Registration or login
+	userID    valueobject.UserID // Registering user (empty for login)
+	session   []byte             // Opaque ceremony state from the WebAuthn library
+	expiresAt time.Time
+}
+
+func NewPasskeyChallenge(
+	idHash string,
+	ceremony PasskeyCeremony,
+	userID valueobject.UserID,
+	session []byte,
+	expiresAt time.Time,
+) (*PasskeyChallenge, error) {
+	if idHash == "" {
+		return nil, errors.New("challenge ID hash is required")
+	}
+
+	if ceremony == PasskeyCeremonyRegistration && userID.IsEmpty() {
+		return nil, errors.New("user ID is required for registration")
+	}
+
+	if len(session) == 0 {
+		return nil, errors.New("session data is required")
+	}
+
+	if !expiresAt.After(time.Now().UTC()) {
+		return nil, errors.New("expiry must be in the future")
+	}
+
+	return &PasskeyChallenge{
+		idHash:    idHash,
+		ceremony:  ceremony,
+		userID:    userID,
+		session:   session,
+		expiresAt: expiresAt.UTC(),
+	}, nil
+}
+
+// ReconstructPasskeyChallenge recreates a challenge from stored data
+func ReconstructPasskeyChallenge(
+	idHash string,
+	ceremony PasskeyCeremony,
+	userID valueobject.UserID,
+	session []byte,
+	expiresAt time.Time,
+) *PasskeyChallenge {
+	return &PasskeyChallenge{
+		idHash:    idHash,
+		ceremony:  ceremony,
+		userID:    userID,
+		session:   session,
+		expiresAt: expiresAt,
+	}
+}
+
+func (c *PasskeyChallenge) IDHash() string {
+	return c.idHash
+}
+
+func (c *PasskeyChallenge) Ceremony() PasskeyCeremony {
+	return c.ceremony
+}
+
+func (c *PasskeyChallenge) UserID() valueobject.UserID {
+	return c.userID
+}
+
+func (c *PasskeyChallenge) Session() []byte {
+	return c.session
+}
+
+func (c *PasskeyChallenge) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+func (c *PasskeyChallenge) IsExpired() bool {
+	return !time.Now().UTC().Before(c.expiresAt)
+}
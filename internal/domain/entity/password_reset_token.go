@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+// PasswordResetToken is the server-side record of a password reset link
+// WHY: Unlike a signed token, a stored record can be consumed exactly once
+type PasswordResetToken struct {
+	tokenHash string             // SHA-256 of the token (never store the raw token)
+	userID    valueobject.UserID // Account being reset
+	createdAt time.Time          // When the reset was requested
+	expiresAt time.Time          // When the link stops working
+	usedAt    *time.Time         // When the password was reset (nil = unused)
+}
+
+func NewPasswordResetToken(
+	tokenHash string,
+	userID valueobject.UserID,
+	expiresAt time.Time,
+) (*PasswordResetToken, error) {
+	if tokenHash == "" {
+		return nil, errors.New("token hash is required")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID is required")
+	}
+
+	now := time.Now().UTC()
+	if !expiresAt.After(now) {
+		return nil, errors.New("expiry must be in the future")
+	}
+
+	return &PasswordResetToken{
+		tokenHash: tokenHash,
+		userID:    userID,
+		createdAt: now,
+		expiresAt: expiresAt.UTC(),
+	}, nil
+}
+
+// ReconstructPasswordResetToken recreates a reset token from stored data
+func ReconstructPasswordResetToken(
+	tokenHash string,
+	userID valueobject.UserID,
+	createdAt time.Time,
+	expiresAt time.Time,
+	usedAt *time.Time,
+) *PasswordResetToken {
+	return &PasswordResetToken{
+		tokenHash: tokenHash,
+		userID:    userID,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+	}
+}
+
+func (t *PasswordResetToken) TokenHash() string {
+	return t.tokenHash
+}
+
+func (t *PasswordResetToken) UserID() valueobject.UserID {
+	return t.userID
+}
+
+func (t *PasswordResetToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *PasswordResetToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *PasswordResetToken) UsedAt() *time.Time {
+	return t.usedAt
+}
+
+func (t *PasswordResetToken) IsUsed() bool {
+	return t.usedAt != nil
+}
+
+func (t *PasswordResetToken) IsExpired() bool {
+	return !time.Now().UTC().Before(t.expiresAt)
+}
+
+// CanBeUsed reports whether the link still works
+func (t *PasswordResetToken) CanBeUsed() bool {
+	return !t.IsUsed() && !t.IsExpired()
+}
+
+// MarkUsed consumes the token
+func (t *PasswordResetToken) MarkUsed() error {
+	if t.IsUsed() {
+		return errors.New("reset token already used")
+	}
+
+	now := time.Now().UTC()
+	t.usedAt = &now
+	return nil
+}
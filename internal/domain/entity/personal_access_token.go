@@ -0,0 +1,164 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// PersonalAccessTokenPrefix starts every personal access token
+// WHY: Tells them apart from JWTs without a lookup, and lets secret
+// scanners recognize leaked ones
+const PersonalAccessTokenPrefix = "lpat_"
+
+// maxPersonalAccessTokenNameLength bounds user-chosen labels ("CI deploy")
+const maxPersonalAccessTokenNameLength = 64
+
+// PersonalAccessToken is a long-lived, user-created token for scripts and CI
+// NOTE: Scopes are a subset of the owner's roles and permissions; the token
+// carries only those of them the owner still has. A token without scopes
+// identifies the owner but grants nothing
+type PersonalAccessToken struct {
+	id        string
+	tokenHash string // SHA-256 of the token (never store plaintext)
+	userID    valueobject.UserID
+	tenantID  valueobject.TenantID
+	name      string
+	scopes    []string
+	createdAt time.Time
+	expiresAt time.Time
+}
+
+func NewPersonalAccessToken(
+	tokenHash string,
+	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
+	name string,
+	scopes []string,
+	expiresAt time.Time,
+) (*PersonalAccessToken, error) {
+	if tokenHash == "" {
+		return nil, errors.New("token hash is required")
+	}
+
+	if userID.IsEmpty() || tenantID.IsEmpty() {
+		return nil, errors.New("user and tenant are required")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("token name is required")
+	}
+	if len(name) > maxPersonalAccessTokenNameLength {
+		return nil, errors.New("token name too long")
+	}
+
+	for _, scope := range scopes {
+		if err := ValidateAccessName(scope); err != nil {
+			return nil, fmt.Errorf("invalid scope %q: %w", scope, err)
+		}
+	}
+
+	now := time.Now().UTC()
+	if !expiresAt.After(now) {
+		return nil, errors.New("expiry must be in the future")
+	}
+
+	return &PersonalAccessToken{
+		id:        uuid.New().String(),
+		tokenHash: tokenHash,
+		userID:    userID,
+		tenantID:  tenantID,
+		name:      name,
+		scopes:    slices.Compact(slices.Sorted(slices.Values(scopes))),
+		createdAt: now,
+		expiresAt: expiresAt.UTC(),
+	}, nil
+}
+
+// ReconstructPersonalAccessToken recreates a token from stored data
+func ReconstructPersonalAccessToken(
+	id string,
+	tokenHash string,
+	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
+	name string,
+	scopes []string,
+	createdAt time.Time,
+	expiresAt time.Time,
+) *PersonalAccessToken {
+	return &PersonalAccessToken{
+		id:        id,
+		tokenHash: tokenHash,
+		userID:    userID,
+		tenantID:  tenantID,
+		name:      name,
+		scopes:    scopes,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+	}
+}
+
+func (t *PersonalAccessToken) ID() string {
+	return t.id
+}
+
+func (t *PersonalAccessToken) TokenHash() string {
+	return t.tokenHash
+}
+
+func (t *PersonalAccessToken) UserID() valueobject.UserID {
+	return t.userID
+}
+
+func (t *PersonalAccessToken) TenantID() valueobject.TenantID {
+	return t.tenantID
+}
+
+func (t *PersonalAccessToken) Name() string {
+	return t.name
+}
+
+func (t *PersonalAccessToken) Scopes() []string {
+	return slices.Clone(t.scopes)
+}
+
+func (t *PersonalAccessToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *PersonalAccessToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *PersonalAccessToken) IsExpired() bool {
+	return time.Now().After(t.expiresAt)
+}
+
+// Restrict returns the grants in access that the token's scopes cover
+func (t *PersonalAccessToken) Restrict(access Access) Access {
+	covered := func(name string) bool { return slices.Contains(t.scopes, name) }
+
+	var restricted Access
+	for _, role := range access.Roles {
+		if covered(role) {
+			restricted.Roles = append(restricted.Roles, role)
+		}
+	}
+	for _, permission := range access.Permissions {
+		if covered(permission) {
+			restricted.Permissions = append(restricted.Permissions, permission)
+		}
+	}
+	return restricted
+}
+
+// IsPersonalAccessToken reports whether token has the personal access token format
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PersonalAccessTokenPrefix)
+}
@@ -0,0 +1,150 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// RefreshToken is the server-side record of an issued refresh token
+// WHY: Refresh tokens are single-use. Keeping a record lets us rotate them
+// and detect when an already-rotated token is replayed.
+type RefreshToken struct {
+	tokenHash  string             // SHA-256 of the token (never store the raw token)
+	familyID   string             // Every token rotated from one login shares a family
+	userID     valueobject.UserID // Owner of the token
+	issuedAt   time.Time          // When the token was issued
+	expiresAt  time.Time          // When the token stops being valid
+	usedAt     *time.Time         // When the token was rotated (nil = unused)
+	replacedBy string             // Hash of the token that replaced this one
+	revokedAt  *time.Time         // When the token was revoked (nil = not revoked)
+}
+
+// NewTokenFamilyID generates a new refresh token family identifier
+// WHY: A family starts at login/signup and follows every rotation after it
+func NewTokenFamilyID() string {
+	return uuid.New().String()
+}
+
+func NewRefreshToken(
+	tokenHash string,
+	familyID string,
+	userID valueobject.UserID,
+	expiresAt time.Time,
+) (*RefreshToken, error) {
+	if tokenHash == "" {
+		return nil, errors.New("token hash is required")
+	}
+
+	if familyID == "" {
+		return nil, errors.New("token family is required")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID is required")
+	}
+
+	now := time.Now().UTC()
+	if !expiresAt.After(now) {
+		return nil, errors.New("expiry must be in the future")
+	}
+
+	return &RefreshToken{
+		tokenHash: tokenHash,
+		familyID:  familyID,
+		userID:    userID,
+		issuedAt:  now,
+		expiresAt: expiresAt.UTC(),
+	}, nil
+}
+
+// ReconstructRefreshToken recreates a refresh token from stored data
+func ReconstructRefreshToken(
+	tokenHash string,
+	familyID string,
+	userID valueobject.UserID,
+	issuedAt time.Time,
+	expiresAt time.Time,
+	usedAt *time.Time,
+	replacedBy string,
+	revokedAt *time.Time,
+) *RefreshToken {
+	return &RefreshToken{
+		tokenHash:  tokenHash,
+		familyID:   familyID,
+		userID:     userID,
+		issuedAt:   issuedAt,
+		expiresAt:  expiresAt,
+		usedAt:     usedAt,
+		replacedBy: replacedBy,
+		revokedAt:  revokedAt,
+	}
+}
+
+func (t *RefreshToken) TokenHash() string {
+	return t.tokenHash
+}
+
+func (t *RefreshToken) FamilyID() string {
+	return t.familyID
+}
+
+func (t *RefreshToken) UserID() valueobject.UserID {
+	return t.userID
+}
+
+func (t *RefreshToken) IssuedAt() time.Time {
+	return t.issuedAt
+}
+
+func (t *RefreshToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *RefreshToken) UsedAt() *time.Time {
+	return t.usedAt
+}
+
+func (t *RefreshToken) ReplacedBy() string {
+	return t.replacedBy
+}
+
+func (t *RefreshToken) RevokedAt() *time.Time {
+	return t.revokedAt
+}
+
+func (t *RefreshToken) IsUsed() bool {
+	return t.usedAt != nil
+}
+
+func (t *RefreshToken) IsRevoked() bool {
+	return t.revokedAt != nil
+}
+
+func (t *RefreshToken) IsExpired() bool {
+	return !time.Now().UTC().Before(t.expiresAt)
+}
+
+// MarkUsed records that the token was rotated into replacedBy
+func (t *RefreshToken) MarkUsed(replacedBy string) error {
+	if t.IsUsed() {
+		return errors.New("refresh token already used")
+	}
+
+	now := time.Now().UTC()
+	t.usedAt = &now
+	t.replacedBy = replacedBy
+	return nil
+}
+
+// Revoke invalidates the token
+func (t *RefreshToken) Revoke() {
+	if t.IsRevoked() {
+		return
+	}
+
+	now := time.Now().UTC()
+	t.revokedAt = &now
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+// RevokedToken marks a JWT (by its jti) as no longer valid
+// WHY: JWTs are stateless - without a denylist a token lives until exp
+type RevokedToken struct {
+	tokenID   string             // JWT ID (jti claim)
+	userID    valueobject.UserID // Owner of the token
+	revokedAt time.Time          // When the token was revoked
+	expiresAt time.Time          // Token's own expiry - entry is useless after this
+}
+
+func NewRevokedToken(tokenID string, userID valueobject.UserID, expiresAt time.Time) (*RevokedToken, error) {
+	if tokenID == "" {
+		return nil, errors.New("token ID is required")
+	}
+
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID is required")
+	}
+
+	return &RevokedToken{
+		tokenID:   tokenID,
+		userID:    userID,
+		revokedAt: time.Now().UTC(),
+		expiresAt: expiresAt.UTC(),
+	}, nil
+}
+
+// ReconstructRevokedToken recreates a revoked token from stored data
+func ReconstructRevokedToken(
+	tokenID string,
+	userID valueobject.UserID,
+	revokedAt time.Time,
+	expiresAt time.Time,
+) *RevokedToken {
+	return &RevokedToken{
+		tokenID:   tokenID,
+		userID:    userID,
+		revokedAt: revokedAt,
+		expiresAt: expiresAt,
+	}
+}
+
+func (t *RevokedToken) TokenID() string {
+	return t.tokenID
+}
+
+func (t *RevokedToken) UserID() valueobject.UserID {
+	return t.userID
+}
+
+func (t *RevokedToken) RevokedAt() time.Time {
+	return t.revokedAt
+}
+
+func (t *RevokedToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
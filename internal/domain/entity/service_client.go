@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// ServiceClient is a machine identity: a backend service that gets tokens
+// for itself with the client_credentials grant
+// NOTE: Unlike an OAuthClient it never acts for a user, so it has no
+// redirect URIs and always has a secret
+type ServiceClient struct {
+	id         string               // client_id, and sub of its tokens
+	tenantID   valueobject.TenantID // Organization the service belongs to
+	name       string
+	secretHash string   // SHA-256 of the client secret
+	scopes     []string // Scopes the client may request
+	isActive   bool     // Inactive clients get no tokens and their tokens stop validating
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+func NewServiceClient(
+	tenantID valueobject.TenantID,
+	name string,
+	secretHash string,
+	scopes []string,
+) (*ServiceClient, error) {
+	if tenantID.IsEmpty() {
+		return nil, errors.New("tenant is required")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("client name is required")
+	}
+
+	if secretHash == "" {
+		return nil, errors.New("client secret is required")
+	}
+
+	if len(scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if err := ValidateScope(scope); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC()
+
+	return &ServiceClient{
+		id:         uuid.New().String(),
+		tenantID:   tenantID,
+		name:       name,
+		secretHash: secretHash,
+		scopes:     slices.Compact(slices.Sorted(slices.Values(scopes))),
+		isActive:   true,
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+// ReconstructServiceClient recreates a service client from stored data
+func ReconstructServiceClient(
+	id string,
+	tenantID valueobject.TenantID,
+	name string,
+	secretHash string,
+	scopes []string,
+	isActive bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *ServiceClient {
+	return &ServiceClient{
+		id:         id,
+		tenantID:   tenantID,
+		name:       name,
+		secretHash: secretHash,
+		scopes:     scopes,
+		isActive:   isActive,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+func (c *ServiceClient) ID() string {
+	return c.id
+}
+
+func (c *ServiceClient) TenantID() valueobject.TenantID {
+	return c.tenantID
+}
+
+func (c *ServiceClient) Name() string {
+	return c.name
+}
+
+func (c *ServiceClient) SecretHash() string {
+	return c.secretHash
+}
+
+func (c *ServiceClient) Scopes() []string {
+	return slices.Clone(c.scopes)
+}
+
+func (c *ServiceClient) IsActive() bool {
+	return c.isActive
+}
+
+func (c *ServiceClient) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *ServiceClient) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *ServiceClient) Deactivate() {
+	c.isActive = false
+	c.updatedAt = time.Now().UTC()
+}
+
+func (c *ServiceClient) Activate() {
+	c.isActive = true
+	c.updatedAt = time.Now().UTC()
+}
+
+// ResolveScopes returns the scopes to grant for requested
+// An empty request means every scope the client is registered for
+func (c *ServiceClient) ResolveScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return c.Scopes(), nil
+	}
+
+	for _, scope := range requested {
+		if !slices.Contains(c.scopes, scope) {
+			return nil, fmt.Errorf("scope %q is not allowed for this client", scope)
+		}
+	}
+	return slices.Clone(requested), nil
+}
@@ -0,0 +1,148 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// maxUserAgentLength bounds the stored User-Agent header
+const maxUserAgentLength = 512
+
+// Session is one signed-in device: a refresh token family and where it is used
+// WHY: Refresh tokens rotate on every use; the session is the stable thing
+// users see and revoke
+// NOTE: Access tokens carry the session ID (sid), so ending a session stops
+// them working before they expire
+type Session struct {
+	id         string
+	userID     valueobject.UserID
+	familyID   string // Refresh token family the session refreshes with
+	clientID   string // OAuth client the session was granted to ("" = first-party)
+	userAgent  string // User-Agent of the latest sign-in or refresh
+	ipAddress  string // Client address of the latest sign-in or refresh
+	createdAt  time.Time
+	lastSeenAt time.Time // Last sign-in or refresh (not every request)
+	expiresAt  time.Time // When the family's refresh token expires
+}
+
+func NewSession(
+	userID valueobject.UserID,
+	familyID string,
+	clientID string,
+	userAgent string,
+	ipAddress string,
+	expiresAt time.Time,
+) (*Session, error) {
+	if userID.IsEmpty() {
+		return nil, errors.New("user ID is required")
+	}
+
+	if familyID == "" {
+		return nil, errors.New("token family is required")
+	}
+
+	now := time.Now().UTC()
+	if !expiresAt.After(now) {
+		return nil, errors.New("expiry must be in the future")
+	}
+
+	return &Session{
+		id:         uuid.New().String(),
+		userID:     userID,
+		familyID:   familyID,
+		clientID:   clientID,
+		userAgent:  truncateUserAgent(userAgent),
+		ipAddress:  ipAddress,
+		createdAt:  now,
+		lastSeenAt: now,
+		expiresAt:  expiresAt.UTC(),
+	}, nil
+}
+
+// ReconstructSession recreates a session from stored data
+func ReconstructSession(
+	id string,
+	userID valueobject.UserID,
+	familyID string,
+	clientID string,
+	userAgent string,
+	ipAddress string,
+	createdAt time.Time,
+	lastSeenAt time.Time,
+	expiresAt time.Time,
+) *Session {
+	return &Session{
+		id:         id,
+		userID:     userID,
+		familyID:   familyID,
+		clientID:   clientID,
+		userAgent:  userAgent,
+		ipAddress:  ipAddress,
+		createdAt:  createdAt,
+		lastSeenAt: lastSeenAt,
+		expiresAt:  expiresAt,
+	}
+}
+
+func (s *Session) ID() string {
+	return s.id
+}
+
+func (s *Session) UserID() valueobject.UserID {
+	return s.userID
+}
+
+func (s *Session) FamilyID() string {
+	return s.familyID
+}
+
+func (s *Session) ClientID() string {
+	return s.clientID
+}
+
+func (s *Session) UserAgent() string {
+	return s.userAgent
+}
+
+func (s *Session) IPAddress() string {
+	return s.ipAddress
+}
+
+func (s *Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Session) LastSeenAt() time.Time {
+	return s.lastSeenAt
+}
+
+func (s *Session) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+func (s *Session) IsExpired() bool {
+	return time.Now().After(s.expiresAt)
+}
+
+// Touch records a refresh from userAgent and ipAddress
+// NOTE: Unknown values (empty) keep the previous ones
+func (s *Session) Touch(userAgent, ipAddress string, expiresAt time.Time) {
+	if userAgent != "" {
+		s.userAgent = truncateUserAgent(userAgent)
+	}
+	if ipAddress != "" {
+		s.ipAddress = ipAddress
+	}
+	s.lastSeenAt = time.Now().UTC()
+	s.expiresAt = expiresAt.UTC()
+}
+
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) > maxUserAgentLength {
+		return userAgent[:maxUserAgentLength]
+	}
+	return userAgent
+}
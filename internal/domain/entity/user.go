@@ -1,7 +1,10 @@
 package entity
 
 import (
+	"crypto/subtle"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
@@ -9,15 +12,36 @@ import (
 
 type User struct {
 	id        valueobject.UserID   // Unique identifier
-	email     valueobject.Email    // Email (validated)
+	tenantID  valueobject.TenantID // Organization the account belongs to
+	email     valueobject.Email    // Email (validated, unique per tenant)
 	password  valueobject.Password // Password (hashed)
 	createdAt time.Time            // When user was created
 	updatedAt time.Time            // When user was last updated
 	isActive  bool                 // Can user log in?
+
+	emailVerifiedAt *time.Time        // When the email was verified (nil = unverified)
+	pendingEmail    valueobject.Email // Requested new address awaiting confirmation (empty = none)
+	mfa             MFA               // Second factor enrollment (zero = none)
+	access          Access            // Roles and permissions (zero = none)
+
+	passwordResetRequired bool // Set by an admin; password login blocked until reset
+}
+
+// MFA is a user's TOTP second-factor state
+// NOTE: Secrets are stored encrypted - the entity never sees them in plain text
+type MFA struct {
+	Secret        string   // Encrypted TOTP secret (set = MFA enabled)
+	PendingSecret string   // Encrypted secret awaiting a confirming code
+	RecoveryCodes []string // Hashes of unused one-time recovery codes
+	LastUsedStep  int64    // Last accepted TOTP time step (replay guard)
 }
 
-func NewUser(email valueobject.Email, password valueobject.Password) (*User, error) {
+func NewUser(tenantID valueobject.TenantID, email valueobject.Email, password valueobject.Password) (*User, error) {
 	// Validate inputs
+	if tenantID.IsEmpty() {
+		return nil, errors.New("tenant is required")
+	}
+
 	if email.IsEmpty() {
 		return nil, errors.New("email is required")
 	}
@@ -30,6 +54,7 @@ func NewUser(email valueobject.Email, password valueobject.Password) (*User, err
 
 	return &User{
 		id:        valueobject.NewUserID(), // Generate new ID
+		tenantID:  tenantID,
 		email:     email,
 		password:  password,
 		createdAt: now,
@@ -43,19 +68,32 @@ func NewUser(email valueobject.Email, password valueobject.Password) (*User, err
 // This bypasses validation since data is already validated
 func ReconstructUser(
 	id valueobject.UserID,
+	tenantID valueobject.TenantID,
 	email valueobject.Email,
 	password valueobject.Password,
 	createdAt time.Time,
 	updatedAt time.Time,
 	isActive bool,
+	emailVerifiedAt *time.Time,
+	pendingEmail valueobject.Email,
+	mfa MFA,
+	access Access,
+	passwordResetRequired bool,
 ) *User {
 	return &User{
-		id:        id,
-		email:     email,
-		password:  password,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
-		isActive:  isActive,
+		id:              id,
+		tenantID:        tenantID,
+		email:           email,
+		password:        password,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+		isActive:        isActive,
+		emailVerifiedAt: emailVerifiedAt,
+		pendingEmail:    pendingEmail,
+		mfa:             mfa,
+		access:          access,
+
+		passwordResetRequired: passwordResetRequired,
 	}
 }
 
@@ -65,6 +103,10 @@ func (u *User) ID() valueobject.UserID {
 	return u.id
 }
 
+func (u *User) TenantID() valueobject.TenantID {
+	return u.tenantID
+}
+
 func (u *User) Email() valueobject.Email {
 	return u.email
 }
@@ -85,6 +127,26 @@ func (u *User) IsActive() bool {
 	return u.isActive
 }
 
+func (u *User) EmailVerifiedAt() *time.Time {
+	return u.emailVerifiedAt
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.emailVerifiedAt != nil
+}
+
+// MarkEmailVerified records that the user proved they own their email
+// NOTE: Idempotent - verifying twice keeps the first timestamp
+func (u *User) MarkEmailVerified() {
+	if u.emailVerifiedAt != nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	u.emailVerifiedAt = &now
+	u.updatedAt = now
+}
+
 func (u *User) Deactivate() {
 	u.isActive = false
 	u.updatedAt = time.Now().UTC()
@@ -95,22 +157,231 @@ func (u *User) Activate() {
 	u.updatedAt = time.Now().UTC()
 }
 
+// PasswordResetRequired reports whether the user must set a new password
+// before logging in with one
+func (u *User) PasswordResetRequired() bool {
+	return u.passwordResetRequired
+}
+
+// RequirePasswordReset blocks password login until the password is changed
+// WHY: Admins force a reset when a password may be compromised
+func (u *User) RequirePasswordReset() {
+	u.passwordResetRequired = true
+	u.updatedAt = time.Now().UTC()
+}
+
+// MFA returns a copy of the user's second-factor state
+func (u *User) MFA() MFA {
+	mfa := u.mfa
+	mfa.RecoveryCodes = append([]string(nil), u.mfa.RecoveryCodes...)
+	return mfa
+}
+
+func (u *User) IsMFAEnabled() bool {
+	return u.mfa.Secret != ""
+}
+
+// BeginMFAEnrollment stores a new secret that takes effect once confirmed
+// NOTE: Starting again replaces an unconfirmed secret
+func (u *User) BeginMFAEnrollment(encryptedSecret string) error {
+	if u.IsMFAEnabled() {
+		return errors.New("multi-factor authentication already enabled")
+	}
+	if encryptedSecret == "" {
+		return errors.New("secret is required")
+	}
+
+	u.mfa.PendingSecret = encryptedSecret
+	u.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// ConfirmMFAEnrollment activates the pending secret
+// step is the TOTP step of the confirming code, so it can't be replayed at login
+func (u *User) ConfirmMFAEnrollment(recoveryCodeHashes []string, step int64) error {
+	if u.mfa.PendingSecret == "" {
+		return errors.New("no multi-factor enrollment in progress")
+	}
+
+	u.mfa = MFA{
+		Secret:        u.mfa.PendingSecret,
+		RecoveryCodes: recoveryCodeHashes,
+		LastUsedStep:  step,
+	}
+	u.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// DisableMFA removes the second factor and all recovery codes
+func (u *User) DisableMFA() {
+	u.mfa = MFA{}
+	u.updatedAt = time.Now().UTC()
+}
+
+// AcceptTOTPStep records a used TOTP step
+// Returns false if step (or a later one) was already used - each code works once
+func (u *User) AcceptTOTPStep(step int64) bool {
+	if step <= u.mfa.LastUsedStep {
+		return false
+	}
+
+	u.mfa.LastUsedStep = step
+	u.updatedAt = time.Now().UTC()
+	return true
+}
+
+// UseRecoveryCode consumes the recovery code with codeHash
+// Returns false if no unused code matches
+func (u *User) UseRecoveryCode(codeHash string) bool {
+	for i, hash := range u.mfa.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(codeHash)) == 1 {
+			u.mfa.RecoveryCodes = append(u.mfa.RecoveryCodes[:i:i], u.mfa.RecoveryCodes[i+1:]...)
+			u.updatedAt = time.Now().UTC()
+			return true
+		}
+	}
+	return false
+}
+
+// Access returns a copy of the user's roles and permissions
+func (u *User) Access() Access {
+	return Access{
+		Roles:       slices.Clone(u.access.Roles),
+		Permissions: slices.Clone(u.access.Permissions),
+	}
+}
+
+func (u *User) HasRole(role string) bool {
+	return slices.Contains(u.access.Roles, role)
+}
+
+func (u *User) HasPermission(permission string) bool {
+	return slices.Contains(u.access.Permissions, permission)
+}
+
+// GrantRole adds a role
+// Returns false if the user already had it
+func (u *User) GrantRole(role string) (bool, error) {
+	roles, changed, err := grant(u.access.Roles, role)
+	if err != nil {
+		return false, fmt.Errorf("invalid role: %w", err)
+	}
+	if changed {
+		u.access.Roles = roles
+		u.updatedAt = time.Now().UTC()
+	}
+	return changed, nil
+}
+
+// RevokeRole removes a role
+// Returns false if the user didn't have it
+func (u *User) RevokeRole(role string) bool {
+	roles, changed := revoke(u.access.Roles, role)
+	if changed {
+		u.access.Roles = roles
+		u.updatedAt = time.Now().UTC()
+	}
+	return changed
+}
+
+// GrantPermission adds a permission
+// Returns false if the user already had it
+func (u *User) GrantPermission(permission string) (bool, error) {
+	permissions, changed, err := grant(u.access.Permissions, permission)
+	if err != nil {
+		return false, fmt.Errorf("invalid permission: %w", err)
+	}
+	if changed {
+		u.access.Permissions = permissions
+		u.updatedAt = time.Now().UTC()
+	}
+	return changed, nil
+}
+
+// RevokePermission removes a permission
+// Returns false if the user didn't have it
+func (u *User) RevokePermission(permission string) bool {
+	permissions, changed := revoke(u.access.Permissions, permission)
+	if changed {
+		u.access.Permissions = permissions
+		u.updatedAt = time.Now().UTC()
+	}
+	return changed
+}
+
 func (u *User) UpdateEmail(newEmail valueobject.Email) error {
 	if newEmail.IsEmpty() {
 		return errors.New("email cannot be empty")
 	}
 
+	// WHY: Verification proves ownership of an address, not of the account
+	if !newEmail.Equals(u.email) {
+		u.emailVerifiedAt = nil
+	}
+
 	u.email = newEmail
 	u.updatedAt = time.Now().UTC()
 	return nil
 }
 
+// PendingEmail is the address an email change is waiting to confirm (empty = none)
+func (u *User) PendingEmail() valueobject.Email {
+	return u.pendingEmail
+}
+
+// RequestEmailChange records a new address to move to once it's confirmed
+// WHY: The account keeps its current address until the owner of the new
+// one proves it - a typo or a stolen session can't take the account over
+// NOTE: A newer request replaces an older one
+func (u *User) RequestEmailChange(newEmail valueobject.Email) error {
+	if newEmail.IsEmpty() {
+		return errors.New("email cannot be empty")
+	}
+
+	if newEmail.Equals(u.email) {
+		return errors.New("new email must differ from the current one")
+	}
+
+	u.pendingEmail = newEmail
+	u.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// ConfirmEmailChange moves the account to the pending address
+// NOTE: Confirming proves ownership, so the new address is verified
+func (u *User) ConfirmEmailChange() error {
+	if u.pendingEmail.IsEmpty() {
+		return errors.New("no email change pending")
+	}
+
+	now := time.Now().UTC()
+	u.email = u.pendingEmail
+	u.pendingEmail = valueobject.Email{}
+	u.emailVerifiedAt = &now
+	u.updatedAt = now
+	return nil
+}
+
 func (u *User) UpdatePassword(newPassword valueobject.Password) error {
 	if newPassword.Hash() == "" {
 		return errors.New("password cannot be empty")
 	}
 
 	u.password = newPassword
+	u.passwordResetRequired = false
+	u.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// RehashPassword replaces the stored hash of the same password
+// NOTE: Unlike UpdatePassword it keeps a required reset - the password
+// itself hasn't changed
+func (u *User) RehashPassword(rehashed valueobject.Password) error {
+	if rehashed.Hash() == "" {
+		return errors.New("password cannot be empty")
+	}
+
+	u.password = rehashed
 	u.updatedAt = time.Now().UTC()
 	return nil
 }
@@ -126,6 +397,10 @@ func (u *User) Validate() error {
 		return errors.New("user ID cannot be empty")
 	}
 
+	if u.tenantID.IsEmpty() {
+		return errors.New("tenant cannot be empty")
+	}
+
 	if u.email.IsEmpty() {
 		return errors.New("email cannot be empty")
 	}
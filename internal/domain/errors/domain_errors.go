@@ -3,6 +3,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 var (
@@ -16,6 +17,8 @@ var (
 	ErrForbidden           = errors.New("forbidden")
 	ErrConflict            = errors.New("resource conflict")
 	ErrNotFound            = errors.New("resource not found")
+	ErrTooManyAttempts     = errors.New("too many attempts")
+	ErrAccountLocked       = errors.New("account temporarily locked")
 )
 
 type DomainError struct {
@@ -30,6 +33,16 @@ type DomainError struct {
 
 	// Err is the underlying error (if any)
 	Err error
+
+	// RetryAfter is how long the caller should wait (throttling errors only)
+	RetryAfter time.Duration
+
+	// OAuthCode is the RFC 6749 error code (OAuth endpoints only)
+	OAuthCode string
+
+	// OAuthRedirect is where to send the user agent with the error
+	// (authorization endpoint only, once the redirect URI is verified)
+	OAuthRedirect string
 }
 
 func (e *DomainError) Error() string {
@@ -92,6 +105,94 @@ func NewNotFoundError(message string) *DomainError {
 	}
 }
 
+// NewTooManyAttemptsError creates a backoff error
+// WHY: Repeated failures must slow down before they lock the account
+func NewTooManyAttemptsError(message string, retryAfter time.Duration) *DomainError {
+	return &DomainError{
+		Type:       ErrTooManyAttempts,
+		Message:    message,
+		RetryAfter: retryAfter,
+	}
+}
+
+// NewAccountLockedError creates a temporary lockout error
+func NewAccountLockedError(message string, retryAfter time.Duration) *DomainError {
+	return &DomainError{
+		Type:       ErrAccountLocked,
+		Message:    message,
+		RetryAfter: retryAfter,
+	}
+}
+
+// RetryAfter returns how long to wait before retrying, if err says
+func RetryAfter(err error) (time.Duration, bool) {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) && domainErr.RetryAfter > 0 {
+		return domainErr.RetryAfter, true
+	}
+	return 0, false
+}
+
+// OAuth 2.0 error codes (RFC 6749 sections 4.1.2.1 and 5.2)
+const (
+	OAuthInvalidRequest          = "invalid_request"
+	OAuthInvalidClient           = "invalid_client"
+	OAuthInvalidGrant            = "invalid_grant"
+	OAuthInvalidScope            = "invalid_scope"
+	OAuthUnauthorizedClient      = "unauthorized_client"
+	OAuthUnsupportedGrantType    = "unsupported_grant_type"
+	OAuthUnsupportedResponseType = "unsupported_response_type"
+	OAuthAccessDenied            = "access_denied"
+)
+
+// NewOAuthError creates an error reported to OAuth clients as code
+// WHY: Clients branch on the RFC 6749 code, so it must survive to the
+// response; the type still maps to an HTTP status like any domain error
+func NewOAuthError(code string, message string) *DomainError {
+	errType := ErrInvalidInput
+	switch code {
+	case OAuthInvalidClient:
+		errType = ErrUnauthorized
+	case OAuthAccessDenied:
+		errType = ErrForbidden
+	}
+
+	return &DomainError{
+		Type:      errType,
+		Message:   message,
+		OAuthCode: code,
+	}
+}
+
+// NewOAuthRedirectError is NewOAuthError for an error reported to the client
+// by redirecting back to it; location already carries the error parameters
+func NewOAuthRedirectError(code string, message string, location string) *DomainError {
+	err := NewOAuthError(code, message)
+	err.OAuthRedirect = location
+	return err
+}
+
+// OAuthRedirect returns where to redirect the user agent for err, if anywhere
+// SECURITY: Empty unless the client's redirect URI was verified - otherwise
+// the error must be shown to the user, not sent to an unknown URI
+func OAuthRedirect(err error) (string, bool) {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) && domainErr.OAuthRedirect != "" {
+		return domainErr.OAuthRedirect, true
+	}
+	return "", false
+}
+
+// OAuthCode returns the RFC 6749 error code for err
+// NOTE: Errors that aren't OAuth errors report server_error
+func OAuthCode(err error) string {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) && domainErr.OAuthCode != "" {
+		return domainErr.OAuthCode
+	}
+	return "server_error"
+}
+
 // WrapError wraps an error with additional context
 // WHY: Preserve error chain (crucial for debugging)
 func WrapError(baseType error, message string, err error) *DomainError {
@@ -0,0 +1,51 @@
+package valueobject
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// DefaultTenant is the tenant of accounts created before multi-tenancy and
+// of requests that don't name one
+const DefaultTenant = "default"
+
+// tenantIDPattern allows short, URL- and header-safe slugs like "acme" or "shop-eu"
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+// TenantID identifies an organization (tenant)
+// WHY: A readable slug rather than a UUID - clients send it in a header
+type TenantID struct {
+	value string
+}
+
+func NewTenantID(id string) (TenantID, error) {
+	id = strings.ToLower(strings.TrimSpace(id))
+	if id == "" {
+		return TenantID{}, errors.New("tenant ID cannot be empty")
+	}
+
+	if !tenantIDPattern.MatchString(id) {
+		return TenantID{}, errors.New("tenant ID must be 1-63 lowercase letters, digits or hyphens and start with a letter or digit")
+	}
+
+	return TenantID{value: id}, nil
+}
+
+// DefaultTenantID returns the ID of the default tenant
+func DefaultTenantID() TenantID {
+	return TenantID{value: DefaultTenant}
+}
+
+// String returns the ID as a string
+func (id TenantID) String() string {
+	return id.value
+}
+
+func (id TenantID) Equals(other TenantID) bool {
+	return id.value == other.value
+}
+
+func (id TenantID) IsEmpty() bool {
+	return id.value == ""
+}
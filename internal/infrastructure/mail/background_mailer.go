@@ -0,0 +1,52 @@
+package mail
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// backgroundSendTimeout bounds one delivery attempt
+const backgroundSendTimeout = 30 * time.Second
+
+// BackgroundMailer delivers messages without making the caller wait
+// WHY: For flows that must answer the same whether or not an account exists -
+// a slow or failing send would otherwise show that one does
+// NOTE: Best effort - failures are logged, never returned
+type BackgroundMailer struct {
+	mailer  Mailer
+	pending sync.WaitGroup
+}
+
+// NewBackgroundMailer creates a background mailer sending through mailer
+func NewBackgroundMailer(mailer Mailer) *BackgroundMailer {
+	return &BackgroundMailer{
+		mailer: mailer,
+	}
+}
+
+// Send queues msg for delivery and returns straight away
+func (m *BackgroundMailer) Send(ctx context.Context, msg Message) {
+	// WHY: Outlives the request, but keeps its values (e.g. the locale)
+	ctx = context.WithoutCancel(ctx)
+
+	m.pending.Add(1)
+	go func() {
+		defer m.pending.Done()
+
+		ctx, cancel := context.WithTimeout(ctx, backgroundSendTimeout)
+		defer cancel()
+
+		// NOTE: The recipient isn't logged - it's the address being protected
+		if err := m.mailer.Send(ctx, msg); err != nil {
+			log.Printf("Failed to send %q email: %v", msg.Subject, err)
+		}
+	}()
+}
+
+// Wait blocks until every queued message has been attempted
+// WHY: Lets shutdown finish sending, and tests read what was sent
+func (m *BackgroundMailer) Wait() {
+	m.pending.Wait()
+}
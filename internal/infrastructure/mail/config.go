@@ -0,0 +1,53 @@
+package mail
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+)
+
+// NewMailerFromConfig creates the configured transport wrapped with templates
+func NewMailerFromConfig(cfg config.MailConfig) (Mailer, error) {
+	var transport Mailer
+	switch cfg.Transport {
+	case "smtp":
+		smtpMailer, err := NewSMTPMailer(SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			TLS:      cfg.SMTPTLS,
+			Timeout:  cfg.SMTPTimeout,
+		}, cfg.From)
+		if err != nil {
+			return nil, err
+		}
+		transport = smtpMailer
+	case "file":
+		fileMailer, err := NewFileMailer(cfg.OutboxDir, cfg.From)
+		if err != nil {
+			return nil, err
+		}
+		transport = fileMailer
+	case "log":
+		transport = NewLogMailer()
+	default:
+		return nil, fmt.Errorf("unknown mail transport: %s", cfg.Transport)
+	}
+
+	templates, err := loadTemplates(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTemplateMailer(transport, templates), nil
+}
+
+// loadTemplates reads templates from TemplateDir, or the built-in set
+func loadTemplates(cfg config.MailConfig) (*Templates, error) {
+	if cfg.TemplateDir == "" {
+		return NewBuiltinTemplates(cfg.DefaultLocale)
+	}
+	return NewTemplates(os.DirFS(cfg.TemplateDir), cfg.DefaultLocale)
+}
@@ -0,0 +1,68 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileMailer writes each message as an .eml file into an outbox directory
+// WHY: Tests and local runs can read real, fully encoded messages (open them
+// in any mail client) without an SMTP server
+// WARNING: Files contain live links and codes - don't point it at shared storage
+type FileMailer struct {
+	dir  string
+	from *mail.Address
+}
+
+// NewFileMailer creates a new file mailer, creating dir if needed
+func NewFileMailer(dir, from string) (*FileMailer, error) {
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", from, err)
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create outbox directory: %w", err)
+	}
+
+	return &FileMailer{dir: dir, from: sender}, nil
+}
+
+// Send writes msg to <dir>/<timestamp>-<random>.eml
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	now := time.Now()
+
+	raw, err := encodeMessage(m.from, msg, now)
+	if err != nil {
+		return err
+	}
+
+	// WHY: Write under a temporary name and rename, so readers polling the
+	// outbox never see a half-written message
+	tmp, err := os.CreateTemp(m.dir, ".outgoing-*")
+	if err != nil {
+		return fmt.Errorf("failed to create outbox file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write outbox file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write outbox file: %w", err)
+	}
+
+	// Timestamp prefix keeps files in send order; the temp suffix keeps names unique
+	name := now.UTC().Format("20060102T150405.000000000Z") + "-" + strings.TrimPrefix(filepath.Base(tmp.Name()), ".outgoing-") + ".eml"
+	if err := os.Rename(tmp.Name(), filepath.Join(m.dir, name)); err != nil {
+		return fmt.Errorf("failed to store outbox file: %w", err)
+	}
+
+	return nil
+}
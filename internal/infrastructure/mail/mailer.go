@@ -0,0 +1,40 @@
+package mail
+
+import (
+	"context"
+	"log"
+)
+
+// Message is an email to deliver
+type Message struct {
+	To       string
+	Subject  string
+	Body     string // Plain text
+	HTMLBody string // Optional HTML alternative
+
+	// Template names a localized template that replaces Subject and both bodies
+	// NOTE: Only TemplateMailer reads it - the fields above are the fallback
+	Template string
+	Locale   string // Template locale (falls back to the request's, then the default)
+	Data     any    // Template data
+}
+
+// Mailer delivers email
+// WHY: Use cases send mail through this interface so transports can be swapped
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogMailer writes messages to the log instead of sending them
+// WARNING: Development only - links in messages (e.g. verification) end up in logs
+type LogMailer struct{}
+
+// NewLogMailer creates a new log mailer
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	log.Printf("📧 Mail to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
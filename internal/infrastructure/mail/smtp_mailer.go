@@ -0,0 +1,134 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+// SMTP TLS modes
+const (
+	SMTPTLSStartTLS = "starttls" // Upgrade a plain connection (port 587)
+	SMTPTLSImplicit = "tls"      // TLS from the first byte (port 465)
+	SMTPTLSNone     = "none"     // Plain text - local relays only
+)
+
+// SMTPConfig describes an SMTP relay
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string // Empty disables AUTH
+	Password string
+	TLS      string // SMTPTLSStartTLS, SMTPTLSImplicit or SMTPTLSNone
+	Timeout  time.Duration
+}
+
+// SMTPMailer sends mail through an SMTP relay
+// NOTE: One connection per message - volume here is a handful of
+// transactional emails, not bulk mail
+type SMTPMailer struct {
+	cfg  SMTPConfig
+	from *mail.Address
+}
+
+// NewSMTPMailer creates a new SMTP mailer
+func NewSMTPMailer(cfg SMTPConfig, from string) (*SMTPMailer, error) {
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", from, err)
+	}
+
+	if cfg.TLS == "" {
+		cfg.TLS = SMTPTLSStartTLS
+	}
+
+	return &SMTPMailer{cfg: cfg, from: sender}, nil
+}
+
+// Send delivers msg, giving up when ctx is done or the timeout expires
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	raw, err := encodeMessage(m.from, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if m.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.cfg.Timeout)
+		defer cancel()
+	}
+
+	conn, err := m.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+
+	// WHY: net/smtp has no context support - a deadline bounds the whole exchange
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if m.cfg.TLS == SMTPTLSStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			// SECURITY: Never fall back to sending credentials and links in the clear
+			return errors.New("SMTP server does not support STARTTLS")
+		}
+		if err := client.StartTLS(&tls.Config{ServerName: m.cfg.Host}); err != nil {
+			return fmt.Errorf("SMTP STARTTLS failed: %w", err)
+		}
+	}
+
+	if m.cfg.Username != "" {
+		auth := smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	to, _ := mail.ParseAddress(msg.To) // Already validated by encodeMessage
+	if err := client.Mail(m.from.Address); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM failed: %w", err)
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("SMTP RCPT TO failed: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA failed: %w", err)
+	}
+	if _, err := w.Write(raw); err != nil {
+		w.Close()
+		return fmt.Errorf("failed to write SMTP message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("SMTP server rejected message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// dial opens the connection, wrapping it in TLS for implicit TLS
+func (m *SMTPMailer) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(m.cfg.Host, m.cfg.Port)
+
+	if m.cfg.TLS == SMTPTLSImplicit {
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: m.cfg.Host}}
+		return dialer.DialContext(ctx, "tcp", addr)
+	}
+
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", addr)
+}
@@ -0,0 +1,225 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// Template names used by the auth use cases
+const (
+	TemplateVerifyEmail        = "verify_email"
+	TemplateConfirmEmailChange = "confirm_email_change"
+	TemplateEmailChangeNotice  = "email_change_notice"
+	TemplateResetPassword      = "reset_password"
+	TemplateLoginCode          = "login_code"
+	TemplateMagicLink          = "magic_link"
+	TemplateInvitation         = "invitation"
+)
+
+// TemplateData is the data passed to the auth templates
+type TemplateData struct {
+	Link         string        // Verification, reset, sign-in or invitation link
+	Code         string        // One-time sign-in code
+	Expiry       time.Duration // How long the link or code stays valid
+	Organization string        // Organization an invitation is for
+	Role         string        // Role an invitation grants
+	Email        string        // New address an email change is waiting to confirm
+}
+
+//go:embed templates
+var builtinTemplates embed.FS
+
+// ErrTemplateNotFound is returned when no locale has the requested template
+var ErrTemplateNotFound = errors.New("mail template not found")
+
+// Templates holds localized email templates
+// Layout: <locale>/<name>.txt (text/template, "subject" and "text" blocks)
+// plus <locale>/<name>.html (html/template, the HTML body)
+// e.g. en/verify_email.txt, en/verify_email.html, fr/verify_email.txt, ...
+type Templates struct {
+	byLocale      map[string]map[string]*localizedTemplate
+	defaultLocale string
+}
+
+// localizedTemplate is one template in one locale
+type localizedTemplate struct {
+	text *texttemplate.Template
+	html *template.Template
+}
+
+// Rendered is a rendered template
+type Rendered struct {
+	Subject  string
+	Body     string // Plain text
+	HTMLBody string
+}
+
+// NewBuiltinTemplates loads the templates shipped with the service
+func NewBuiltinTemplates(defaultLocale string) (*Templates, error) {
+	sub, err := fs.Sub(builtinTemplates, "templates")
+	if err != nil {
+		return nil, err
+	}
+	return NewTemplates(sub, defaultLocale)
+}
+
+// NewTemplates parses every <locale>/<name>.txt and .html pair in fsys
+// WHY: Parse once at startup so a broken template fails the boot, not a signup
+func NewTemplates(fsys fs.FS, defaultLocale string) (*Templates, error) {
+	t := &Templates{
+		byLocale:      make(map[string]map[string]*localizedTemplate),
+		defaultLocale: normalizeLocale(defaultLocale),
+	}
+
+	files, err := fs.Glob(fsys, "*/*.txt")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		locale := normalizeLocale(path.Dir(file))
+		name := strings.TrimSuffix(path.Base(file), ".txt")
+		htmlFile := strings.TrimSuffix(file, ".txt") + ".html"
+
+		text, err := texttemplate.ParseFS(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse mail template %s: %w", file, err)
+		}
+		for _, block := range []string{"subject", "text"} {
+			if text.Lookup(block) == nil {
+				return nil, fmt.Errorf("mail template %s has no %q block", file, block)
+			}
+		}
+
+		html, err := template.ParseFS(fsys, htmlFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse mail template %s: %w", htmlFile, err)
+		}
+
+		if t.byLocale[locale] == nil {
+			t.byLocale[locale] = make(map[string]*localizedTemplate)
+		}
+		t.byLocale[locale][name] = &localizedTemplate{text: text, html: html}
+	}
+
+	if len(t.byLocale[t.defaultLocale]) == 0 {
+		return nil, fmt.Errorf("no mail templates for default locale %q", t.defaultLocale)
+	}
+
+	return t, nil
+}
+
+// Render renders a template in the closest available locale
+// Locale fallback: exact ("pt-br"), then language ("pt"), then the default
+func (t *Templates) Render(name, locale string, data any) (*Rendered, error) {
+	tmpl := t.lookup(name, locale)
+	if tmpl == nil {
+		return nil, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	if err := tmpl.text.ExecuteTemplate(&text, "text", data); err != nil {
+		return nil, fmt.Errorf("failed to render %s text: %w", name, err)
+	}
+	if err := tmpl.html.Execute(&html, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s HTML: %w", name, err)
+	}
+
+	return &Rendered{
+		Subject:  strings.TrimSpace(subject.String()),
+		Body:     strings.TrimSpace(text.String()),
+		HTMLBody: html.String(),
+	}, nil
+}
+
+func (t *Templates) lookup(name, locale string) *localizedTemplate {
+	locale = normalizeLocale(locale)
+	candidates := []string{locale}
+	if lang, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, lang)
+	}
+	candidates = append(candidates, t.defaultLocale)
+
+	for _, candidate := range candidates {
+		if tmpl := t.byLocale[candidate][name]; tmpl != nil {
+			return tmpl
+		}
+	}
+	return nil
+}
+
+// normalizeLocale lowercases a language tag and uses "-" as separator ("en_US" -> "en-us")
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// TemplateMailer renders templated messages before handing them to a transport
+// WHY: Use cases describe what to send (template + data); wording, HTML
+// and translations live in template files. Their own Subject and Body are
+// the untemplated fallback (e.g. for test doubles)
+type TemplateMailer struct {
+	next      Mailer
+	templates *Templates
+}
+
+// NewTemplateMailer wraps a transport with template rendering
+func NewTemplateMailer(next Mailer, templates *Templates) *TemplateMailer {
+	return &TemplateMailer{next: next, templates: templates}
+}
+
+// Send renders msg.Template (if set) into Subject and HTMLBody, then sends
+func (m *TemplateMailer) Send(ctx context.Context, msg Message) error {
+	if msg.Template != "" {
+		locale := msg.Locale
+		if locale == "" {
+			locale = LocaleFromContext(ctx)
+		}
+
+		rendered, err := m.templates.Render(msg.Template, locale, msg.Data)
+		if err != nil {
+			return err
+		}
+		msg.Subject = rendered.Subject
+		msg.Body = rendered.Body
+		msg.HTMLBody = rendered.HTMLBody
+	}
+
+	return m.next.Send(ctx, msg)
+}
+
+type localeKey struct{}
+
+// WithLocale records the caller's preferred locale for templated mail
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFromContext returns the locale set by WithLocale ("" if none)
+func LocaleFromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(localeKey{}).(string)
+	return locale
+}
+
+// PreferredLocale returns the first language tag of an Accept-Language value
+// NOTE: Ignores q-weights - clients list their preferred language first
+func PreferredLocale(acceptLanguage string) string {
+	first, _, _ := strings.Cut(acceptLanguage, ",")
+	tag, _, _ := strings.Cut(first, ";")
+	tag = strings.TrimSpace(tag)
+	if tag == "*" {
+		return ""
+	}
+	return tag
+}
@@ -0,0 +1,29 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type AuditLogRepository struct {
+	collection *mongo.Collection
+}
+
+func NewAuditLogRepository(db *mongo.Database) *AuditLogRepository {
+	return &AuditLogRepository{
+		collection: db.Collection("audit_log"),
+	}
+}
+
+func (r *AuditLogRepository) Append(ctx context.Context, event *entity.AuditEvent) error {
+	doc := fromAuditEventEntity(event)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Append", err)
+	}
+
+	return nil
+}
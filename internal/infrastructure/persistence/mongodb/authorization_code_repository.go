@@ -0,0 +1,80 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type AuthorizationCodeRepository struct {
+	collection *mongo.Collection
+}
+
+func NewAuthorizationCodeRepository(db *mongo.Database) *AuthorizationCodeRepository {
+	return &AuthorizationCodeRepository{
+		collection: db.Collection("authorization_codes"),
+	}
+}
+
+func (r *AuthorizationCodeRepository) Create(ctx context.Context, code *entity.AuthorizationCode) error {
+	doc := fromAuthorizationCodeEntity(code)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *AuthorizationCodeRepository) FindByHash(ctx context.Context, codeHash string) (*entity.AuthorizationCode, error) {
+	var doc AuthorizationCodeDocument
+	err := r.collection.FindOne(ctx, bson.M{"_id": codeHash}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewTokenNotFoundError("FindByHash")
+		}
+		return nil, repository.NewDatabaseQueryError("FindByHash", err)
+	}
+
+	code, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByHash", fmt.Errorf("invalid authorization code data: %w", err))
+	}
+
+	return code, nil
+}
+
+func (r *AuthorizationCodeRepository) MarkUsed(ctx context.Context, codeHash string, familyID string) error {
+	now := time.Now().UTC()
+
+	// WHY: The filter makes check-and-set a single atomic operation
+	filter := bson.M{
+		"_id":        codeHash,
+		"used_at":    nil,
+		"expires_at": bson.M{"$gt": now},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"used_at":   now,
+			"family_id": familyID,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("MarkUsed", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return repository.NewTokenAlreadyUsedError("MarkUsed")
+	}
+
+	return nil
+}
@@ -4,19 +4,32 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// legacyEmailIndexName is the pre-multi-tenancy index that made emails
+// globally unique
+const legacyEmailIndexName = "email_unique_idx"
+
 func CreateIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// Migrate users created before multi-tenancy
+	if err := migrateUsersToTenants(ctx, collection); err != nil {
+		return err
+	}
+
+	// Tenant + email index - emails are unique within a tenant
+	// WHY: The same person can hold separate accounts in two organizations
 	emailIndexModel := mongo.IndexModel{
 		Keys: bson.D{
+			{Key: "tenant_id", Value: 1},
 			{Key: "email", Value: 1}, // 1 = ascending order
 		},
 		Options: options.Index().
-			SetUnique(true).             // Enforce uniqueness
-			SetName("email_unique_idx"), // Name for management
+			SetUnique(true).                    // Enforce uniqueness
+			SetName("tenant_email_unique_idx"), // Name for management
 	}
 
 	// Created_at index - for sorting/filtering
@@ -29,6 +42,16 @@ func CreateIndexes(ctx context.Context, collection *mongo.Collection) error {
 			SetName("created_at_idx"),
 	}
 
+	// Tenant + created_at index - admin listing pages one tenant, newest first
+	tenantCreatedAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "tenant_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+		Options: options.Index().
+			SetName("tenant_created_at_idx"),
+	}
+
 	// Is_active index - for filtering active users
 	// WHY: Common query: Find all active users
 	isActiveIndexModel := mongo.IndexModel{
@@ -52,6 +75,7 @@ func CreateIndexes(ctx context.Context, collection *mongo.Collection) error {
 	indexModels := []mongo.IndexModel{
 		emailIndexModel,
 		createdAtIndexModel,
+		tenantCreatedAtIndexModel,
 		isActiveIndexModel,
 		compoundIndexModel,
 	}
@@ -64,6 +88,42 @@ func CreateIndexes(ctx context.Context, collection *mongo.Collection) error {
 	return nil
 }
 
+// migrateUsersToTenants moves existing users into the default tenant
+// NOTE: Idempotent - safe to run on every startup
+func migrateUsersToTenants(ctx context.Context, collection *mongo.Collection) error {
+	// Step 1: Backfill the tenant on users that don't have one
+	_, err := collection.UpdateMany(ctx,
+		bson.M{"tenant_id": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"tenant_id": valueobject.DefaultTenant}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to backfill user tenants: %w", err)
+	}
+
+	// Step 2: Drop the globally unique email index
+	// WHY: It would keep rejecting the same email in a second tenant
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list indexes: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var index bson.M
+		if err := cursor.Decode(&index); err != nil {
+			continue
+		}
+
+		if index["name"] == legacyEmailIndexName {
+			if _, err := collection.Indexes().DropOne(ctx, legacyEmailIndexName); err != nil {
+				return fmt.Errorf("failed to drop index %s: %w", legacyEmailIndexName, err)
+			}
+		}
+	}
+
+	return cursor.Err()
+}
+
 func DropIndexes(ctx context.Context, collection *mongo.Collection) error {
 	// Get all index names
 	cursor, err := collection.Indexes().List(ctx)
@@ -91,3 +151,374 @@ func DropIndexes(ctx context.Context, collection *mongo.Collection) error {
 
 	return nil
 }
+
+func CreateRefreshTokenIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// Family index - revoking a family touches every token in it
+	familyIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "family_id", Value: 1},
+		},
+		Options: options.Index().
+			SetName("family_id_idx"),
+	}
+
+	userIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().
+			SetName("user_id_idx"),
+	}
+
+	// TTL index - MongoDB deletes tokens once they expire
+	// WHY: An expired refresh token fails JWT validation anyway
+	expiresAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expires_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("expires_at_ttl_idx"),
+	}
+
+	indexModels := []mongo.IndexModel{
+		familyIndexModel,
+		userIndexModel,
+		expiresAtIndexModel,
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreateRevokedTokenIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// TTL index - entries disappear once the token would have expired anyway
+	expiresAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expires_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("expires_at_ttl_idx"),
+	}
+
+	_, err := collection.Indexes().CreateOne(ctx, expiresAtIndexModel)
+	if err != nil {
+		return fmt.Errorf("failed to create revoked token indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreatePasswordResetTokenIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// User index - a new reset request deletes the user's older tokens
+	userIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().
+			SetName("user_id_idx"),
+	}
+
+	// TTL index - MongoDB deletes reset tokens once they expire
+	expiresAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expires_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("expires_at_ttl_idx"),
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{userIndexModel, expiresAtIndexModel})
+	if err != nil {
+		return fmt.Errorf("failed to create password reset token indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreateLoginCodeIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// User index - a new code request deletes the user's older codes
+	userIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().
+			SetName("user_id_idx"),
+	}
+
+	// TTL index - MongoDB deletes login codes once they expire
+	expiresAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expires_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("expires_at_ttl_idx"),
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{userIndexModel, expiresAtIndexModel})
+	if err != nil {
+		return fmt.Errorf("failed to create login code indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreateLoginAttemptIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// Tenant + email index - admin unlock clears every counter for an account
+	emailIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "tenant_id", Value: 1},
+			{Key: "email", Value: 1},
+		},
+		Options: options.Index().
+			SetName("tenant_email_idx"),
+	}
+
+	// TTL index - MongoDB forgets counters after the quiet window
+	expiresAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expires_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("expires_at_ttl_idx"),
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{emailIndexModel, expiresAtIndexModel})
+	if err != nil {
+		return fmt.Errorf("failed to create login attempt indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreatePasskeyIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// User index - list a user's passkeys and build sign-in credential lists
+	// NOTE: The credential ID is _id, so it is unique already
+	userIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().
+			SetName("user_id_idx"),
+	}
+
+	_, err := collection.Indexes().CreateOne(ctx, userIndexModel)
+	if err != nil {
+		return fmt.Errorf("failed to create passkey indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreatePersonalAccessTokenIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// Token hash index - every request authenticated with a token looks it up
+	tokenHashIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "token_hash", Value: 1},
+		},
+		Options: options.Index().
+			SetUnique(true).
+			SetName("token_hash_unique_idx"),
+	}
+
+	// User index - list a user's tokens
+	userIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().
+			SetName("user_id_idx"),
+	}
+
+	// TTL index - MongoDB deletes tokens once they expire
+	expiresAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expires_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("expires_at_ttl_idx"),
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{tokenHashIndexModel, userIndexModel, expiresAtIndexModel})
+	if err != nil {
+		return fmt.Errorf("failed to create personal access token indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreateSessionIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// Family index - every refresh looks its session up
+	familyIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "family_id", Value: 1},
+		},
+		Options: options.Index().
+			SetUnique(true).
+			SetName("family_id_unique_idx"),
+	}
+
+	// User index - list a user's sessions, most recently seen first
+	userIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "last_seen_at", Value: -1},
+		},
+		Options: options.Index().
+			SetName("user_id_last_seen_at_idx"),
+	}
+
+	// TTL index - MongoDB deletes sessions once they can no longer refresh
+	expiresAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expires_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("expires_at_ttl_idx"),
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{familyIndexModel, userIndexModel, expiresAtIndexModel})
+	if err != nil {
+		return fmt.Errorf("failed to create session indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreatePasskeyChallengeIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// TTL index - MongoDB deletes abandoned ceremonies
+	expiresAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expires_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("expires_at_ttl_idx"),
+	}
+
+	_, err := collection.Indexes().CreateOne(ctx, expiresAtIndexModel)
+	if err != nil {
+		return fmt.Errorf("failed to create passkey challenge indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreateAuditLogIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// Target index - "what happened to this account", newest first
+	targetIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "target_user_id", Value: 1},
+			{Key: "occurred_at", Value: -1},
+		},
+		Options: options.Index().
+			SetName("target_user_id_occurred_at_idx"),
+	}
+
+	// Actor index - "what did this admin do", newest first
+	actorIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "actor_id", Value: 1},
+			{Key: "occurred_at", Value: -1},
+		},
+		Options: options.Index().
+			SetName("actor_id_occurred_at_idx"),
+	}
+
+	// NOTE: No TTL index - retention is an operational decision
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{targetIndexModel, actorIndexModel})
+	if err != nil {
+		return fmt.Errorf("failed to create audit log indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreateOrganizationIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// Created_at index - list organizations oldest first
+	// NOTE: The tenant ID is _id, so it is unique already
+	createdAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "created_at", Value: 1},
+		},
+		Options: options.Index().
+			SetName("created_at_idx"),
+	}
+
+	_, err := collection.Indexes().CreateOne(ctx, createdAtIndexModel)
+	if err != nil {
+		return fmt.Errorf("failed to create organization indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreateInvitationIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// Token index - accepting looks the invitation up by its hash
+	tokenIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "token_hash", Value: 1},
+		},
+		Options: options.Index().
+			SetUnique(true).
+			SetName("token_hash_unique_idx"),
+	}
+
+	// Tenant index - admins list their organization's invitations, newest first
+	tenantIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "tenant_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+		Options: options.Index().
+			SetName("tenant_id_created_at_idx"),
+	}
+
+	// TTL index - MongoDB deletes invitations once they expire
+	// NOTE: Accepted ones go too; the audit log keeps the record
+	expiresAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expires_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("expires_at_ttl_idx"),
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{tokenIndexModel, tenantIndexModel, expiresAtIndexModel})
+	if err != nil {
+		return fmt.Errorf("failed to create invitation indexes: %w", err)
+	}
+
+	return nil
+}
+
+func CreateAuthorizationCodeIndexes(ctx context.Context, collection *mongo.Collection) error {
+	// TTL index - MongoDB deletes codes once they expire
+	// NOTE: The code hash is _id, so lookups need no extra index
+	expiresAtIndexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expires_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("expires_at_ttl_idx"),
+	}
+
+	_, err := collection.Indexes().CreateOne(ctx, expiresAtIndexModel)
+	if err != nil {
+		return fmt.Errorf("failed to create authorization code indexes: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type InvitationRepository struct {
+	collection *mongo.Collection
+}
+
+func NewInvitationRepository(db *mongo.Database) *InvitationRepository {
+	return &InvitationRepository{
+		collection: db.Collection("invitations"),
+	}
+}
+
+func (r *InvitationRepository) Create(ctx context.Context, invitation *entity.Invitation) error {
+	doc := fromInvitationEntity(invitation)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) FindByID(ctx context.Context, id string) (*entity.Invitation, error) {
+	return r.findOne(ctx, "FindByID", bson.M{"_id": id})
+}
+
+func (r *InvitationRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.Invitation, error) {
+	return r.findOne(ctx, "FindByHash", bson.M{"token_hash": tokenHash})
+}
+
+func (r *InvitationRepository) findOne(ctx context.Context, op string, filter bson.M) (*entity.Invitation, error) {
+	var doc InvitationDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewInvitationNotFoundError(op)
+		}
+		return nil, repository.NewDatabaseQueryError(op, err)
+	}
+
+	invitation, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError(op, fmt.Errorf("invalid invitation data: %w", err))
+	}
+
+	return invitation, nil
+}
+
+func (r *InvitationRepository) ListByTenant(ctx context.Context, tenantID valueobject.TenantID) ([]*entity.Invitation, error) {
+	filter := bson.M{"tenant_id": tenantID.String()}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("ListByTenant", err)
+	}
+	defer cursor.Close(ctx)
+
+	var invitations []*entity.Invitation
+	for cursor.Next(ctx) {
+		var doc InvitationDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, repository.NewDatabaseQueryError("ListByTenant", err)
+		}
+
+		invitation, err := doc.toEntity()
+		if err != nil {
+			return nil, repository.NewDatabaseQueryError("ListByTenant", fmt.Errorf("invalid invitation data: %w", err))
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, repository.NewDatabaseQueryError("ListByTenant", err)
+	}
+
+	return invitations, nil
+}
+
+func (r *InvitationRepository) MarkAccepted(ctx context.Context, id string, userID valueobject.UserID) error {
+	now := time.Now().UTC()
+
+	// WHY: The filter makes check-and-set a single atomic operation
+	filter := bson.M{
+		"_id":         id,
+		"accepted_at": nil,
+		"revoked_at":  nil,
+		"expires_at":  bson.M{"$gt": now},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"accepted_at": now,
+			"accepted_by": userID.String(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("MarkAccepted", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return repository.NewTokenAlreadyUsedError("MarkAccepted")
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) Revoke(ctx context.Context, id string) error {
+	filter := bson.M{
+		"_id":         id,
+		"accepted_at": nil,
+	}
+
+	// NOTE: Revoking twice keeps the first timestamp
+	update := bson.A{
+		bson.M{"$set": bson.M{
+			"revoked_at": bson.M{"$ifNull": bson.A{"$revoked_at", time.Now().UTC()}},
+		}},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("Revoke", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return repository.NewTokenAlreadyUsedError("Revoke")
+	}
+
+	return nil
+}
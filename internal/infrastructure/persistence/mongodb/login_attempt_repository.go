@@ -0,0 +1,115 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type LoginAttemptRepository struct {
+	collection *mongo.Collection
+}
+
+func NewLoginAttemptRepository(db *mongo.Database) *LoginAttemptRepository {
+	return &LoginAttemptRepository{
+		collection: db.Collection("login_attempts"),
+	}
+}
+
+func (r *LoginAttemptRepository) FindByKeys(ctx context.Context, keys []string) ([]*entity.LoginAttempt, error) {
+	filter := bson.M{"_id": bson.M{"$in": keys}}
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByKeys", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []LoginAttemptDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByKeys", err)
+	}
+
+	attempts := make([]*entity.LoginAttempt, 0, len(docs))
+	for _, doc := range docs {
+		attempt, err := doc.toEntity()
+		if err != nil {
+			return nil, repository.NewDatabaseQueryError("FindByKeys", err)
+		}
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, nil
+}
+
+func (r *LoginAttemptRepository) RecordAttempt(
+	ctx context.Context,
+	key string,
+	tenantID valueobject.TenantID,
+	email valueobject.Email,
+	expiresAt time.Time,
+) (*entity.LoginAttempt, error) {
+	// WHY: $inc with upsert reserves the attempt in one write - concurrent
+	// guesses each see a different count instead of all reading the same one
+	filter := bson.M{"_id": key}
+	update := bson.M{
+		"$inc": bson.M{"failures": 1},
+		"$set": bson.M{
+			"tenant_id":       tenantID.String(),
+			"email":           email.String(),
+			"last_failure_at": time.Now().UTC(),
+			"expires_at":      expiresAt.UTC(),
+		},
+	}
+	// NOTE: Returns the document as it was before this attempt - the
+	// previous attempt's time is what the backoff is measured from
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.Before)
+
+	var doc LoginAttemptDocument
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// First attempt - the counter was just created
+			return nil, nil
+		}
+		return nil, repository.NewDatabaseQueryError("RecordAttempt", err)
+	}
+
+	attempt, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("RecordAttempt", err)
+	}
+
+	return attempt, nil
+}
+
+func (r *LoginAttemptRepository) DeleteByKeys(ctx context.Context, keys []string) error {
+	filter := bson.M{"_id": bson.M{"$in": keys}}
+
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		return repository.NewDatabaseQueryError("DeleteByKeys", err)
+	}
+
+	return nil
+}
+
+func (r *LoginAttemptRepository) DeleteByEmail(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) error {
+	filter := bson.M{
+		"tenant_id": tenantID.String(),
+		"email":     email.String(),
+	}
+
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		return repository.NewDatabaseQueryError("DeleteByEmail", err)
+	}
+
+	return nil
+}
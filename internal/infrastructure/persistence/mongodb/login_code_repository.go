@@ -0,0 +1,62 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type LoginCodeRepository struct {
+	collection *mongo.Collection
+}
+
+func NewLoginCodeRepository(db *mongo.Database) *LoginCodeRepository {
+	return &LoginCodeRepository{
+		collection: db.Collection("login_codes"),
+	}
+}
+
+func (r *LoginCodeRepository) Create(ctx context.Context, code *entity.LoginCode) error {
+	doc := fromLoginCodeEntity(code)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *LoginCodeRepository) Consume(ctx context.Context, codeHash string) (*entity.LoginCode, error) {
+	// WHY: Find-and-delete in one step, so two requests can't both use a code
+	var doc LoginCodeDocument
+	err := r.collection.FindOneAndDelete(ctx, bson.M{"_id": codeHash}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewTokenNotFoundError("Consume")
+		}
+		return nil, repository.NewDatabaseQueryError("Consume", err)
+	}
+
+	code, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("Consume", fmt.Errorf("invalid login code data: %w", err))
+	}
+
+	return code, nil
+}
+
+func (r *LoginCodeRepository) DeleteByUserID(ctx context.Context, userID valueobject.UserID) error {
+	filter := bson.M{"user_id": userID.String()}
+
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		return repository.NewDatabaseQueryError("DeleteByUserID", err)
+	}
+
+	return nil
+}
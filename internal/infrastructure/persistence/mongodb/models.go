@@ -1,19 +1,55 @@
 package mongodb
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
 	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
 )
 
 type UserDocument struct {
 	ID        string    `bson:"_id,omitempty"`
+	TenantID  string    `bson:"tenant_id"`
 	Email     string    `bson:"email"`
 	Password  string    `bson:"password"`
 	CreatedAt time.Time `bson:"created_at"`
 	UpdatedAt time.Time `bson:"updated_at"`
 	IsActive  bool      `bson:"is_active"`
+
+	EmailVerifiedAt *time.Time   `bson:"email_verified_at,omitempty"`
+	PendingEmail    string       `bson:"pending_email,omitempty"`
+	MFA             *MFADocument `bson:"mfa,omitempty"`
+	Roles           []string     `bson:"roles,omitempty"`
+	Permissions     []string     `bson:"permissions,omitempty"`
+
+	PasswordResetRequired bool `bson:"password_reset_required,omitempty"`
+}
+
+// MFADocument is a user's second-factor state
+// SECURITY: Secrets are AES-GCM encrypted; recovery codes are SHA-256 hashes
+type MFADocument struct {
+	Secret        string   `bson:"secret,omitempty"`
+	PendingSecret string   `bson:"pending_secret,omitempty"`
+	RecoveryCodes []string `bson:"recovery_codes,omitempty"`
+	LastUsedStep  int64    `bson:"last_used_step,omitempty"`
+}
+
+func (d *MFADocument) toEntity() entity.MFA {
+	if d == nil {
+		return entity.MFA{}
+	}
+	return entity.MFA(*d)
+}
+
+// fromMFAEntity returns nil for users without MFA (field omitted)
+func fromMFAEntity(mfa entity.MFA) *MFADocument {
+	if mfa.Secret == "" && mfa.PendingSecret == "" {
+		return nil
+	}
+	doc := MFADocument(mfa)
+	return &doc
 }
 
 func (d *UserDocument) toEntity() (*entity.User, error) {
@@ -23,21 +59,47 @@ func (d *UserDocument) toEntity() (*entity.User, error) {
 		return nil, err
 	}
 
+	// Reconstruct TenantID value object
+	// NOTE: Documents written before multi-tenancy have no tenant_id until
+	// the index migration backfills them - they belong to the default tenant
+	tenantID := valueobject.DefaultTenantID()
+	if d.TenantID != "" {
+		tenantID, err = valueobject.NewTenantID(d.TenantID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Reconstruct Email value object
 	email, err := valueobject.NewEmail(d.Email)
 	if err != nil {
 		return nil, err
 	}
 
+	// Pending email change, if any
+	var pendingEmail valueobject.Email
+	if d.PendingEmail != "" {
+		pendingEmail, err = valueobject.NewEmail(d.PendingEmail)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Reconstruct Password value object (from hash)
 	password := valueobject.NewPasswordFromHash(d.Password)
 	user := entity.ReconstructUser(
 		userID,
+		tenantID,
 		email,
 		password,
 		d.CreatedAt,
 		d.UpdatedAt,
 		d.IsActive,
+		d.EmailVerifiedAt,
+		pendingEmail,
+		d.MFA.toEntity(),
+		entity.Access{Roles: d.Roles, Permissions: d.Permissions},
+		d.PasswordResetRequired,
 	)
 
 	return user, nil
@@ -46,10 +108,670 @@ func (d *UserDocument) toEntity() (*entity.User, error) {
 func fromEntity(user *entity.User) *UserDocument {
 	return &UserDocument{
 		ID:        user.ID().String(),
+		TenantID:  user.TenantID().String(),
 		Email:     user.Email().String(),
 		Password:  user.Password().Hash(),
 		CreatedAt: user.CreatedAt(),
 		UpdatedAt: user.UpdatedAt(),
 		IsActive:  user.IsActive(),
+
+		EmailVerifiedAt: user.EmailVerifiedAt(),
+		PendingEmail:    user.PendingEmail().String(),
+		MFA:             fromMFAEntity(user.MFA()),
+		Roles:           user.Access().Roles,
+		Permissions:     user.Access().Permissions,
+
+		PasswordResetRequired: user.PasswordResetRequired(),
+	}
+}
+
+type OrganizationDocument struct {
+	ID        string    `bson:"_id"` // Tenant ID
+	Name      string    `bson:"name"`
+	CreatedAt time.Time `bson:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+func (d *OrganizationDocument) toEntity() (*entity.Organization, error) {
+	id, err := valueobject.NewTenantID(d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructOrganization(id, d.Name, d.CreatedAt, d.UpdatedAt), nil
+}
+
+func fromOrganizationEntity(org *entity.Organization) *OrganizationDocument {
+	return &OrganizationDocument{
+		ID:        org.ID().String(),
+		Name:      org.Name(),
+		CreatedAt: org.CreatedAt(),
+		UpdatedAt: org.UpdatedAt(),
+	}
+}
+
+// InvitationDocument is an invitation to join an organization
+// SECURITY: Only the SHA-256 of the token is stored
+type InvitationDocument struct {
+	ID         string     `bson:"_id"`
+	TenantID   string     `bson:"tenant_id"`
+	Email      string     `bson:"email"`
+	Role       string     `bson:"role"`
+	TokenHash  string     `bson:"token_hash"`
+	InvitedBy  string     `bson:"invited_by"`
+	CreatedAt  time.Time  `bson:"created_at"`
+	ExpiresAt  time.Time  `bson:"expires_at"`
+	AcceptedAt *time.Time `bson:"accepted_at,omitempty"`
+	AcceptedBy string     `bson:"accepted_by,omitempty"`
+	RevokedAt  *time.Time `bson:"revoked_at,omitempty"`
+}
+
+func (d *InvitationDocument) toEntity() (*entity.Invitation, error) {
+	tenantID, err := valueobject.NewTenantID(d.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := valueobject.NewEmail(d.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructInvitation(
+		d.ID,
+		tenantID,
+		email,
+		d.Role,
+		d.TokenHash,
+		d.InvitedBy,
+		d.CreatedAt,
+		d.ExpiresAt,
+		d.AcceptedAt,
+		d.AcceptedBy,
+		d.RevokedAt,
+	), nil
+}
+
+func fromInvitationEntity(invitation *entity.Invitation) *InvitationDocument {
+	return &InvitationDocument{
+		ID:         invitation.ID(),
+		TenantID:   invitation.TenantID().String(),
+		Email:      invitation.Email().String(),
+		Role:       invitation.Role(),
+		TokenHash:  invitation.TokenHash(),
+		InvitedBy:  invitation.InvitedBy(),
+		CreatedAt:  invitation.CreatedAt(),
+		ExpiresAt:  invitation.ExpiresAt(),
+		AcceptedAt: invitation.AcceptedAt(),
+		AcceptedBy: invitation.AcceptedBy(),
+		RevokedAt:  invitation.RevokedAt(),
+	}
+}
+
+// OAuthClientDocument is a registered OAuth client
+// SECURITY: Only the SHA-256 of the client secret is stored
+type OAuthClientDocument struct {
+	ID           string    `bson:"_id"`
+	TenantID     string    `bson:"tenant_id"`
+	Name         string    `bson:"name"`
+	SecretHash   string    `bson:"secret_hash,omitempty"` // Empty for public clients
+	RedirectURIs []string  `bson:"redirect_uris"`
+	Scopes       []string  `bson:"scopes"`
+	CreatedAt    time.Time `bson:"created_at"`
+}
+
+func (d *OAuthClientDocument) toEntity() (*entity.OAuthClient, error) {
+	tenantID, err := valueobject.NewTenantID(d.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructOAuthClient(
+		d.ID,
+		tenantID,
+		d.Name,
+		d.SecretHash,
+		d.RedirectURIs,
+		d.Scopes,
+		d.CreatedAt,
+	), nil
+}
+
+func fromOAuthClientEntity(client *entity.OAuthClient) *OAuthClientDocument {
+	return &OAuthClientDocument{
+		ID:           client.ID(),
+		TenantID:     client.TenantID().String(),
+		Name:         client.Name(),
+		SecretHash:   client.SecretHash(),
+		RedirectURIs: client.RedirectURIs(),
+		Scopes:       client.Scopes(),
+		CreatedAt:    client.CreatedAt(),
+	}
+}
+
+// ServiceClientDocument is a machine identity for the client_credentials grant
+// SECURITY: Only the SHA-256 of the client secret is stored
+type ServiceClientDocument struct {
+	ID         string    `bson:"_id"`
+	TenantID   string    `bson:"tenant_id"`
+	Name       string    `bson:"name"`
+	SecretHash string    `bson:"secret_hash"`
+	Scopes     []string  `bson:"scopes"`
+	IsActive   bool      `bson:"is_active"`
+	CreatedAt  time.Time `bson:"created_at"`
+	UpdatedAt  time.Time `bson:"updated_at"`
+}
+
+func (d *ServiceClientDocument) toEntity() (*entity.ServiceClient, error) {
+	tenantID, err := valueobject.NewTenantID(d.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructServiceClient(
+		d.ID,
+		tenantID,
+		d.Name,
+		d.SecretHash,
+		d.Scopes,
+		d.IsActive,
+		d.CreatedAt,
+		d.UpdatedAt,
+	), nil
+}
+
+func fromServiceClientEntity(client *entity.ServiceClient) *ServiceClientDocument {
+	return &ServiceClientDocument{
+		ID:         client.ID(),
+		TenantID:   client.TenantID().String(),
+		Name:       client.Name(),
+		SecretHash: client.SecretHash(),
+		Scopes:     client.Scopes(),
+		IsActive:   client.IsActive(),
+		CreatedAt:  client.CreatedAt(),
+		UpdatedAt:  client.UpdatedAt(),
+	}
+}
+
+// AuthorizationCodeDocument is an OAuth authorization code
+// SECURITY: Only the SHA-256 of the code is stored
+type AuthorizationCodeDocument struct {
+	CodeHash           string     `bson:"_id"`
+	ClientID           string     `bson:"client_id"`
+	UserID             string     `bson:"user_id"`
+	TenantID           string     `bson:"tenant_id"`
+	RedirectURI        string     `bson:"redirect_uri"`
+	RedirectURIOmitted bool       `bson:"redirect_uri_omitted,omitempty"` // Inverted so older codes keep exact matching
+	Scopes             []string   `bson:"scopes"`
+	CodeChallenge      string     `bson:"code_challenge"`
+	Nonce              string     `bson:"nonce,omitempty"`
+	AuthTime           time.Time  `bson:"auth_time,omitempty"`
+	CreatedAt          time.Time  `bson:"created_at"`
+	ExpiresAt          time.Time  `bson:"expires_at"` // TTL index removes expired codes
+	UsedAt             *time.Time `bson:"used_at,omitempty"`
+	FamilyID           string     `bson:"family_id,omitempty"`
+}
+
+func (d *AuthorizationCodeDocument) toEntity() (*entity.AuthorizationCode, error) {
+	userID, err := valueobject.NewUserIDFromString(d.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := valueobject.NewTenantID(d.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructAuthorizationCode(
+		d.CodeHash,
+		d.ClientID,
+		userID,
+		tenantID,
+		d.RedirectURI,
+		!d.RedirectURIOmitted,
+		d.Scopes,
+		d.CodeChallenge,
+		d.Nonce,
+		d.AuthTime,
+		d.CreatedAt,
+		d.ExpiresAt,
+		d.UsedAt,
+		d.FamilyID,
+	), nil
+}
+
+func fromAuthorizationCodeEntity(code *entity.AuthorizationCode) *AuthorizationCodeDocument {
+	return &AuthorizationCodeDocument{
+		CodeHash:           code.CodeHash(),
+		ClientID:           code.ClientID(),
+		UserID:             code.UserID().String(),
+		TenantID:           code.TenantID().String(),
+		RedirectURI:        code.RedirectURI(),
+		RedirectURIOmitted: !code.RedirectURISent(),
+		Scopes:             code.Scopes(),
+		CodeChallenge:      code.CodeChallenge(),
+		Nonce:              code.Nonce(),
+		AuthTime:           code.AuthTime(),
+		CreatedAt:          code.CreatedAt(),
+		ExpiresAt:          code.ExpiresAt(),
+		UsedAt:             code.UsedAt(),
+		FamilyID:           code.FamilyID(),
+	}
+}
+
+type RefreshTokenDocument struct {
+	TokenHash  string     `bson:"_id"`
+	FamilyID   string     `bson:"family_id"`
+	UserID     string     `bson:"user_id"`
+	IssuedAt   time.Time  `bson:"issued_at"`
+	ExpiresAt  time.Time  `bson:"expires_at"` // TTL index removes expired tokens
+	UsedAt     *time.Time `bson:"used_at,omitempty"`
+	ReplacedBy string     `bson:"replaced_by,omitempty"`
+	RevokedAt  *time.Time `bson:"revoked_at,omitempty"`
+}
+
+func (d *RefreshTokenDocument) toEntity() (*entity.RefreshToken, error) {
+	userID, err := valueobject.NewUserIDFromString(d.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructRefreshToken(
+		d.TokenHash,
+		d.FamilyID,
+		userID,
+		d.IssuedAt,
+		d.ExpiresAt,
+		d.UsedAt,
+		d.ReplacedBy,
+		d.RevokedAt,
+	), nil
+}
+
+func fromRefreshTokenEntity(token *entity.RefreshToken) *RefreshTokenDocument {
+	return &RefreshTokenDocument{
+		TokenHash:  token.TokenHash(),
+		FamilyID:   token.FamilyID(),
+		UserID:     token.UserID().String(),
+		IssuedAt:   token.IssuedAt(),
+		ExpiresAt:  token.ExpiresAt(),
+		UsedAt:     token.UsedAt(),
+		ReplacedBy: token.ReplacedBy(),
+		RevokedAt:  token.RevokedAt(),
+	}
+}
+
+type RevokedTokenDocument struct {
+	TokenID   string    `bson:"_id"`
+	UserID    string    `bson:"user_id"`
+	RevokedAt time.Time `bson:"revoked_at"`
+	ExpiresAt time.Time `bson:"expires_at"` // TTL index removes entries once the token expires
+}
+
+func fromRevokedTokenEntity(token *entity.RevokedToken) *RevokedTokenDocument {
+	return &RevokedTokenDocument{
+		TokenID:   token.TokenID(),
+		UserID:    token.UserID().String(),
+		RevokedAt: token.RevokedAt(),
+		ExpiresAt: token.ExpiresAt(),
+	}
+}
+
+type PasswordResetTokenDocument struct {
+	TokenHash string     `bson:"_id"`
+	UserID    string     `bson:"user_id"`
+	CreatedAt time.Time  `bson:"created_at"`
+	ExpiresAt time.Time  `bson:"expires_at"` // TTL index removes expired tokens
+	UsedAt    *time.Time `bson:"used_at,omitempty"`
+}
+
+func (d *PasswordResetTokenDocument) toEntity() (*entity.PasswordResetToken, error) {
+	userID, err := valueobject.NewUserIDFromString(d.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructPasswordResetToken(
+		d.TokenHash,
+		userID,
+		d.CreatedAt,
+		d.ExpiresAt,
+		d.UsedAt,
+	), nil
+}
+
+func fromPasswordResetTokenEntity(token *entity.PasswordResetToken) *PasswordResetTokenDocument {
+	return &PasswordResetTokenDocument{
+		TokenHash: token.TokenHash(),
+		UserID:    token.UserID().String(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+		UsedAt:    token.UsedAt(),
+	}
+}
+
+// PersonalAccessTokenDocument is a user-created API token
+// SECURITY: Only the SHA-256 of the token is stored
+type PersonalAccessTokenDocument struct {
+	ID        string    `bson:"_id"`
+	TokenHash string    `bson:"token_hash"` // Unique index
+	UserID    string    `bson:"user_id"`
+	TenantID  string    `bson:"tenant_id"`
+	Name      string    `bson:"name"`
+	Scopes    []string  `bson:"scopes"`
+	CreatedAt time.Time `bson:"created_at"`
+	ExpiresAt time.Time `bson:"expires_at"` // TTL index removes expired tokens
+}
+
+func (d *PersonalAccessTokenDocument) toEntity() (*entity.PersonalAccessToken, error) {
+	userID, err := valueobject.NewUserIDFromString(d.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := valueobject.NewTenantID(d.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructPersonalAccessToken(
+		d.ID,
+		d.TokenHash,
+		userID,
+		tenantID,
+		d.Name,
+		d.Scopes,
+		d.CreatedAt,
+		d.ExpiresAt,
+	), nil
+}
+
+func fromPersonalAccessTokenEntity(token *entity.PersonalAccessToken) *PersonalAccessTokenDocument {
+	return &PersonalAccessTokenDocument{
+		ID:        token.ID(),
+		TokenHash: token.TokenHash(),
+		UserID:    token.UserID().String(),
+		TenantID:  token.TenantID().String(),
+		Name:      token.Name(),
+		Scopes:    token.Scopes(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+	}
+}
+
+// SessionDocument is a signed-in device
+type SessionDocument struct {
+	ID         string    `bson:"_id"`
+	UserID     string    `bson:"user_id"`
+	FamilyID   string    `bson:"family_id"` // Unique index
+	ClientID   string    `bson:"client_id,omitempty"`
+	UserAgent  string    `bson:"user_agent,omitempty"`
+	IPAddress  string    `bson:"ip_address,omitempty"`
+	CreatedAt  time.Time `bson:"created_at"`
+	LastSeenAt time.Time `bson:"last_seen_at"`
+	ExpiresAt  time.Time `bson:"expires_at"` // TTL index removes sessions that can no longer refresh
+}
+
+func (d *SessionDocument) toEntity() (*entity.Session, error) {
+	userID, err := valueobject.NewUserIDFromString(d.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructSession(
+		d.ID,
+		userID,
+		d.FamilyID,
+		d.ClientID,
+		d.UserAgent,
+		d.IPAddress,
+		d.CreatedAt,
+		d.LastSeenAt,
+		d.ExpiresAt,
+	), nil
+}
+
+func fromSessionEntity(session *entity.Session) *SessionDocument {
+	return &SessionDocument{
+		ID:         session.ID(),
+		UserID:     session.UserID().String(),
+		FamilyID:   session.FamilyID(),
+		ClientID:   session.ClientID(),
+		UserAgent:  session.UserAgent(),
+		IPAddress:  session.IPAddress(),
+		CreatedAt:  session.CreatedAt(),
+		LastSeenAt: session.LastSeenAt(),
+		ExpiresAt:  session.ExpiresAt(),
+	}
+}
+
+// PasskeyDocument is a registered WebAuthn credential
+type PasskeyDocument struct {
+	ID              string     `bson:"_id"` // Credential ID, base64url
+	UserID          string     `bson:"user_id"`
+	Name            string     `bson:"name"`
+	PublicKey       []byte     `bson:"public_key"`
+	AttestationType string     `bson:"attestation_type"`
+	AAGUID          []byte     `bson:"aaguid,omitempty"`
+	SignCount       uint32     `bson:"sign_count"`
+	Transports      []string   `bson:"transports,omitempty"`
+	BackupEligible  bool       `bson:"backup_eligible"`
+	BackupState     bool       `bson:"backup_state"`
+	CreatedAt       time.Time  `bson:"created_at"`
+	LastUsedAt      *time.Time `bson:"last_used_at,omitempty"`
+}
+
+func (d *PasskeyDocument) toEntity() (*entity.Passkey, error) {
+	userID, err := valueobject.NewUserIDFromString(d.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialID, err := entity.DecodePasskeyID(d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructPasskey(
+		entity.PasskeyCredential{
+			ID:              credentialID,
+			PublicKey:       d.PublicKey,
+			AttestationType: d.AttestationType,
+			AAGUID:          d.AAGUID,
+			SignCount:       d.SignCount,
+			Transports:      d.Transports,
+			BackupEligible:  d.BackupEligible,
+			BackupState:     d.BackupState,
+		},
+		userID,
+		d.Name,
+		d.CreatedAt,
+		d.LastUsedAt,
+	), nil
+}
+
+func fromPasskeyEntity(passkey *entity.Passkey) *PasskeyDocument {
+	credential := passkey.Credential()
+	return &PasskeyDocument{
+		ID:              passkey.ID(),
+		UserID:          passkey.UserID().String(),
+		Name:            passkey.Name(),
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		AAGUID:          credential.AAGUID,
+		SignCount:       credential.SignCount,
+		Transports:      credential.Transports,
+		BackupEligible:  credential.BackupEligible,
+		BackupState:     credential.BackupState,
+		CreatedAt:       passkey.CreatedAt(),
+		LastUsedAt:      passkey.LastUsedAt(),
+	}
+}
+
+// PasskeyChallengeDocument is the state of an in-flight WebAuthn ceremony
+type PasskeyChallengeDocument struct {
+	IDHash    string    `bson:"_id"`
+	Ceremony  string    `bson:"ceremony"`
+	UserID    string    `bson:"user_id,omitempty"` // Registration only
+	Session   []byte    `bson:"session"`
+	ExpiresAt time.Time `bson:"expires_at"` // TTL index removes abandoned ceremonies
+}
+
+func (d *PasskeyChallengeDocument) toEntity() (*entity.PasskeyChallenge, error) {
+	var userID valueobject.UserID
+	if d.UserID != "" {
+		id, err := valueobject.NewUserIDFromString(d.UserID)
+		if err != nil {
+			return nil, err
+		}
+		userID = id
+	}
+
+	return entity.ReconstructPasskeyChallenge(
+		d.IDHash,
+		entity.PasskeyCeremony(d.Ceremony),
+		userID,
+		d.Session,
+		d.ExpiresAt,
+	), nil
+}
+
+func fromPasskeyChallengeEntity(challenge *entity.PasskeyChallenge) *PasskeyChallengeDocument {
+	doc := &PasskeyChallengeDocument{
+		IDHash:    challenge.IDHash(),
+		Ceremony:  string(challenge.Ceremony()),
+		Session:   challenge.Session(),
+		ExpiresAt: challenge.ExpiresAt(),
+	}
+	if !challenge.UserID().IsEmpty() {
+		doc.UserID = challenge.UserID().String()
+	}
+	return doc
+}
+
+// LoginCodeDocument is an emailed passwordless login code
+type LoginCodeDocument struct {
+	CodeHash  string    `bson:"_id"`
+	Kind      string    `bson:"kind"`
+	UserID    string    `bson:"user_id"`
+	Email     string    `bson:"email"`
+	CreatedAt time.Time `bson:"created_at"`
+	ExpiresAt time.Time `bson:"expires_at"` // TTL index removes expired codes
+}
+
+func (d *LoginCodeDocument) toEntity() (*entity.LoginCode, error) {
+	userID, err := valueobject.NewUserIDFromString(d.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := valueobject.NewEmail(d.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructLoginCode(
+		d.CodeHash,
+		entity.LoginCodeKind(d.Kind),
+		userID,
+		email,
+		d.CreatedAt,
+		d.ExpiresAt,
+	), nil
+}
+
+func fromLoginCodeEntity(code *entity.LoginCode) *LoginCodeDocument {
+	return &LoginCodeDocument{
+		CodeHash:  code.CodeHash(),
+		Kind:      string(code.Kind()),
+		UserID:    code.UserID().String(),
+		Email:     code.Email().String(),
+		CreatedAt: code.CreatedAt(),
+		ExpiresAt: code.ExpiresAt(),
+	}
+}
+
+// LoginAttemptDocument is a failed-login counter
+type LoginAttemptDocument struct {
+	Key           string    `bson:"_id"`
+	TenantID      string    `bson:"tenant_id"`
+	Email         string    `bson:"email"`
+	Failures      int       `bson:"failures"`
+	LastFailureAt time.Time `bson:"last_failure_at"`
+	ExpiresAt     time.Time `bson:"expires_at"` // TTL index forgets quiet counters
+}
+
+func (d *LoginAttemptDocument) toEntity() (*entity.LoginAttempt, error) {
+	tenantID, err := valueobject.NewTenantID(d.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstructLoginAttempt(
+		d.Key,
+		tenantID,
+		d.Email,
+		d.Failures,
+		d.LastFailureAt,
+		d.ExpiresAt,
+	), nil
+}
+
+// SigningKeyDocument is a JWT signing key in the shared key ring
+// SECURITY: Material is a private key, AES-GCM encrypted with the MFA
+// encryption key and bound to the kid - a database dump alone can't sign tokens
+type SigningKeyDocument struct {
+	ID                string    `bson:"_id"` // kid
+	Algorithm         string    `bson:"algorithm"`
+	EncryptedMaterial string    `bson:"encrypted_material,omitempty"` // Wiped on retire
+	Material          []byte    `bson:"material,omitempty"`           // Plain text, written before encryption
+	Status            string    `bson:"status"`
+	CreatedAt         time.Time `bson:"created_at"`
+}
+
+func (d *SigningKeyDocument) toStoredKey(cipher security.SecretCipher) (security.StoredKey, error) {
+	key := security.StoredKey{
+		ID:        d.ID,
+		Algorithm: d.Algorithm,
+		Material:  d.Material,
+		Status:    security.KeyStatus(d.Status),
+		CreatedAt: d.CreatedAt,
+	}
+
+	if d.EncryptedMaterial != "" {
+		material, err := cipher.Decrypt(d.EncryptedMaterial, d.ID)
+		if err != nil {
+			return security.StoredKey{}, fmt.Errorf("failed to decrypt signing key %q: %w", d.ID, err)
+		}
+		key.Material = []byte(material)
+	}
+
+	return key, nil
+}
+
+// AuditEventDocument is an entry in the admin audit log
+// NOTE: Written only - read it with MongoDB tooling or export it to a SIEM
+type AuditEventDocument struct {
+	ID           string            `bson:"_id"`
+	TenantID     string            `bson:"tenant_id"`
+	ActorID      string            `bson:"actor_id"`
+	Action       string            `bson:"action"`
+	TargetUserID string            `bson:"target_user_id,omitempty"`
+	TargetEmail  string            `bson:"target_email,omitempty"`
+	Details      map[string]string `bson:"details,omitempty"`
+	OccurredAt   time.Time         `bson:"occurred_at"`
+}
+
+func fromAuditEventEntity(event *entity.AuditEvent) *AuditEventDocument {
+	return &AuditEventDocument{
+		ID:           event.ID(),
+		TenantID:     event.TenantID().String(),
+		ActorID:      event.ActorID(),
+		Action:       string(event.Action()),
+		TargetUserID: event.TargetUserID(),
+		TargetEmail:  event.TargetEmail(),
+		Details:      event.Details(),
+		OccurredAt:   event.OccurredAt(),
 	}
 }
@@ -0,0 +1,50 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type OAuthClientRepository struct {
+	collection *mongo.Collection
+}
+
+func NewOAuthClientRepository(db *mongo.Database) *OAuthClientRepository {
+	return &OAuthClientRepository{
+		collection: db.Collection("oauth_clients"),
+	}
+}
+
+func (r *OAuthClientRepository) Create(ctx context.Context, client *entity.OAuthClient) error {
+	doc := fromOAuthClientEntity(client)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *OAuthClientRepository) FindByID(ctx context.Context, clientID string) (*entity.OAuthClient, error) {
+	var doc OAuthClientDocument
+	err := r.collection.FindOne(ctx, bson.M{"_id": clientID}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewOAuthClientNotFoundError("FindByID")
+		}
+		return nil, repository.NewDatabaseQueryError("FindByID", err)
+	}
+
+	client, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByID", fmt.Errorf("invalid oauth client data: %w", err))
+	}
+
+	return client, nil
+}
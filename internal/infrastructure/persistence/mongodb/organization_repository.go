@@ -0,0 +1,55 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type OrganizationRepository struct {
+	collection *mongo.Collection
+}
+
+func NewOrganizationRepository(db *mongo.Database) *OrganizationRepository {
+	return &OrganizationRepository{
+		collection: db.Collection("organizations"),
+	}
+}
+
+func (r *OrganizationRepository) Create(ctx context.Context, org *entity.Organization) error {
+	doc := fromOrganizationEntity(org)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		// WHY: The tenant ID is _id - a repeat means the organization exists
+		if mongo.IsDuplicateKeyError(err) {
+			return repository.NewOrganizationExistsError("Create", err)
+		}
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepository) FindByID(ctx context.Context, id valueobject.TenantID) (*entity.Organization, error) {
+	var doc OrganizationDocument
+	err := r.collection.FindOne(ctx, bson.M{"_id": id.String()}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewOrganizationNotFoundError("FindByID")
+		}
+		return nil, repository.NewDatabaseQueryError("FindByID", err)
+	}
+
+	org, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByID", fmt.Errorf("invalid organization data: %w", err))
+	}
+
+	return org, nil
+}
@@ -0,0 +1,161 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PasskeyRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPasskeyRepository(db *mongo.Database) *PasskeyRepository {
+	return &PasskeyRepository{
+		collection: db.Collection("passkeys"),
+	}
+}
+
+func (r *PasskeyRepository) Create(ctx context.Context, passkey *entity.Passkey) error {
+	doc := fromPasskeyEntity(passkey)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		// WHY: Credential IDs are globally unique - a repeat means it's already registered
+		if mongo.IsDuplicateKeyError(err) {
+			return repository.NewPasskeyExistsError("Create", err)
+		}
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *PasskeyRepository) FindByUserID(ctx context.Context, userID valueobject.UserID) ([]*entity.Passkey, error) {
+	filter := bson.M{"user_id": userID.String()}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByUserID", err)
+	}
+	defer cursor.Close(ctx)
+
+	var passkeys []*entity.Passkey
+	for cursor.Next(ctx) {
+		var doc PasskeyDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, repository.NewDatabaseQueryError("FindByUserID", err)
+		}
+
+		passkey, err := doc.toEntity()
+		if err != nil {
+			return nil, repository.NewDatabaseQueryError("FindByUserID", fmt.Errorf("invalid passkey data: %w", err))
+		}
+		passkeys = append(passkeys, passkey)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByUserID", err)
+	}
+
+	return passkeys, nil
+}
+
+func (r *PasskeyRepository) Update(ctx context.Context, passkey *entity.Passkey) error {
+	credential := passkey.Credential()
+	filter := bson.M{"_id": passkey.ID()}
+
+	update := bson.M{
+		"$set": bson.M{
+			"sign_count":   credential.SignCount,
+			"backup_state": credential.BackupState,
+			"last_used_at": passkey.LastUsedAt(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("Update", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return repository.NewPasskeyNotFoundError("Update")
+	}
+
+	return nil
+}
+
+func (r *PasskeyRepository) Delete(ctx context.Context, userID valueobject.UserID, credentialID []byte) error {
+	// SECURITY: Scoped to the owner - users can't delete each other's passkeys
+	filter := bson.M{
+		"_id":     entity.EncodePasskeyID(credentialID),
+		"user_id": userID.String(),
+	}
+
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return repository.NewDatabaseQueryError("Delete", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return repository.NewPasskeyNotFoundError("Delete")
+	}
+
+	return nil
+}
+
+func (r *PasskeyRepository) DeleteByUserID(ctx context.Context, userID valueobject.UserID) error {
+	filter := bson.M{"user_id": userID.String()}
+
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		return repository.NewDatabaseQueryError("DeleteByUserID", err)
+	}
+
+	return nil
+}
+
+type PasskeyChallengeRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPasskeyChallengeRepository(db *mongo.Database) *PasskeyChallengeRepository {
+	return &PasskeyChallengeRepository{
+		collection: db.Collection("passkey_challenges"),
+	}
+}
+
+func (r *PasskeyChallengeRepository) Create(ctx context.Context, challenge *entity.PasskeyChallenge) error {
+	doc := fromPasskeyChallengeEntity(challenge)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *PasskeyChallengeRepository) Consume(ctx context.Context, idHash string) (*entity.PasskeyChallenge, error) {
+	// WHY: Find-and-delete in one step, so a challenge can't be answered twice
+	var doc PasskeyChallengeDocument
+	err := r.collection.FindOneAndDelete(ctx, bson.M{"_id": idHash}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewTokenNotFoundError("Consume")
+		}
+		return nil, repository.NewDatabaseQueryError("Consume", err)
+	}
+
+	challenge, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("Consume", fmt.Errorf("invalid passkey challenge data: %w", err))
+	}
+
+	return challenge, nil
+}
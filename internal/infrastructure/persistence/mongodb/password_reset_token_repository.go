@@ -0,0 +1,87 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type PasswordResetTokenRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPasswordResetTokenRepository(db *mongo.Database) *PasswordResetTokenRepository {
+	return &PasswordResetTokenRepository{
+		collection: db.Collection("password_reset_tokens"),
+	}
+}
+
+func (r *PasswordResetTokenRepository) Create(ctx context.Context, token *entity.PasswordResetToken) error {
+	doc := fromPasswordResetTokenEntity(token)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *PasswordResetTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error) {
+	filter := bson.M{"_id": tokenHash}
+
+	var doc PasswordResetTokenDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewTokenNotFoundError("FindByHash")
+		}
+		return nil, repository.NewDatabaseQueryError("FindByHash", err)
+	}
+
+	token, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByHash", fmt.Errorf("invalid password reset token data: %w", err))
+	}
+
+	return token, nil
+}
+
+func (r *PasswordResetTokenRepository) MarkUsed(ctx context.Context, tokenHash string) error {
+	// WHY: The filter makes check-and-set a single atomic operation
+	filter := bson.M{
+		"_id":     tokenHash,
+		"used_at": nil,
+	}
+
+	update := bson.M{
+		"$set": bson.M{"used_at": time.Now().UTC()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("MarkUsed", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return repository.NewTokenAlreadyUsedError("MarkUsed")
+	}
+
+	return nil
+}
+
+func (r *PasswordResetTokenRepository) DeleteByUserID(ctx context.Context, userID valueobject.UserID) error {
+	filter := bson.M{"user_id": userID.String()}
+
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		return repository.NewDatabaseQueryError("DeleteByUserID", err)
+	}
+
+	return nil
+}
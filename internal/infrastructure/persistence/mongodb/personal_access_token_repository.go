@@ -0,0 +1,112 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PersonalAccessTokenRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPersonalAccessTokenRepository(db *mongo.Database) *PersonalAccessTokenRepository {
+	return &PersonalAccessTokenRepository{
+		collection: db.Collection("personal_access_tokens"),
+	}
+}
+
+func (r *PersonalAccessTokenRepository) Create(ctx context.Context, token *entity.PersonalAccessToken) error {
+	doc := fromPersonalAccessTokenEntity(token)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *PersonalAccessTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error) {
+	var doc PersonalAccessTokenDocument
+	err := r.collection.FindOne(ctx, bson.M{"token_hash": tokenHash}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewTokenNotFoundError("FindByHash")
+		}
+		return nil, repository.NewDatabaseQueryError("FindByHash", err)
+	}
+
+	token, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByHash", fmt.Errorf("invalid personal access token data: %w", err))
+	}
+
+	return token, nil
+}
+
+func (r *PersonalAccessTokenRepository) FindByUserID(ctx context.Context, userID valueobject.UserID) ([]*entity.PersonalAccessToken, error) {
+	filter := bson.M{"user_id": userID.String()}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByUserID", err)
+	}
+	defer cursor.Close(ctx)
+
+	var tokens []*entity.PersonalAccessToken
+	for cursor.Next(ctx) {
+		var doc PersonalAccessTokenDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, repository.NewDatabaseQueryError("FindByUserID", err)
+		}
+
+		token, err := doc.toEntity()
+		if err != nil {
+			return nil, repository.NewDatabaseQueryError("FindByUserID", fmt.Errorf("invalid personal access token data: %w", err))
+		}
+		tokens = append(tokens, token)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByUserID", err)
+	}
+
+	return tokens, nil
+}
+
+func (r *PersonalAccessTokenRepository) Delete(ctx context.Context, userID valueobject.UserID, id string) error {
+	// SECURITY: Scoped to the owner - users can't revoke each other's tokens
+	filter := bson.M{
+		"_id":     id,
+		"user_id": userID.String(),
+	}
+
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return repository.NewDatabaseQueryError("Delete", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return repository.NewTokenNotFoundError("Delete")
+	}
+
+	return nil
+}
+
+func (r *PersonalAccessTokenRepository) DeleteByUserID(ctx context.Context, userID valueobject.UserID) error {
+	filter := bson.M{"user_id": userID.String()}
+
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		return repository.NewDatabaseQueryError("DeleteByUserID", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type RefreshTokenRepository struct {
+	collection *mongo.Collection
+}
+
+func NewRefreshTokenRepository(db *mongo.Database) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		collection: db.Collection("refresh_tokens"),
+	}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
+	doc := fromRefreshTokenEntity(token)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	filter := bson.M{"_id": tokenHash}
+
+	var doc RefreshTokenDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewTokenNotFoundError("FindByHash")
+		}
+		return nil, repository.NewDatabaseQueryError("FindByHash", err)
+	}
+
+	token, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByHash", fmt.Errorf("invalid refresh token data: %w", err))
+	}
+
+	return token, nil
+}
+
+func (r *RefreshTokenRepository) MarkUsed(ctx context.Context, tokenHash, replacedBy string) error {
+	// Only match tokens that are still usable
+	// WHY: The filter makes check-and-set a single atomic operation
+	filter := bson.M{
+		"_id":        tokenHash,
+		"used_at":    nil,
+		"revoked_at": nil,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"used_at":     time.Now().UTC(),
+			"replaced_by": replacedBy,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("MarkUsed", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return repository.NewTokenAlreadyUsedError("MarkUsed")
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	filter := bson.M{
+		"family_id":  familyID,
+		"revoked_at": nil,
+	}
+
+	update := bson.M{
+		"$set": bson.M{"revoked_at": time.Now().UTC()},
+	}
+
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		return repository.NewDatabaseQueryError("RevokeFamily", err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID valueobject.UserID) error {
+	filter := bson.M{
+		"user_id":    userID.String(),
+		"revoked_at": nil,
+	}
+
+	update := bson.M{
+		"$set": bson.M{"revoked_at": time.Now().UTC()},
+	}
+
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		return repository.NewDatabaseQueryError("RevokeAllForUser", err)
+	}
+
+	return nil
+}
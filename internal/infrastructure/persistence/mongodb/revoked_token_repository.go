@@ -0,0 +1,48 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RevokedTokenRepository struct {
+	collection *mongo.Collection
+}
+
+func NewRevokedTokenRepository(db *mongo.Database) *RevokedTokenRepository {
+	return &RevokedTokenRepository{
+		collection: db.Collection("revoked_tokens"),
+	}
+}
+
+func (r *RevokedTokenRepository) Create(ctx context.Context, token *entity.RevokedToken) error {
+	doc := fromRevokedTokenEntity(token)
+
+	_, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		// Already revoked - nothing to do
+		// WHY: Logging out twice must not fail
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *RevokedTokenRepository) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	filter := bson.M{"_id": tokenID}
+
+	count, err := r.collection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, repository.NewDatabaseQueryError("IsRevoked", err)
+	}
+
+	return count > 0, nil
+}
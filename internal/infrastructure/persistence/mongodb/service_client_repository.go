@@ -0,0 +1,73 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ServiceClientRepository struct {
+	collection *mongo.Collection
+}
+
+func NewServiceClientRepository(db *mongo.Database) *ServiceClientRepository {
+	return &ServiceClientRepository{
+		collection: db.Collection("service_clients"),
+	}
+}
+
+func (r *ServiceClientRepository) Create(ctx context.Context, client *entity.ServiceClient) error {
+	doc := fromServiceClientEntity(client)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *ServiceClientRepository) FindByID(ctx context.Context, clientID string) (*entity.ServiceClient, error) {
+	var doc ServiceClientDocument
+	err := r.collection.FindOne(ctx, bson.M{"_id": clientID}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewServiceClientNotFoundError("FindByID")
+		}
+		return nil, repository.NewDatabaseQueryError("FindByID", err)
+	}
+
+	client, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByID", fmt.Errorf("invalid service client data: %w", err))
+	}
+
+	return client, nil
+}
+
+func (r *ServiceClientRepository) Update(ctx context.Context, client *entity.ServiceClient) error {
+	update := bson.M{
+		"$set": bson.M{
+			"name":        client.Name(),
+			"secret_hash": client.SecretHash(),
+			"scopes":      client.Scopes(),
+			"is_active":   client.IsActive(),
+			"updated_at":  client.UpdatedAt(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": client.ID()}, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("Update", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return repository.NewServiceClientNotFoundError("Update")
+	}
+
+	return nil
+}
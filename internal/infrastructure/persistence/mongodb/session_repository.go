@@ -0,0 +1,137 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SessionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewSessionRepository(db *mongo.Database) *SessionRepository {
+	return &SessionRepository{
+		collection: db.Collection("sessions"),
+	}
+}
+
+func (r *SessionRepository) Create(ctx context.Context, session *entity.Session) error {
+	doc := fromSessionEntity(session)
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return repository.NewDatabaseQueryError("Create", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) FindByID(ctx context.Context, id string) (*entity.Session, error) {
+	return r.findOne(ctx, "FindByID", bson.M{"_id": id})
+}
+
+func (r *SessionRepository) FindByFamilyID(ctx context.Context, familyID string) (*entity.Session, error) {
+	return r.findOne(ctx, "FindByFamilyID", bson.M{"family_id": familyID})
+}
+
+func (r *SessionRepository) findOne(ctx context.Context, op string, filter bson.M) (*entity.Session, error) {
+	var doc SessionDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.NewSessionNotFoundError(op)
+		}
+		return nil, repository.NewDatabaseQueryError(op, err)
+	}
+
+	session, err := doc.toEntity()
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError(op, fmt.Errorf("invalid session data: %w", err))
+	}
+
+	return session, nil
+}
+
+func (r *SessionRepository) FindByUserID(ctx context.Context, userID valueobject.UserID) ([]*entity.Session, error) {
+	filter := bson.M{"user_id": userID.String()}
+	opts := options.Find().SetSort(bson.D{{Key: "last_seen_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByUserID", err)
+	}
+	defer cursor.Close(ctx)
+
+	var sessions []*entity.Session
+	for cursor.Next(ctx) {
+		var doc SessionDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, repository.NewDatabaseQueryError("FindByUserID", err)
+		}
+
+		session, err := doc.toEntity()
+		if err != nil {
+			return nil, repository.NewDatabaseQueryError("FindByUserID", fmt.Errorf("invalid session data: %w", err))
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, repository.NewDatabaseQueryError("FindByUserID", err)
+	}
+
+	return sessions, nil
+}
+
+func (r *SessionRepository) Update(ctx context.Context, session *entity.Session) error {
+	update := bson.M{
+		"$set": bson.M{
+			"user_agent":   session.UserAgent(),
+			"ip_address":   session.IPAddress(),
+			"last_seen_at": session.LastSeenAt(),
+			"expires_at":   session.ExpiresAt(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": session.ID()}, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("Update", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return repository.NewSessionNotFoundError("Update")
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) Delete(ctx context.Context, id string) error {
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"_id": id}); err != nil {
+		return repository.NewDatabaseQueryError("Delete", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) DeleteByFamilyID(ctx context.Context, familyID string) error {
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"family_id": familyID}); err != nil {
+		return repository.NewDatabaseQueryError("DeleteByFamilyID", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) DeleteByUserID(ctx context.Context, userID valueobject.UserID) error {
+	if _, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID.String()}); err != nil {
+		return repository.NewDatabaseQueryError("DeleteByUserID", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SigningKeyStore keeps the JWT signing key ring in MongoDB
+// WHY: Every instance reads the same collection, so a rotation reaches all
+// of them on their next reload
+// SECURITY: Key material is encrypted with cipher before it's written
+type SigningKeyStore struct {
+	collection *mongo.Collection
+	cipher     security.SecretCipher
+}
+
+func NewSigningKeyStore(db *mongo.Database, cipher security.SecretCipher) *SigningKeyStore {
+	return &SigningKeyStore{
+		collection: db.Collection("signing_keys"),
+		cipher:     cipher,
+	}
+}
+
+func (s *SigningKeyStore) List(ctx context.Context) ([]security.StoredKey, error) {
+	cursor, err := s.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, repository.NewDatabaseQueryError("List", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []SigningKeyDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, repository.NewDatabaseQueryError("List", err)
+	}
+
+	keys := make([]security.StoredKey, 0, len(docs))
+	for _, doc := range docs {
+		// NOTE: Keys written before encryption are sealed the first time they're read
+		if len(doc.Material) > 0 {
+			if err := s.seal(ctx, &doc); err != nil {
+				return nil, err
+			}
+		}
+
+		key, err := doc.toStoredKey(s.cipher)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (s *SigningKeyStore) Add(ctx context.Context, key security.StoredKey) error {
+	// SECURITY: Bound to the kid - material can't be moved to another key's document
+	material, err := s.cipher.Encrypt(string(key.Material), key.ID)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt signing key: %w", err)
+	}
+
+	doc := SigningKeyDocument{
+		ID:                key.ID,
+		Algorithm:         key.Algorithm,
+		EncryptedMaterial: material,
+		Status:            string(security.KeyStatusVerify), // Promote separately
+		CreatedAt:         key.CreatedAt.UTC(),
+	}
+
+	if _, err := s.collection.InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return security.ErrKeyExists
+		}
+		return repository.NewDatabaseQueryError("Add", err)
+	}
+
+	return nil
+}
+
+func (s *SigningKeyStore) Promote(ctx context.Context, kid string) error {
+	// Step 1: Activate the new key
+	// NOTE: Until step 2 runs there are two active keys; readers treat that
+	// as inconsistent and keep their current ring
+	filter := bson.M{
+		"_id":    kid,
+		"status": bson.M{"$ne": string(security.KeyStatusRetired)},
+	}
+	update := bson.M{"$set": bson.M{"status": string(security.KeyStatusActive)}}
+
+	result, err := s.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("Promote", err)
+	}
+	if result.MatchedCount == 0 {
+		return security.ErrKeyNotFound
+	}
+
+	// Step 2: Demote the previous active key
+	filter = bson.M{
+		"_id":    bson.M{"$ne": kid},
+		"status": string(security.KeyStatusActive),
+	}
+	update = bson.M{"$set": bson.M{"status": string(security.KeyStatusVerify)}}
+
+	if _, err := s.collection.UpdateMany(ctx, filter, update); err != nil {
+		return repository.NewDatabaseQueryError("Promote", err)
+	}
+
+	return nil
+}
+
+func (s *SigningKeyStore) Retire(ctx context.Context, kid string) error {
+	var doc SigningKeyDocument
+	err := s.collection.FindOne(ctx, bson.M{"_id": kid}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return security.ErrKeyNotFound
+		}
+		return repository.NewDatabaseQueryError("Retire", err)
+	}
+
+	// SECURITY: Retiring the signing key would leave nothing to sign with
+	filter := bson.M{
+		"_id":    kid,
+		"status": bson.M{"$ne": string(security.KeyStatusActive)},
+	}
+	update := bson.M{
+		"$set":   bson.M{"status": string(security.KeyStatusRetired)},
+		"$unset": bson.M{"encrypted_material": "", "material": ""},
+	}
+
+	result, err := s.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("Retire", err)
+	}
+	if result.MatchedCount == 0 {
+		return security.ErrRetireActiveKey
+	}
+
+	return nil
+}
+
+// seal replaces a key's plain-text material with its encrypted form
+func (s *SigningKeyStore) seal(ctx context.Context, doc *SigningKeyDocument) error {
+	material, err := s.cipher.Encrypt(string(doc.Material), doc.ID)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt signing key: %w", err)
+	}
+
+	filter := bson.M{"_id": doc.ID}
+	update := bson.M{
+		"$set":   bson.M{"encrypted_material": material},
+		"$unset": bson.M{"material": ""},
+	}
+
+	if _, err := s.collection.UpdateOne(ctx, filter, update); err != nil {
+		return repository.NewDatabaseQueryError("List", err)
+	}
+
+	doc.EncryptedMaterial = material
+	doc.Material = nil
+	return nil
+}
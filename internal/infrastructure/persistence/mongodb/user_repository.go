@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
 	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
@@ -33,7 +34,7 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
 	if err != nil {
 		// Check if error is duplicate key (unique constraint violation)
 		if mongo.IsDuplicateKeyError(err) {
-			// Email already exists (unique index violated)
+			// Email already exists in this tenant (unique index violated)
 			return repository.NewUserAlreadyExistsError("Create", err)
 		}
 
@@ -71,9 +72,8 @@ func (r *UserRepository) FindByID(ctx context.Context, id valueobject.UserID) (*
 	return user, nil
 }
 
-func (r *UserRepository) FindByEmail(ctx context.Context, email valueobject.Email) (*entity.User, error) {
-
-	filter := bson.M{"email": email.String()}
+func (r *UserRepository) FindByEmail(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (*entity.User, error) {
+	filter := bson.M{"tenant_id": tenantID.String(), "email": email.String()}
 
 	// Execute query
 	var doc UserDocument
@@ -105,6 +105,14 @@ func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 			"password":   user.Password().Hash(),
 			"updated_at": user.UpdatedAt(),
 			"is_active":  user.IsActive(),
+
+			"email_verified_at": user.EmailVerifiedAt(),
+			"pending_email":     user.PendingEmail().String(),
+			"mfa":               fromMFAEntity(user.MFA()),
+			"roles":             user.Access().Roles,
+			"permissions":       user.Access().Permissions,
+
+			"password_reset_required": user.PasswordResetRequired(),
 			// Note: Don't update created_at (immutable)
 		},
 	}
@@ -127,6 +135,58 @@ func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 	return nil
 }
 
+// UseTOTPStep records a used TOTP step
+// WHY: Conditional, so of two logins racing with one code only one succeeds
+func (r *UserRepository) UseTOTPStep(ctx context.Context, id valueobject.UserID, step int64) error {
+	filter := bson.M{
+		"_id":                id.String(),
+		"mfa.secret":         bson.M{"$exists": true},
+		"mfa.last_used_step": bson.M{"$lt": step},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"mfa.last_used_step": step,
+			"updated_at":         time.Now().UTC(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("UseTOTPStep", err)
+	}
+
+	// Unknown user, MFA disabled, or step already used
+	if result.MatchedCount == 0 {
+		return repository.NewMFACodeUsedError("UseTOTPStep")
+	}
+
+	return nil
+}
+
+// UseRecoveryCode consumes a recovery code
+// WHY: Conditional, so of two logins racing with one code only one succeeds
+func (r *UserRepository) UseRecoveryCode(ctx context.Context, id valueobject.UserID, codeHash string) error {
+	filter := bson.M{
+		"_id":                id.String(),
+		"mfa.recovery_codes": codeHash,
+	}
+	update := bson.M{
+		"$pull": bson.M{"mfa.recovery_codes": codeHash},
+		"$set":  bson.M{"updated_at": time.Now().UTC()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return repository.NewDatabaseQueryError("UseRecoveryCode", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return repository.NewMFACodeUsedError("UseRecoveryCode")
+	}
+
+	return nil
+}
+
 func (r *UserRepository) Delete(ctx context.Context, id valueobject.UserID) error {
 	// Build filter
 	filter := bson.M{"_id": id.String()}
@@ -145,9 +205,9 @@ func (r *UserRepository) Delete(ctx context.Context, id valueobject.UserID) erro
 	return nil
 }
 
-func (r *UserRepository) ExistsByEmail(ctx context.Context, email valueobject.Email) (bool, error) {
+func (r *UserRepository) ExistsByEmail(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error) {
 	// Build filter
-	filter := bson.M{"email": email.String()}
+	filter := bson.M{"tenant_id": tenantID.String(), "email": email.String()}
 
 	// Count documents (more efficient than FindOne)
 	// WHY: We only need existence, not the actual document
@@ -159,7 +219,7 @@ func (r *UserRepository) ExistsByEmail(ctx context.Context, email valueobject.Em
 	return count > 0, nil
 }
 
-func (r *UserRepository) List(ctx context.Context, offset, limit int) ([]*entity.User, error) {
+func (r *UserRepository) List(ctx context.Context, tenantID valueobject.TenantID, offset, limit int) ([]*entity.User, error) {
 	// Validate pagination parameters
 	if offset < 0 {
 		offset = 0
@@ -175,7 +235,7 @@ func (r *UserRepository) List(ctx context.Context, offset, limit int) ([]*entity
 		SetSort(bson.D{{Key: "created_at", Value: -1}})
 
 	// Execute query
-	cursor, err := r.collection.Find(ctx, bson.M{}, findOptions)
+	cursor, err := r.collection.Find(ctx, bson.M{"tenant_id": tenantID.String()}, findOptions)
 	if err != nil {
 		return nil, repository.NewDatabaseQueryError("List", err)
 	}
@@ -207,8 +267,8 @@ func (r *UserRepository) List(ctx context.Context, offset, limit int) ([]*entity
 	return users, nil
 }
 
-func (r *UserRepository) Count(ctx context.Context) (int64, error) {
-	count, err := r.collection.CountDocuments(ctx, bson.M{})
+func (r *UserRepository) Count(ctx context.Context, tenantID valueobject.TenantID) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"tenant_id": tenantID.String()})
 	if err != nil {
 		return 0, repository.NewDatabaseQueryError("Count", err)
 	}
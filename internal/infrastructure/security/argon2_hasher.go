@@ -0,0 +1,148 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2Prefix     = "$argon2id$"
+	argon2SaltLength = 16 // bytes
+	argon2KeyLength  = 32 // bytes
+
+	// argon2MaxMemory bounds the memory a stored hash may ask for (4 GiB)
+	// SECURITY: Hashes carry their own parameters - a tampered or imported
+	// one must not exhaust the server
+	argon2MaxMemory = 4 * 1024 * 1024
+)
+
+var errMalformedArgon2Hash = errors.New("malformed argon2id hash")
+
+// Argon2Params are the Argon2id cost parameters
+type Argon2Params struct {
+	Memory      uint32 // KiB
+	Time        uint32 // Passes over the memory
+	Parallelism uint8  // Lanes
+}
+
+// Argon2Hasher hashes passwords with Argon2id (RFC 9106)
+// Hashes use the PHC string format, which records the parameters:
+// $argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>
+type Argon2Hasher struct {
+	params Argon2Params
+}
+
+func NewArgon2Hasher(params Argon2Params) *Argon2Hasher {
+	return &Argon2Hasher{
+		params: params,
+	}
+}
+
+func (h *Argon2Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Time, h.params.Memory, h.params.Parallelism, argon2KeyLength)
+
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2Prefix,
+		argon2.Version,
+		h.params.Memory,
+		h.params.Time,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Compare verifies password with the parameters recorded in hashedPassword
+func (h *Argon2Hasher) Compare(hashedPassword, password string) error {
+	decoded, err := decodeArgon2Hash(hashedPassword)
+	if err != nil {
+		return err
+	}
+
+	key := argon2.IDKey(
+		[]byte(password),
+		decoded.salt,
+		decoded.params.Time,
+		decoded.params.Memory,
+		decoded.params.Parallelism,
+		uint32(len(decoded.key)),
+	)
+
+	// SECURITY: Constant-time comparison
+	if subtle.ConstantTimeCompare(key, decoded.key) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+// NeedsRehash reports whether hashedPassword was made with different parameters
+func (h *Argon2Hasher) NeedsRehash(hashedPassword string) bool {
+	decoded, err := decodeArgon2Hash(hashedPassword)
+	if err != nil {
+		return true
+	}
+	return decoded.params != h.params || len(decoded.key) != argon2KeyLength
+}
+
+// Recognizes reports whether hashedPassword is an Argon2id PHC string
+func (h *Argon2Hasher) Recognizes(hashedPassword string) bool {
+	return strings.HasPrefix(hashedPassword, argon2Prefix)
+}
+
+type argon2Hash struct {
+	params Argon2Params
+	salt   []byte
+	key    []byte
+}
+
+// decodeArgon2Hash parses $argon2id$v=19$m=...,t=...,p=...$<salt>$<key>
+func decodeArgon2Hash(hashedPassword string) (*argon2Hash, error) {
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, errMalformedArgon2Hash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, errMalformedArgon2Hash
+	}
+	if version != argon2.Version {
+		return nil, fmt.Errorf("unsupported argon2 version %d", version)
+	}
+
+	var decoded argon2Hash
+	if _, err := fmt.Sscanf(
+		parts[3],
+		"m=%d,t=%d,p=%d",
+		&decoded.params.Memory,
+		&decoded.params.Time,
+		&decoded.params.Parallelism,
+	); err != nil {
+		return nil, errMalformedArgon2Hash
+	}
+	if decoded.params.Time == 0 || decoded.params.Parallelism == 0 || decoded.params.Memory > argon2MaxMemory {
+		return nil, errMalformedArgon2Hash
+	}
+
+	var err error
+	if decoded.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, errMalformedArgon2Hash
+	}
+	if decoded.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(decoded.key) == 0 {
+		return nil, errMalformedArgon2Hash
+	}
+
+	return &decoded, nil
+}
@@ -0,0 +1,111 @@
+package security
+
+import (
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const (
+	djangoPBKDF2Prefix       = "pbkdf2_sha256$"
+	djangoBcryptPrefix       = "bcrypt$"
+	djangoBcryptSHA256Prefix = "bcrypt_sha256$"
+
+	// djangoPBKDF2MaxIterations bounds the work a stored hash may ask for
+	// SECURITY: Imported hashes carry their own iteration count - a bad
+	// import must not tie up the server on every login attempt
+	djangoPBKDF2MaxIterations = 10_000_000
+)
+
+var errMalformedPBKDF2Hash = errors.New("malformed pbkdf2_sha256 hash")
+
+// DjangoPBKDF2Verifier checks Django's default PBKDF2-SHA256 hashes:
+// pbkdf2_sha256$<iterations>$<salt>$<base64 hash>
+type DjangoPBKDF2Verifier struct{}
+
+func NewDjangoPBKDF2Verifier() *DjangoPBKDF2Verifier {
+	return &DjangoPBKDF2Verifier{}
+}
+
+func (v *DjangoPBKDF2Verifier) Compare(hashedPassword, password string) error {
+	iterations, salt, key, err := decodeDjangoPBKDF2Hash(hashedPassword)
+	if err != nil {
+		return err
+	}
+
+	computed, err := pbkdf2.Key(sha256.New, password, []byte(salt), iterations, len(key))
+	if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare(computed, key) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+func (v *DjangoPBKDF2Verifier) Recognizes(hashedPassword string) bool {
+	return strings.HasPrefix(hashedPassword, djangoPBKDF2Prefix)
+}
+
+// decodeDjangoPBKDF2Hash parses a pbkdf2_sha256 hash into its parts
+func decodeDjangoPBKDF2Hash(hashedPassword string) (iterations int, salt string, key []byte, err error) {
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 4 || parts[0]+"$" != djangoPBKDF2Prefix {
+		return 0, "", nil, errMalformedPBKDF2Hash
+	}
+
+	iterations, err = strconv.Atoi(parts[1])
+	if err != nil || iterations < 1 || iterations > djangoPBKDF2MaxIterations {
+		return 0, "", nil, errMalformedPBKDF2Hash
+	}
+
+	// NOTE: Django uses the salt string as is - it isn't encoded
+	if parts[2] == "" {
+		return 0, "", nil, errMalformedPBKDF2Hash
+	}
+
+	key, err = base64.StdEncoding.DecodeString(parts[3])
+	if err != nil || len(key) == 0 {
+		return 0, "", nil, errMalformedPBKDF2Hash
+	}
+
+	return iterations, parts[2], key, nil
+}
+
+// DjangoBcryptVerifier checks Django's bcrypt hashes, which wrap a plain
+// bcrypt hash: bcrypt$<bcrypt hash> or bcrypt_sha256$<bcrypt hash>
+// NOTE: bcrypt_sha256 hashes the hex SHA-256 of the password, so passwords
+// longer than bcrypt's 72 bytes aren't truncated
+type DjangoBcryptVerifier struct {
+	bcrypt *BcryptHasher
+}
+
+func NewDjangoBcryptVerifier() *DjangoBcryptVerifier {
+	return &DjangoBcryptVerifier{
+		bcrypt: NewBcryptHasher(0), // cost is read from each hash
+	}
+}
+
+func (v *DjangoBcryptVerifier) Compare(hashedPassword, password string) error {
+	if inner, ok := strings.CutPrefix(hashedPassword, djangoBcryptSHA256Prefix); ok {
+		digest := sha256.Sum256([]byte(password))
+		return v.bcrypt.Compare(inner, hex.EncodeToString(digest[:]))
+	}
+
+	inner, _ := strings.CutPrefix(hashedPassword, djangoBcryptPrefix)
+	return v.bcrypt.Compare(inner, password)
+}
+
+func (v *DjangoBcryptVerifier) Recognizes(hashedPassword string) bool {
+	inner, ok := strings.CutPrefix(hashedPassword, djangoBcryptSHA256Prefix)
+	if !ok {
+		inner, ok = strings.CutPrefix(hashedPassword, djangoBcryptPrefix)
+	}
+	return ok && v.bcrypt.Recognizes(inner)
+}
@@ -0,0 +1,175 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileKeyStore keeps the signing key ring in a single JSON file
+// WHY: Single-node deployments (or a mounted secret volume) don't need Mongo
+// SECURITY: The file holds private keys - it is written with 0600 permissions
+type FileKeyStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// fileKey is the on-disk representation of a StoredKey
+type fileKey struct {
+	ID        string    `json:"kid"`
+	Algorithm string    `json:"alg"`
+	Material  []byte    `json:"material,omitempty"` // base64 in JSON
+	Status    KeyStatus `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewFileKeyStore creates a key store backed by the file at path
+func NewFileKeyStore(path string) *FileKeyStore {
+	return &FileKeyStore{
+		path: path,
+	}
+}
+
+func (s *FileKeyStore) List(ctx context.Context) ([]StoredKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileKeys, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]StoredKey, 0, len(fileKeys))
+	for _, k := range fileKeys {
+		keys = append(keys, StoredKey(k))
+	}
+	return keys, nil
+}
+
+func (s *FileKeyStore) Add(ctx context.Context, key StoredKey) error {
+	return s.update(func(keys []fileKey) ([]fileKey, error) {
+		for _, k := range keys {
+			if k.ID == key.ID {
+				return nil, ErrKeyExists
+			}
+		}
+
+		key.Status = KeyStatusVerify
+		return append(keys, fileKey(key)), nil
+	})
+}
+
+func (s *FileKeyStore) Promote(ctx context.Context, kid string) error {
+	return s.update(func(keys []fileKey) ([]fileKey, error) {
+		index := findFileKey(keys, kid)
+		if index < 0 || keys[index].Status == KeyStatusRetired {
+			return nil, ErrKeyNotFound
+		}
+
+		for i := range keys {
+			if keys[i].Status == KeyStatusActive {
+				keys[i].Status = KeyStatusVerify
+			}
+		}
+		keys[index].Status = KeyStatusActive
+		return keys, nil
+	})
+}
+
+func (s *FileKeyStore) Retire(ctx context.Context, kid string) error {
+	return s.update(func(keys []fileKey) ([]fileKey, error) {
+		index := findFileKey(keys, kid)
+		if index < 0 {
+			return nil, ErrKeyNotFound
+		}
+		if keys[index].Status == KeyStatusActive {
+			return nil, ErrRetireActiveKey
+		}
+
+		keys[index].Status = KeyStatusRetired
+		keys[index].Material = nil
+		return keys, nil
+	})
+}
+
+// update applies fn to the stored keys and writes the result atomically
+func (s *FileKeyStore) update(fn func([]fileKey) ([]fileKey, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	keys, err = fn(keys)
+	if err != nil {
+		return err
+	}
+
+	return s.write(keys)
+}
+
+// read loads the key file (a missing file is an empty store)
+func (s *FileKeyStore) read() ([]fileKey, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	var keys []fileKey
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("failed to parse key file: %w", err)
+	}
+	return keys, nil
+}
+
+// write replaces the key file via rename
+// WHY: A reader (another instance) never sees a half-written file
+func (s *FileKeyStore) write(keys []fileKey) error {
+	data, err := json.MarshalIndent(keys, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".keys-*.json")
+	if err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	return nil
+}
+
+// findFileKey returns the index of kid, or -1
+func findFileKey(keys []fileKey, kid string) int {
+	for i, k := range keys {
+		if k.ID == kid {
+			return i
+		}
+	}
+	return -1
+}
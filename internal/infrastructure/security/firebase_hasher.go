@@ -0,0 +1,139 @@
+package security
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	firebaseScryptPrefix = "$firebase-scrypt$"
+	firebaseKeyLength    = 32 // bytes (AES-256)
+)
+
+var errMalformedFirebaseHash = errors.New("malformed firebase-scrypt hash")
+
+// FirebaseScryptParams are a Firebase project's password hash parameters
+// NOTE: Shared by every user of the project, so they're configured rather
+// than stored in each hash
+type FirebaseScryptParams struct {
+	SignerKey     []byte
+	SaltSeparator []byte
+	Rounds        int // scrypt r
+	MemCost       int // scrypt N = 2^MemCost
+}
+
+// FirebaseScryptVerifier checks hashes exported from Firebase Authentication,
+// stored as $firebase-scrypt$<base64 salt>$<base64 hash>
+// Firebase's modified scrypt derives a key from the password and salt, then
+// uses it to encrypt the project's signer key with AES-256-CTR; the
+// ciphertext is the password hash
+type FirebaseScryptVerifier struct {
+	params FirebaseScryptParams
+}
+
+func NewFirebaseScryptVerifier(params FirebaseScryptParams) *FirebaseScryptVerifier {
+	return &FirebaseScryptVerifier{
+		params: params,
+	}
+}
+
+// NewFirebaseScryptVerifierFromConfig creates a verifier for the configured project
+func NewFirebaseScryptVerifierFromConfig(cfg config.PasswordConfig) (*FirebaseScryptVerifier, error) {
+	signerKey, err := base64.StdEncoding.DecodeString(cfg.FirebaseSignerKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid firebase signer key: %w", err)
+	}
+
+	saltSeparator, err := base64.StdEncoding.DecodeString(cfg.FirebaseSaltSeparator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid firebase salt separator: %w", err)
+	}
+
+	return NewFirebaseScryptVerifier(FirebaseScryptParams{
+		SignerKey:     signerKey,
+		SaltSeparator: saltSeparator,
+		Rounds:        cfg.FirebaseRounds,
+		MemCost:       cfg.FirebaseMemCost,
+	}), nil
+}
+
+// FirebaseScryptHash builds the stored form of a hash from a Firebase
+// export, which has the salt and hash as separate base64 fields
+func FirebaseScryptHash(salt, hash string) (string, error) {
+	if _, err := base64.StdEncoding.DecodeString(salt); err != nil || salt == "" {
+		return "", errors.New("firebase salt must be base64")
+	}
+	if _, err := base64.StdEncoding.DecodeString(hash); err != nil || hash == "" {
+		return "", errors.New("firebase password hash must be base64")
+	}
+	return firebaseScryptPrefix + salt + "$" + hash, nil
+}
+
+func (v *FirebaseScryptVerifier) Compare(hashedPassword, password string) error {
+	salt, hash, err := decodeFirebaseScryptHash(hashedPassword)
+	if err != nil {
+		return err
+	}
+
+	derivedKey, err := scrypt.Key(
+		[]byte(password),
+		append(salt, v.params.SaltSeparator...),
+		1<<v.params.MemCost,
+		v.params.Rounds,
+		1,
+		firebaseKeyLength,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return err
+	}
+
+	computed := make([]byte, len(v.params.SignerKey))
+	cipher.NewCTR(block, make([]byte, aes.BlockSize)).XORKeyStream(computed, v.params.SignerKey)
+
+	if subtle.ConstantTimeCompare(computed, hash) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+func (v *FirebaseScryptVerifier) Recognizes(hashedPassword string) bool {
+	return strings.HasPrefix(hashedPassword, firebaseScryptPrefix)
+}
+
+// decodeFirebaseScryptHash parses a $firebase-scrypt$ hash into its salt and hash
+func decodeFirebaseScryptHash(hashedPassword string) (salt, hash []byte, err error) {
+	rest, ok := strings.CutPrefix(hashedPassword, firebaseScryptPrefix)
+	if !ok {
+		return nil, nil, errMalformedFirebaseHash
+	}
+
+	encodedSalt, encodedHash, ok := strings.Cut(rest, "$")
+	if !ok {
+		return nil, nil, errMalformedFirebaseHash
+	}
+
+	salt, err = base64.StdEncoding.DecodeString(encodedSalt)
+	if err != nil || len(salt) == 0 {
+		return nil, nil, errMalformedFirebaseHash
+	}
+
+	hash, err = base64.StdEncoding.DecodeString(encodedHash)
+	if err != nil || len(hash) == 0 {
+		return nil, nil, errMalformedFirebaseHash
+	}
+
+	return salt, hash, nil
+}
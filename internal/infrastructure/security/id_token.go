@@ -0,0 +1,99 @@
+package security
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// IDToken describes an OpenID Connect ID token to issue
+type IDToken struct {
+	Issuer      string             // OIDC issuer URL (not the access token issuer)
+	UserID      valueobject.UserID // sub
+	ClientID    string             // aud
+	Nonce       string             // From the authorization request (empty on refresh)
+	AuthTime    time.Time          // When the user signed in (zero if unknown)
+	AccessToken string             // Issued alongside, for at_hash
+	UserClaims                     // Released by the granted scopes
+}
+
+// UserClaims are the OIDC standard claims this service can release
+// NOTE: The account has no name or picture, so profile only carries updated_at
+type UserClaims struct {
+	Email         string `json:"email,omitempty"`          // email scope
+	EmailVerified *bool  `json:"email_verified,omitempty"` // email scope
+	UpdatedAt     int64  `json:"updated_at,omitempty"`     // profile scope (seconds since epoch)
+}
+
+// IDTokenClaims are the claims of an ID token (OIDC Core section 2)
+type IDTokenClaims struct {
+	Nonce           string           `json:"nonce,omitempty"`
+	AuthTime        *jwt.NumericDate `json:"auth_time,omitempty"`
+	AccessTokenHash string           `json:"at_hash,omitempty"`
+	UserClaims
+	jwt.RegisteredClaims
+}
+
+// GenerateIDToken creates an ID token that expires with its access token
+func (g *JWTGeneratorImpl) GenerateIDToken(idToken IDToken) (string, error) {
+	key := g.keyRing.Active()
+
+	// SECURITY: An HS256 ID token could only be checked with our secret
+	if key.IsSymmetric() {
+		return "", errors.New("ID tokens need an asymmetric signing key")
+	}
+
+	atHash, err := accessTokenHash(key.Algorithm(), idToken.AccessToken)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := IDTokenClaims{
+		Nonce:           idToken.Nonce,
+		AuthTime:        numericDate(idToken.AuthTime),
+		AccessTokenHash: atHash,
+		UserClaims:      idToken.UserClaims,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(g.accessTokenExpiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    idToken.Issuer,
+			Subject:   idToken.UserID.String(),
+			Audience:  jwt.ClaimStrings{idToken.ClientID},
+		},
+	}
+
+	return key.sign(claims)
+}
+
+// accessTokenHash computes at_hash: the left half of the access token's
+// hash, base64url-encoded (OIDC Core section 3.1.3.6)
+// NOTE: The hash follows the alg - SHA-512 for EdDSA (Ed25519)
+func accessTokenHash(algorithm, accessToken string) (string, error) {
+	if accessToken == "" {
+		return "", nil
+	}
+
+	var h hash.Hash
+	switch algorithm {
+	case AlgorithmRS256, AlgorithmES256:
+		h = sha256.New()
+	case AlgorithmEdDSA:
+		h = sha512.New()
+	default:
+		return "", fmt.Errorf("no at_hash function for %s", algorithm)
+	}
+
+	h.Write([]byte(accessToken))
+	sum := h.Sum(nil)
+	return base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2]), nil
+}
@@ -0,0 +1,87 @@
+package security
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// JWK is a JSON Web Key (RFC 7517) - public keys only
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	Alg string `json:"alg,omitempty"`
+
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// EC and OKP
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set, served at /.well-known/jwks.json
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// KeySetProvider publishes the public keys that verify our tokens
+type KeySetProvider interface {
+	JWKS() JWKS
+}
+
+// jwkThumbprint computes the RFC 7638 thumbprint of a public JWK
+// WHY: Stable, derived key ID - same key always gets the same kid
+func jwkThumbprint(jwk JWK) (string, error) {
+	// Required members only, in lexicographic order (RFC 7638 section 3.2)
+	var members any
+	switch jwk.Kty {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	case "EC":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{jwk.Crv, jwk.Kty, jwk.X, jwk.Y}
+	case "OKP":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	default:
+		return "", fmt.Errorf("unsupported key type: %q", jwk.Kty)
+	}
+
+	data, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return base64URL(sum[:]), nil
+}
+
+// base64URL encodes bytes as unpadded base64url (JOSE encoding)
+func base64URL(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// bigEndianBytes encodes a positive int with no leading zero bytes
+func bigEndianBytes(n int) []byte {
+	var out []byte
+	for ; n > 0; n >>= 8 {
+		out = append([]byte{byte(n)}, out...)
+	}
+	return out
+}
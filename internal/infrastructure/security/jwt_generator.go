@@ -2,26 +2,109 @@ package security
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type JWTGenerator interface {
-	GenerateAccessToken(userID valueobject.UserID, email valueobject.Email) (string, error)
-	GenerateRefreshToken(userID valueobject.UserID) (string, error)
-	ValidateToken(tokenString string) (*Claims, error)
+	// GenerateAccessToken creates an access token carrying the user's roles
+	// and permissions, so services verifying it via JWKS can authorize offline
+	// authTime is when the user signed in (zero if unknown) and sessionID the
+	// session the token belongs to (sid claim, "" = none)
+	GenerateAccessToken(userID valueobject.UserID, tenantID valueobject.TenantID, email valueobject.Email, roles, permissions []string, authTime time.Time, sessionID string) (string, error)
+	GenerateRefreshToken(userID valueobject.UserID, tenantID valueobject.TenantID, authTime time.Time) (string, error)
+
+	// GenerateClientAccessToken and GenerateClientRefreshToken create tokens
+	// for an OAuth client: aud is the client ID and scope the granted scopes
+	GenerateClientAccessToken(userID valueobject.UserID, tenantID valueobject.TenantID, email valueobject.Email, roles, permissions []string, authTime time.Time, sessionID string, grant ClientGrant) (string, error)
+	GenerateClientRefreshToken(userID valueobject.UserID, tenantID valueobject.TenantID, authTime time.Time, grant ClientGrant) (string, error)
+
+	// GenerateServiceAccessToken creates an access token for a service client
+	// (client_credentials grant): sub and client_id are the client ID and
+	// there is no user
+	GenerateServiceAccessToken(clientID string, tenantID valueobject.TenantID, scopes []string) (string, error)
+
+	// GenerateIDToken creates an OpenID Connect ID token
+	// NOTE: Fails unless the active key is asymmetric - clients verify ID
+	// tokens with the published keys
+	GenerateIDToken(idToken IDToken) (string, error)
+
+	// GenerateActionToken creates a short-lived token for a single purpose
+	// (e.g. email verification), bound to the email it was issued for
+	GenerateActionToken(userID valueobject.UserID, email valueobject.Email, use TokenUse, expiry time.Duration) (string, error)
+
+	// ValidateToken verifies signature and expiry, and rejects tokens
+	// whose token_use doesn't match expectedUse (ErrWrongTokenUse)
+	ValidateToken(tokenString string, expectedUse TokenUse) (*Claims, error)
+}
+
+// TokenUse says what a token may be used for
+// WHY: Access and refresh tokens share a signing key, so without this
+// claim a refresh token would pass as an access token and vice versa
+type TokenUse string
+
+const (
+	TokenUseAccess            TokenUse = "access"
+	TokenUseRefresh           TokenUse = "refresh"
+	TokenUseEmailVerification TokenUse = "email_verification"
+	TokenUseMFAChallenge      TokenUse = "mfa_challenge"
+)
+
+// ErrWrongTokenUse is returned when a valid token is used for the wrong purpose
+var ErrWrongTokenUse = errors.New("wrong token use")
+
+// ClientGrant binds tokens to the OAuth client they were issued to
+type ClientGrant struct {
+	ClientID string   // aud claim
+	Scopes   []string // scope claim (space-separated)
 }
 
+// Claims are the JWT claims issued by this service
+// NOTE: RegisteredClaims.ID is the jti - unique per token, used for revocation
 type Claims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
+	UserID      string   `json:"user_id,omitempty"`   // Absent on service tokens
+	TenantID    string   `json:"tenant_id,omitempty"` // Access and refresh tokens; absent on tokens issued before multi-tenancy
+	Email       string   `json:"email"`
+	TokenUse    TokenUse `json:"token_use"`
+	Roles       []string `json:"roles,omitempty"`       // Access tokens only
+	Permissions []string `json:"permissions,omitempty"` // Access tokens only
+	Scope       string   `json:"scope,omitempty"`       // OAuth client and service tokens only (RFC 9068)
+	ClientID    string   `json:"client_id,omitempty"`   // Service tokens only (RFC 9068)
+	SessionID   string   `json:"sid,omitempty"`         // Access tokens only; absent on tokens issued before sessions
+
+	// AuthTime is when the user signed in, carried through refresh so ID
+	// tokens can report it (absent on tokens issued before it existed)
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// ClientGrant returns the OAuth client the token was issued to, if any
+// NOTE: Tokens from login, signup and refresh have no aud claim
+func (c *Claims) ClientGrant() (ClientGrant, bool) {
+	if len(c.Audience) == 0 {
+		return ClientGrant{}, false
+	}
+	return ClientGrant{
+		ClientID: c.Audience[0],
+		Scopes:   strings.Fields(c.Scope),
+	}, true
+}
+
+// ServiceClientID returns the service client the token was issued to, if any
+// NOTE: Service tokens carry client_id instead of user_id
+func (c *Claims) ServiceClientID() (string, bool) {
+	if c.ClientID == "" || c.UserID != "" {
+		return "", false
+	}
+	return c.ClientID, true
+}
+
 type JWTGeneratorImpl struct {
-	secretKey          []byte        // Signing key
+	keyRing            *KeyRing      // Signing and verification keys
 	accessTokenExpiry  time.Duration // Access token lifetime
 	refreshTokenExpiry time.Duration // Refresh token lifetime
 	issuer             string        // JWT issuer
@@ -29,13 +112,13 @@ type JWTGeneratorImpl struct {
 
 // NewJWTGenerator creates a new JWT generator
 func NewJWTGenerator(
-	secretKey string,
+	keyRing *KeyRing,
 	accessTokenExpiry time.Duration,
 	refreshTokenExpiry time.Duration,
 	issuer string,
 ) *JWTGeneratorImpl {
 	return &JWTGeneratorImpl{
-		secretKey:          []byte(secretKey),
+		keyRing:            keyRing,
 		accessTokenExpiry:  accessTokenExpiry,
 		refreshTokenExpiry: refreshTokenExpiry,
 		issuer:             issuer,
@@ -45,16 +128,56 @@ func NewJWTGenerator(
 // GenerateAccessToken creates an access token
 func (g *JWTGeneratorImpl) GenerateAccessToken(
 	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
+	email valueobject.Email,
+	roles []string,
+	permissions []string,
+	authTime time.Time,
+	sessionID string,
+) (string, error) {
+	return g.generateAccessToken(userID, tenantID, email, roles, permissions, authTime, sessionID, nil)
+}
+
+// GenerateClientAccessToken creates an access token for an OAuth client
+func (g *JWTGeneratorImpl) GenerateClientAccessToken(
+	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
+	email valueobject.Email,
+	roles []string,
+	permissions []string,
+	authTime time.Time,
+	sessionID string,
+	grant ClientGrant,
+) (string, error) {
+	return g.generateAccessToken(userID, tenantID, email, roles, permissions, authTime, sessionID, &grant)
+}
+
+// generateAccessToken signs access token claims, bound to grant if not nil
+func (g *JWTGeneratorImpl) generateAccessToken(
+	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
 	email valueobject.Email,
+	roles []string,
+	permissions []string,
+	authTime time.Time,
+	sessionID string,
+	grant *ClientGrant,
 ) (string, error) {
 	now := time.Now()
 	expiresAt := now.Add(g.accessTokenExpiry)
 
 	// Create claims
 	claims := Claims{
-		UserID: userID.String(),
-		Email:  email.String(),
+		UserID:      userID.String(),
+		TenantID:    tenantID.String(),
+		Email:       email.String(),
+		TokenUse:    TokenUseAccess,
+		Roles:       roles,
+		Permissions: permissions,
+		SessionID:   sessionID,
+		AuthTime:    numericDate(authTime),
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(), // jti - lets us revoke this token
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
@@ -62,12 +185,10 @@ func (g *JWTGeneratorImpl) GenerateAccessToken(
 			Subject:   userID.String(),
 		},
 	}
+	grant.apply(&claims)
 
-	// Create token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	// Sign token
-	tokenString, err := token.SignedString(g.secretKey)
+	// Sign token (sets kid header)
+	tokenString, err := g.keyRing.Active().sign(claims)
 	if err != nil {
 		return "", err
 	}
@@ -75,18 +196,72 @@ func (g *JWTGeneratorImpl) GenerateAccessToken(
 	return tokenString, nil
 }
 
+// GenerateServiceAccessToken creates an access token for a service client
+// NOTE: No refresh token - the client authenticates again when it expires
+func (g *JWTGeneratorImpl) GenerateServiceAccessToken(
+	clientID string,
+	tenantID valueobject.TenantID,
+	scopes []string,
+) (string, error) {
+	now := time.Now()
+
+	claims := Claims{
+		TenantID: tenantID.String(),
+		TokenUse: TokenUseAccess,
+		Scope:    strings.Join(scopes, " "),
+		ClientID: clientID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(g.accessTokenExpiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    g.issuer,
+			Subject:   clientID,
+		},
+	}
+
+	return g.keyRing.Active().sign(claims)
+}
+
 // GenerateRefreshToken creates a refresh token
 // WHY: Refresh tokens don't need email (less data in token)
 func (g *JWTGeneratorImpl) GenerateRefreshToken(
 	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
+	authTime time.Time,
+) (string, error) {
+	return g.generateRefreshToken(userID, tenantID, authTime, nil)
+}
+
+// GenerateClientRefreshToken creates a refresh token for an OAuth client
+// WHY: The refresh grant must reissue the same audience and scopes
+func (g *JWTGeneratorImpl) GenerateClientRefreshToken(
+	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
+	authTime time.Time,
+	grant ClientGrant,
+) (string, error) {
+	return g.generateRefreshToken(userID, tenantID, authTime, &grant)
+}
+
+// generateRefreshToken signs refresh token claims, bound to grant if not nil
+func (g *JWTGeneratorImpl) generateRefreshToken(
+	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
+	authTime time.Time,
+	grant *ClientGrant,
 ) (string, error) {
 	now := time.Now()
 	expiresAt := now.Add(g.refreshTokenExpiry)
 
 	claims := Claims{
-		UserID: userID.String(),
+		UserID:   userID.String(),
+		TenantID: tenantID.String(),
+		TokenUse: TokenUseRefresh,
+		AuthTime: numericDate(authTime),
 		// No email in refresh token
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(), // jti - two tokens issued in the same second must differ
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
@@ -94,9 +269,9 @@ func (g *JWTGeneratorImpl) GenerateRefreshToken(
 			Subject:   userID.String(),
 		},
 	}
+	grant.apply(&claims)
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(g.secretKey)
+	tokenString, err := g.keyRing.Active().sign(claims)
 	if err != nil {
 		return "", err
 	}
@@ -104,18 +279,72 @@ func (g *JWTGeneratorImpl) GenerateRefreshToken(
 	return tokenString, nil
 }
 
+// apply sets the aud and scope claims (no-op for a nil grant)
+func (grant *ClientGrant) apply(claims *Claims) {
+	if grant == nil {
+		return
+	}
+	claims.Audience = jwt.ClaimStrings{grant.ClientID}
+	claims.Scope = strings.Join(grant.Scopes, " ")
+}
+
+// numericDate converts t to a JWT date, omitting the claim for zero t
+func numericDate(t time.Time) *jwt.NumericDate {
+	if t.IsZero() {
+		return nil
+	}
+	return jwt.NewNumericDate(t)
+}
+
+// GenerateActionToken creates a single-purpose token
+// SECURITY: token_use keeps it from ever passing as an access token
+func (g *JWTGeneratorImpl) GenerateActionToken(
+	userID valueobject.UserID,
+	email valueobject.Email,
+	use TokenUse,
+	expiry time.Duration,
+) (string, error) {
+	now := time.Now()
+
+	claims := Claims{
+		UserID:   userID.String(),
+		Email:    email.String(),
+		TokenUse: use,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    g.issuer,
+			Subject:   userID.String(),
+		},
+	}
+
+	return g.keyRing.Active().sign(claims)
+}
+
 // ValidateToken validates JWT and returns claims
-func (g *JWTGeneratorImpl) ValidateToken(tokenString string) (*Claims, error) {
+func (g *JWTGeneratorImpl) ValidateToken(tokenString string, expectedUse TokenUse) (*Claims, error) {
 	// Parse token
 	token, err := jwt.ParseWithClaims(
 		tokenString,
 		&Claims{},
 		func(token *jwt.Token) (interface{}, error) {
+			// Select key by kid
+			kid, _ := token.Header["kid"].(string)
+			key, ok := g.keyRing.Lookup(kid)
+			if !ok {
+				return nil, errors.New("unknown signing key")
+			}
+
 			// Verify signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			// SECURITY: Must match the selected key exactly - blocks alg
+			// confusion (e.g. HS256 signed with a published RSA public key)
+			if token.Method.Alg() != key.Algorithm() {
 				return nil, errors.New("unexpected signing method")
 			}
-			return g.secretKey, nil
+
+			return key.verifyKey, nil
 		},
 	)
 
@@ -129,5 +358,17 @@ func (g *JWTGeneratorImpl) ValidateToken(tokenString string) (*Claims, error) {
 		return nil, errors.New("invalid token")
 	}
 
+	// Enforce token kind
+	// SECURITY: Tokens without token_use (issued before it existed) are rejected
+	if claims.TokenUse != expectedUse {
+		return nil, ErrWrongTokenUse
+	}
+
 	return claims, nil
 }
+
+// JWKS returns the public signing keys
+// NOTE: HS256 keys are never included - shared secrets are never published
+func (g *JWTGeneratorImpl) JWKS() JWKS {
+	return g.keyRing.JWKS()
+}
@@ -0,0 +1,93 @@
+package security
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// KeyRing holds one active signing key plus verification-only keys
+// WHY: Rotation adds a new key and promotes it; tokens signed by the old
+// key stay valid until it is retired, so nobody is logged out at once
+type KeyRing struct {
+	mu     sync.RWMutex
+	active *SigningKey
+	keys   map[string]*SigningKey // kid -> key (includes active)
+}
+
+// NewKeyRing creates a key ring that signs with active
+func NewKeyRing(active *SigningKey) *KeyRing {
+	return &KeyRing{
+		active: active,
+		keys:   map[string]*SigningKey{active.ID(): active},
+	}
+}
+
+// Active returns the key that signs new tokens
+func (r *KeyRing) Active() *SigningKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.active
+}
+
+// Lookup finds the key that verifies a token with the given kid
+// NOTE: Tokens without a kid (issued before rotation) use the active key
+func (r *KeyRing) Lookup(kid string) (*SigningKey, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if kid == "" {
+		return r.active, true
+	}
+
+	key, ok := r.keys[kid]
+	return key, ok
+}
+
+// Keys returns every key in the ring, ordered by kid
+func (r *KeyRing) Keys() []*SigningKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]*SigningKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID() < keys[j].ID() })
+	return keys
+}
+
+// Replace swaps the ring contents in one step
+// WHY: Reloads from a KeyStore must never expose a half-built ring
+func (r *KeyRing) Replace(active *SigningKey, verificationKeys []*SigningKey) error {
+	keys := map[string]*SigningKey{active.ID(): active}
+	for _, key := range verificationKeys {
+		// SECURITY: kid selects the key - it must be set and unique
+		if key.ID() == "" {
+			return errors.New("verification keys must have a key ID")
+		}
+		if _, exists := keys[key.ID()]; exists {
+			return fmt.Errorf("duplicate key ID: %s", key.ID())
+		}
+		keys[key.ID()] = key
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.active = active
+	r.keys = keys
+	return nil
+}
+
+// JWKS returns the public half of every asymmetric key in the ring
+// WHY: Verifiers need the old keys too, until they are retired
+func (r *KeyRing) JWKS() JWKS {
+	keys := []JWK{}
+	for _, key := range r.Keys() {
+		if jwk, ok := key.PublicJWK(); ok {
+			keys = append(keys, jwk)
+		}
+	}
+	return JWKS{Keys: keys}
+}
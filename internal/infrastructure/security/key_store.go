@@ -0,0 +1,204 @@
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"github.com/google/uuid"
+)
+
+// KeyStatus is where a signing key is in its rotation lifecycle
+type KeyStatus string
+
+const (
+	KeyStatusActive  KeyStatus = "active"  // Signs new tokens (exactly one)
+	KeyStatusVerify  KeyStatus = "verify"  // Verifies existing tokens only
+	KeyStatusRetired KeyStatus = "retired" // No longer trusted, material wiped
+)
+
+// Key store errors
+var (
+	ErrKeyNotFound     = errors.New("signing key not found")
+	ErrKeyExists       = errors.New("signing key already exists")
+	ErrRetireActiveKey = errors.New("cannot retire the active signing key")
+)
+
+// StoredKey is a signing key as persisted in a KeyStore
+type StoredKey struct {
+	ID        string
+	Algorithm string
+	Material  []byte // PEM private key, or raw HMAC secret
+	Status    KeyStatus
+	CreatedAt time.Time
+}
+
+// KeyStore persists the signing key ring
+// WHY: Shared storage lets every instance pick up a rotation without a restart
+type KeyStore interface {
+	// List returns all keys, including retired ones
+	List(ctx context.Context) ([]StoredKey, error)
+
+	// Add stores a new key in KeyStatusVerify (ErrKeyExists on duplicate kid)
+	Add(ctx context.Context, key StoredKey) error
+
+	// Promote makes kid the active key and demotes the previous one to verify
+	Promote(ctx context.Context, kid string) error
+
+	// Retire stops trusting kid and wipes its material
+	Retire(ctx context.Context, kid string) error
+}
+
+// NewStoredKey validates key material and derives the kid if id is empty
+// NOTE: HMAC secrets get a random kid - deriving one would leak a hash of the secret
+func NewStoredKey(id, algorithm string, material []byte) (StoredKey, error) {
+	key, err := parseStoredKey(StoredKey{ID: id, Algorithm: algorithm, Material: material})
+	if err != nil {
+		return StoredKey{}, err
+	}
+
+	if id == "" {
+		id = key.ID()
+	}
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	return StoredKey{
+		ID:        id,
+		Algorithm: algorithm,
+		Material:  material,
+		Status:    KeyStatusVerify,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// GenerateStoredKey creates a fresh random key for algorithm
+func GenerateStoredKey(algorithm string) (StoredKey, error) {
+	var privateKey any
+	var err error
+
+	switch algorithm {
+	case AlgorithmHS256:
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return StoredKey{}, err
+		}
+		return NewStoredKey("", algorithm, secret)
+	case AlgorithmRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, 3072)
+	case AlgorithmES256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return StoredKey{}, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+	if err != nil {
+		return StoredKey{}, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return StoredKey{}, fmt.Errorf("failed to encode key: %w", err)
+	}
+
+	return NewStoredKey("", algorithm, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+// StoredKeyFromConfig converts the configured key for bootstrapping a KeyStore
+func StoredKeyFromConfig(cfg config.JWTConfig) (StoredKey, error) {
+	if cfg.SigningAlgorithm == AlgorithmHS256 {
+		return NewStoredKey(cfg.KeyID, cfg.SigningAlgorithm, []byte(cfg.SecretKey))
+	}
+
+	material, err := os.ReadFile(cfg.PrivateKeyPath)
+	if err != nil {
+		return StoredKey{}, fmt.Errorf("failed to read private key: %w", err)
+	}
+	return NewStoredKey(cfg.KeyID, cfg.SigningAlgorithm, material)
+}
+
+// BootstrapKeyStore stores key as the active key if the store is empty
+// WHY: First start with a store reuses the configured key, so tokens issued
+// before the store existed stay valid
+func BootstrapKeyStore(ctx context.Context, store KeyStore, key StoredKey) (bool, error) {
+	keys, err := store.List(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(keys) > 0 {
+		return false, nil
+	}
+
+	if err := store.Add(ctx, key); err != nil {
+		return false, err
+	}
+	if err := store.Promote(ctx, key.ID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReloadKeyRing rebuilds ring from the keys in store
+// NOTE: On error the ring is left untouched - a bad store never breaks signing
+func ReloadKeyRing(ctx context.Context, ring *KeyRing, store KeyStore) error {
+	stored, err := store.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list signing keys: %w", err)
+	}
+
+	var active *SigningKey
+	var verificationKeys []*SigningKey
+
+	for _, storedKey := range stored {
+		if storedKey.Status == KeyStatusRetired {
+			continue
+		}
+
+		key, err := parseStoredKey(storedKey)
+		if err != nil {
+			return fmt.Errorf("invalid signing key %s: %w", storedKey.ID, err)
+		}
+
+		if storedKey.Status != KeyStatusActive {
+			verificationKeys = append(verificationKeys, key)
+			continue
+		}
+
+		// Promote is not atomic across instances - wait for a consistent view
+		if active != nil {
+			return errors.New("more than one active signing key")
+		}
+		active = key
+	}
+
+	if active == nil {
+		return errors.New("no active signing key")
+	}
+
+	return ring.Replace(active, verificationKeys)
+}
+
+// parseStoredKey turns stored material into a SigningKey
+func parseStoredKey(stored StoredKey) (*SigningKey, error) {
+	if stored.Algorithm == AlgorithmHS256 {
+		// Same minimum as config validation
+		if len(stored.Material) < 32 {
+			return nil, errors.New("HMAC secret too short (need at least 32 bytes)")
+		}
+		return NewHMACSigningKey(stored.ID, stored.Material), nil
+	}
+
+	return ParseSigningKey(stored.ID, stored.Algorithm, stored.Material)
+}
@@ -0,0 +1,240 @@
+package security
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// PasskeyVerifier runs the server side of WebAuthn ceremonies
+// Options and sessions are opaque JSON: options go to the browser
+// (navigator.credentials), sessions are stored until the ceremony finishes
+type PasskeyVerifier interface {
+	BeginRegistration(user PasskeyUser) (options []byte, session []byte, err error)
+
+	// FinishRegistration verifies an attestation response (ErrPasskeyVerification)
+	FinishRegistration(user PasskeyUser, session []byte, response []byte) (*entity.PasskeyCredential, error)
+
+	// BeginLogin starts a discoverable (usernameless) sign-in
+	BeginLogin() (options []byte, session []byte, err error)
+
+	// FinishLogin verifies an assertion response (ErrPasskeyVerification)
+	// lookup finds the account the authenticator says the credential belongs to
+	FinishLogin(session []byte, response []byte, lookup PasskeyLookup) (*PasskeyAssertion, error)
+}
+
+// PasskeyUser is an account taking part in a ceremony
+type PasskeyUser struct {
+	Handle      []byte // WebAuthn user handle (our user ID - never the email)
+	Name        string // Shown by the authenticator
+	Credentials []entity.PasskeyCredential
+}
+
+// PasskeyLookup finds the account for a discoverable sign-in
+type PasskeyLookup func(credentialID, userHandle []byte) (*PasskeyUser, error)
+
+// PasskeyAssertion is a verified sign-in
+type PasskeyAssertion struct {
+	UserHandle   []byte
+	Credential   entity.PasskeyCredential // With the new sign counter and backup state
+	CloneWarning bool                     // Counter didn't advance - the key may have been copied
+}
+
+// ErrPasskeyVerification is returned when a ceremony response doesn't verify
+var ErrPasskeyVerification = errors.New("passkey verification failed")
+
+// WebAuthnVerifier implements PasskeyVerifier with go-webauthn
+type WebAuthnVerifier struct {
+	webauthn *webauthn.WebAuthn
+}
+
+// NewWebAuthnVerifier creates a verifier for one relying party
+// rpID is the registrable domain (e.g. example.com); origins are the exact
+// web origins allowed to run ceremonies (e.g. https://app.example.com)
+func NewWebAuthnVerifier(rpID, rpDisplayName string, origins []string, timeout time.Duration) (*WebAuthnVerifier, error) {
+	// WHY: Enforced server-side too - a stale challenge must not verify
+	ceremonyTimeout := webauthn.TimeoutConfig{
+		Enforce:    true,
+		Timeout:    timeout,
+		TimeoutUVD: timeout,
+	}
+
+	w, err := webauthn.New(&webauthn.Config{
+		RPID:          rpID,
+		RPDisplayName: rpDisplayName,
+		RPOrigins:     origins,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        ceremonyTimeout,
+			Registration: ceremonyTimeout,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid WebAuthn configuration: %w", err)
+	}
+
+	return &WebAuthnVerifier{webauthn: w}, nil
+}
+
+func (v *WebAuthnVerifier) BeginRegistration(user PasskeyUser) ([]byte, []byte, error) {
+	u := webAuthnUser(user)
+
+	// SECURITY: Discoverable credentials with user verification - a passkey
+	// replaces the password, so it must prove possession and the user (PIN/biometric)
+	creation, session, err := v.webauthn.BeginRegistration(
+		u,
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+		webauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			UserVerification:   protocol.VerificationRequired,
+		}),
+		webauthn.WithExclusions(webauthn.Credentials(u.WebAuthnCredentials()).CredentialDescriptors()),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin registration: %w", err)
+	}
+
+	return marshalCeremony(creation, session)
+}
+
+func (v *WebAuthnVerifier) FinishRegistration(user PasskeyUser, session []byte, response []byte) (*entity.PasskeyCredential, error) {
+	var sessionData webauthn.SessionData
+	if err := json.Unmarshal(session, &sessionData); err != nil {
+		return nil, fmt.Errorf("invalid registration session: %w", err)
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPasskeyVerification, err)
+	}
+
+	credential, err := v.webauthn.CreateCredential(webAuthnUser(user), sessionData, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPasskeyVerification, err)
+	}
+
+	result := fromWebAuthnCredential(credential)
+	return &result, nil
+}
+
+func (v *WebAuthnVerifier) BeginLogin() ([]byte, []byte, error) {
+	assertion, session, err := v.webauthn.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin login: %w", err)
+	}
+
+	return marshalCeremony(assertion, session)
+}
+
+func (v *WebAuthnVerifier) FinishLogin(session []byte, response []byte, lookup PasskeyLookup) (*PasskeyAssertion, error) {
+	var sessionData webauthn.SessionData
+	if err := json.Unmarshal(session, &sessionData); err != nil {
+		return nil, fmt.Errorf("invalid login session: %w", err)
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPasskeyVerification, err)
+	}
+
+	var handle []byte
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		user, err := lookup(rawID, userHandle)
+		if err != nil {
+			return nil, err
+		}
+		handle = user.Handle
+		return webAuthnUser(*user), nil
+	}
+
+	_, credential, err := v.webauthn.ValidatePasskeyLogin(handler, sessionData, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPasskeyVerification, err)
+	}
+
+	return &PasskeyAssertion{
+		UserHandle:   handle,
+		Credential:   fromWebAuthnCredential(credential),
+		CloneWarning: credential.Authenticator.CloneWarning,
+	}, nil
+}
+
+func marshalCeremony(options any, session *webauthn.SessionData) ([]byte, []byte, error) {
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode ceremony options: %w", err)
+	}
+
+	sessionJSON, err := json.Marshal(session)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode ceremony session: %w", err)
+	}
+
+	return optionsJSON, sessionJSON, nil
+}
+
+// webAuthnUser adapts PasskeyUser to the library's User interface
+type webAuthnUser PasskeyUser
+
+func (u webAuthnUser) WebAuthnID() []byte {
+	return u.Handle
+}
+
+func (u webAuthnUser) WebAuthnName() string {
+	return u.Name
+}
+
+func (u webAuthnUser) WebAuthnDisplayName() string {
+	return u.Name
+}
+
+func (u webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, len(u.Credentials))
+	for i, c := range u.Credentials {
+		transports := make([]protocol.AuthenticatorTransport, len(c.Transports))
+		for j, t := range c.Transports {
+			transports[j] = protocol.AuthenticatorTransport(t)
+		}
+
+		credentials[i] = webauthn.Credential{
+			ID:              c.ID,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: c.BackupEligible,
+				BackupState:    c.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    c.AAGUID,
+				SignCount: c.SignCount,
+			},
+		}
+	}
+	return credentials
+}
+
+func fromWebAuthnCredential(c *webauthn.Credential) entity.PasskeyCredential {
+	transports := make([]string, len(c.Transport))
+	for i, t := range c.Transport {
+		transports[i] = string(t)
+	}
+
+	return entity.PasskeyCredential{
+		ID:              c.ID,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		AAGUID:          c.Authenticator.AAGUID,
+		SignCount:       c.Authenticator.SignCount,
+		Transports:      transports,
+		BackupEligible:  c.Flags.BackupEligible,
+		BackupState:     c.Flags.BackupState,
+	}
+}
@@ -1,6 +1,21 @@
 package security
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	// ErrPasswordMismatch is returned by Compare for a wrong password
+	ErrPasswordMismatch = errors.New("password does not match")
+
+	// ErrUnknownHashFormat is returned by Compare for a hash no hasher recognizes
+	ErrUnknownHashFormat = errors.New("unknown password hash format")
+)
 
 type PasswordHasher interface {
 	// Hash generates a hash from plain text password
@@ -8,6 +23,28 @@ type PasswordHasher interface {
 
 	// Compare compares plain text password with hash
 	Compare(hashedPassword, password string) error
+
+	// NeedsRehash reports whether hashedPassword uses an outdated algorithm
+	// or parameters and should be replaced by Hash(password) after a
+	// successful Compare
+	NeedsRehash(hashedPassword string) bool
+}
+
+// HashVerifier checks passwords against one hash format
+// NOTE: Formats imported from other systems (Django, Firebase) are only
+// verified - their users move to the current algorithm on next login
+type HashVerifier interface {
+	// Compare compares plain text password with hash
+	Compare(hashedPassword, password string) error
+
+	// Recognizes reports whether hashedPassword is in this format
+	Recognizes(hashedPassword string) bool
+}
+
+// HashAlgorithm is a PasswordHasher for one hash format
+type HashAlgorithm interface {
+	PasswordHasher
+	HashVerifier
 }
 
 type BcryptHasher struct {
@@ -34,5 +71,127 @@ func (h *BcryptHasher) Hash(password string) (string, error) {
 
 func (h *BcryptHasher) Compare(hashedPassword, password string) error {
 	// bcrypt.CompareHashAndPassword is constant-time
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return ErrPasswordMismatch
+	}
+	return err
+}
+
+// NeedsRehash reports whether hashedPassword was made with a different cost
+func (h *BcryptHasher) NeedsRehash(hashedPassword string) bool {
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	return err != nil || cost != h.cost
+}
+
+// Recognizes reports whether hashedPassword is a bcrypt hash ($2a$, $2b$ or $2y$)
+func (h *BcryptHasher) Recognizes(hashedPassword string) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if strings.HasPrefix(hashedPassword, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MultiHasher hashes with one algorithm and verifies any it knows
+// WHY: Switching algorithms must not lock out users whose passwords were
+// hashed with the previous one - their hashes are upgraded as they log in
+type MultiHasher struct {
+	current HashAlgorithm
+	legacy  []HashVerifier
+}
+
+// NewMultiHasher creates a hasher that hashes with current and also
+// verifies hashes made by legacy
+func NewMultiHasher(current HashAlgorithm, legacy ...HashVerifier) *MultiHasher {
+	return &MultiHasher{
+		current: current,
+		legacy:  legacy,
+	}
+}
+
+// NewPasswordHasherFromConfig creates a hasher for the configured algorithm
+// that still verifies the other one and the imported formats, peppered if
+// pepper keys are configured
+func NewPasswordHasherFromConfig(cfg config.PasswordConfig) (HashAlgorithm, error) {
+	hasher, err := newMultiHasherFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.PepperVersion == 0 {
+		return hasher, nil
+	}
+	return NewPepperedHasherFromConfig(hasher, cfg)
+}
+
+func newMultiHasherFromConfig(cfg config.PasswordConfig) (*MultiHasher, error) {
+	bcryptHasher := NewBcryptHasher(cfg.BcryptCost)
+	argon2Hasher := NewArgon2Hasher(Argon2Params{
+		Memory:      cfg.Argon2Memory,
+		Time:        cfg.Argon2Time,
+		Parallelism: cfg.Argon2Parallelism,
+	})
+
+	imported := []HashVerifier{NewDjangoPBKDF2Verifier(), NewDjangoBcryptVerifier()}
+
+	// NOTE: Firebase hashes can only be checked with the project's signer key
+	if cfg.FirebaseSignerKey != "" {
+		firebase, err := NewFirebaseScryptVerifierFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		imported = append(imported, firebase)
+	}
+
+	switch cfg.Algorithm {
+	case "argon2id":
+		return NewMultiHasher(argon2Hasher, append([]HashVerifier{bcryptHasher}, imported...)...), nil
+	case "bcrypt":
+		return NewMultiHasher(bcryptHasher, append([]HashVerifier{argon2Hasher}, imported...)...), nil
+	default:
+		return nil, fmt.Errorf("unknown password hash algorithm: %s", cfg.Algorithm)
+	}
+}
+
+// Hash hashes with the current algorithm
+func (h *MultiHasher) Hash(password string) (string, error) {
+	return h.current.Hash(password)
+}
+
+// Compare verifies password with whichever algorithm made hashedPassword
+func (h *MultiHasher) Compare(hashedPassword, password string) error {
+	algorithm, ok := h.algorithmFor(hashedPassword)
+	if !ok {
+		return ErrUnknownHashFormat
+	}
+	return algorithm.Compare(hashedPassword, password)
+}
+
+// NeedsRehash reports whether hashedPassword was made by a legacy algorithm
+// or with outdated parameters
+func (h *MultiHasher) NeedsRehash(hashedPassword string) bool {
+	if h.current.Recognizes(hashedPassword) {
+		return h.current.NeedsRehash(hashedPassword)
+	}
+	return true
+}
+
+// Recognizes reports whether any of the hasher's formats can verify hashedPassword
+func (h *MultiHasher) Recognizes(hashedPassword string) bool {
+	_, ok := h.algorithmFor(hashedPassword)
+	return ok
+}
+
+func (h *MultiHasher) algorithmFor(hashedPassword string) (HashVerifier, bool) {
+	if h.current.Recognizes(hashedPassword) {
+		return h.current, true
+	}
+	for _, algorithm := range h.legacy {
+		if algorithm.Recognizes(hashedPassword) {
+			return algorithm, true
+		}
+	}
+	return nil, false
 }
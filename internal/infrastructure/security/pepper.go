@@ -0,0 +1,180 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+)
+
+const (
+	pepperPrefix = "$pepper$v="
+
+	// pepperMinKeyLength is the shortest pepper key accepted (bytes)
+	pepperMinKeyLength = 32
+)
+
+// ErrUnknownPepperVersion is returned by Compare for a hash peppered with a
+// key that is no longer configured
+var ErrUnknownPepperVersion = errors.New("unknown password pepper version")
+
+// PepperedHasher applies a server-side HMAC pepper before hashing
+// Hashes record the pepper version they were made with:
+// $pepper$v=<version><inner hash>, e.g. $pepper$v=2$argon2id$v=19$...
+// WHY: The pepper lives outside the database, so a dump alone isn't enough
+// to crack passwords. Versions let keys be rotated - hashes made with an
+// older key, or before peppering, are upgraded when their owner logs in
+type PepperedHasher struct {
+	inner   HashAlgorithm
+	keys    map[int][]byte
+	current int
+}
+
+// NewPepperedHasher creates a hasher that peppers with keys[current] and
+// verifies hashes made with any of keys
+func NewPepperedHasher(inner HashAlgorithm, keys map[int][]byte, current int) (*PepperedHasher, error) {
+	if _, ok := keys[current]; !ok {
+		return nil, fmt.Errorf("no pepper key for version %d", current)
+	}
+
+	return &PepperedHasher{
+		inner:   inner,
+		keys:    keys,
+		current: current,
+	}, nil
+}
+
+// NewPepperedHasherFromConfig wraps inner with the configured pepper keys
+func NewPepperedHasherFromConfig(inner HashAlgorithm, cfg config.PasswordConfig) (*PepperedHasher, error) {
+	entries := strings.Split(cfg.Peppers, ",")
+	if cfg.PepperFile != "" {
+		data, err := os.ReadFile(cfg.PepperFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read pepper file: %w", err)
+		}
+		entries = strings.Split(string(data), "\n")
+	}
+
+	keys, err := parsePepperKeys(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPepperedHasher(inner, keys, cfg.PepperVersion)
+}
+
+// parsePepperKeys parses <version>:<base64 key> entries, skipping blank
+// lines and # comments
+func parsePepperKeys(entries []string) (map[int][]byte, error) {
+	keys := make(map[int][]byte)
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		versionText, encodedKey, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, errors.New("pepper keys must be <version>:<base64 key>")
+		}
+
+		version, err := strconv.Atoi(versionText)
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("invalid pepper version %q", versionText)
+		}
+		if _, ok := keys[version]; ok {
+			return nil, fmt.Errorf("duplicate pepper version %d", version)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("pepper key %d must be base64", version)
+		}
+		if len(key) < pepperMinKeyLength {
+			return nil, fmt.Errorf("pepper key %d too short (got %d bytes, need at least %d)", version, len(key), pepperMinKeyLength)
+		}
+
+		keys[version] = key
+	}
+
+	return keys, nil
+}
+
+// Hash peppers password with the current key and hashes it with inner
+func (h *PepperedHasher) Hash(password string) (string, error) {
+	hash, err := h.inner.Hash(h.pepper(h.keys[h.current], password))
+	if err != nil {
+		return "", err
+	}
+	return pepperPrefix + strconv.Itoa(h.current) + hash, nil
+}
+
+// Compare verifies password against a peppered hash, or an unpeppered one
+// from before peppering (or an import)
+func (h *PepperedHasher) Compare(hashedPassword, password string) error {
+	version, inner, ok := splitPepperedHash(hashedPassword)
+	if !ok {
+		return h.inner.Compare(hashedPassword, password)
+	}
+
+	key, ok := h.keys[version]
+	if !ok {
+		return ErrUnknownPepperVersion
+	}
+	return h.inner.Compare(inner, h.pepper(key, password))
+}
+
+// NeedsRehash reports whether hashedPassword is unpeppered, peppered with an
+// older key, or outdated underneath
+func (h *PepperedHasher) NeedsRehash(hashedPassword string) bool {
+	version, inner, ok := splitPepperedHash(hashedPassword)
+	if !ok || version != h.current {
+		return true
+	}
+	return h.inner.NeedsRehash(inner)
+}
+
+// Recognizes reports whether Compare can verify hashedPassword
+func (h *PepperedHasher) Recognizes(hashedPassword string) bool {
+	version, inner, ok := splitPepperedHash(hashedPassword)
+	if !ok {
+		return h.inner.Recognizes(hashedPassword)
+	}
+
+	_, known := h.keys[version]
+	return known && h.inner.Recognizes(inner)
+}
+
+// pepper returns the HMAC-SHA256 of password under key, base64 encoded
+// WHY: Encoded so the inner hasher gets printable input, and short enough
+// (43 bytes) that bcrypt's 72-byte limit never truncates it
+func (h *PepperedHasher) pepper(key []byte, password string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(password))
+	return base64.RawStdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// splitPepperedHash returns the pepper version and inner hash of a peppered hash
+func splitPepperedHash(hashedPassword string) (version int, inner string, ok bool) {
+	rest, ok := strings.CutPrefix(hashedPassword, pepperPrefix)
+	if !ok {
+		return 0, "", false
+	}
+
+	end := strings.IndexByte(rest, '$')
+	if end < 1 {
+		return 0, "", false
+	}
+
+	version, err := strconv.Atoi(rest[:end])
+	if err != nil {
+		return 0, "", false
+	}
+	return version, rest[end:], true
+}
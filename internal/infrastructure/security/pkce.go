@@ -0,0 +1,38 @@
+package security
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"regexp"
+)
+
+// PKCEMethodS256 is the only code_challenge_method we accept
+// SECURITY: "plain" sends the verifier itself as the challenge, so anyone
+// who sees the authorization request could redeem the code
+const PKCEMethodS256 = "S256"
+
+// pkceVerifierPattern is RFC 7636 section 4.1: 43-128 unreserved characters
+var pkceVerifierPattern = regexp.MustCompile(`^[A-Za-z0-9._~-]{43,128}$`)
+
+// pkceChallengePattern is a base64url SHA-256 digest without padding
+var pkceChallengePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{43}$`)
+
+// PKCEChallenge returns the S256 code challenge for verifier
+func PKCEChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// ValidPKCEChallenge reports whether challenge looks like an S256 challenge
+func ValidPKCEChallenge(challenge string) bool {
+	return pkceChallengePattern.MatchString(challenge)
+}
+
+// VerifyPKCE reports whether verifier matches the S256 challenge
+func VerifyPKCE(challenge, verifier string) bool {
+	if !pkceVerifierPattern.MatchString(verifier) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(PKCEChallenge(verifier)), []byte(challenge)) == 1
+}
@@ -0,0 +1,82 @@
+package security
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// SecretCipher encrypts small secrets (e.g. TOTP seeds) for storage
+type SecretCipher interface {
+	// Encrypt seals plaintext, binding it to associatedData (e.g. the owner's ID)
+	Encrypt(plaintext, associatedData string) (string, error)
+
+	// Decrypt opens a value from Encrypt; associatedData must match
+	Decrypt(ciphertext, associatedData string) (string, error)
+}
+
+// AESCipher is AES-256-GCM
+// WHY: Authenticated encryption - tampered or swapped ciphertexts fail to decrypt
+type AESCipher struct {
+	aead cipher.AEAD
+}
+
+// NewAESCipher creates a cipher from a 32-byte key
+func NewAESCipher(key []byte) (*AESCipher, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes (got %d)", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AESCipher{aead: aead}, nil
+}
+
+// NewAESCipherFromBase64 creates a cipher from a base64-encoded 32-byte key
+func NewAESCipherFromBase64(encodedKey string) (*AESCipher, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("encryption key is not valid base64: %w", err)
+	}
+	return NewAESCipher(key)
+}
+
+func (c *AESCipher) Encrypt(plaintext, associatedData string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	// Output is nonce || ciphertext
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), []byte(associatedData))
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *AESCipher) Decrypt(ciphertext, associatedData string) (string, error) {
+	sealed, err := base64.RawStdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("invalid ciphertext: %w", err)
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("invalid ciphertext: too short")
+	}
+
+	plaintext, err := c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(associatedData))
+	if err != nil {
+		return "", errors.New("failed to decrypt secret")
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,216 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported JWT signing algorithms
+const (
+	AlgorithmHS256 = "HS256" // Shared secret (default)
+	AlgorithmRS256 = "RS256" // RSA, 2048 bits or more
+	AlgorithmES256 = "ES256" // ECDSA P-256
+	AlgorithmEdDSA = "EdDSA" // Ed25519
+)
+
+// minRSAKeyBits is the smallest RSA key we accept
+const minRSAKeyBits = 2048
+
+// SigningKey is a key that signs and verifies JWTs
+// WHY: Asymmetric keys let other services verify tokens offline with the
+// public half, without ever holding a secret that can mint tokens
+type SigningKey struct {
+	id        string            // kid header
+	method    jwt.SigningMethod // Signing algorithm
+	signKey   any               // []byte (HMAC) or crypto.Signer
+	verifyKey any               // []byte (HMAC) or crypto.PublicKey
+}
+
+// NewHMACSigningKey creates a symmetric HS256 key
+// NOTE: id may be empty - HMAC keys are never published, so kid is optional
+func NewHMACSigningKey(id string, secret []byte) *SigningKey {
+	return &SigningKey{
+		id:        id,
+		method:    jwt.SigningMethodHS256,
+		signKey:   secret,
+		verifyKey: secret,
+	}
+}
+
+// ParseSigningKey creates an asymmetric key from a PEM-encoded private key
+// Accepts PKCS#8, PKCS#1 (RSA) and SEC 1 (EC) encodings.
+// If id is empty, the RFC 7638 JWK thumbprint is used as kid.
+func ParseSigningKey(id, algorithm string, pemData []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, errors.New("no PEM block found in private key")
+	}
+
+	privateKey, err := parsePrivateKey(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check key type matches algorithm
+	// SECURITY: Never let the key decide the algorithm
+	var method jwt.SigningMethod
+	switch algorithm {
+	case AlgorithmRS256:
+		rsaKey, ok := privateKey.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("%s requires an RSA private key", algorithm)
+		}
+		if rsaKey.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("RSA key too short (got %d bits, need at least %d)", rsaKey.N.BitLen(), minRSAKeyBits)
+		}
+		method = jwt.SigningMethodRS256
+
+	case AlgorithmES256:
+		ecKey, ok := privateKey.(*ecdsa.PrivateKey)
+		if !ok || ecKey.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("%s requires an ECDSA P-256 private key", algorithm)
+		}
+		method = jwt.SigningMethodES256
+
+	case AlgorithmEdDSA:
+		if _, ok := privateKey.(ed25519.PrivateKey); !ok {
+			return nil, fmt.Errorf("%s requires an Ed25519 private key", algorithm)
+		}
+		method = jwt.SigningMethodEdDSA
+
+	default:
+		return nil, fmt.Errorf("unsupported asymmetric signing algorithm: %s", algorithm)
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key cannot sign")
+	}
+
+	key := &SigningKey{
+		id:        id,
+		method:    method,
+		signKey:   signer,
+		verifyKey: signer.Public(),
+	}
+
+	if key.id == "" {
+		key.id, err = jwkThumbprint(key.jwk())
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute key ID: %w", err)
+		}
+	}
+
+	return key, nil
+}
+
+// LoadSigningKey reads a PEM private key file and parses it
+func LoadSigningKey(id, algorithm, path string) (*SigningKey, error) {
+	pemData, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+
+	return ParseSigningKey(id, algorithm, pemData)
+}
+
+// NewSigningKeyFromConfig creates the signing key described by cfg
+func NewSigningKeyFromConfig(cfg config.JWTConfig) (*SigningKey, error) {
+	if cfg.SigningAlgorithm == AlgorithmHS256 {
+		return NewHMACSigningKey(cfg.KeyID, []byte(cfg.SecretKey)), nil
+	}
+
+	return LoadSigningKey(cfg.KeyID, cfg.SigningAlgorithm, cfg.PrivateKeyPath)
+}
+
+// ID returns the key ID (kid header)
+func (k *SigningKey) ID() string {
+	return k.id
+}
+
+// Algorithm returns the JWT algorithm name (alg header)
+func (k *SigningKey) Algorithm() string {
+	return k.method.Alg()
+}
+
+// IsSymmetric reports whether the key is a shared secret
+func (k *SigningKey) IsSymmetric() bool {
+	_, ok := k.verifyKey.([]byte)
+	return ok
+}
+
+// PublicJWK returns the public half of the key as a JWK
+// Returns false for symmetric keys - secrets are never published
+func (k *SigningKey) PublicJWK() (JWK, bool) {
+	if k.IsSymmetric() {
+		return JWK{}, false
+	}
+
+	jwk := k.jwk()
+	jwk.Use = "sig"
+	jwk.Kid = k.id
+	jwk.Alg = k.Algorithm()
+	return jwk, true
+}
+
+// jwk encodes the public key members of the key (no metadata)
+func (k *SigningKey) jwk() JWK {
+	switch pub := k.verifyKey.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			N:   base64URL(pub.N.Bytes()),
+			E:   base64URL(bigEndianBytes(pub.E)),
+		}
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		return JWK{
+			Kty: "EC",
+			Crv: pub.Curve.Params().Name,
+			X:   base64URL(pub.X.FillBytes(make([]byte, size))),
+			Y:   base64URL(pub.Y.FillBytes(make([]byte, size))),
+		}
+	case ed25519.PublicKey:
+		return JWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64URL(pub),
+		}
+	default:
+		return JWK{}
+	}
+}
+
+// sign signs claims with this key and sets the kid header
+func (k *SigningKey) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(k.method, claims)
+	if k.id != "" {
+		token.Header["kid"] = k.id
+	}
+	return token.SignedString(k.signKey)
+}
+
+// parsePrivateKey decodes the supported private key encodings
+func parsePrivateKey(block *pem.Block) (any, error) {
+	switch block.Type {
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type: %s", block.Type)
+	}
+}
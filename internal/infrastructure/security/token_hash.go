@@ -0,0 +1,35 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// HashToken returns the hex-encoded SHA-256 digest of a token
+// WHY: Tokens are stored hashed so a database leak can't be replayed.
+// Tokens are high-entropy, so a fast hash is enough (no bcrypt needed).
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchesTokenHash reports whether token hashes to hash
+// SECURITY: Constant time - for secrets checked against a known record
+// (e.g. client secrets), where timing could leak the stored hash
+func MatchesTokenHash(hash, token string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashToken(token)), []byte(hash)) == 1
+}
+
+// GenerateOpaqueToken returns a random URL-safe token (256 bits)
+// WHY: For tokens looked up server-side (e.g. password reset) - nothing to
+// sign, just unguessable
+func GenerateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+)
+
+type AuditLogRepository interface {
+	// Append stores an event
+	// NOTE: There is deliberately no update or delete
+	Append(ctx context.Context, event *entity.AuditEvent) error
+}
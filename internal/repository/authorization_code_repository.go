@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+)
+
+type AuthorizationCodeRepository interface {
+	Create(ctx context.Context, code *entity.AuthorizationCode) error
+	FindByHash(ctx context.Context, codeHash string) (*entity.AuthorizationCode, error)
+
+	// MarkUsed atomically redeems a code and records the token family it produced
+	// Returns ErrTokenAlreadyUsed if it was redeemed first (single use)
+	MarkUsed(ctx context.Context, codeHash string, familyID string) error
+}
@@ -6,12 +6,23 @@ import (
 )
 
 var (
-	ErrUserNotFound        = errors.New("user not found")
-	ErrUserAlreadyExists   = errors.New("user already exists")
-	ErrDatabaseConnection  = errors.New("database connection error")
-	ErrDatabaseQuery       = errors.New("database query error")
-	ErrDatabaseTransaction = errors.New("database transaction error")
-	ErrInvalidID           = errors.New("invalid ID")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrUserAlreadyExists     = errors.New("user already exists")
+	ErrDatabaseConnection    = errors.New("database connection error")
+	ErrDatabaseQuery         = errors.New("database query error")
+	ErrDatabaseTransaction   = errors.New("database transaction error")
+	ErrInvalidID             = errors.New("invalid ID")
+	ErrTokenNotFound         = errors.New("token not found")
+	ErrTokenAlreadyUsed      = errors.New("token already used")
+	ErrMFACodeUsed           = errors.New("mfa code already used")
+	ErrPasskeyNotFound       = errors.New("passkey not found")
+	ErrPasskeyExists         = errors.New("passkey already registered")
+	ErrOrganizationNotFound  = errors.New("organization not found")
+	ErrOrganizationExists    = errors.New("organization already exists")
+	ErrInvitationNotFound    = errors.New("invitation not found")
+	ErrOAuthClientNotFound   = errors.New("oauth client not found")
+	ErrServiceClientNotFound = errors.New("service client not found")
+	ErrSessionNotFound       = errors.New("session not found")
 )
 
 type RepositoryError struct {
@@ -54,6 +65,88 @@ func NewUserAlreadyExistsError(op string, err error) *RepositoryError {
 	}
 }
 
+// NewTokenNotFoundError creates a token not found error
+func NewTokenNotFoundError(op string) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrTokenNotFound,
+	}
+}
+
+// NewTokenAlreadyUsedError creates an error for a token consumed twice
+func NewTokenAlreadyUsedError(op string) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrTokenAlreadyUsed,
+	}
+}
+
+// NewMFACodeUsedError creates an error for a TOTP step or recovery code consumed twice
+func NewMFACodeUsedError(op string) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrMFACodeUsed,
+	}
+}
+
+// NewPasskeyNotFoundError creates a passkey not found error
+func NewPasskeyNotFoundError(op string) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrPasskeyNotFound,
+	}
+}
+
+// NewPasskeyExistsError creates a duplicate credential ID error
+func NewPasskeyExistsError(op string, err error) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrPasskeyExists,
+		Err:  err,
+	}
+}
+
+// NewOrganizationNotFoundError creates an organization not found error
+func NewOrganizationNotFoundError(op string) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrOrganizationNotFound,
+	}
+}
+
+// NewOrganizationExistsError creates a duplicate tenant ID error
+func NewOrganizationExistsError(op string, err error) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrOrganizationExists,
+		Err:  err,
+	}
+}
+
+// NewInvitationNotFoundError creates an invitation not found error
+func NewInvitationNotFoundError(op string) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrInvitationNotFound,
+	}
+}
+
+// NewOAuthClientNotFoundError creates an OAuth client not found error
+func NewOAuthClientNotFoundError(op string) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrOAuthClientNotFound,
+	}
+}
+
+// NewServiceClientNotFoundError creates a service client not found error
+func NewServiceClientNotFoundError(op string) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrServiceClientNotFound,
+	}
+}
+
 // NewDatabaseConnectionError creates a connection error
 func NewDatabaseConnectionError(op string, err error) *RepositoryError {
 	return &RepositoryError{
@@ -90,3 +183,11 @@ func WrapRepositoryError(op string, baseType error, err error) *RepositoryError
 		Err:  err,
 	}
 }
+
+// NewSessionNotFoundError creates a session not found error
+func NewSessionNotFoundError(op string) *RepositoryError {
+	return &RepositoryError{
+		Op:   op,
+		Type: ErrSessionNotFound,
+	}
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *entity.Invitation) error
+	FindByID(ctx context.Context, id string) (*entity.Invitation, error)
+	FindByHash(ctx context.Context, tokenHash string) (*entity.Invitation, error)
+
+	// ListByTenant returns a tenant's invitations, newest first
+	ListByTenant(ctx context.Context, tenantID valueobject.TenantID) ([]*entity.Invitation, error)
+
+	// MarkAccepted atomically consumes a pending invitation
+	// Returns ErrTokenAlreadyUsed if it was accepted or revoked first (single use)
+	MarkAccepted(ctx context.Context, id string, userID valueobject.UserID) error
+
+	// Revoke cancels an invitation that hasn't been accepted
+	// Returns ErrTokenAlreadyUsed if it was accepted first
+	Revoke(ctx context.Context, id string) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+type LoginAttemptRepository interface {
+	// FindByKeys returns the counters that exist for keys (missing keys are skipped)
+	FindByKeys(ctx context.Context, keys []string) ([]*entity.LoginAttempt, error)
+
+	// RecordAttempt atomically increments the counter for key and returns it
+	// as it was before this attempt (nil if the counter is new)
+	// WHY: Counting and reading in one step - concurrent guesses can't all
+	// see the same count and slip past the limit together
+	RecordAttempt(ctx context.Context, key string, tenantID valueobject.TenantID, email valueobject.Email, expiresAt time.Time) (*entity.LoginAttempt, error)
+
+	// DeleteByKeys clears the given counters (after a successful login)
+	DeleteByKeys(ctx context.Context, keys []string) error
+
+	// DeleteByEmail clears every counter for an account in tenantID (admin unlock)
+	DeleteByEmail(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+type LoginCodeRepository interface {
+	Create(ctx context.Context, code *entity.LoginCode) error
+
+	// Consume atomically fetches and deletes a code (single use)
+	// Returns ErrTokenNotFound if it doesn't exist or was consumed first
+	Consume(ctx context.Context, codeHash string) (*entity.LoginCode, error)
+
+	// DeleteByUserID removes every outstanding login code for a user
+	// WHY: Only the most recently emailed code should work
+	DeleteByUserID(ctx context.Context, userID valueobject.UserID) error
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+)
+
+type OAuthClientRepository interface {
+	Create(ctx context.Context, client *entity.OAuthClient) error
+	FindByID(ctx context.Context, clientID string) (*entity.OAuthClient, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *entity.Organization) error
+	FindByID(ctx context.Context, id valueobject.TenantID) (*entity.Organization, error)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+type PasskeyRepository interface {
+	// Create stores a new passkey
+	// Returns ErrPasskeyExists if the credential ID is already registered
+	Create(ctx context.Context, passkey *entity.Passkey) error
+
+	FindByUserID(ctx context.Context, userID valueobject.UserID) ([]*entity.Passkey, error)
+
+	// Update saves the sign counter, backup state and last use
+	Update(ctx context.Context, passkey *entity.Passkey) error
+
+	// Delete removes one of a user's passkeys
+	// Returns ErrPasskeyNotFound if the user has no passkey with that ID
+	Delete(ctx context.Context, userID valueobject.UserID, credentialID []byte) error
+
+	// DeleteByUserID removes all of a user's passkeys (account deletion)
+	DeleteByUserID(ctx context.Context, userID valueobject.UserID) error
+}
+
+type PasskeyChallengeRepository interface {
+	Create(ctx context.Context, challenge *entity.PasskeyChallenge) error
+
+	// Consume atomically fetches and deletes a challenge (single use)
+	// Returns ErrTokenNotFound if it doesn't exist or was consumed first
+	Consume(ctx context.Context, idHash string) (*entity.PasskeyChallenge, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+type PasswordResetTokenRepository interface {
+	Create(ctx context.Context, token *entity.PasswordResetToken) error
+	FindByHash(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error)
+
+	// MarkUsed atomically consumes an unused token
+	// Returns ErrTokenAlreadyUsed if it was consumed first (single use)
+	MarkUsed(ctx context.Context, tokenHash string) error
+
+	// DeleteByUserID removes every outstanding reset token for a user
+	// WHY: Only the most recent reset link should work
+	DeleteByUserID(ctx context.Context, userID valueobject.UserID) error
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *entity.PersonalAccessToken) error
+
+	// FindByHash returns ErrTokenNotFound for unknown (or revoked) tokens
+	FindByHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error)
+
+	FindByUserID(ctx context.Context, userID valueobject.UserID) ([]*entity.PersonalAccessToken, error)
+
+	// Delete revokes one of a user's tokens
+	// Returns ErrTokenNotFound if the user has no token with that ID
+	Delete(ctx context.Context, userID valueobject.UserID, id string) error
+
+	// DeleteByUserID revokes all of a user's tokens (account deletion)
+	DeleteByUserID(ctx context.Context, userID valueobject.UserID) error
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *entity.RefreshToken) error
+	FindByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error)
+
+	// MarkUsed atomically flags an unused, unrevoked token as rotated
+	// WHY: Two concurrent refreshes with the same token must not both win.
+	// Returns ErrTokenAlreadyUsed if the token was consumed or revoked first.
+	MarkUsed(ctx context.Context, tokenHash, replacedBy string) error
+
+	RevokeFamily(ctx context.Context, familyID string) error
+
+	// RevokeAllForUser revokes every refresh token a user holds
+	// WHY: Credential changes (e.g. password reset) end all existing sessions
+	RevokeAllForUser(ctx context.Context, userID valueobject.UserID) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+)
+
+// RevokedTokenRepository is the JWT denylist
+type RevokedTokenRepository interface {
+	// Create adds a token to the denylist (idempotent)
+	Create(ctx context.Context, token *entity.RevokedToken) error
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
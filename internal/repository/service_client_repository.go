@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+)
+
+type ServiceClientRepository interface {
+	Create(ctx context.Context, client *entity.ServiceClient) error
+	FindByID(ctx context.Context, clientID string) (*entity.ServiceClient, error)
+	Update(ctx context.Context, client *entity.ServiceClient) error
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *entity.Session) error
+
+	// FindByID returns ErrSessionNotFound for unknown (or ended) sessions
+	FindByID(ctx context.Context, id string) (*entity.Session, error)
+
+	// FindByFamilyID returns ErrSessionNotFound if the refresh token family
+	// has no session (families from before sessions were recorded)
+	FindByFamilyID(ctx context.Context, familyID string) (*entity.Session, error)
+
+	// FindByUserID returns a user's sessions, most recently seen first
+	FindByUserID(ctx context.Context, userID valueobject.UserID) ([]*entity.Session, error)
+
+	Update(ctx context.Context, session *entity.Session) error
+
+	// Delete ends a session; deleting an unknown session is not an error
+	Delete(ctx context.Context, id string) error
+
+	DeleteByFamilyID(ctx context.Context, familyID string) error
+	DeleteByUserID(ctx context.Context, userID valueobject.UserID) error
+}
@@ -7,13 +7,21 @@ import (
 	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
 )
 
+// UserRepository stores user accounts
+// NOTE: Emails are unique per tenant, so email lookups and listings are
+// tenant-scoped; IDs are globally unique and FindByID is not
 type UserRepository interface {
 	Create(ctx context.Context, user *entity.User) error
 	FindByID(ctx context.Context, id valueobject.UserID) (*entity.User, error)
-	FindByEmail(ctx context.Context, email valueobject.Email) (*entity.User, error)
+	FindByEmail(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (*entity.User, error)
 	Update(ctx context.Context, user *entity.User) error
+	// UseTOTPStep stores step as the last used TOTP step if it is later than
+	// the stored one, else returns ErrMFACodeUsed
+	UseTOTPStep(ctx context.Context, id valueobject.UserID, step int64) error
+	// UseRecoveryCode removes an unused recovery code, else returns ErrMFACodeUsed
+	UseRecoveryCode(ctx context.Context, id valueobject.UserID, codeHash string) error
 	Delete(ctx context.Context, id valueobject.UserID) error
-	ExistsByEmail(ctx context.Context, email valueobject.Email) (bool, error)
-	List(ctx context.Context, offset, limit int) ([]*entity.User, error)
-	Count(ctx context.Context) (int64, error)
+	ExistsByEmail(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error)
+	List(ctx context.Context, tenantID valueobject.TenantID, offset, limit int) ([]*entity.User, error)
+	Count(ctx context.Context, tenantID valueobject.TenantID) (int64, error)
 }
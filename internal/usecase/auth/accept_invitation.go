@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// AcceptInvitationUseCase joins an organization from an invitation link
+// WHY: The only way to register when signup is invite-only
+type AcceptInvitationUseCase struct {
+	userRepo       repository.UserRepository
+	invitationRepo repository.InvitationRepository
+	passwordHasher security.PasswordHasher
+	tokenIssuer    *TokenIssuer
+	throttle       *LoginThrottle
+	auditLog       *AuditLog
+}
+
+// NewAcceptInvitationUseCase creates a new accept invitation use case
+func NewAcceptInvitationUseCase(
+	userRepo repository.UserRepository,
+	invitationRepo repository.InvitationRepository,
+	passwordHasher security.PasswordHasher,
+	tokenIssuer *TokenIssuer,
+	throttle *LoginThrottle,
+	auditLog *AuditLog,
+) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{
+		userRepo:       userRepo,
+		invitationRepo: invitationRepo,
+		passwordHasher: passwordHasher,
+		tokenIssuer:    tokenIssuer,
+		throttle:       throttle,
+		auditLog:       auditLog,
+	}
+}
+
+// Execute accepts the invitation, creating the account if the invited
+// email isn't registered in the tenant yet
+func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, req usecase.AcceptInvitationRequest) (*usecase.AcceptInvitationResponse, error) {
+	// Step 1: Look up invitation
+	invitation, err := uc.invitationRepo.FindByHash(ctx, security.HashToken(req.Token))
+	if err != nil {
+		if errors.Is(err, repository.ErrInvitationNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("invalid or expired invitation")
+		}
+		return nil, fmt.Errorf("failed to find invitation: %w", err)
+	}
+
+	// SECURITY: An invitation only works in the organization it was created for
+	if !invitation.CanBeAccepted() || !invitation.TenantID().Equals(usecase.TenantFromContext(ctx)) {
+		return nil, domainErrors.NewUnauthorizedError("invalid or expired invitation")
+	}
+
+	// Step 2: Link an existing account or create a new one
+	user, err := uc.userRepo.FindByEmail(ctx, invitation.TenantID(), invitation.Email())
+	switch {
+	case err == nil:
+		return uc.acceptExisting(ctx, invitation, user, req)
+	case errors.Is(err, repository.ErrUserNotFound):
+		return uc.acceptNew(ctx, invitation, req)
+	default:
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+}
+
+// acceptExisting grants the role to an account that already exists
+// SECURITY: Needs the account's password, so a leaked link alone can't
+// change someone's access
+func (uc *AcceptInvitationUseCase) acceptExisting(
+	ctx context.Context,
+	invitation *entity.Invitation,
+	user *entity.User,
+	req usecase.AcceptInvitationRequest,
+) (*usecase.AcceptInvitationResponse, error) {
+	// Step 3: Authenticate like a login (same lockout)
+	if err := uc.throttle.Reserve(ctx, user.Email(), req.IPAddress); err != nil {
+		return nil, err
+	}
+
+	if !user.CanLogin() {
+		return nil, domainErrors.NewForbiddenError("account is inactive")
+	}
+
+	if err := uc.passwordHasher.Compare(user.Password().Hash(), req.Password); err != nil {
+		return nil, domainErrors.NewUnauthorizedError("invalid credentials")
+	}
+
+	if err := uc.throttle.Reset(ctx, user.Email(), req.IPAddress); err != nil {
+		return nil, err
+	}
+
+	if user.PasswordResetRequired() {
+		return nil, domainErrors.NewForbiddenError("password reset required")
+	}
+
+	// Step 4: Consume invitation
+	// WHY: Atomic - the link can't be used twice
+	if err := uc.consume(ctx, invitation, user); err != nil {
+		return nil, err
+	}
+
+	// Step 5: Grant role and save
+	changed, err := user.GrantRole(invitation.Role())
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant role: %w", err)
+	}
+
+	if changed {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+
+	// Step 6: Record
+	if err := uc.record(ctx, invitation, user, false); err != nil {
+		return nil, err
+	}
+
+	// NOTE: No tokens - the user signs in as usual so MFA still applies
+	return &usecase.AcceptInvitationResponse{
+		UserID: user.ID().String(),
+		Email:  user.Email().String(),
+		Role:   invitation.Role(),
+	}, nil
+}
+
+// acceptNew creates the invited account
+func (uc *AcceptInvitationUseCase) acceptNew(
+	ctx context.Context,
+	invitation *entity.Invitation,
+	req usecase.AcceptInvitationRequest,
+) (*usecase.AcceptInvitationResponse, error) {
+	// Step 3: Validate password
+	// WHY: Before consuming - a rejected password must not burn the link
+	password, err := valueobject.NewPassword(req.Password)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError(err.Error(), "password")
+	}
+
+	hashedPassword, err := uc.passwordHasher.Hash(password.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user, err := entity.NewUser(invitation.TenantID(), invitation.Email(), valueobject.NewPasswordFromHash(hashedPassword))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	// WHY: The link was delivered to the inbox, which proves ownership
+	user.MarkEmailVerified()
+
+	if _, err := user.GrantRole(invitation.Role()); err != nil {
+		return nil, fmt.Errorf("failed to grant role: %w", err)
+	}
+
+	// Step 4: Consume invitation, then save the user
+	// WHY: Consuming first means two concurrent accepts can't both create an account
+	if err := uc.consume(ctx, invitation, user); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.Create(ctx, user); err != nil {
+		if errors.Is(err, repository.ErrUserAlreadyExists) {
+			// Race: registered between the lookup and the insert
+			return nil, domainErrors.NewConflictError("email already in use")
+		}
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	// Step 5: Record
+	if err := uc.record(ctx, invitation, user, true); err != nil {
+		return nil, err
+	}
+
+	// Step 6: Generate tokens (starts a new refresh token family)
+	tokens, err := uc.tokenIssuer.Issue(ctx, user, entity.NewTokenFamilyID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.AcceptInvitationResponse{
+		UserID:         user.ID().String(),
+		Email:          user.Email().String(),
+		Role:           invitation.Role(),
+		AccountCreated: true,
+		AccessToken:    tokens.AccessToken,
+		RefreshToken:   tokens.RefreshToken,
+	}, nil
+}
+
+// consume marks the invitation accepted by user
+func (uc *AcceptInvitationUseCase) consume(ctx context.Context, invitation *entity.Invitation, user *entity.User) error {
+	if err := uc.invitationRepo.MarkAccepted(ctx, invitation.ID(), user.ID()); err != nil {
+		if errors.Is(err, repository.ErrTokenAlreadyUsed) {
+			return domainErrors.NewUnauthorizedError("invalid or expired invitation")
+		}
+		return fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	return nil
+}
+
+// record writes the audit event, with the accepting user as the actor
+func (uc *AcceptInvitationUseCase) record(ctx context.Context, invitation *entity.Invitation, user *entity.User, created bool) error {
+	return uc.auditLog.Record(ctx, user.ID().String(), entity.AuditActionAcceptInvitation, user, map[string]string{
+		"invitation_id":   invitation.ID(),
+		"role":            invitation.Role(),
+		"account_created": strconv.FormatBool(created),
+	})
+}
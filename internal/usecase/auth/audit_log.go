@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// AuditLog records administrative actions
+// WHY: Admin operations are recorded after they succeed. If the record
+// can't be written the operation is reported as failed, so an unaudited
+// change gets noticed; reads return nothing unless they were recorded
+type AuditLog struct {
+	auditRepo repository.AuditLogRepository
+}
+
+// NewAuditLog creates a new audit log
+func NewAuditLog(auditRepo repository.AuditLogRepository) *AuditLog {
+	return &AuditLog{
+		auditRepo: auditRepo,
+	}
+}
+
+// Record stores one action by actorID (empty for the CLI) on target (nil for listings)
+// in the request's tenant
+func (a *AuditLog) Record(
+	ctx context.Context,
+	actorID string,
+	action entity.AuditAction,
+	target *entity.User,
+	details map[string]string,
+) error {
+	var targetID, targetEmail string
+	if target != nil {
+		targetID = target.ID().String()
+		targetEmail = target.Email().String()
+	}
+
+	event, err := entity.NewAuditEvent(usecase.TenantFromContext(ctx), actorID, action, targetID, targetEmail, details)
+	if err != nil {
+		return fmt.Errorf("failed to create audit event: %w", err)
+	}
+
+	if err := a.auditRepo.Append(ctx, event); err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+
+	return nil
+}
@@ -2,34 +2,285 @@ package auth
 
 import (
 	"context"
+	"time"
 
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
 	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
 	"github.com/Ruseigha/LabukaAuth/internal/repository"
 	"github.com/Ruseigha/LabukaAuth/internal/usecase"
 )
 
+// Config holds token lifetimes and auth policy
+type Config struct {
+	AccessTokenExpiry              time.Duration // Reported to OAuth clients as expires_in
+	RefreshTokenExpiry             time.Duration
+	RequireVerifiedEmail           bool          // Block login until the email is verified
+	EmailVerificationExpiry        time.Duration // Verification link lifetime
+	EmailVerificationURL           string        // Link target; token is appended as ?token=
+	PasswordResetExpiry            time.Duration // Reset link lifetime
+	PasswordResetURL               string        // Link target; token is appended as ?token=
+	LoginCodeExpiry                time.Duration // Passwordless code and magic link lifetime
+	MagicLinkURL                   string        // Link target; token is appended as ?token=
+	Lockout                        LockoutPolicy // Failed-login backoff and lockout
+	MFAIssuer                      string        // Account issuer shown in authenticator apps
+	MFAChallengeExpiry             time.Duration // Time allowed for the second login step
+	PasskeyChallengeExpiry         time.Duration // Time allowed to answer a WebAuthn challenge
+	InviteOnlySignup               bool          // Disable Signup; accounts come from invitations
+	InvitationExpiry               time.Duration // Invitation link lifetime
+	InvitationURL                  string        // Link target; token and tenant are appended as query parameters
+	PersonalAccessTokenMaxLifetime time.Duration // Longest expiry a personal access token may have
+	OAuthCodeExpiry                time.Duration // Authorization code lifetime
+	OIDCIssuer                     string        // OpenID Connect issuer URL ("" = no ID tokens)
+}
+
 // AuthService aggregates all auth use cases
 type AuthService struct {
 	signupUC        *SignupUseCase
 	loginUC         *LoginUseCase
 	validateTokenUC *ValidateTokenUseCase
 	refreshTokenUC  *RefreshTokenUseCase
+	logoutUC        *LogoutUseCase
+	revokeTokenUC   *RevokeTokenUseCase
+
+	sendVerificationUC *SendVerificationUseCase
+	verifyEmailUC      *VerifyEmailUseCase
+
+	requestPasswordResetUC *RequestPasswordResetUseCase
+	resetPasswordUC        *ResetPasswordUseCase
+
+	changePasswordUC *ChangePasswordUseCase
+	changeEmailUC    *ChangeEmailUseCase
+
+	verifyMFAUC  *VerifyMFAUseCase
+	enrollMFAUC  *EnrollMFAUseCase
+	confirmMFAUC *ConfirmMFAUseCase
+	disableMFAUC *DisableMFAUseCase
+
+	beginPasskeyRegistrationUC  *BeginPasskeyRegistrationUseCase
+	finishPasskeyRegistrationUC *FinishPasskeyRegistrationUseCase
+	beginPasskeyLoginUC         *BeginPasskeyLoginUseCase
+	finishPasskeyLoginUC        *FinishPasskeyLoginUseCase
+	listPasskeysUC              *ListPasskeysUseCase
+	deletePasskeyUC             *DeletePasskeyUseCase
+
+	createPersonalAccessTokenUC *CreatePersonalAccessTokenUseCase
+	listPersonalAccessTokensUC  *ListPersonalAccessTokensUseCase
+	revokePersonalAccessTokenUC *RevokePersonalAccessTokenUseCase
+
+	listSessionsUC        *ListSessionsUseCase
+	revokeSessionUC       *RevokeSessionUseCase
+	revokeOtherSessionsUC *RevokeOtherSessionsUseCase
+
+	requestLoginCodeUC *RequestLoginCodeUseCase
+	requestMagicLinkUC *RequestMagicLinkUseCase
+	verifyLoginCodeUC  *VerifyLoginCodeUseCase
+
+	getUserAccessUC    *GetUserAccessUseCase
+	updateUserAccessUC *UpdateUserAccessUseCase
+
+	listUsersUC          *ListUsersUseCase
+	getUserUC            *GetUserUseCase
+	setUserActiveUC      *SetUserActiveUseCase
+	forcePasswordResetUC *ForcePasswordResetUseCase
+	revokeUserSessionsUC *RevokeUserSessionsUseCase
+	unlockAccountUC      *UnlockAccountUseCase
+	deleteUserUC         *DeleteUserUseCase
+
+	createInvitationUC *CreateInvitationUseCase
+	listInvitationsUC  *ListInvitationsUseCase
+	revokeInvitationUC *RevokeInvitationUseCase
+	acceptInvitationUC *AcceptInvitationUseCase
+
+	checkAuthorizationUC *CheckAuthorizationUseCase
+	authorizeUC          *AuthorizeUseCase
+	oauthTokenUC         *OAuthTokenUseCase
+	userInfoUC           *UserInfoUseCase
+
+	backgroundMailer *mail.BackgroundMailer
 }
 
 // NewAuthService creates auth service with all use cases
 func NewAuthService(
 	userRepo repository.UserRepository,
+	orgRepo repository.OrganizationRepository,
 	passwordHasher security.PasswordHasher,
 	jwtGenerator security.JWTGenerator,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	revokedTokenRepo repository.RevokedTokenRepository,
+	passwordResetTokenRepo repository.PasswordResetTokenRepository,
+	loginAttemptRepo repository.LoginAttemptRepository,
+	passkeyRepo repository.PasskeyRepository,
+	passkeyChallengeRepo repository.PasskeyChallengeRepository,
+	personalAccessTokenRepo repository.PersonalAccessTokenRepository,
+	sessionRepo repository.SessionRepository,
+	loginCodeRepo repository.LoginCodeRepository,
+	auditLogRepo repository.AuditLogRepository,
+	invitationRepo repository.InvitationRepository,
+	oauthClientRepo repository.OAuthClientRepository,
+	serviceClientRepo repository.ServiceClientRepository,
+	authorizationCodeRepo repository.AuthorizationCodeRepository,
+	mailer mail.Mailer,
+	secretCipher security.SecretCipher,
+	passkeyVerifier security.PasskeyVerifier,
+	cfg Config,
 ) *AuthService {
+	tokenIssuer := NewTokenIssuer(jwtGenerator, refreshTokenRepo, sessionRepo, cfg.RefreshTokenExpiry)
+	sessions := NewSessionManager(refreshTokenRepo, sessionRepo)
+	throttle := NewLoginThrottle(loginAttemptRepo, cfg.Lockout)
+	backgroundMailer := mail.NewBackgroundMailer(mailer)
+	revokeTokenUC := NewRevokeTokenUseCase(jwtGenerator, revokedTokenRepo, refreshTokenRepo, sessions)
+	sendVerificationUC := NewSendVerificationUseCase(
+		userRepo,
+		jwtGenerator,
+		mailer,
+		cfg.EmailVerificationExpiry,
+		cfg.EmailVerificationURL,
+	)
+	requestPasswordResetUC := NewRequestPasswordResetUseCase(
+		userRepo,
+		passwordResetTokenRepo,
+		backgroundMailer,
+		cfg.PasswordResetExpiry,
+		cfg.PasswordResetURL,
+	)
+	auditLog := NewAuditLog(auditLogRepo)
+	refreshTokenUC := NewRefreshTokenUseCase(userRepo, jwtGenerator, refreshTokenRepo, tokenIssuer)
+	checkAuthorizationUC := NewCheckAuthorizationUseCase(oauthClientRepo)
+
 	return &AuthService{
-		signupUC:        NewSignupUseCase(userRepo, passwordHasher, jwtGenerator),
-		loginUC:         NewLoginUseCase(userRepo, passwordHasher, jwtGenerator),
-		validateTokenUC: NewValidateTokenUseCase(jwtGenerator, userRepo),
-		refreshTokenUC:  NewRefreshTokenUseCase(userRepo, jwtGenerator),
+		signupUC: NewSignupUseCase(userRepo, orgRepo, passwordHasher, tokenIssuer, sendVerificationUC, cfg.RequireVerifiedEmail, cfg.InviteOnlySignup),
+		loginUC: NewLoginUseCase(
+			userRepo,
+			passwordHasher,
+			jwtGenerator,
+			tokenIssuer,
+			throttle,
+			cfg.RequireVerifiedEmail,
+			cfg.MFAChallengeExpiry,
+		),
+		validateTokenUC: NewValidateTokenUseCase(jwtGenerator, userRepo, serviceClientRepo, revokedTokenRepo, personalAccessTokenRepo, sessionRepo),
+		refreshTokenUC:  refreshTokenUC,
+		logoutUC:        NewLogoutUseCase(jwtGenerator, revokeTokenUC),
+		revokeTokenUC:   revokeTokenUC,
+
+		sendVerificationUC: sendVerificationUC,
+		verifyEmailUC:      NewVerifyEmailUseCase(userRepo, jwtGenerator, sessions),
+
+		requestPasswordResetUC: requestPasswordResetUC,
+		resetPasswordUC:        NewResetPasswordUseCase(userRepo, passwordHasher, passwordResetTokenRepo, sessions),
+
+		changePasswordUC: NewChangePasswordUseCase(userRepo, passwordHasher, throttle, sessions, tokenIssuer),
+		changeEmailUC:    NewChangeEmailUseCase(userRepo, passwordHasher, throttle, sendVerificationUC),
+
+		verifyMFAUC:  NewVerifyMFAUseCase(userRepo, jwtGenerator, secretCipher, tokenIssuer, throttle),
+		enrollMFAUC:  NewEnrollMFAUseCase(userRepo, passwordHasher, throttle, secretCipher, cfg.MFAIssuer),
+		confirmMFAUC: NewConfirmMFAUseCase(userRepo, secretCipher),
+		disableMFAUC: NewDisableMFAUseCase(userRepo, passwordHasher, throttle, secretCipher),
+
+		beginPasskeyRegistrationUC: NewBeginPasskeyRegistrationUseCase(
+			userRepo,
+			passwordHasher,
+			throttle,
+			passkeyRepo,
+			passkeyChallengeRepo,
+			passkeyVerifier,
+			cfg.PasskeyChallengeExpiry,
+		),
+		finishPasskeyRegistrationUC: NewFinishPasskeyRegistrationUseCase(userRepo, passkeyRepo, passkeyChallengeRepo, passkeyVerifier),
+		beginPasskeyLoginUC:         NewBeginPasskeyLoginUseCase(passkeyChallengeRepo, passkeyVerifier, cfg.PasskeyChallengeExpiry),
+		finishPasskeyLoginUC: NewFinishPasskeyLoginUseCase(
+			userRepo,
+			passkeyRepo,
+			passkeyChallengeRepo,
+			passkeyVerifier,
+			tokenIssuer,
+			cfg.RequireVerifiedEmail,
+		),
+		listPasskeysUC:  NewListPasskeysUseCase(userRepo, passkeyRepo),
+		deletePasskeyUC: NewDeletePasskeyUseCase(userRepo, passkeyRepo),
+
+		createPersonalAccessTokenUC: NewCreatePersonalAccessTokenUseCase(
+			userRepo,
+			passwordHasher,
+			throttle,
+			personalAccessTokenRepo,
+			cfg.PersonalAccessTokenMaxLifetime,
+		),
+		listPersonalAccessTokensUC:  NewListPersonalAccessTokensUseCase(userRepo, personalAccessTokenRepo),
+		revokePersonalAccessTokenUC: NewRevokePersonalAccessTokenUseCase(userRepo, personalAccessTokenRepo),
+
+		listSessionsUC:        NewListSessionsUseCase(userRepo, sessionRepo),
+		revokeSessionUC:       NewRevokeSessionUseCase(userRepo, sessionRepo, sessions),
+		revokeOtherSessionsUC: NewRevokeOtherSessionsUseCase(userRepo, sessionRepo, sessions),
+
+		requestLoginCodeUC: NewRequestLoginCodeUseCase(userRepo, loginCodeRepo, backgroundMailer, cfg.LoginCodeExpiry),
+		requestMagicLinkUC: NewRequestMagicLinkUseCase(userRepo, loginCodeRepo, backgroundMailer, cfg.LoginCodeExpiry, cfg.MagicLinkURL),
+		verifyLoginCodeUC: NewVerifyLoginCodeUseCase(
+			userRepo,
+			loginCodeRepo,
+			jwtGenerator,
+			tokenIssuer,
+			throttle,
+			cfg.MFAChallengeExpiry,
+		),
+
+		getUserAccessUC:    NewGetUserAccessUseCase(userRepo),
+		updateUserAccessUC: NewUpdateUserAccessUseCase(userRepo, auditLog),
+
+		listUsersUC:          NewListUsersUseCase(userRepo, auditLog),
+		getUserUC:            NewGetUserUseCase(userRepo, auditLog),
+		setUserActiveUC:      NewSetUserActiveUseCase(userRepo, sessions, auditLog),
+		forcePasswordResetUC: NewForcePasswordResetUseCase(userRepo, sessions, requestPasswordResetUC, auditLog),
+		revokeUserSessionsUC: NewRevokeUserSessionsUseCase(userRepo, sessions, auditLog),
+		unlockAccountUC:      NewUnlockAccountUseCase(userRepo, loginAttemptRepo, auditLog),
+		deleteUserUC: NewDeleteUserUseCase(
+			userRepo,
+			sessions,
+			passwordResetTokenRepo,
+			loginCodeRepo,
+			passkeyRepo,
+			personalAccessTokenRepo,
+			loginAttemptRepo,
+			auditLog,
+		),
+
+		createInvitationUC: NewCreateInvitationUseCase(
+			orgRepo,
+			invitationRepo,
+			mailer,
+			auditLog,
+			cfg.InvitationExpiry,
+			cfg.InvitationURL,
+		),
+		listInvitationsUC:  NewListInvitationsUseCase(invitationRepo, auditLog),
+		revokeInvitationUC: NewRevokeInvitationUseCase(invitationRepo, auditLog),
+		acceptInvitationUC: NewAcceptInvitationUseCase(userRepo, invitationRepo, passwordHasher, tokenIssuer, throttle, auditLog),
+
+		checkAuthorizationUC: checkAuthorizationUC,
+		authorizeUC:          NewAuthorizeUseCase(checkAuthorizationUC, userRepo, authorizationCodeRepo, cfg.OAuthCodeExpiry),
+		oauthTokenUC: NewOAuthTokenUseCase(
+			oauthClientRepo,
+			authorizationCodeRepo,
+			userRepo,
+			tokenIssuer,
+			refreshTokenUC,
+			NewClientCredentialsUseCase(serviceClientRepo, jwtGenerator, cfg.AccessTokenExpiry),
+			jwtGenerator,
+			cfg.AccessTokenExpiry,
+			cfg.OIDCIssuer,
+		),
+		userInfoUC: NewUserInfoUseCase(jwtGenerator, userRepo, revokedTokenRepo),
+
+		backgroundMailer: backgroundMailer,
 	}
 }
 
+// WaitForMail blocks until email still being sent in the background is done
+// WHY: Called at shutdown so reset links and sign-in codes aren't dropped
+func (s *AuthService) WaitForMail() {
+	s.backgroundMailer.Wait()
+}
+
 // Signup registers a new user
 func (s *AuthService) Signup(ctx context.Context, req usecase.SignupRequest) (*usecase.SignupResponse, error) {
 	return s.signupUC.Execute(ctx, req)
@@ -49,3 +300,243 @@ func (s *AuthService) ValidateToken(ctx context.Context, token string) (*usecase
 func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (*usecase.RefreshResponse, error) {
 	return s.refreshTokenUC.Execute(ctx, refreshToken)
 }
+
+// Logout revokes the caller's access token and optional refresh token
+func (s *AuthService) Logout(ctx context.Context, req usecase.LogoutRequest) error {
+	return s.logoutUC.Execute(ctx, req)
+}
+
+// RevokeToken revokes a single access or refresh token
+func (s *AuthService) RevokeToken(ctx context.Context, token string) error {
+	return s.revokeTokenUC.Execute(ctx, token)
+}
+
+// SendVerification (re)sends the email verification link
+func (s *AuthService) SendVerification(ctx context.Context, email string) error {
+	return s.sendVerificationUC.Execute(ctx, email)
+}
+
+// VerifyEmail verifies an email address from a verification token
+func (s *AuthService) VerifyEmail(ctx context.Context, token string) error {
+	return s.verifyEmailUC.Execute(ctx, token)
+}
+
+// RequestPasswordReset emails a password reset link
+func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) error {
+	return s.requestPasswordResetUC.Execute(ctx, email)
+}
+
+// ResetPassword sets a new password from a reset token
+func (s *AuthService) ResetPassword(ctx context.Context, req usecase.ResetPasswordRequest) error {
+	return s.resetPasswordUC.Execute(ctx, req)
+}
+
+// ChangePassword changes an authenticated user's password
+func (s *AuthService) ChangePassword(ctx context.Context, req usecase.ChangePasswordRequest) (*usecase.ChangePasswordResponse, error) {
+	return s.changePasswordUC.Execute(ctx, req)
+}
+
+// ChangeEmail changes an authenticated user's email address
+func (s *AuthService) ChangeEmail(ctx context.Context, req usecase.ChangeEmailRequest) (*usecase.ChangeEmailResponse, error) {
+	return s.changeEmailUC.Execute(ctx, req)
+}
+
+// VerifyMFA completes a login with a second factor
+func (s *AuthService) VerifyMFA(ctx context.Context, req usecase.VerifyMFARequest) (*usecase.LoginResponse, error) {
+	return s.verifyMFAUC.Execute(ctx, req)
+}
+
+// EnrollMFA starts TOTP enrollment
+func (s *AuthService) EnrollMFA(ctx context.Context, req usecase.EnrollMFARequest) (*usecase.EnrollMFAResponse, error) {
+	return s.enrollMFAUC.Execute(ctx, req)
+}
+
+// ConfirmMFA enables MFA once the authenticator app is set up
+func (s *AuthService) ConfirmMFA(ctx context.Context, req usecase.ConfirmMFARequest) (*usecase.ConfirmMFAResponse, error) {
+	return s.confirmMFAUC.Execute(ctx, req)
+}
+
+// DisableMFA turns off MFA
+func (s *AuthService) DisableMFA(ctx context.Context, req usecase.DisableMFARequest) error {
+	return s.disableMFAUC.Execute(ctx, req)
+}
+
+// BeginPasskeyRegistration starts adding a passkey to an account
+func (s *AuthService) BeginPasskeyRegistration(ctx context.Context, req usecase.BeginPasskeyRegistrationRequest) (*usecase.PasskeyChallengeResponse, error) {
+	return s.beginPasskeyRegistrationUC.Execute(ctx, req)
+}
+
+// FinishPasskeyRegistration stores the passkey created by the browser
+func (s *AuthService) FinishPasskeyRegistration(ctx context.Context, req usecase.FinishPasskeyRegistrationRequest) (*usecase.PasskeyInfo, error) {
+	return s.finishPasskeyRegistrationUC.Execute(ctx, req)
+}
+
+// BeginPasskeyLogin starts a passwordless sign-in
+func (s *AuthService) BeginPasskeyLogin(ctx context.Context) (*usecase.PasskeyChallengeResponse, error) {
+	return s.beginPasskeyLoginUC.Execute(ctx)
+}
+
+// FinishPasskeyLogin signs a user in with a passkey assertion
+func (s *AuthService) FinishPasskeyLogin(ctx context.Context, req usecase.FinishPasskeyLoginRequest) (*usecase.LoginResponse, error) {
+	return s.finishPasskeyLoginUC.Execute(ctx, req)
+}
+
+// ListPasskeys lists an authenticated user's passkeys
+func (s *AuthService) ListPasskeys(ctx context.Context, userID string) ([]usecase.PasskeyInfo, error) {
+	return s.listPasskeysUC.Execute(ctx, userID)
+}
+
+// DeletePasskey removes one of an authenticated user's passkeys
+func (s *AuthService) DeletePasskey(ctx context.Context, req usecase.DeletePasskeyRequest) error {
+	return s.deletePasskeyUC.Execute(ctx, req)
+}
+
+// CreatePersonalAccessToken creates a personal access token for an authenticated user
+func (s *AuthService) CreatePersonalAccessToken(ctx context.Context, req usecase.CreatePersonalAccessTokenRequest) (*usecase.CreatePersonalAccessTokenResponse, error) {
+	return s.createPersonalAccessTokenUC.Execute(ctx, req)
+}
+
+// ListPersonalAccessTokens lists an authenticated user's personal access tokens
+func (s *AuthService) ListPersonalAccessTokens(ctx context.Context, userID string) ([]usecase.PersonalAccessTokenInfo, error) {
+	return s.listPersonalAccessTokensUC.Execute(ctx, userID)
+}
+
+// RevokePersonalAccessToken revokes one of an authenticated user's personal access tokens
+func (s *AuthService) RevokePersonalAccessToken(ctx context.Context, req usecase.RevokePersonalAccessTokenRequest) error {
+	return s.revokePersonalAccessTokenUC.Execute(ctx, req)
+}
+
+// ListSessions lists the devices an authenticated user is signed in on
+func (s *AuthService) ListSessions(ctx context.Context, req usecase.ListSessionsRequest) ([]usecase.SessionInfo, error) {
+	return s.listSessionsUC.Execute(ctx, req)
+}
+
+// RevokeSession signs one of an authenticated user's devices out
+func (s *AuthService) RevokeSession(ctx context.Context, req usecase.RevokeSessionRequest) error {
+	return s.revokeSessionUC.Execute(ctx, req)
+}
+
+// RevokeOtherSessions signs an authenticated user out everywhere but the current device
+func (s *AuthService) RevokeOtherSessions(ctx context.Context, req usecase.ListSessionsRequest) error {
+	return s.revokeOtherSessionsUC.Execute(ctx, req)
+}
+
+// RequestLoginCode emails a one-time login code
+func (s *AuthService) RequestLoginCode(ctx context.Context, email string) error {
+	return s.requestLoginCodeUC.Execute(ctx, email)
+}
+
+// RequestMagicLink emails a one-time sign-in link
+func (s *AuthService) RequestMagicLink(ctx context.Context, email string) error {
+	return s.requestMagicLinkUC.Execute(ctx, email)
+}
+
+// VerifyLoginCode logs a user in with an emailed code or magic link
+func (s *AuthService) VerifyLoginCode(ctx context.Context, req usecase.VerifyLoginCodeRequest) (*usecase.LoginResponse, error) {
+	return s.verifyLoginCodeUC.Execute(ctx, req)
+}
+
+// GetUserAccess returns a user's roles and permissions
+func (s *AuthService) GetUserAccess(ctx context.Context, userID string) (*usecase.UserAccess, error) {
+	return s.getUserAccessUC.Execute(ctx, userID)
+}
+
+// GrantRole gives a user a role
+func (s *AuthService) GrantRole(ctx context.Context, req usecase.UserAccessRequest) (*usecase.UserAccess, error) {
+	return s.updateUserAccessUC.Execute(ctx, req, GrantRole)
+}
+
+// RevokeRole takes a role away from a user
+func (s *AuthService) RevokeRole(ctx context.Context, req usecase.UserAccessRequest) (*usecase.UserAccess, error) {
+	return s.updateUserAccessUC.Execute(ctx, req, RevokeRole)
+}
+
+// GrantPermission gives a user a permission
+func (s *AuthService) GrantPermission(ctx context.Context, req usecase.UserAccessRequest) (*usecase.UserAccess, error) {
+	return s.updateUserAccessUC.Execute(ctx, req, GrantPermission)
+}
+
+// RevokePermission takes a permission away from a user
+func (s *AuthService) RevokePermission(ctx context.Context, req usecase.UserAccessRequest) (*usecase.UserAccess, error) {
+	return s.updateUserAccessUC.Execute(ctx, req, RevokePermission)
+}
+
+// ListUsers returns one page of users
+func (s *AuthService) ListUsers(ctx context.Context, req usecase.ListUsersRequest) (*usecase.UserList, error) {
+	return s.listUsersUC.Execute(ctx, req)
+}
+
+// GetUser looks up a user by ID or email
+func (s *AuthService) GetUser(ctx context.Context, req usecase.GetUserRequest) (*usecase.UserDetails, error) {
+	return s.getUserUC.Execute(ctx, req)
+}
+
+// ActivateUser lets a deactivated user log in again
+func (s *AuthService) ActivateUser(ctx context.Context, req usecase.AdminUserRequest) (*usecase.UserDetails, error) {
+	return s.setUserActiveUC.Execute(ctx, req, true)
+}
+
+// DeactivateUser blocks a user and ends their sessions
+func (s *AuthService) DeactivateUser(ctx context.Context, req usecase.AdminUserRequest) (*usecase.UserDetails, error) {
+	return s.setUserActiveUC.Execute(ctx, req, false)
+}
+
+// ForcePasswordReset makes a user choose a new password
+func (s *AuthService) ForcePasswordReset(ctx context.Context, req usecase.AdminUserRequest) error {
+	return s.forcePasswordResetUC.Execute(ctx, req)
+}
+
+// RevokeUserSessions signs a user out of every device
+func (s *AuthService) RevokeUserSessions(ctx context.Context, req usecase.AdminUserRequest) error {
+	return s.revokeUserSessionsUC.Execute(ctx, req)
+}
+
+// UnlockAccount lifts a user's login backoff or lockout
+func (s *AuthService) UnlockAccount(ctx context.Context, req usecase.AdminUserRequest) error {
+	return s.unlockAccountUC.Execute(ctx, req)
+}
+
+// DeleteUser permanently removes a user
+func (s *AuthService) DeleteUser(ctx context.Context, req usecase.AdminUserRequest) error {
+	return s.deleteUserUC.Execute(ctx, req)
+}
+
+// CreateInvitation invites an email address into the caller's organization
+func (s *AuthService) CreateInvitation(ctx context.Context, req usecase.CreateInvitationRequest) (*usecase.InvitationDetails, error) {
+	return s.createInvitationUC.Execute(ctx, req)
+}
+
+// ListInvitations lists the caller's organization's invitations
+func (s *AuthService) ListInvitations(ctx context.Context, actorID string) ([]usecase.InvitationDetails, error) {
+	return s.listInvitationsUC.Execute(ctx, actorID)
+}
+
+// RevokeInvitation cancels a pending invitation
+func (s *AuthService) RevokeInvitation(ctx context.Context, req usecase.RevokeInvitationRequest) (*usecase.InvitationDetails, error) {
+	return s.revokeInvitationUC.Execute(ctx, req)
+}
+
+// AcceptInvitation joins an organization from an invitation link
+func (s *AuthService) AcceptInvitation(ctx context.Context, req usecase.AcceptInvitationRequest) (*usecase.AcceptInvitationResponse, error) {
+	return s.acceptInvitationUC.Execute(ctx, req)
+}
+
+// CheckAuthorization validates an OAuth authorization request for the consent screen
+func (s *AuthService) CheckAuthorization(ctx context.Context, req usecase.AuthorizeRequest) (*usecase.AuthorizationDetails, error) {
+	return s.checkAuthorizationUC.Execute(ctx, req)
+}
+
+// Authorize records the user's consent decision for an OAuth client
+func (s *AuthService) Authorize(ctx context.Context, req usecase.ConsentRequest) (*usecase.AuthorizeResponse, error) {
+	return s.authorizeUC.Execute(ctx, req)
+}
+
+// OAuthToken exchanges an authorization code or refresh token at the token endpoint
+func (s *AuthService) OAuthToken(ctx context.Context, req usecase.OAuthTokenRequest) (*usecase.OAuthTokenResponse, error) {
+	return s.oauthTokenUC.Execute(ctx, req)
+}
+
+// UserInfo returns the OpenID Connect claims an access token may read
+func (s *AuthService) UserInfo(ctx context.Context, token string) (*usecase.UserInfo, error) {
+	return s.userInfoUC.Execute(ctx, token)
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// reauthenticate loads the caller's account and checks their current password
+// WHY: A stolen access token alone must not be enough to change credentials
+// SECURITY: Wrong passwords count against the same lockout as logins, so
+// the token can't be used to guess the password without limit
+func reauthenticate(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	passwordHasher security.PasswordHasher,
+	throttle *LoginThrottle,
+	userID string,
+	currentPassword string,
+) (*entity.User, error) {
+	user, err := checkCurrentPassword(ctx, userRepo, passwordHasher, throttle, userID, currentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := throttle.Reset(ctx, user.Email(), usecase.ClientInfoFromContext(ctx).IPAddress); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// checkCurrentPassword is reauthenticate without clearing the failure counters
+// WHY: For flows that check a second factor next - the right password must
+// not wipe out the failed codes before it
+func checkCurrentPassword(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	passwordHasher security.PasswordHasher,
+	throttle *LoginThrottle,
+	userID string,
+	currentPassword string,
+) (*entity.User, error) {
+	user, err := loadActiveUser(ctx, userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := throttle.Reserve(ctx, user.Email(), usecase.ClientInfoFromContext(ctx).IPAddress); err != nil {
+		return nil, err
+	}
+
+	if err := passwordHasher.Compare(user.Password().Hash(), currentPassword); err != nil {
+		return nil, domainErrors.NewUnauthorizedError("current password is incorrect")
+	}
+
+	return user, nil
+}
+
+// loadActiveUser loads the caller's account from a validated token's user ID
+func loadActiveUser(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	userID string,
+) (*entity.User, error) {
+	id, err := valueobject.NewUserIDFromString(userID)
+	if err != nil {
+		return nil, domainErrors.NewUnauthorizedError("invalid user")
+	}
+
+	user, err := userRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("invalid user")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if !user.CanLogin() {
+		return nil, domainErrors.NewForbiddenError("account is inactive")
+	}
+
+	return user, nil
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// AuthorizeUseCase records the user's consent and issues an authorization code
+type AuthorizeUseCase struct {
+	checkAuthorizationUC *CheckAuthorizationUseCase
+	userRepo             repository.UserRepository
+	codeRepo             repository.AuthorizationCodeRepository
+	codeExpiry           time.Duration
+}
+
+// NewAuthorizeUseCase creates a new authorize use case
+func NewAuthorizeUseCase(
+	checkAuthorizationUC *CheckAuthorizationUseCase,
+	userRepo repository.UserRepository,
+	codeRepo repository.AuthorizationCodeRepository,
+	codeExpiry time.Duration,
+) *AuthorizeUseCase {
+	return &AuthorizeUseCase{
+		checkAuthorizationUC: checkAuthorizationUC,
+		userRepo:             userRepo,
+		codeRepo:             codeRepo,
+		codeExpiry:           codeExpiry,
+	}
+}
+
+// Execute answers an authorization request on the signed-in user's behalf
+func (uc *AuthorizeUseCase) Execute(ctx context.Context, req usecase.ConsentRequest) (*usecase.AuthorizeResponse, error) {
+	// Step 1: Validate the request again
+	// SECURITY: The consent screen posts the query back; never trust it
+	client, redirectURI, scopes, err := uc.checkAuthorizationUC.validate(ctx, req.AuthorizeRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Handle denial
+	if !req.Approve {
+		return &usecase.AuthorizeResponse{
+			RedirectURI: oauthRedirect(redirectURI, url.Values{
+				"error":             {domainErrors.OAuthAccessDenied},
+				"error_description": {"the user denied the request"},
+			}, req.State),
+		}, nil
+	}
+
+	// Step 3: Load the user
+	userID, err := valueobject.NewUserIDFromString(req.UserID)
+	if err != nil {
+		return nil, domainErrors.NewUnauthorizedError("invalid user ID")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("user not found")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if !user.CanLogin() {
+		return nil, domainErrors.NewForbiddenError("account is inactive")
+	}
+
+	// Step 4: Check the client serves the user's organization
+	// SECURITY: A client registered in one tenant must not get another's users
+	if !user.TenantID().Equals(client.TenantID()) {
+		return nil, domainErrors.NewForbiddenError("client belongs to another organization")
+	}
+
+	// Step 5: Create and store the code (hashed)
+	rawCode, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate authorization code: %w", err)
+	}
+
+	code, err := entity.NewAuthorizationCode(
+		security.HashToken(rawCode),
+		client.ID(),
+		user.ID(),
+		user.TenantID(),
+		redirectURI,
+		req.RedirectURI != "",
+		scopes,
+		req.CodeChallenge,
+		req.Nonce,
+		req.AuthTime,
+		time.Now().Add(uc.codeExpiry),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authorization code: %w", err)
+	}
+
+	if err := uc.codeRepo.Create(ctx, code); err != nil {
+		return nil, fmt.Errorf("failed to store authorization code: %w", err)
+	}
+
+	// Step 6: Send the user back to the client with the code
+	return &usecase.AuthorizeResponse{
+		RedirectURI: oauthRedirect(redirectURI, url.Values{"code": {rawCode}}, req.State),
+	}, nil
+}
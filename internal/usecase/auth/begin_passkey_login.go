@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// BeginPasskeyLoginUseCase starts a passwordless sign-in
+type BeginPasskeyLoginUseCase struct {
+	challengeRepo   repository.PasskeyChallengeRepository
+	verifier        security.PasskeyVerifier
+	challengeExpiry time.Duration
+}
+
+// NewBeginPasskeyLoginUseCase creates a new begin passkey login use case
+func NewBeginPasskeyLoginUseCase(
+	challengeRepo repository.PasskeyChallengeRepository,
+	verifier security.PasskeyVerifier,
+	challengeExpiry time.Duration,
+) *BeginPasskeyLoginUseCase {
+	return &BeginPasskeyLoginUseCase{
+		challengeRepo:   challengeRepo,
+		verifier:        verifier,
+		challengeExpiry: challengeExpiry,
+	}
+}
+
+// Execute returns credential request options for the browser
+// NOTE: No email needed - the authenticator picks the account (discoverable
+// credential), so this can't be used to probe which emails exist
+func (uc *BeginPasskeyLoginUseCase) Execute(ctx context.Context) (*usecase.PasskeyChallengeResponse, error) {
+	options, session, err := uc.verifier.BeginLogin()
+	if err != nil {
+		return nil, err
+	}
+
+	challengeID, err := startPasskeyChallenge(
+		ctx,
+		uc.challengeRepo,
+		entity.PasskeyCeremonyLogin,
+		valueobject.UserID{},
+		session,
+		uc.challengeExpiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.PasskeyChallengeResponse{
+		ChallengeID: challengeID,
+		Options:     options,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// BeginPasskeyRegistrationUseCase starts adding a passkey to an account
+type BeginPasskeyRegistrationUseCase struct {
+	userRepo        repository.UserRepository
+	passwordHasher  security.PasswordHasher
+	throttle        *LoginThrottle
+	passkeyRepo     repository.PasskeyRepository
+	challengeRepo   repository.PasskeyChallengeRepository
+	verifier        security.PasskeyVerifier
+	challengeExpiry time.Duration
+}
+
+// NewBeginPasskeyRegistrationUseCase creates a new begin passkey registration use case
+func NewBeginPasskeyRegistrationUseCase(
+	userRepo repository.UserRepository,
+	passwordHasher security.PasswordHasher,
+	throttle *LoginThrottle,
+	passkeyRepo repository.PasskeyRepository,
+	challengeRepo repository.PasskeyChallengeRepository,
+	verifier security.PasskeyVerifier,
+	challengeExpiry time.Duration,
+) *BeginPasskeyRegistrationUseCase {
+	return &BeginPasskeyRegistrationUseCase{
+		userRepo:        userRepo,
+		passwordHasher:  passwordHasher,
+		throttle:        throttle,
+		passkeyRepo:     passkeyRepo,
+		challengeRepo:   challengeRepo,
+		verifier:        verifier,
+		challengeExpiry: challengeExpiry,
+	}
+}
+
+// Execute returns credential creation options for the browser
+func (uc *BeginPasskeyRegistrationUseCase) Execute(
+	ctx context.Context,
+	req usecase.BeginPasskeyRegistrationRequest,
+) (*usecase.PasskeyChallengeResponse, error) {
+	// Step 1: Re-authenticate
+	// SECURITY: A passkey is a new way in - a stolen session must not add one
+	user, err := reauthenticate(ctx, uc.userRepo, uc.passwordHasher, uc.throttle, req.UserID, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Load existing passkeys (excluded, so one authenticator registers once)
+	passkeys, err := uc.passkeyRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find passkeys: %w", err)
+	}
+
+	// Step 3: Create options and store the challenge
+	options, session, err := uc.verifier.BeginRegistration(passkeyUser(user, passkeys))
+	if err != nil {
+		return nil, err
+	}
+
+	challengeID, err := startPasskeyChallenge(
+		ctx,
+		uc.challengeRepo,
+		entity.PasskeyCeremonyRegistration,
+		user.ID(),
+		session,
+		uc.challengeExpiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.PasskeyChallengeResponse{
+		ChallengeID: challengeID,
+		Options:     options,
+	}, nil
+}
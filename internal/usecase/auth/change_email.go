@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ChangeEmailUseCase starts moving an authenticated user to a new email address
+type ChangeEmailUseCase struct {
+	userRepo       repository.UserRepository
+	passwordHasher security.PasswordHasher
+	throttle       *LoginThrottle
+	verification   *SendVerificationUseCase
+}
+
+// NewChangeEmailUseCase creates a new change email use case
+func NewChangeEmailUseCase(
+	userRepo repository.UserRepository,
+	passwordHasher security.PasswordHasher,
+	throttle *LoginThrottle,
+	verification *SendVerificationUseCase,
+) *ChangeEmailUseCase {
+	return &ChangeEmailUseCase{
+		userRepo:       userRepo,
+		passwordHasher: passwordHasher,
+		throttle:       throttle,
+		verification:   verification,
+	}
+}
+
+// Execute records the new email as pending, sends a notice to the current
+// address and a confirmation link to the new one
+// NOTE: The account keeps its current email (and sessions) until the link
+// is confirmed through VerifyEmail
+func (uc *ChangeEmailUseCase) Execute(
+	ctx context.Context,
+	req usecase.ChangeEmailRequest,
+) (*usecase.ChangeEmailResponse, error) {
+	// Step 1: Validate new email
+	newEmail, err := valueobject.NewEmail(req.NewEmail)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError("invalid email format", "new_email")
+	}
+
+	// Step 2: Re-authenticate
+	user, err := reauthenticate(ctx, uc.userRepo, uc.passwordHasher, uc.throttle, req.UserID, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if newEmail.Equals(user.Email()) {
+		return nil, domainErrors.NewInvalidInputError("new email must differ from the current one", "new_email")
+	}
+
+	// Step 3: Check uniqueness
+	// NOTE: Checked again when the change is confirmed - the address may be
+	// taken in between
+	exists, err := uc.userRepo.ExistsByEmail(ctx, user.TenantID(), newEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email existence: %w", err)
+	}
+
+	if exists {
+		return nil, domainErrors.NewConflictError("email already in use")
+	}
+
+	// Step 4: Record the pending change
+	if err := user.RequestEmailChange(newEmail); err != nil {
+		return nil, fmt.Errorf("failed to request email change: %w", err)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// Step 5: Warn the current address, confirm with the new one
+	if err := uc.verification.sendEmailChange(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &usecase.ChangeEmailResponse{
+		Email:        user.Email().String(),
+		PendingEmail: user.PendingEmail().String(),
+	}, nil
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ChangePasswordUseCase changes an authenticated user's password
+type ChangePasswordUseCase struct {
+	userRepo       repository.UserRepository
+	passwordHasher security.PasswordHasher
+	throttle       *LoginThrottle
+	sessions       *SessionManager
+	tokenIssuer    *TokenIssuer
+}
+
+// NewChangePasswordUseCase creates a new change password use case
+func NewChangePasswordUseCase(
+	userRepo repository.UserRepository,
+	passwordHasher security.PasswordHasher,
+	throttle *LoginThrottle,
+	sessions *SessionManager,
+	tokenIssuer *TokenIssuer,
+) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		userRepo:       userRepo,
+		passwordHasher: passwordHasher,
+		throttle:       throttle,
+		sessions:       sessions,
+		tokenIssuer:    tokenIssuer,
+	}
+}
+
+// Execute changes the password, signs out other sessions and returns
+// a fresh token pair for the caller
+func (uc *ChangePasswordUseCase) Execute(
+	ctx context.Context,
+	req usecase.ChangePasswordRequest,
+) (*usecase.ChangePasswordResponse, error) {
+	// Step 1: Validate new password
+	password, err := valueobject.NewPassword(req.NewPassword)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError(err.Error(), "new_password")
+	}
+
+	// Step 2: Re-authenticate
+	user, err := reauthenticate(ctx, uc.userRepo, uc.passwordHasher, uc.throttle, req.UserID, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 3: Hash and save new password
+	hashedPassword, err := uc.passwordHasher.Hash(password.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err := user.UpdatePassword(valueobject.NewPasswordFromHash(hashedPassword)); err != nil {
+		return nil, fmt.Errorf("failed to update password: %w", err)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		// Update rewrites the whole document, email included
+		if errors.Is(err, repository.ErrUserAlreadyExists) {
+			return nil, domainErrors.NewConflictError("email already in use")
+		}
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// Step 4: End existing sessions
+	// SECURITY: Whoever knew the old password may hold refresh tokens
+	if err := uc.sessions.EndAll(ctx, user.ID()); err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	// Step 5: Keep the caller signed in with a new token family
+	tokens, err := uc.tokenIssuer.Issue(ctx, user, entity.NewTokenFamilyID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.ChangePasswordResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
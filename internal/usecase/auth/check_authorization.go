@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// maxNonceLength caps the OpenID Connect nonce parameter
+const maxNonceLength = 512
+
+// CheckAuthorizationUseCase validates an OAuth authorization request
+// WHY: Runs before the consent screen so it only ever shows requests that
+// could succeed, and again when the user answers (the query can be edited)
+type CheckAuthorizationUseCase struct {
+	clientRepo repository.OAuthClientRepository
+}
+
+// NewCheckAuthorizationUseCase creates a new check authorization use case
+func NewCheckAuthorizationUseCase(clientRepo repository.OAuthClientRepository) *CheckAuthorizationUseCase {
+	return &CheckAuthorizationUseCase{
+		clientRepo: clientRepo,
+	}
+}
+
+// Execute returns what the consent screen should show
+func (uc *CheckAuthorizationUseCase) Execute(ctx context.Context, req usecase.AuthorizeRequest) (*usecase.AuthorizationDetails, error) {
+	client, redirectURI, scopes, err := uc.validate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.AuthorizationDetails{
+		ClientID:    client.ID(),
+		ClientName:  client.Name(),
+		RedirectURI: redirectURI,
+		Scopes:      scopes,
+	}, nil
+}
+
+// validate checks req and returns the client, redirect URI and scopes to grant
+// SECURITY: Until the client and redirect URI check out, errors are shown to
+// the user; after that they go back to the client (RFC 6749 section 4.1.2.1)
+func (uc *CheckAuthorizationUseCase) validate(
+	ctx context.Context,
+	req usecase.AuthorizeRequest,
+) (*entity.OAuthClient, string, []string, error) {
+	// Step 1: Look up the client
+	if req.ClientID == "" {
+		return nil, "", nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidRequest, "client_id is required")
+	}
+
+	client, err := uc.clientRepo.FindByID(ctx, req.ClientID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOAuthClientNotFound) {
+			return nil, "", nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidRequest, "unknown client")
+		}
+		return nil, "", nil, fmt.Errorf("failed to find oauth client: %w", err)
+	}
+
+	// Step 2: Check the redirect URI
+	// NOTE: May be omitted only when the client registered exactly one
+	redirectURI := req.RedirectURI
+	if redirectURI == "" {
+		registered := client.RedirectURIs()
+		if len(registered) != 1 {
+			return nil, "", nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidRequest, "redirect_uri is required")
+		}
+		redirectURI = registered[0]
+	}
+
+	if !client.HasRedirectURI(redirectURI) {
+		return nil, "", nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidRequest, "redirect_uri is not registered for this client")
+	}
+
+	// From here on, errors are redirected back to the client
+	fail := func(code, message string) error {
+		return domainErrors.NewOAuthRedirectError(code, message, oauthRedirect(redirectURI, url.Values{
+			"error":             {code},
+			"error_description": {message},
+		}, req.State))
+	}
+
+	// Step 3: Check the response type
+	if req.ResponseType != "code" {
+		return nil, "", nil, fail(domainErrors.OAuthUnsupportedResponseType, "only response_type=code is supported")
+	}
+
+	// Step 4: Require PKCE
+	// SECURITY: Required for every client, not just public ones - it also
+	// stops authorization code injection (OAuth 2.0 Security BCP)
+	if req.CodeChallenge == "" || req.CodeChallengeMethod != security.PKCEMethodS256 {
+		return nil, "", nil, fail(domainErrors.OAuthInvalidRequest, "code_challenge with code_challenge_method=S256 is required")
+	}
+
+	if !security.ValidPKCEChallenge(req.CodeChallenge) {
+		return nil, "", nil, fail(domainErrors.OAuthInvalidRequest, "invalid code_challenge")
+	}
+
+	// Step 5: Resolve scopes
+	scopes, err := client.ResolveScopes(entity.ParseScope(req.Scope))
+	if err != nil {
+		return nil, "", nil, fail(domainErrors.OAuthInvalidScope, err.Error())
+	}
+
+	// Step 6: Bound the nonce
+	// WHY: It is stored with the code and copied into the ID token
+	if len(req.Nonce) > maxNonceLength {
+		return nil, "", nil, fail(domainErrors.OAuthInvalidRequest, "nonce is too long")
+	}
+
+	return client, redirectURI, scopes, nil
+}
+
+// oauthRedirect adds params and state to a verified redirect URI
+// NOTE: The registered URI may carry its own query, which is kept
+func oauthRedirect(redirectURI string, params url.Values, state string) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		// Registered URIs are validated when the client is created
+		return redirectURI
+	}
+
+	query := u.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	if state != "" {
+		query.Set("state", state)
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ClientCredentialsUseCase implements the client_credentials grant
+// (RFC 6749 section 4.4) for service clients
+// NOTE: The token identifies the service itself - there is no user
+type ClientCredentialsUseCase struct {
+	serviceClientRepo repository.ServiceClientRepository
+	jwtGenerator      security.JWTGenerator
+	accessTokenExpiry time.Duration
+}
+
+// NewClientCredentialsUseCase creates a new client credentials use case
+func NewClientCredentialsUseCase(
+	serviceClientRepo repository.ServiceClientRepository,
+	jwtGenerator security.JWTGenerator,
+	accessTokenExpiry time.Duration,
+) *ClientCredentialsUseCase {
+	return &ClientCredentialsUseCase{
+		serviceClientRepo: serviceClientRepo,
+		jwtGenerator:      jwtGenerator,
+		accessTokenExpiry: accessTokenExpiry,
+	}
+}
+
+// Execute issues an access token to an authenticated service client
+func (uc *ClientCredentialsUseCase) Execute(ctx context.Context, req usecase.OAuthTokenRequest) (*usecase.OAuthTokenResponse, error) {
+	// Step 1: Authenticate the client
+	client, err := uc.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Resolve scopes
+	scopes, err := client.ResolveScopes(entity.ParseScope(req.Scope))
+	if err != nil {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidScope, err.Error())
+	}
+
+	// Step 3: Issue the access token
+	// NOTE: No refresh token (RFC 6749 section 4.4.3) - the client just
+	// authenticates again
+	accessToken, err := uc.jwtGenerator.GenerateServiceAccessToken(client.ID(), client.TenantID(), scopes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate access token: %w", err)
+	}
+
+	// Step 4: Return token
+	return &usecase.OAuthTokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(uc.accessTokenExpiry.Seconds()),
+		Scope:       entity.FormatScope(scopes),
+	}, nil
+}
+
+// authenticateClient looks the service client up and checks its secret
+// SECURITY: Unknown, inactive and wrong-secret clients get the same error
+func (uc *ClientCredentialsUseCase) authenticateClient(ctx context.Context, clientID, clientSecret string) (*entity.ServiceClient, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidClient, "client authentication failed")
+	}
+
+	client, err := uc.serviceClientRepo.FindByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, repository.ErrServiceClientNotFound) {
+			return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidClient, "client authentication failed")
+		}
+		return nil, fmt.Errorf("failed to find service client: %w", err)
+	}
+
+	if !security.MatchesTokenHash(client.SecretHash(), clientSecret) || !client.IsActive() {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidClient, "client authentication failed")
+	}
+
+	return client, nil
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ConfirmMFAUseCase finishes TOTP enrollment with a code from the app
+type ConfirmMFAUseCase struct {
+	userRepo repository.UserRepository
+	cipher   security.SecretCipher
+}
+
+// NewConfirmMFAUseCase creates a new confirm MFA use case
+func NewConfirmMFAUseCase(
+	userRepo repository.UserRepository,
+	cipher security.SecretCipher,
+) *ConfirmMFAUseCase {
+	return &ConfirmMFAUseCase{
+		userRepo: userRepo,
+		cipher:   cipher,
+	}
+}
+
+// Execute enables MFA and returns one-time recovery codes
+// NOTE: Recovery codes are only ever shown here - we store hashes
+func (uc *ConfirmMFAUseCase) Execute(
+	ctx context.Context,
+	req usecase.ConfirmMFARequest,
+) (*usecase.ConfirmMFAResponse, error) {
+	// Step 1: Load user
+	user, err := loadActiveUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := user.MFA().PendingSecret
+	if pending == "" {
+		return nil, domainErrors.NewConflictError("no multi-factor enrollment in progress")
+	}
+
+	// Step 2: Check code against the pending secret
+	secret, err := decryptMFASecret(user, uc.cipher, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	step, ok := security.ValidateTOTP(secret, strings.TrimSpace(req.Code), time.Now())
+	if !ok {
+		return nil, domainErrors.NewInvalidInputError("invalid verification code", "code")
+	}
+
+	// Step 3: Generate recovery codes
+	codes, err := security.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate recovery codes: %w", err)
+	}
+
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = security.HashRecoveryCode(code)
+	}
+
+	// Step 4: Enable and save
+	if err := user.ConfirmMFAEnrollment(hashes, step); err != nil {
+		return nil, fmt.Errorf("failed to confirm mfa enrollment: %w", err)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &usecase.ConfirmMFAResponse{RecoveryCodes: codes}, nil
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// CreateInvitationUseCase emails a single-use invitation into the caller's tenant (admin operation)
+type CreateInvitationUseCase struct {
+	orgRepo        repository.OrganizationRepository
+	invitationRepo repository.InvitationRepository
+	mailer         mail.Mailer
+	auditLog       *AuditLog
+	expiry         time.Duration
+	invitationURL  string
+}
+
+// NewCreateInvitationUseCase creates a new create invitation use case
+func NewCreateInvitationUseCase(
+	orgRepo repository.OrganizationRepository,
+	invitationRepo repository.InvitationRepository,
+	mailer mail.Mailer,
+	auditLog *AuditLog,
+	expiry time.Duration,
+	invitationURL string,
+) *CreateInvitationUseCase {
+	return &CreateInvitationUseCase{
+		orgRepo:        orgRepo,
+		invitationRepo: invitationRepo,
+		mailer:         mailer,
+		auditLog:       auditLog,
+		expiry:         expiry,
+		invitationURL:  invitationURL,
+	}
+}
+
+// Execute stores the invitation and emails the link
+// NOTE: The email may already have an account in the tenant - accepting
+// then links that account and grants it the role
+func (uc *CreateInvitationUseCase) Execute(ctx context.Context, req usecase.CreateInvitationRequest) (*usecase.InvitationDetails, error) {
+	// Step 1: Validate input
+	email, err := valueobject.NewEmail(req.Email)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError("invalid email format", "email")
+	}
+
+	if err := entity.ValidateAccessName(req.Role); err != nil {
+		return nil, domainErrors.NewInvalidInputError("invalid role: "+err.Error(), "role")
+	}
+
+	// Step 2: Load the organization (its name goes in the email)
+	tenantID := usecase.TenantFromContext(ctx)
+	org, err := uc.orgRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrganizationNotFound) {
+			return nil, domainErrors.NewNotFoundError("organization not found")
+		}
+		return nil, fmt.Errorf("failed to find organization: %w", err)
+	}
+
+	// Step 3: Create and store invitation (hashed token)
+	rawToken, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+
+	invitation, err := entity.NewInvitation(
+		tenantID,
+		email,
+		req.Role,
+		security.HashToken(rawToken),
+		req.ActorID,
+		time.Now().Add(uc.expiry),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	if err := uc.invitationRepo.Create(ctx, invitation); err != nil {
+		return nil, fmt.Errorf("failed to store invitation: %w", err)
+	}
+
+	// Step 4: Email the link (the raw token only ever exists in the email)
+	// WHY: The tenant rides along so the accept page sends the right X-Tenant-ID
+	link := uc.invitationURL + "?token=" + url.QueryEscape(rawToken) + "&tenant=" + url.QueryEscape(tenantID.String())
+
+	err = uc.mailer.Send(ctx, mail.Message{
+		To:      email.String(),
+		Subject: "You're invited to join " + org.Name(),
+		Body: fmt.Sprintf(
+			"You've been invited to join %s as %s. To accept, open the link below and sign up, or sign in with your existing account:\n\n%s\n\nThe link expires in %s and works once. If you weren't expecting this, ignore this email.",
+			org.Name(), req.Role, link, uc.expiry,
+		),
+		Template: mail.TemplateInvitation,
+		Data: mail.TemplateData{
+			Link:         link,
+			Expiry:       uc.expiry,
+			Organization: org.Name(),
+			Role:         req.Role,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send invitation email: %w", err)
+	}
+
+	// Step 5: Record
+	err = uc.auditLog.Record(ctx, req.ActorID, entity.AuditActionCreateInvitation, nil, map[string]string{
+		"invitation_id": invitation.ID(),
+		"email":         email.String(),
+		"role":          req.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toInvitationDetails(invitation), nil
+}
+
+func toInvitationDetails(invitation *entity.Invitation) *usecase.InvitationDetails {
+	return &usecase.InvitationDetails{
+		ID:         invitation.ID(),
+		TenantID:   invitation.TenantID().String(),
+		Email:      invitation.Email().String(),
+		Role:       invitation.Role(),
+		Status:     string(invitation.Status()),
+		InvitedBy:  invitation.InvitedBy(),
+		CreatedAt:  invitation.CreatedAt(),
+		ExpiresAt:  invitation.ExpiresAt(),
+		AcceptedAt: invitation.AcceptedAt(),
+		AcceptedBy: invitation.AcceptedBy(),
+	}
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// CreateOAuthClientUseCase registers an OAuth client in the context's tenant (operator task)
+type CreateOAuthClientUseCase struct {
+	orgRepo    repository.OrganizationRepository
+	clientRepo repository.OAuthClientRepository
+}
+
+// NewCreateOAuthClientUseCase creates a new create OAuth client use case
+func NewCreateOAuthClientUseCase(
+	orgRepo repository.OrganizationRepository,
+	clientRepo repository.OAuthClientRepository,
+) *CreateOAuthClientUseCase {
+	return &CreateOAuthClientUseCase{
+		orgRepo:    orgRepo,
+		clientRepo: clientRepo,
+	}
+}
+
+// Execute creates the client, returning its secret if confidential
+// SECURITY: Only the secret's hash is stored; this is the one chance to see it
+func (uc *CreateOAuthClientUseCase) Execute(
+	ctx context.Context,
+	name string,
+	redirectURIs []string,
+	scopes []string,
+	confidential bool,
+) (*entity.OAuthClient, string, error) {
+	// Step 1: Check the organization exists
+	tenantID := usecase.TenantFromContext(ctx)
+	if _, err := uc.orgRepo.FindByID(ctx, tenantID); err != nil {
+		if errors.Is(err, repository.ErrOrganizationNotFound) {
+			return nil, "", domainErrors.NewNotFoundError("organization not found")
+		}
+		return nil, "", fmt.Errorf("failed to find organization: %w", err)
+	}
+
+	// Step 2: Generate a secret for confidential clients
+	var secret, secretHash string
+	if confidential {
+		var err error
+		secret, err = security.GenerateOpaqueToken()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to generate client secret: %w", err)
+		}
+		secretHash = security.HashToken(secret)
+	}
+
+	// Step 3: Validate and save
+	client, err := entity.NewOAuthClient(tenantID, name, secretHash, redirectURIs, scopes)
+	if err != nil {
+		return nil, "", domainErrors.NewInvalidInputError(err.Error(), "")
+	}
+
+	if err := uc.clientRepo.Create(ctx, client); err != nil {
+		return nil, "", fmt.Errorf("failed to create oauth client: %w", err)
+	}
+
+	return client, secret, nil
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// DefaultOrganizationName is the display name of the default tenant
+const DefaultOrganizationName = "Default"
+
+// CreateOrganizationUseCase registers a new tenant (operator task)
+type CreateOrganizationUseCase struct {
+	orgRepo repository.OrganizationRepository
+}
+
+// NewCreateOrganizationUseCase creates a new create organization use case
+func NewCreateOrganizationUseCase(orgRepo repository.OrganizationRepository) *CreateOrganizationUseCase {
+	return &CreateOrganizationUseCase{
+		orgRepo: orgRepo,
+	}
+}
+
+// Execute creates the organization
+func (uc *CreateOrganizationUseCase) Execute(ctx context.Context, id, name string) (*entity.Organization, error) {
+	// Step 1: Validate input
+	tenantID, err := valueobject.NewTenantID(id)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError(err.Error(), "tenant_id")
+	}
+
+	org, err := entity.NewOrganization(tenantID, name)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError(err.Error(), "name")
+	}
+
+	// Step 2: Save
+	if err := uc.orgRepo.Create(ctx, org); err != nil {
+		if errors.Is(err, repository.ErrOrganizationExists) {
+			return nil, domainErrors.NewConflictError("organization already exists")
+		}
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	return org, nil
+}
+
+// EnsureDefault creates the default organization if it doesn't exist yet
+// WHY: Existing users are migrated into it, and requests without a tenant use it
+// NOTE: Idempotent - run on every startup
+func (uc *CreateOrganizationUseCase) EnsureDefault(ctx context.Context) error {
+	_, err := uc.Execute(ctx, valueobject.DefaultTenant, DefaultOrganizationName)
+	if err != nil && !errors.Is(err, domainErrors.ErrConflict) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// defaultPersonalAccessTokenLifetime applies when no expiry is requested
+const defaultPersonalAccessTokenLifetime = 30 * 24 * time.Hour
+
+// CreatePersonalAccessTokenUseCase creates a personal access token for an
+// authenticated user
+type CreatePersonalAccessTokenUseCase struct {
+	userRepo       repository.UserRepository
+	passwordHasher security.PasswordHasher
+	throttle       *LoginThrottle
+	tokenRepo      repository.PersonalAccessTokenRepository
+	maxLifetime    time.Duration
+}
+
+// NewCreatePersonalAccessTokenUseCase creates a new create personal access token use case
+func NewCreatePersonalAccessTokenUseCase(
+	userRepo repository.UserRepository,
+	passwordHasher security.PasswordHasher,
+	throttle *LoginThrottle,
+	tokenRepo repository.PersonalAccessTokenRepository,
+	maxLifetime time.Duration,
+) *CreatePersonalAccessTokenUseCase {
+	return &CreatePersonalAccessTokenUseCase{
+		userRepo:       userRepo,
+		passwordHasher: passwordHasher,
+		throttle:       throttle,
+		tokenRepo:      tokenRepo,
+		maxLifetime:    maxLifetime,
+	}
+}
+
+// Execute creates the token and returns it
+// SECURITY: Only the token's hash is stored; this is the one chance to see it
+func (uc *CreatePersonalAccessTokenUseCase) Execute(
+	ctx context.Context,
+	req usecase.CreatePersonalAccessTokenRequest,
+) (*usecase.CreatePersonalAccessTokenResponse, error) {
+	// Step 1: Re-authenticate
+	// SECURITY: A token outlives the session - a stolen access token (or
+	// personal access token) must not mint one
+	user, err := reauthenticate(ctx, uc.userRepo, uc.passwordHasher, uc.throttle, req.UserID, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Check the scopes are the user's own
+	// WHY: A token can narrow the account's access, never widen it
+	access := user.Access()
+	for _, scope := range req.Scopes {
+		if !slices.Contains(access.Roles, scope) && !slices.Contains(access.Permissions, scope) {
+			return nil, domainErrors.NewInvalidInputError(fmt.Sprintf("you don't have the role or permission %q", scope), "scopes")
+		}
+	}
+
+	// Step 3: Resolve the lifetime
+	lifetime := req.ExpiresIn
+	if lifetime == 0 {
+		lifetime = min(defaultPersonalAccessTokenLifetime, uc.maxLifetime)
+	}
+	if lifetime < 0 || lifetime > uc.maxLifetime {
+		return nil, domainErrors.NewInvalidInputError(fmt.Sprintf("expiry must be between 0 and %s", uc.maxLifetime), "expires_in")
+	}
+
+	// Step 4: Generate the token
+	secret, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+	tokenString := entity.PersonalAccessTokenPrefix + secret
+
+	token, err := entity.NewPersonalAccessToken(
+		security.HashToken(tokenString),
+		user.ID(),
+		user.TenantID(),
+		req.Name,
+		req.Scopes,
+		time.Now().Add(lifetime),
+	)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError(err.Error(), "")
+	}
+
+	// Step 5: Save
+	if err := uc.tokenRepo.Create(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to create personal access token: %w", err)
+	}
+
+	return &usecase.CreatePersonalAccessTokenResponse{
+		PersonalAccessTokenInfo: toPersonalAccessTokenInfo(token),
+		Token:                   tokenString,
+	}, nil
+}
+
+// toPersonalAccessTokenInfo converts a token for API responses
+func toPersonalAccessTokenInfo(token *entity.PersonalAccessToken) usecase.PersonalAccessTokenInfo {
+	return usecase.PersonalAccessTokenInfo{
+		ID:        token.ID(),
+		Name:      token.Name(),
+		Scopes:    token.Scopes(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+	}
+}
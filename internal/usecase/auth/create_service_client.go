@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// CreateServiceClientUseCase registers a service client in the context's tenant (operator task)
+type CreateServiceClientUseCase struct {
+	orgRepo           repository.OrganizationRepository
+	serviceClientRepo repository.ServiceClientRepository
+}
+
+// NewCreateServiceClientUseCase creates a new create service client use case
+func NewCreateServiceClientUseCase(
+	orgRepo repository.OrganizationRepository,
+	serviceClientRepo repository.ServiceClientRepository,
+) *CreateServiceClientUseCase {
+	return &CreateServiceClientUseCase{
+		orgRepo:           orgRepo,
+		serviceClientRepo: serviceClientRepo,
+	}
+}
+
+// Execute creates the client and returns its secret
+// SECURITY: Only the secret's hash is stored; this is the one chance to see it
+func (uc *CreateServiceClientUseCase) Execute(
+	ctx context.Context,
+	name string,
+	scopes []string,
+) (*entity.ServiceClient, string, error) {
+	// Step 1: Check the organization exists
+	tenantID := usecase.TenantFromContext(ctx)
+	if _, err := uc.orgRepo.FindByID(ctx, tenantID); err != nil {
+		if errors.Is(err, repository.ErrOrganizationNotFound) {
+			return nil, "", domainErrors.NewNotFoundError("organization not found")
+		}
+		return nil, "", fmt.Errorf("failed to find organization: %w", err)
+	}
+
+	// Step 2: Generate a secret
+	secret, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate client secret: %w", err)
+	}
+
+	// Step 3: Validate and save
+	client, err := entity.NewServiceClient(tenantID, name, security.HashToken(secret), scopes)
+	if err != nil {
+		return nil, "", domainErrors.NewInvalidInputError(err.Error(), "")
+	}
+
+	if err := uc.serviceClientRepo.Create(ctx, client); err != nil {
+		return nil, "", fmt.Errorf("failed to create service client: %w", err)
+	}
+
+	return client, secret, nil
+}
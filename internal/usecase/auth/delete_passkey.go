@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// DeletePasskeyUseCase removes one of an authenticated user's passkeys
+type DeletePasskeyUseCase struct {
+	userRepo    repository.UserRepository
+	passkeyRepo repository.PasskeyRepository
+}
+
+// NewDeletePasskeyUseCase creates a new delete passkey use case
+func NewDeletePasskeyUseCase(
+	userRepo repository.UserRepository,
+	passkeyRepo repository.PasskeyRepository,
+) *DeletePasskeyUseCase {
+	return &DeletePasskeyUseCase{
+		userRepo:    userRepo,
+		passkeyRepo: passkeyRepo,
+	}
+}
+
+// Execute deletes the passkey (e.g. a lost device)
+// NOTE: The password still works, so this can't lock the user out
+func (uc *DeletePasskeyUseCase) Execute(ctx context.Context, req usecase.DeletePasskeyRequest) error {
+	credentialID, err := entity.DecodePasskeyID(req.PasskeyID)
+	if err != nil || len(credentialID) == 0 {
+		return domainErrors.NewInvalidInputError("invalid passkey ID", "passkey_id")
+	}
+
+	user, err := loadActiveUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.passkeyRepo.Delete(ctx, user.ID(), credentialID); err != nil {
+		if errors.Is(err, repository.ErrPasskeyNotFound) {
+			return domainErrors.NewNotFoundError("passkey not found")
+		}
+		return fmt.Errorf("failed to delete passkey: %w", err)
+	}
+
+	return nil
+}
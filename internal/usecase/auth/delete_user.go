@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// DeleteUserUseCase permanently removes an account (admin operation)
+type DeleteUserUseCase struct {
+	userRepo               repository.UserRepository
+	sessions               *SessionManager
+	passwordResetTokenRepo repository.PasswordResetTokenRepository
+	loginCodeRepo          repository.LoginCodeRepository
+	passkeyRepo            repository.PasskeyRepository
+	patRepo                repository.PersonalAccessTokenRepository
+	loginAttemptRepo       repository.LoginAttemptRepository
+	auditLog               *AuditLog
+}
+
+// NewDeleteUserUseCase creates a new delete user use case
+func NewDeleteUserUseCase(
+	userRepo repository.UserRepository,
+	sessions *SessionManager,
+	passwordResetTokenRepo repository.PasswordResetTokenRepository,
+	loginCodeRepo repository.LoginCodeRepository,
+	passkeyRepo repository.PasskeyRepository,
+	patRepo repository.PersonalAccessTokenRepository,
+	loginAttemptRepo repository.LoginAttemptRepository,
+	auditLog *AuditLog,
+) *DeleteUserUseCase {
+	return &DeleteUserUseCase{
+		userRepo:               userRepo,
+		sessions:               sessions,
+		passwordResetTokenRepo: passwordResetTokenRepo,
+		loginCodeRepo:          loginCodeRepo,
+		passkeyRepo:            passkeyRepo,
+		patRepo:                patRepo,
+		loginAttemptRepo:       loginAttemptRepo,
+		auditLog:               auditLog,
+	}
+}
+
+// Execute deletes the user and everything that could sign them in
+func (uc *DeleteUserUseCase) Execute(ctx context.Context, req usecase.AdminUserRequest) error {
+	// Step 1: Keep admins from deleting themselves
+	if req.ActorID != "" && req.ActorID == req.UserID {
+		return domainErrors.NewForbiddenError("cannot delete your own account")
+	}
+
+	// Step 2: Find target
+	user, err := findTargetUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Delete the account first
+	// WHY: Every token check looks the user up, so once the account is gone
+	// nothing below can sign anyone in, even if a cleanup step fails
+	if err := uc.userRepo.Delete(ctx, user.ID()); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domainErrors.NewNotFoundError("user not found")
+		}
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	// Step 4: Remove credentials and pending codes
+	if err := uc.sessions.EndAll(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	if err := uc.passwordResetTokenRepo.DeleteByUserID(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to delete reset tokens: %w", err)
+	}
+	if err := uc.loginCodeRepo.DeleteByUserID(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to delete login codes: %w", err)
+	}
+	if err := uc.passkeyRepo.DeleteByUserID(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to delete passkeys: %w", err)
+	}
+	if err := uc.patRepo.DeleteByUserID(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to delete personal access tokens: %w", err)
+	}
+	if err := uc.loginAttemptRepo.DeleteByEmail(ctx, user.TenantID(), user.Email()); err != nil {
+		return fmt.Errorf("failed to clear login attempts: %w", err)
+	}
+
+	// Step 5: Record (the event keeps the deleted email)
+	return uc.auditLog.Record(ctx, req.ActorID, entity.AuditActionDeleteUser, user, nil)
+}
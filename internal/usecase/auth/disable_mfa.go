@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// DisableMFAUseCase turns off MFA for an authenticated user
+type DisableMFAUseCase struct {
+	userRepo       repository.UserRepository
+	passwordHasher security.PasswordHasher
+	throttle       *LoginThrottle
+	cipher         security.SecretCipher
+}
+
+// NewDisableMFAUseCase creates a new disable MFA use case
+func NewDisableMFAUseCase(
+	userRepo repository.UserRepository,
+	passwordHasher security.PasswordHasher,
+	throttle *LoginThrottle,
+	cipher security.SecretCipher,
+) *DisableMFAUseCase {
+	return &DisableMFAUseCase{
+		userRepo:       userRepo,
+		passwordHasher: passwordHasher,
+		throttle:       throttle,
+		cipher:         cipher,
+	}
+}
+
+// Execute removes the TOTP secret and recovery codes
+// SECURITY: Needs both factors - a stolen session or password alone can't remove MFA
+func (uc *DisableMFAUseCase) Execute(ctx context.Context, req usecase.DisableMFARequest) error {
+	// Step 1: Re-authenticate
+	// NOTE: The attempt counted here covers the code too - counters are
+	// only cleared once both factors pass
+	user, err := checkCurrentPassword(ctx, uc.userRepo, uc.passwordHasher, uc.throttle, req.UserID, req.CurrentPassword)
+	if err != nil {
+		return err
+	}
+
+	if !user.IsMFAEnabled() {
+		return domainErrors.NewConflictError("multi-factor authentication not enabled")
+	}
+
+	// Step 2: Verify second factor
+	// SECURITY: Wrong codes count against the login lockout, as in VerifyMFA -
+	// knowing the password mustn't allow unlimited guesses at the code
+	ok, err := verifySecondFactor(ctx, uc.userRepo, user, uc.cipher, req.Code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domainErrors.NewUnauthorizedError("invalid verification code")
+	}
+
+	if err := uc.throttle.Reset(ctx, user.Email(), usecase.ClientInfoFromContext(ctx).IPAddress); err != nil {
+		return err
+	}
+
+	// Step 3: Disable and save
+	user.DisableMFA()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return nil
+}
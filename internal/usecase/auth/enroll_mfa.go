@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// EnrollMFAUseCase starts TOTP enrollment for an authenticated user
+type EnrollMFAUseCase struct {
+	userRepo       repository.UserRepository
+	passwordHasher security.PasswordHasher
+	throttle       *LoginThrottle
+	cipher         security.SecretCipher
+	issuer         string // Shown in authenticator apps
+}
+
+// NewEnrollMFAUseCase creates a new enroll MFA use case
+func NewEnrollMFAUseCase(
+	userRepo repository.UserRepository,
+	passwordHasher security.PasswordHasher,
+	throttle *LoginThrottle,
+	cipher security.SecretCipher,
+	issuer string,
+) *EnrollMFAUseCase {
+	return &EnrollMFAUseCase{
+		userRepo:       userRepo,
+		passwordHasher: passwordHasher,
+		throttle:       throttle,
+		cipher:         cipher,
+		issuer:         issuer,
+	}
+}
+
+// Execute generates a pending TOTP secret
+// NOTE: MFA is not enabled until ConfirmMFA proves the app was set up
+func (uc *EnrollMFAUseCase) Execute(
+	ctx context.Context,
+	req usecase.EnrollMFARequest,
+) (*usecase.EnrollMFAResponse, error) {
+	// Step 1: Re-authenticate
+	user, err := reauthenticate(ctx, uc.userRepo, uc.passwordHasher, uc.throttle, req.UserID, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsMFAEnabled() {
+		return nil, domainErrors.NewConflictError("multi-factor authentication already enabled")
+	}
+
+	// Step 2: Generate and encrypt secret
+	secret, err := security.GenerateTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate mfa secret: %w", err)
+	}
+
+	encrypted, err := uc.cipher.Encrypt(secret, user.ID().String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt mfa secret: %w", err)
+	}
+
+	// Step 3: Store as pending
+	if err := user.BeginMFAEnrollment(encrypted); err != nil {
+		return nil, fmt.Errorf("failed to begin mfa enrollment: %w", err)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// Step 4: Return secret for the authenticator app
+	return &usecase.EnrollMFAResponse{
+		Secret: secret,
+		URI:    security.TOTPURI(uc.issuer, user.Email().String(), secret),
+	}, nil
+}
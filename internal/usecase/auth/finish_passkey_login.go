@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// errUnknownPasskey marks a lookup that found no matching account
+var errUnknownPasskey = errors.New("unknown passkey")
+
+// FinishPasskeyLoginUseCase verifies a passkey assertion and signs the user in
+type FinishPasskeyLoginUseCase struct {
+	userRepo      repository.UserRepository
+	passkeyRepo   repository.PasskeyRepository
+	challengeRepo repository.PasskeyChallengeRepository
+	verifier      security.PasskeyVerifier
+	tokenIssuer   *TokenIssuer
+
+	requireVerifiedEmail bool // Policy: unverified users can't log in
+}
+
+// NewFinishPasskeyLoginUseCase creates a new finish passkey login use case
+func NewFinishPasskeyLoginUseCase(
+	userRepo repository.UserRepository,
+	passkeyRepo repository.PasskeyRepository,
+	challengeRepo repository.PasskeyChallengeRepository,
+	verifier security.PasskeyVerifier,
+	tokenIssuer *TokenIssuer,
+	requireVerifiedEmail bool,
+) *FinishPasskeyLoginUseCase {
+	return &FinishPasskeyLoginUseCase{
+		userRepo:             userRepo,
+		passkeyRepo:          passkeyRepo,
+		challengeRepo:        challengeRepo,
+		verifier:             verifier,
+		tokenIssuer:          tokenIssuer,
+		requireVerifiedEmail: requireVerifiedEmail,
+	}
+}
+
+// Execute verifies the assertion and issues a token pair
+// NOTE: No TOTP step - a user-verified passkey is already two factors
+// (the device plus its PIN/biometric) and can't be phished.
+// No lockout either: there is nothing to guess.
+func (uc *FinishPasskeyLoginUseCase) Execute(
+	ctx context.Context,
+	req usecase.FinishPasskeyLoginRequest,
+) (*usecase.LoginResponse, error) {
+	// Step 1: Consume challenge
+	challenge, err := consumePasskeyChallenge(ctx, uc.challengeRepo, req.ChallengeID, entity.PasskeyCeremonyLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Verify assertion, loading the account the authenticator names
+	var (
+		user      *entity.User
+		passkeys  []*entity.Passkey
+		lookupErr error
+	)
+	lookup := func(credentialID, userHandle []byte) (*security.PasskeyUser, error) {
+		user, passkeys, lookupErr = uc.findAccount(ctx, userHandle)
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		pu := passkeyUser(user, passkeys)
+		return &pu, nil
+	}
+
+	assertion, err := uc.verifier.FinishLogin(challenge.Session(), req.Credential, lookup)
+	if err != nil {
+		// Database failures are ours, not the client's
+		if lookupErr != nil && !errors.Is(lookupErr, errUnknownPasskey) {
+			return nil, lookupErr
+		}
+		if errors.Is(err, security.ErrPasskeyVerification) {
+			return nil, domainErrors.NewUnauthorizedError("passkey could not be verified")
+		}
+		return nil, err
+	}
+
+	// SECURITY: A counter that didn't advance suggests a cloned key
+	if assertion.CloneWarning {
+		return nil, domainErrors.NewUnauthorizedError("passkey could not be verified")
+	}
+
+	// Step 3: Check account policy
+	if !user.CanLogin() {
+		return nil, domainErrors.NewForbiddenError("account is inactive")
+	}
+
+	if uc.requireVerifiedEmail && !user.IsEmailVerified() {
+		return nil, domainErrors.NewForbiddenError("email address not verified")
+	}
+
+	// Step 4: Record use (sign counter, backup state)
+	for _, passkey := range passkeys {
+		if bytes.Equal(passkey.Credential().ID, assertion.Credential.ID) {
+			passkey.RecordUse(assertion.Credential.SignCount, assertion.Credential.BackupState)
+			if err := uc.passkeyRepo.Update(ctx, passkey); err != nil {
+				return nil, fmt.Errorf("failed to update passkey: %w", err)
+			}
+			break
+		}
+	}
+
+	// Step 5: Generate tokens
+	tokens, err := uc.tokenIssuer.Issue(ctx, user, entity.NewTokenFamilyID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.LoginResponse{
+		UserID:       user.ID().String(),
+		Email:        user.Email().String(),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+// findAccount loads the user and passkeys for a WebAuthn user handle
+func (uc *FinishPasskeyLoginUseCase) findAccount(
+	ctx context.Context,
+	userHandle []byte,
+) (*entity.User, []*entity.Passkey, error) {
+	userID, err := valueobject.NewUserIDFromString(string(userHandle))
+	if err != nil {
+		return nil, nil, errUnknownPasskey
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, nil, errUnknownPasskey
+		}
+		return nil, nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	passkeys, err := uc.passkeyRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find passkeys: %w", err)
+	}
+
+	return user, passkeys, nil
+}
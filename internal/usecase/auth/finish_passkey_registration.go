@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// FinishPasskeyRegistrationUseCase verifies and stores a new passkey
+type FinishPasskeyRegistrationUseCase struct {
+	userRepo      repository.UserRepository
+	passkeyRepo   repository.PasskeyRepository
+	challengeRepo repository.PasskeyChallengeRepository
+	verifier      security.PasskeyVerifier
+}
+
+// NewFinishPasskeyRegistrationUseCase creates a new finish passkey registration use case
+func NewFinishPasskeyRegistrationUseCase(
+	userRepo repository.UserRepository,
+	passkeyRepo repository.PasskeyRepository,
+	challengeRepo repository.PasskeyChallengeRepository,
+	verifier security.PasskeyVerifier,
+) *FinishPasskeyRegistrationUseCase {
+	return &FinishPasskeyRegistrationUseCase{
+		userRepo:      userRepo,
+		passkeyRepo:   passkeyRepo,
+		challengeRepo: challengeRepo,
+		verifier:      verifier,
+	}
+}
+
+// Execute verifies the attestation and registers the passkey
+func (uc *FinishPasskeyRegistrationUseCase) Execute(
+	ctx context.Context,
+	req usecase.FinishPasskeyRegistrationRequest,
+) (*usecase.PasskeyInfo, error) {
+	// Step 1: Consume challenge
+	challenge, err := consumePasskeyChallenge(ctx, uc.challengeRepo, req.ChallengeID, entity.PasskeyCeremonyRegistration)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Load user
+	user, err := loadActiveUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// SECURITY: A challenge only completes for the account that started it
+	if !challenge.UserID().Equals(user.ID()) {
+		return nil, domainErrors.NewUnauthorizedError("invalid or expired passkey challenge")
+	}
+
+	passkeys, err := uc.passkeyRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find passkeys: %w", err)
+	}
+
+	// Step 3: Verify attestation
+	credential, err := uc.verifier.FinishRegistration(passkeyUser(user, passkeys), challenge.Session(), req.Credential)
+	if err != nil {
+		if errors.Is(err, security.ErrPasskeyVerification) {
+			return nil, domainErrors.NewInvalidInputError("passkey could not be verified", "credential")
+		}
+		return nil, err
+	}
+
+	// Step 4: Save passkey
+	passkey, err := entity.NewPasskey(user.ID(), req.Name, *credential)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError(err.Error(), "name")
+	}
+
+	if err := uc.passkeyRepo.Create(ctx, passkey); err != nil {
+		if errors.Is(err, repository.ErrPasskeyExists) {
+			return nil, domainErrors.NewConflictError("passkey already registered")
+		}
+		return nil, fmt.Errorf("failed to save passkey: %w", err)
+	}
+
+	info := toPasskeyInfo(passkey)
+	return &info, nil
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ForcePasswordResetUseCase makes a user choose a new password (admin operation)
+// WHY: For passwords that may be compromised - the old one stops working
+// for login and every session ends, but the account stays usable
+type ForcePasswordResetUseCase struct {
+	userRepo       repository.UserRepository
+	sessions       *SessionManager
+	requestResetUC *RequestPasswordResetUseCase
+	auditLog       *AuditLog
+}
+
+// NewForcePasswordResetUseCase creates a new force password reset use case
+func NewForcePasswordResetUseCase(
+	userRepo repository.UserRepository,
+	sessions *SessionManager,
+	requestResetUC *RequestPasswordResetUseCase,
+	auditLog *AuditLog,
+) *ForcePasswordResetUseCase {
+	return &ForcePasswordResetUseCase{
+		userRepo:       userRepo,
+		sessions:       sessions,
+		requestResetUC: requestResetUC,
+		auditLog:       auditLog,
+	}
+}
+
+// Execute flags the password, ends sessions and emails a reset link
+// NOTE: Inactive accounts are flagged but get no email until reactivated
+// (they can then use "forgot password")
+func (uc *ForcePasswordResetUseCase) Execute(ctx context.Context, req usecase.AdminUserRequest) error {
+	// Step 1: Find target
+	user, err := findTargetUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Block password login until the password changes
+	user.RequirePasswordReset()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// Step 3: End existing sessions
+	// SECURITY: Whoever knows the old password may hold refresh tokens
+	if err := uc.sessions.EndAll(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	// Step 4: Email a reset link
+	if err := uc.requestResetUC.Execute(ctx, user.Email().String()); err != nil {
+		return err
+	}
+
+	// Step 5: Record
+	return uc.auditLog.Record(ctx, req.ActorID, entity.AuditActionForcePasswordReset, user, nil)
+}
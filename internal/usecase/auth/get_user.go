@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// GetUserUseCase looks up one user by ID or email (admin operation)
+type GetUserUseCase struct {
+	userRepo repository.UserRepository
+	auditLog *AuditLog
+}
+
+// NewGetUserUseCase creates a new get user use case
+func NewGetUserUseCase(userRepo repository.UserRepository, auditLog *AuditLog) *GetUserUseCase {
+	return &GetUserUseCase{
+		userRepo: userRepo,
+		auditLog: auditLog,
+	}
+}
+
+// Execute finds the user
+func (uc *GetUserUseCase) Execute(ctx context.Context, req usecase.GetUserRequest) (*usecase.UserDetails, error) {
+	// Step 1: Find user
+	var user *entity.User
+	var err error
+	switch {
+	case req.UserID != "" && req.Email != "":
+		return nil, domainErrors.NewInvalidInputError("provide a user ID or an email, not both", "user_id")
+	case req.UserID != "":
+		user, err = findTargetUser(ctx, uc.userRepo, req.UserID)
+	case req.Email != "":
+		user, err = findTargetUserByEmail(ctx, uc.userRepo, req.Email)
+	default:
+		return nil, domainErrors.NewInvalidInputError("user ID or email is required", "user_id")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Record
+	if err := uc.auditLog.Record(ctx, req.ActorID, entity.AuditActionViewUser, user, nil); err != nil {
+		return nil, err
+	}
+
+	return toUserDetails(user), nil
+}
+
+// findTargetUserByEmail loads the account an admin operation acts on by email
+func findTargetUserByEmail(ctx context.Context, userRepo repository.UserRepository, emailAddress string) (*entity.User, error) {
+	email, err := valueobject.NewEmail(emailAddress)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError("invalid email format", "email")
+	}
+
+	user, err := userRepo.FindByEmail(ctx, usecase.TenantFromContext(ctx), email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, domainErrors.NewNotFoundError("user not found")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	return user, nil
+}
+
+func toUserDetails(user *entity.User) *usecase.UserDetails {
+	access := user.Access()
+	return &usecase.UserDetails{
+		ID:                    user.ID().String(),
+		TenantID:              user.TenantID().String(),
+		Email:                 user.Email().String(),
+		IsActive:              user.IsActive(),
+		EmailVerified:         user.IsEmailVerified(),
+		MFAEnabled:            user.IsMFAEnabled(),
+		PasswordResetRequired: user.PasswordResetRequired(),
+		Roles:                 access.Roles,
+		Permissions:           access.Permissions,
+		CreatedAt:             user.CreatedAt(),
+		UpdatedAt:             user.UpdatedAt(),
+	}
+}
@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// HashFormatFirebaseScrypt marks import records exported from Firebase
+const HashFormatFirebaseScrypt = "firebase-scrypt"
+
+// ImportUsersUseCase creates accounts migrated from another system (operator task)
+// WHY: Users keep their passwords - hashes are imported as they are and
+// upgraded to the current algorithm when each user first logs in
+type ImportUsersUseCase struct {
+	userRepo       repository.UserRepository
+	orgRepo        repository.OrganizationRepository
+	passwordHasher security.HashVerifier
+	auditLog       *AuditLog
+}
+
+// NewImportUsersUseCase creates a new import users use case
+// NOTE: passwordHasher must be the one logins use, so every imported hash
+// can be checked on first login
+func NewImportUsersUseCase(
+	userRepo repository.UserRepository,
+	orgRepo repository.OrganizationRepository,
+	passwordHasher security.HashVerifier,
+	auditLog *AuditLog,
+) *ImportUsersUseCase {
+	return &ImportUsersUseCase{
+		userRepo:       userRepo,
+		orgRepo:        orgRepo,
+		passwordHasher: passwordHasher,
+		auditLog:       auditLog,
+	}
+}
+
+// Execute imports records into the request's tenant
+// NOTE: Invalid records and emails already in use are skipped and reported,
+// so a failed import can be fixed and re-run. Other errors stop the import
+func (uc *ImportUsersUseCase) Execute(
+	ctx context.Context,
+	records []usecase.ImportUserRecord,
+) (*usecase.ImportUsersResult, error) {
+	// Step 1: Resolve the tenant
+	tenantID := usecase.TenantFromContext(ctx)
+	if _, err := uc.orgRepo.FindByID(ctx, tenantID); err != nil {
+		if errors.Is(err, repository.ErrOrganizationNotFound) {
+			return nil, domainErrors.NewInvalidInputError("unknown tenant", "tenant_id")
+		}
+		return nil, fmt.Errorf("failed to find organization: %w", err)
+	}
+
+	// Step 2: Create the accounts
+	result := &usecase.ImportUsersResult{}
+	var importErr error
+	for i, record := range records {
+		err := uc.importUser(ctx, tenantID, record)
+
+		var domainErr *domainErrors.DomainError
+		if errors.As(err, &domainErr) {
+			result.Failed = append(result.Failed, usecase.ImportFailure{
+				Index:  i,
+				Email:  record.Email,
+				Reason: domainErr.Message,
+			})
+			continue
+		}
+		if err != nil {
+			importErr = err
+			break
+		}
+
+		result.Imported++
+	}
+
+	// Step 3: Audit what was imported, even if the import stopped early
+	details := map[string]string{
+		"imported": strconv.Itoa(result.Imported),
+		"failed":   strconv.Itoa(len(result.Failed)),
+	}
+	if err := uc.auditLog.Record(ctx, "", entity.AuditActionImportUsers, nil, details); err != nil {
+		return result, err
+	}
+
+	return result, importErr
+}
+
+// importUser creates one account
+// Returns a domain error for records that can't be imported
+func (uc *ImportUsersUseCase) importUser(
+	ctx context.Context,
+	tenantID valueobject.TenantID,
+	record usecase.ImportUserRecord,
+) error {
+	email, err := valueobject.NewEmail(record.Email)
+	if err != nil {
+		return domainErrors.NewInvalidInputError("invalid email format", "email")
+	}
+
+	hash, err := importedHash(record)
+	if err != nil {
+		return domainErrors.NewInvalidInputError(err.Error(), "password_hash")
+	}
+
+	// SECURITY: A hash no login could verify would lock the user out with
+	// no sign of why - reject it now instead
+	if !uc.passwordHasher.Recognizes(hash) {
+		return domainErrors.NewInvalidInputError("unsupported password hash format", "password_hash")
+	}
+
+	user, err := entity.NewUser(tenantID, email, valueobject.NewPasswordFromHash(hash))
+	if err != nil {
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	if record.EmailVerified {
+		user.MarkEmailVerified()
+	}
+
+	if err := uc.userRepo.Create(ctx, user); err != nil {
+		if errors.Is(err, repository.ErrUserAlreadyExists) {
+			return domainErrors.NewConflictError("email already in use")
+		}
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return nil
+}
+
+// importedHash returns the hash to store for record
+func importedHash(record usecase.ImportUserRecord) (string, error) {
+	switch record.HashFormat {
+	case "":
+		if record.PasswordHash == "" {
+			return "", errors.New("password hash is required")
+		}
+		return record.PasswordHash, nil
+	case HashFormatFirebaseScrypt:
+		return security.FirebaseScryptHash(record.PasswordSalt, record.PasswordHash)
+	default:
+		return "", fmt.Errorf("unknown hash format: %s", record.HashFormat)
+	}
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ListInvitationsUseCase lists the tenant's invitations (admin operation)
+type ListInvitationsUseCase struct {
+	invitationRepo repository.InvitationRepository
+	auditLog       *AuditLog
+}
+
+// NewListInvitationsUseCase creates a new list invitations use case
+func NewListInvitationsUseCase(invitationRepo repository.InvitationRepository, auditLog *AuditLog) *ListInvitationsUseCase {
+	return &ListInvitationsUseCase{
+		invitationRepo: invitationRepo,
+		auditLog:       auditLog,
+	}
+}
+
+// Execute returns every invitation in the caller's tenant, newest first
+// NOTE: Expired invitations stay listed until the TTL index removes them
+func (uc *ListInvitationsUseCase) Execute(ctx context.Context, actorID string) ([]usecase.InvitationDetails, error) {
+	// Step 1: Load (this tenant only)
+	invitations, err := uc.invitationRepo.ListByTenant(ctx, usecase.TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	// Step 2: Record
+	if err := uc.auditLog.Record(ctx, actorID, entity.AuditActionListInvitations, nil, nil); err != nil {
+		return nil, err
+	}
+
+	list := make([]usecase.InvitationDetails, 0, len(invitations))
+	for _, invitation := range invitations {
+		list = append(list, *toInvitationDetails(invitation))
+	}
+
+	return list, nil
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ListPasskeysUseCase lists an authenticated user's passkeys
+type ListPasskeysUseCase struct {
+	userRepo    repository.UserRepository
+	passkeyRepo repository.PasskeyRepository
+}
+
+// NewListPasskeysUseCase creates a new list passkeys use case
+func NewListPasskeysUseCase(
+	userRepo repository.UserRepository,
+	passkeyRepo repository.PasskeyRepository,
+) *ListPasskeysUseCase {
+	return &ListPasskeysUseCase{
+		userRepo:    userRepo,
+		passkeyRepo: passkeyRepo,
+	}
+}
+
+// Execute returns the caller's passkeys, oldest first
+func (uc *ListPasskeysUseCase) Execute(ctx context.Context, userID string) ([]usecase.PasskeyInfo, error) {
+	user, err := loadActiveUser(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := uc.passkeyRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find passkeys: %w", err)
+	}
+
+	infos := make([]usecase.PasskeyInfo, len(passkeys))
+	for i, p := range passkeys {
+		infos[i] = toPasskeyInfo(p)
+	}
+	return infos, nil
+}
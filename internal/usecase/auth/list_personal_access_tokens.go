@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ListPersonalAccessTokensUseCase lists an authenticated user's personal access tokens
+type ListPersonalAccessTokensUseCase struct {
+	userRepo  repository.UserRepository
+	tokenRepo repository.PersonalAccessTokenRepository
+}
+
+// NewListPersonalAccessTokensUseCase creates a new list personal access tokens use case
+func NewListPersonalAccessTokensUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.PersonalAccessTokenRepository,
+) *ListPersonalAccessTokensUseCase {
+	return &ListPersonalAccessTokensUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Execute returns the caller's unexpired tokens, oldest first
+// NOTE: Expired tokens linger until the TTL monitor removes them, so they
+// are filtered out here
+func (uc *ListPersonalAccessTokensUseCase) Execute(ctx context.Context, userID string) ([]usecase.PersonalAccessTokenInfo, error) {
+	user, err := loadActiveUser(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := uc.tokenRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find personal access tokens: %w", err)
+	}
+
+	infos := make([]usecase.PersonalAccessTokenInfo, 0, len(tokens))
+	for _, token := range tokens {
+		if !token.IsExpired() {
+			infos = append(infos, toPersonalAccessTokenInfo(token))
+		}
+	}
+	return infos, nil
+}
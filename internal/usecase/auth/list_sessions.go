@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ListSessionsUseCase lists the devices an authenticated user is signed in on
+type ListSessionsUseCase struct {
+	userRepo    repository.UserRepository
+	sessionRepo repository.SessionRepository
+}
+
+// NewListSessionsUseCase creates a new list sessions use case
+func NewListSessionsUseCase(
+	userRepo repository.UserRepository,
+	sessionRepo repository.SessionRepository,
+) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Execute returns the caller's unexpired sessions, most recently seen first
+// NOTE: Expired sessions linger until the TTL monitor removes them, so they
+// are filtered out here
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, req usecase.ListSessionsRequest) ([]usecase.SessionInfo, error) {
+	user, err := loadActiveUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := uc.sessionRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sessions: %w", err)
+	}
+
+	infos := make([]usecase.SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		if !session.IsExpired() {
+			infos = append(infos, toSessionInfo(session, req.CurrentSessionID))
+		}
+	}
+	return infos, nil
+}
+
+func toSessionInfo(session *entity.Session, currentSessionID string) usecase.SessionInfo {
+	return usecase.SessionInfo{
+		ID:         session.ID(),
+		ClientID:   session.ClientID(),
+		UserAgent:  session.UserAgent(),
+		IPAddress:  session.IPAddress(),
+		CreatedAt:  session.CreatedAt(),
+		LastSeenAt: session.LastSeenAt(),
+		ExpiresAt:  session.ExpiresAt(),
+		Current:    session.ID() == currentSessionID,
+	}
+}
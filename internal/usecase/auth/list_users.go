@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// Page size limits for ListUsers
+const (
+	DefaultUserPageSize = 20
+	MaxUserPageSize     = 100
+)
+
+// ListUsersUseCase pages through the tenant's users (admin operation)
+type ListUsersUseCase struct {
+	userRepo repository.UserRepository
+	auditLog *AuditLog
+}
+
+// NewListUsersUseCase creates a new list users use case
+func NewListUsersUseCase(userRepo repository.UserRepository, auditLog *AuditLog) *ListUsersUseCase {
+	return &ListUsersUseCase{
+		userRepo: userRepo,
+		auditLog: auditLog,
+	}
+}
+
+// Execute returns one page of users, newest first
+func (uc *ListUsersUseCase) Execute(ctx context.Context, req usecase.ListUsersRequest) (*usecase.UserList, error) {
+	// Step 1: Normalize paging
+	offset := max(req.Offset, 0)
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultUserPageSize
+	}
+	limit = min(limit, MaxUserPageSize)
+
+	// Step 2: Load the page and the total (this tenant only)
+	tenantID := usecase.TenantFromContext(ctx)
+	users, err := uc.userRepo.List(ctx, tenantID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	total, err := uc.userRepo.Count(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	// Step 3: Record
+	err = uc.auditLog.Record(ctx, req.ActorID, entity.AuditActionListUsers, nil, map[string]string{
+		"offset": strconv.Itoa(offset),
+		"limit":  strconv.Itoa(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := &usecase.UserList{
+		Users:  make([]usecase.UserDetails, 0, len(users)),
+		Total:  total,
+		Offset: offset,
+		Limit:  limit,
+	}
+	for _, user := range users {
+		list.Users = append(list.Users, *toUserDetails(user))
+	}
+
+	return list, nil
+}
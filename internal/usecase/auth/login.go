@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
 	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
 	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
 	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
@@ -17,6 +19,11 @@ type LoginUseCase struct {
 	userRepo       repository.UserRepository
 	passwordHasher security.PasswordHasher
 	jwtGenerator   security.JWTGenerator
+	tokenIssuer    *TokenIssuer
+	throttle       *LoginThrottle
+
+	requireVerifiedEmail bool          // Policy: unverified users can't log in
+	mfaChallengeExpiry   time.Duration // Time allowed for the second factor
 }
 
 // NewLoginUseCase creates a new login use case
@@ -24,11 +31,19 @@ func NewLoginUseCase(
 	userRepo repository.UserRepository,
 	passwordHasher security.PasswordHasher,
 	jwtGenerator security.JWTGenerator,
+	tokenIssuer *TokenIssuer,
+	throttle *LoginThrottle,
+	requireVerifiedEmail bool,
+	mfaChallengeExpiry time.Duration,
 ) *LoginUseCase {
 	return &LoginUseCase{
-		userRepo:       userRepo,
-		passwordHasher: passwordHasher,
-		jwtGenerator:   jwtGenerator,
+		userRepo:             userRepo,
+		passwordHasher:       passwordHasher,
+		jwtGenerator:         jwtGenerator,
+		tokenIssuer:          tokenIssuer,
+		throttle:             throttle,
+		requireVerifiedEmail: requireVerifiedEmail,
+		mfaChallengeExpiry:   mfaChallengeExpiry,
 	}
 }
 
@@ -45,23 +60,30 @@ func (uc *LoginUseCase) Execute(
 		return nil, domainErrors.NewUnauthorizedError("invalid credentials")
 	}
 
-	// Step 2: Find user by email
-	user, err := uc.userRepo.FindByEmail(ctx, email)
+	// Step 2: Count the attempt, enforcing backoff and lockout
+	// SECURITY: Before the password check - a blocked attempt learns nothing
+	if err := uc.throttle.Reserve(ctx, email, req.IPAddress); err != nil {
+		return nil, err
+	}
+
+	// Step 3: Find user by email
+	user, err := uc.userRepo.FindByEmail(ctx, usecase.TenantFromContext(ctx), email)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
-			// SECURITY: Same error as wrong password
+			// SECURITY: Already counted like a wrong password, so lockouts
+			// don't reveal which emails exist. Same error as wrong password
 			return nil, domainErrors.NewUnauthorizedError("invalid credentials")
 		}
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
 
-	// Step 3: Check if user is active
+	// Step 4: Check if user is active
 	// WHY: Business rule - inactive users can't login
 	if !user.CanLogin() {
 		return nil, domainErrors.NewForbiddenError("account is inactive")
 	}
 
-	// Step 4: Verify password
+	// Step 5: Verify password
 	// WHY: Core authentication - does password match?
 	err = uc.passwordHasher.Compare(user.Password().Hash(), req.Password)
 	if err != nil {
@@ -69,22 +91,94 @@ func (uc *LoginUseCase) Execute(
 		return nil, domainErrors.NewUnauthorizedError("invalid credentials")
 	}
 
-	// Step 5: Generate tokens
-	accessToken, err := uc.jwtGenerator.GenerateAccessToken(user.ID(), user.Email())
+	if err := uc.throttle.Reset(ctx, email, req.IPAddress); err != nil {
+		return nil, err
+	}
+
+	// Step 6: Upgrade an outdated hash
+	// WHY: Only now is the plaintext password at hand - hashes made with an
+	// older algorithm or cost move to the current one as users log in
+	if err := uc.rehashPassword(ctx, user, req.Password); err != nil {
+		return nil, err
+	}
+
+	// Step 7: Enforce email verification and forced reset policies
+	// SECURITY: Checked after the password so they don't reveal which emails exist
+	if uc.requireVerifiedEmail && !user.IsEmailVerified() {
+		return nil, domainErrors.NewForbiddenError("email address not verified")
+	}
+
+	// WHY: An admin flagged the password as compromised - it must not open a session
+	if user.PasswordResetRequired() {
+		return nil, domainErrors.NewForbiddenError("password reset required")
+	}
+
+	// Step 8: Require the second factor if enrolled, otherwise issue tokens
+	return completeLogin(ctx, user, uc.jwtGenerator, uc.tokenIssuer, uc.mfaChallengeExpiry)
+}
+
+// rehashPassword re-hashes the user's verified password if its stored hash is outdated
+func (uc *LoginUseCase) rehashPassword(ctx context.Context, user *entity.User, password string) error {
+	if !uc.passwordHasher.NeedsRehash(user.Password().Hash()) {
+		return nil
+	}
+
+	hashedPassword, err := uc.passwordHasher.Hash(password)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate access token: %w", err)
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err := user.RehashPassword(valueobject.NewPasswordFromHash(hashedPassword)); err != nil {
+		return fmt.Errorf("failed to rehash password: %w", err)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return nil
+}
+
+// completeLogin finishes a login whose first factor has been checked
+// WHY: Password and passwordless logins must hand out the same response,
+// including the MFA step
+func completeLogin(
+	ctx context.Context,
+	user *entity.User,
+	jwtGenerator security.JWTGenerator,
+	tokenIssuer *TokenIssuer,
+	mfaChallengeExpiry time.Duration,
+) (*usecase.LoginResponse, error) {
+	// No tokens yet - the challenge token only works with VerifyMFA
+	if user.IsMFAEnabled() {
+		challenge, err := jwtGenerator.GenerateActionToken(
+			user.ID(),
+			user.Email(),
+			security.TokenUseMFAChallenge,
+			mfaChallengeExpiry,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate mfa token: %w", err)
+		}
+
+		return &usecase.LoginResponse{
+			UserID:      user.ID().String(),
+			Email:       user.Email().String(),
+			MFARequired: true,
+			MFAToken:    challenge,
+		}, nil
 	}
 
-	refreshToken, err := uc.jwtGenerator.GenerateRefreshToken(user.ID())
+	// Each login starts a new refresh token family
+	tokens, err := tokenIssuer.Issue(ctx, user, entity.NewTokenFamilyID())
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+		return nil, err
 	}
 
-	// Step 6: Return response
 	return &usecase.LoginResponse{
 		UserID:       user.ID().String(),
 		Email:        user.Email().String(),
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
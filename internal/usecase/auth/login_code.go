@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// findPasswordlessUser finds the active account a login code would be sent to
+// Returns nil (and no error) when no code should be sent
+// SECURITY: Unknown and inactive addresses look the same to the caller
+func findPasswordlessUser(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	emailAddress string,
+) (*entity.User, error) {
+	email, err := valueobject.NewEmail(emailAddress)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError("invalid email format", "email")
+	}
+
+	user, err := userRepo.FindByEmail(ctx, usecase.TenantFromContext(ctx), email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if !user.CanLogin() {
+		return nil, nil
+	}
+
+	return user, nil
+}
+
+// storeLoginCode replaces the user's outstanding login codes with a new one
+func storeLoginCode(
+	ctx context.Context,
+	codeRepo repository.LoginCodeRepository,
+	user *entity.User,
+	kind entity.LoginCodeKind,
+	codeHash string,
+	expiry time.Duration,
+) error {
+	// WHY: Only the most recently emailed code should work
+	if err := codeRepo.DeleteByUserID(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to invalidate login codes: %w", err)
+	}
+
+	code, err := entity.NewLoginCode(codeHash, kind, user.ID(), user.Email(), time.Now().Add(expiry))
+	if err != nil {
+		return fmt.Errorf("failed to create login code: %w", err)
+	}
+
+	if err := codeRepo.Create(ctx, code); err != nil {
+		return fmt.Errorf("failed to store login code: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// LockoutPolicy configures failed-login throttling
+// NOTE: The zero value disables throttling
+type LockoutPolicy struct {
+	Enabled          bool
+	FreeAttempts     int           // Failures allowed before backoff starts
+	BaseDelay        time.Duration // First backoff delay (doubles per failure)
+	MaxDelay         time.Duration // Backoff cap
+	AccountThreshold int           // Failures from any IP before the account locks
+	ClientThreshold  int           // Failures from one IP before that IP is locked out
+	Duration         time.Duration // How long a lockout lasts
+	Window           time.Duration // Counters reset after this long without failures
+}
+
+// LoginThrottle applies progressive delays and lockouts to failed logins
+// WHY: Kept out of LoginUseCase so other password-checking flows can share the counters
+type LoginThrottle struct {
+	attemptRepo repository.LoginAttemptRepository
+	policy      LockoutPolicy
+}
+
+// NewLoginThrottle creates a new login throttle
+func NewLoginThrottle(attemptRepo repository.LoginAttemptRepository, policy LockoutPolicy) *LoginThrottle {
+	return &LoginThrottle{
+		attemptRepo: attemptRepo,
+		policy:      policy,
+	}
+}
+
+// Reserve counts an attempt against both counters and rejects it if email
+// (or email from ip) was already backing off or locked
+// SECURITY: Call before checking the credential. Counting first, in one
+// atomic step per counter, means parallel guesses can't all pass a check
+// made before any of them failed. The counters stay raised until Reset, so
+// a failed check needs nothing further recorded
+// NOTE: Blocked attempts are counted too - retrying early extends the wait
+func (t *LoginThrottle) Reserve(ctx context.Context, email valueobject.Email, ip string) error {
+	if !t.policy.Enabled {
+		return nil
+	}
+
+	// The longest wait across both counters wins
+	tenantID := usecase.TenantFromContext(ctx)
+	now := time.Now()
+	expiresAt := now.Add(t.policy.Window)
+	var wait time.Duration
+	var locked bool
+
+	for _, key := range attemptKeys(tenantID, email, ip) {
+		previous, err := t.attemptRepo.RecordAttempt(ctx, key, tenantID, email, expiresAt)
+		if err != nil {
+			return fmt.Errorf("failed to record login attempt: %w", err)
+		}
+		if previous == nil {
+			continue
+		}
+
+		threshold := t.policy.ClientThreshold
+		if key == entity.AccountAttemptKey(tenantID, email) {
+			threshold = t.policy.AccountThreshold
+		}
+
+		until, isLock := t.blockedUntil(previous, threshold)
+		if remaining := until.Sub(now); remaining > wait {
+			wait = remaining
+			locked = isLock
+		}
+	}
+
+	if wait <= 0 {
+		return nil
+	}
+
+	// Round up so clients never retry a moment too early
+	wait = wait.Truncate(time.Second) + time.Second
+
+	if locked {
+		return domainErrors.NewAccountLockedError("too many failed login attempts, account temporarily locked", wait)
+	}
+	return domainErrors.NewTooManyAttemptsError("too many failed login attempts, try again later", wait)
+}
+
+// Reset clears both counters after a successful login
+// WHY: Reserve counts every attempt, so success must undo its own count
+func (t *LoginThrottle) Reset(ctx context.Context, email valueobject.Email, ip string) error {
+	if !t.policy.Enabled {
+		return nil
+	}
+
+	if err := t.attemptRepo.DeleteByKeys(ctx, attemptKeys(usecase.TenantFromContext(ctx), email, ip)); err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+
+	return nil
+}
+
+// blockedUntil returns when attempt (a counter as it stood before the
+// current attempt) stops blocking logins, and whether that block is a
+// lockout (rather than backoff)
+func (t *LoginThrottle) blockedUntil(attempt *entity.LoginAttempt, threshold int) (time.Time, bool) {
+	failures := attempt.Failures()
+
+	if failures >= threshold {
+		return attempt.LastFailureAt().Add(t.policy.Duration), true
+	}
+
+	if failures <= t.policy.FreeAttempts {
+		return time.Time{}, false
+	}
+
+	// Exponential backoff: BaseDelay, 2x, 4x ... capped at MaxDelay
+	delay := t.policy.BaseDelay
+	for i := t.policy.FreeAttempts + 1; i < failures && delay < t.policy.MaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, t.policy.MaxDelay)
+
+	return attempt.LastFailureAt().Add(delay), false
+}
+
+// attemptKeys returns the counters a login to email in tenantID from ip touches
+func attemptKeys(tenantID valueobject.TenantID, email valueobject.Email, ip string) []string {
+	keys := []string{entity.AccountAttemptKey(tenantID, email)}
+	if ip != "" {
+		keys = append(keys, entity.ClientAttemptKey(tenantID, email, ip))
+	}
+	return keys
+}
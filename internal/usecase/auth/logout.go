@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// LogoutUseCase implements user logout
+// WHY: Ends a session by revoking its access token (and refresh token)
+type LogoutUseCase struct {
+	jwtGenerator  security.JWTGenerator
+	revokeTokenUC *RevokeTokenUseCase
+}
+
+// NewLogoutUseCase creates a new logout use case
+func NewLogoutUseCase(
+	jwtGenerator security.JWTGenerator,
+	revokeTokenUC *RevokeTokenUseCase,
+) *LogoutUseCase {
+	return &LogoutUseCase{
+		jwtGenerator:  jwtGenerator,
+		revokeTokenUC: revokeTokenUC,
+	}
+}
+
+// Execute logs the user out
+func (uc *LogoutUseCase) Execute(ctx context.Context, req usecase.LogoutRequest) error {
+	// Step 1: Validate access token
+	accessClaims, err := uc.jwtGenerator.ValidateToken(req.AccessToken, security.TokenUseAccess)
+	if err != nil {
+		return domainErrors.NewUnauthorizedError("invalid token")
+	}
+
+	// Step 2: Validate refresh token (optional)
+	// WHY: Check everything before revoking anything
+	var refreshClaims *security.Claims
+	if req.RefreshToken != "" {
+		refreshClaims, err = uc.jwtGenerator.ValidateToken(req.RefreshToken, security.TokenUseRefresh)
+		if err != nil {
+			return domainErrors.NewUnauthorizedError("invalid refresh token")
+		}
+
+		// SECURITY: Users may only log out their own sessions
+		if refreshClaims.UserID != accessClaims.UserID {
+			return domainErrors.NewForbiddenError("refresh token belongs to another user")
+		}
+	}
+
+	// Step 3: Revoke access token
+	if err := uc.revokeTokenUC.revoke(ctx, req.AccessToken, accessClaims); err != nil {
+		return err
+	}
+
+	// Step 4: Revoke refresh token family
+	if refreshClaims != nil {
+		if err := uc.revokeTokenUC.revoke(ctx, req.RefreshToken, refreshClaims); err != nil {
+			return err
+		}
+	}
+
+	// Step 5: End the session the access token belongs to
+	// WHY: Signs the device out even when the client doesn't send its refresh token
+	if accessClaims.SessionID != "" {
+		if err := uc.revokeTokenUC.sessions.EndByID(ctx, accessClaims.SessionID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
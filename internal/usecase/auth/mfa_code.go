@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// recoveryCodeCount is how many recovery codes a confirmed enrollment gets
+const recoveryCodeCount = 10
+
+// verifySecondFactor checks a TOTP code or, failing the TOTP format, a recovery code
+// NOTE: On success the used step / consumed code is already saved
+func verifySecondFactor(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	user *entity.User,
+	cipher security.SecretCipher,
+	code string,
+) (bool, error) {
+	code = strings.TrimSpace(code)
+	if code == "" || !user.IsMFAEnabled() {
+		return false, nil
+	}
+
+	if isTOTPCode(code) {
+		secret, err := decryptMFASecret(user, cipher, user.MFA().Secret)
+		if err != nil {
+			return false, err
+		}
+
+		step, ok := security.ValidateTOTP(secret, code, time.Now())
+		if !ok {
+			return false, nil
+		}
+
+		// SECURITY: A code seen once (e.g. by a phishing proxy) can't be replayed
+		if !user.AcceptTOTPStep(step) {
+			return false, nil
+		}
+		return consumeMFACode(userRepo.UseTOTPStep(ctx, user.ID(), step))
+	}
+
+	codeHash := security.HashRecoveryCode(code)
+	if !user.UseRecoveryCode(codeHash) {
+		return false, nil
+	}
+	return consumeMFACode(userRepo.UseRecoveryCode(ctx, user.ID(), codeHash))
+}
+
+// consumeMFACode interprets the result of saving a used code
+// SECURITY: The save is conditional - if a concurrent request used the code
+// first, the copy of the user checked above was stale and the code is invalid
+func consumeMFACode(err error) (bool, error) {
+	if errors.Is(err, repository.ErrMFACodeUsed) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to save used mfa code: %w", err)
+	}
+	return true, nil
+}
+
+// decryptMFASecret decrypts a stored TOTP secret
+// SECURITY: Bound to the user ID, so a secret copied to another account won't decrypt
+func decryptMFASecret(user *entity.User, cipher security.SecretCipher, encrypted string) (string, error) {
+	secret, err := cipher.Decrypt(encrypted, user.ID().String())
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt mfa secret: %w", err)
+	}
+	return secret, nil
+}
+
+// isTOTPCode reports whether code looks like a TOTP code (6 digits)
+// WHY: Recovery codes are 16 characters, so the two can't be confused
+func isTOTPCode(code string) bool {
+	if len(code) != 6 {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,259 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// OAuth grant types supported by the token endpoint
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+)
+
+// OAuthTokenUseCase implements the OAuth token endpoint
+type OAuthTokenUseCase struct {
+	clientRepo          repository.OAuthClientRepository
+	codeRepo            repository.AuthorizationCodeRepository
+	userRepo            repository.UserRepository
+	tokenIssuer         *TokenIssuer
+	refreshTokenUC      *RefreshTokenUseCase
+	clientCredentialsUC *ClientCredentialsUseCase
+	jwtGenerator        security.JWTGenerator
+	accessTokenExpiry   time.Duration
+	oidcIssuer          string // Empty disables ID tokens
+}
+
+// NewOAuthTokenUseCase creates a new OAuth token use case
+func NewOAuthTokenUseCase(
+	clientRepo repository.OAuthClientRepository,
+	codeRepo repository.AuthorizationCodeRepository,
+	userRepo repository.UserRepository,
+	tokenIssuer *TokenIssuer,
+	refreshTokenUC *RefreshTokenUseCase,
+	clientCredentialsUC *ClientCredentialsUseCase,
+	jwtGenerator security.JWTGenerator,
+	accessTokenExpiry time.Duration,
+	oidcIssuer string,
+) *OAuthTokenUseCase {
+	return &OAuthTokenUseCase{
+		clientRepo:          clientRepo,
+		codeRepo:            codeRepo,
+		userRepo:            userRepo,
+		tokenIssuer:         tokenIssuer,
+		refreshTokenUC:      refreshTokenUC,
+		clientCredentialsUC: clientCredentialsUC,
+		jwtGenerator:        jwtGenerator,
+		accessTokenExpiry:   accessTokenExpiry,
+		oidcIssuer:          oidcIssuer,
+	}
+}
+
+// Execute exchanges a grant for tokens
+// NOTE: Every error carries an RFC 6749 code for the response body
+func (uc *OAuthTokenUseCase) Execute(ctx context.Context, req usecase.OAuthTokenRequest) (*usecase.OAuthTokenResponse, error) {
+	// Step 1: Check the grant type
+	// NOTE: client_credentials is for service clients, which have their own
+	// registry - they never get user tokens
+	switch req.GrantType {
+	case GrantTypeAuthorizationCode, GrantTypeRefreshToken:
+	case GrantTypeClientCredentials:
+		return uc.clientCredentialsUC.Execute(ctx, req)
+	default:
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthUnsupportedGrantType, "unsupported grant_type")
+	}
+
+	// Step 2: Authenticate the client
+	client, err := uc.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	// WHY: Refresh tokens are checked against the tenant in the context;
+	// here it's the client's organization, not a request header
+	ctx = usecase.WithTenant(ctx, client.TenantID())
+
+	// Step 3: Redeem the grant
+	var tokens *ClientTokens
+	if req.GrantType == GrantTypeAuthorizationCode {
+		tokens, err = uc.exchangeCode(ctx, client, req)
+	} else {
+		tokens, err = uc.refreshTokenUC.ExecuteForClient(ctx, req.RefreshToken, client.ID(), entity.ParseScope(req.Scope))
+	}
+	if err != nil {
+		return nil, invalidGrant(err)
+	}
+
+	// Step 4: Issue an ID token if the client asked for openid
+	// NOTE: Also on refresh, with the original auth_time and no nonce
+	// (OIDC Core section 12.2)
+	var idToken string
+	if uc.oidcIssuer != "" && slices.Contains(tokens.Grant.Scopes, entity.ScopeOpenID) {
+		idToken, err = uc.jwtGenerator.GenerateIDToken(security.IDToken{
+			Issuer:      uc.oidcIssuer,
+			UserID:      tokens.User.ID(),
+			ClientID:    client.ID(),
+			Nonce:       tokens.Nonce,
+			AuthTime:    tokens.AuthTime,
+			AccessToken: tokens.AccessToken,
+			UserClaims:  userClaims(tokens.User, tokens.Grant.Scopes),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ID token: %w", err)
+		}
+	}
+
+	// Step 5: Return tokens
+	return &usecase.OAuthTokenResponse{
+		AccessToken:  tokens.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(uc.accessTokenExpiry.Seconds()),
+		RefreshToken: tokens.RefreshToken,
+		Scope:        entity.FormatScope(tokens.Grant.Scopes),
+		IDToken:      idToken,
+	}, nil
+}
+
+// authenticateClient looks the client up and checks its secret
+// SECURITY: Public clients have no secret to send; confidential clients
+// must send theirs (HTTP Basic or form body)
+func (uc *OAuthTokenUseCase) authenticateClient(ctx context.Context, clientID, clientSecret string) (*entity.OAuthClient, error) {
+	if clientID == "" {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidClient, "client authentication failed")
+	}
+
+	client, err := uc.clientRepo.FindByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOAuthClientNotFound) {
+			return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidClient, "client authentication failed")
+		}
+		return nil, fmt.Errorf("failed to find oauth client: %w", err)
+	}
+
+	if client.IsConfidential() {
+		if clientSecret == "" || !security.MatchesTokenHash(client.SecretHash(), clientSecret) {
+			return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidClient, "client authentication failed")
+		}
+	} else if clientSecret != "" {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidClient, "client authentication failed")
+	}
+
+	return client, nil
+}
+
+// exchangeCode redeems an authorization code (RFC 6749 section 4.1.3)
+func (uc *OAuthTokenUseCase) exchangeCode(
+	ctx context.Context,
+	client *entity.OAuthClient,
+	req usecase.OAuthTokenRequest,
+) (*ClientTokens, error) {
+	if req.Code == "" || req.CodeVerifier == "" {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidRequest, "code and code_verifier are required")
+	}
+
+	// Find the code
+	codeHash := security.HashToken(req.Code)
+	code, err := uc.codeRepo.FindByHash(ctx, codeHash)
+	if err != nil {
+		if errors.Is(err, repository.ErrTokenNotFound) {
+			return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, "invalid authorization code")
+		}
+		return nil, fmt.Errorf("failed to find authorization code: %w", err)
+	}
+
+	// Check it was issued to this client
+	// SECURITY: Before replay detection, so another client can't present a
+	// used code to end the session it started
+	if code.ClientID() != client.ID() {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, "invalid authorization code")
+	}
+
+	// SECURITY: A replayed code means it leaked - revoke what it issued
+	if code.IsUsed() {
+		return nil, uc.revokeCodeFamily(ctx, code)
+	}
+
+	if code.IsExpired() {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, "authorization code expired")
+	}
+
+	// Check it was issued for this redirect URI and challenge
+	// NOTE: redirect_uri is only required here if the authorization request
+	// sent it (RFC 6749 section 4.1.3)
+	if code.RedirectURISent() && code.RedirectURI() != req.RedirectURI {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, "invalid authorization code")
+	}
+
+	if !security.VerifyPKCE(code.CodeChallenge(), req.CodeVerifier) {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, "code_verifier does not match")
+	}
+
+	// Check the user can still sign in
+	user, err := uc.userRepo.FindByID(ctx, code.UserID())
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, "user not found")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if !user.CanLogin() || !user.TenantID().Equals(code.TenantID()) {
+		return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, "account is inactive")
+	}
+
+	// Consume the code before issuing tokens
+	// WHY: Atomic - of two concurrent exchanges only one gets tokens
+	familyID := entity.NewTokenFamilyID()
+	if err := uc.codeRepo.MarkUsed(ctx, codeHash, familyID); err != nil {
+		if errors.Is(err, repository.ErrTokenAlreadyUsed) {
+			return nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, "authorization code already used")
+		}
+		return nil, fmt.Errorf("failed to redeem authorization code: %w", err)
+	}
+
+	grant := security.ClientGrant{ClientID: client.ID(), Scopes: code.Scopes()}
+	tokens, err := uc.tokenIssuer.IssueForClient(ctx, user, familyID, code.AuthTime(), grant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientTokens{
+		TokenPair: tokens,
+		User:      user,
+		Grant:     grant,
+		Nonce:     code.Nonce(),
+	}, nil
+}
+
+// revokeCodeFamily ends the session started with a replayed code
+func (uc *OAuthTokenUseCase) revokeCodeFamily(ctx context.Context, code *entity.AuthorizationCode) error {
+	if code.FamilyID() != "" {
+		if err := uc.tokenIssuer.sessions.EndFamily(ctx, code.FamilyID()); err != nil {
+			return err
+		}
+	}
+	return domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, "authorization code already used")
+}
+
+// invalidGrant reports refresh failures (revoked, reused, wrong tenant...)
+// as invalid_grant, leaving errors that already have an OAuth code alone
+func invalidGrant(err error) error {
+	var domainErr *domainErrors.DomainError
+	if !errors.As(err, &domainErr) || domainErr.OAuthCode != "" {
+		return err
+	}
+	if errors.Is(err, domainErrors.ErrUnauthorized) || errors.Is(err, domainErrors.ErrForbidden) {
+		return domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, domainErr.Message)
+	}
+	return err
+}
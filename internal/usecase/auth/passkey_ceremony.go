@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// startPasskeyChallenge stores ceremony state and returns the ID for the client
+// SECURITY: Only the hash is stored, like reset tokens
+func startPasskeyChallenge(
+	ctx context.Context,
+	challengeRepo repository.PasskeyChallengeRepository,
+	ceremony entity.PasskeyCeremony,
+	userID valueobject.UserID,
+	session []byte,
+	expiry time.Duration,
+) (string, error) {
+	challengeID, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate challenge ID: %w", err)
+	}
+
+	challenge, err := entity.NewPasskeyChallenge(
+		security.HashToken(challengeID),
+		ceremony,
+		userID,
+		session,
+		time.Now().UTC().Add(expiry),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create passkey challenge: %w", err)
+	}
+
+	if err := challengeRepo.Create(ctx, challenge); err != nil {
+		return "", fmt.Errorf("failed to save passkey challenge: %w", err)
+	}
+
+	return challengeID, nil
+}
+
+// consumePasskeyChallenge fetches and deletes ceremony state (single use)
+func consumePasskeyChallenge(
+	ctx context.Context,
+	challengeRepo repository.PasskeyChallengeRepository,
+	challengeID string,
+	ceremony entity.PasskeyCeremony,
+) (*entity.PasskeyChallenge, error) {
+	challenge, err := challengeRepo.Consume(ctx, security.HashToken(challengeID))
+	if err != nil {
+		if errors.Is(err, repository.ErrTokenNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("invalid or expired passkey challenge")
+		}
+		return nil, fmt.Errorf("failed to find passkey challenge: %w", err)
+	}
+
+	// NOTE: TTL deletion lags, so expiry is checked here too
+	if challenge.Ceremony() != ceremony || challenge.IsExpired() {
+		return nil, domainErrors.NewUnauthorizedError("invalid or expired passkey challenge")
+	}
+
+	return challenge, nil
+}
+
+// passkeyUser describes a user and their passkeys to the WebAuthn verifier
+// WHY: The user handle is the user ID - stable across email changes and not personal data
+func passkeyUser(user *entity.User, passkeys []*entity.Passkey) security.PasskeyUser {
+	credentials := make([]entity.PasskeyCredential, len(passkeys))
+	for i, p := range passkeys {
+		credentials[i] = p.Credential()
+	}
+
+	return security.PasskeyUser{
+		Handle:      []byte(user.ID().String()),
+		Name:        user.Email().String(),
+		Credentials: credentials,
+	}
+}
+
+func toPasskeyInfo(p *entity.Passkey) usecase.PasskeyInfo {
+	return usecase.PasskeyInfo{
+		ID:         p.ID(),
+		Name:       p.Name(),
+		CreatedAt:  p.CreatedAt(),
+		LastUsedAt: p.LastUsedAt(),
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
 	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
 	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
 	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
@@ -13,19 +15,27 @@ import (
 )
 
 // RefreshTokenUseCase implements token refresh logic
+// WHY: Refresh tokens are single-use. Each refresh rotates the token, and
+// replaying a rotated token revokes its whole family (likely stolen).
 type RefreshTokenUseCase struct {
-	userRepo     repository.UserRepository
-	jwtGenerator security.JWTGenerator
+	userRepo         repository.UserRepository
+	jwtGenerator     security.JWTGenerator
+	refreshTokenRepo repository.RefreshTokenRepository
+	tokenIssuer      *TokenIssuer
 }
 
 // NewRefreshTokenUseCase creates a new refresh token use case
 func NewRefreshTokenUseCase(
 	userRepo repository.UserRepository,
 	jwtGenerator security.JWTGenerator,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	tokenIssuer *TokenIssuer,
 ) *RefreshTokenUseCase {
 	return &RefreshTokenUseCase{
-		userRepo:     userRepo,
-		jwtGenerator: jwtGenerator,
+		userRepo:         userRepo,
+		jwtGenerator:     jwtGenerator,
+		refreshTokenRepo: refreshTokenRepo,
+		tokenIssuer:      tokenIssuer,
 	}
 }
 
@@ -34,47 +44,159 @@ func (uc *RefreshTokenUseCase) Execute(
 	ctx context.Context,
 	refreshToken string,
 ) (*usecase.RefreshResponse, error) {
+	tokens, _, err := uc.rotate(ctx, refreshToken, func(claims *security.Claims) (*security.ClientGrant, *security.ClientGrant, error) {
+		// SECURITY: Tokens issued to an OAuth client are only refreshed by
+		// that client, at the token endpoint
+		if _, ok := claims.ClientGrant(); ok {
+			return nil, nil, domainErrors.NewUnauthorizedError("invalid refresh token")
+		}
+		return nil, nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.RefreshResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+// ExecuteForClient refreshes tokens issued to clientID (refresh_token grant)
+// scopes may narrow the new access token; the new refresh token keeps the
+// original grant
+func (uc *RefreshTokenUseCase) ExecuteForClient(
+	ctx context.Context,
+	refreshToken string,
+	clientID string,
+	scopes []string,
+) (*ClientTokens, error) {
+	var accessGrant *security.ClientGrant
+	tokens, user, err := uc.rotate(ctx, refreshToken, func(claims *security.Claims) (*security.ClientGrant, *security.ClientGrant, error) {
+		grant, ok := claims.ClientGrant()
+		if !ok || grant.ClientID != clientID {
+			return nil, nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidGrant, "refresh token was not issued to this client")
+		}
+
+		accessGrant = &security.ClientGrant{ClientID: grant.ClientID, Scopes: grant.Scopes}
+		if len(scopes) > 0 {
+			for _, scope := range scopes {
+				if !slices.Contains(grant.Scopes, scope) {
+					return nil, nil, domainErrors.NewOAuthError(domainErrors.OAuthInvalidScope, fmt.Sprintf("scope %q was not granted", scope))
+				}
+			}
+			accessGrant.Scopes = scopes
+		}
+		return accessGrant, &grant, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientTokens{
+		TokenPair: tokens,
+		User:      user,
+		Grant:     *accessGrant,
+	}, nil
+}
+
+// rotate exchanges refreshToken for a new pair in the same family and
+// returns it with the user it was issued for
+// bind checks who may use the token and returns the grants for the new pair
+// (nil for first-party tokens)
+func (uc *RefreshTokenUseCase) rotate(
+	ctx context.Context,
+	refreshToken string,
+	bind func(claims *security.Claims) (*security.ClientGrant, *security.ClientGrant, error),
+) (*TokenPair, *entity.User, error) {
 	// Step 1: Validate refresh token
-	claims, err := uc.jwtGenerator.ValidateToken(refreshToken)
+	// SECURITY: Access tokens are rejected here (token_use must be refresh)
+	claims, err := uc.jwtGenerator.ValidateToken(refreshToken, security.TokenUseRefresh)
 	if err != nil {
-		return nil, domainErrors.NewUnauthorizedError("invalid refresh token")
+		return nil, nil, domainErrors.NewUnauthorizedError("invalid refresh token")
 	}
 
-	// Step 2: Parse user ID
+	// Step 2: Check who is refreshing
+	accessGrant, refreshGrant, err := bind(claims)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Step 3: Parse user ID
 	userID, err := valueobject.NewUserIDFromString(claims.UserID)
 	if err != nil {
-		return nil, domainErrors.NewUnauthorizedError("invalid user ID in token")
+		return nil, nil, domainErrors.NewUnauthorizedError("invalid user ID in token")
+	}
+
+	// Step 4: Look up server-side record
+	// WHY: Only tokens we issued and recorded can be rotated
+	tokenHash := security.HashToken(refreshToken)
+	stored, err := uc.refreshTokenRepo.FindByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, repository.ErrTokenNotFound) {
+			return nil, nil, domainErrors.NewUnauthorizedError("invalid refresh token")
+		}
+		return nil, nil, fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	if !stored.UserID().Equals(userID) {
+		return nil, nil, domainErrors.NewUnauthorizedError("invalid refresh token")
+	}
+
+	if stored.IsRevoked() {
+		return nil, nil, domainErrors.NewUnauthorizedError("refresh token revoked")
 	}
 
-	// Step 3: Verify user exists
+	// Step 5: Detect reuse
+	// SECURITY: A rotated token should never come back. If it does, either
+	// the client or an attacker holds a copy - kill the whole family.
+	if stored.IsUsed() {
+		return nil, nil, uc.revokeFamily(ctx, stored.FamilyID())
+	}
+
+	// Step 6: Verify user exists
 	user, err := uc.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
-			return nil, domainErrors.NewUnauthorizedError("user not found")
+			return nil, nil, domainErrors.NewUnauthorizedError("user not found")
 		}
-		return nil, fmt.Errorf("failed to find user: %w", err)
+		return nil, nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// Step 7: Check tenant and that the user is active
+	// SECURITY: Refreshing must not move a session into another tenant
+	if err := checkTokenTenant(ctx, claims, user); err != nil {
+		return nil, nil, err
 	}
 
-	// Step 4: Check if user is active
 	if !user.CanLogin() {
-		return nil, domainErrors.NewForbiddenError("account is inactive")
+		return nil, nil, domainErrors.NewForbiddenError("account is inactive")
 	}
 
-	// Step 5: Generate new access token
-	newAccessToken, err := uc.jwtGenerator.GenerateAccessToken(user.ID(), user.Email())
+	// Step 8: Issue new tokens in the same family
+	// NOTE: The sign-in time carries over - refreshing isn't signing in
+	tokens, err := uc.tokenIssuer.reissue(ctx, user, stored.FamilyID(), authTime(claims), accessGrant, refreshGrant)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate access token: %w", err)
+		return nil, nil, err
 	}
 
-	// Step 6: Generate new refresh token
-	newRefreshToken, err := uc.jwtGenerator.GenerateRefreshToken(user.ID())
+	// Step 9: Consume the old token
+	// WHY: Atomic - if a concurrent request consumed it first, that's reuse
+	err = uc.refreshTokenRepo.MarkUsed(ctx, tokenHash, security.HashToken(tokens.RefreshToken))
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+		if errors.Is(err, repository.ErrTokenAlreadyUsed) {
+			return nil, nil, uc.revokeFamily(ctx, stored.FamilyID())
+		}
+		return nil, nil, fmt.Errorf("failed to rotate refresh token: %w", err)
 	}
 
-	// Step 7: Return new tokens
-	return &usecase.RefreshResponse{
-		AccessToken:  newAccessToken,
-		RefreshToken: newRefreshToken,
-	}, nil
+	return tokens, user, nil
+}
+
+// revokeFamily ends the family's session after reuse is detected
+func (uc *RefreshTokenUseCase) revokeFamily(ctx context.Context, familyID string) error {
+	if err := uc.tokenIssuer.sessions.EndFamily(ctx, familyID); err != nil {
+		return err
+	}
+	return domainErrors.NewUnauthorizedError("refresh token reuse detected")
 }
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// RequestLoginCodeUseCase emails a one-time 6-digit login code
+type RequestLoginCodeUseCase struct {
+	userRepo   repository.UserRepository
+	codeRepo   repository.LoginCodeRepository
+	mailer     *mail.BackgroundMailer
+	codeExpiry time.Duration
+}
+
+// NewRequestLoginCodeUseCase creates a new request login code use case
+func NewRequestLoginCodeUseCase(
+	userRepo repository.UserRepository,
+	codeRepo repository.LoginCodeRepository,
+	mailer *mail.BackgroundMailer,
+	codeExpiry time.Duration,
+) *RequestLoginCodeUseCase {
+	return &RequestLoginCodeUseCase{
+		userRepo:   userRepo,
+		codeRepo:   codeRepo,
+		mailer:     mailer,
+		codeExpiry: codeExpiry,
+	}
+}
+
+// Execute sends a login code if the email belongs to an active account
+// SECURITY: Unknown and inactive addresses succeed silently, and the email
+// is sent in the background - the caller can't tell whether an email is
+// registered from the response or how long it took
+func (uc *RequestLoginCodeUseCase) Execute(ctx context.Context, emailAddress string) error {
+	// Step 1: Find active user
+	user, err := findPasswordlessUser(ctx, uc.userRepo, emailAddress)
+	if err != nil || user == nil {
+		return err
+	}
+
+	// Step 2: Create and store code (hashed with the address)
+	code, err := security.GenerateLoginCode()
+	if err != nil {
+		return fmt.Errorf("failed to generate login code: %w", err)
+	}
+
+	codeHash := security.HashLoginCode(user.Email().String(), code)
+	if err := storeLoginCode(ctx, uc.codeRepo, user, entity.LoginCodeKindOTP, codeHash, uc.codeExpiry); err != nil {
+		return err
+	}
+
+	// Step 3: Email the code (the raw code only ever exists in the email)
+	uc.mailer.Send(ctx, mail.Message{
+		To:      user.Email().String(),
+		Subject: "Your sign-in code",
+		Body: fmt.Sprintf(
+			"Your sign-in code is:\n\n%s\n\nIt expires in %s and works once. If you didn't try to sign in, ignore this email.",
+			code, uc.codeExpiry,
+		),
+		Template: mail.TemplateLoginCode,
+		Data:     mail.TemplateData{Code: code, Expiry: uc.codeExpiry},
+	})
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// RequestMagicLinkUseCase emails a one-time sign-in link
+type RequestMagicLinkUseCase struct {
+	userRepo     repository.UserRepository
+	codeRepo     repository.LoginCodeRepository
+	mailer       *mail.BackgroundMailer
+	codeExpiry   time.Duration
+	magicLinkURL string
+}
+
+// NewRequestMagicLinkUseCase creates a new request magic link use case
+func NewRequestMagicLinkUseCase(
+	userRepo repository.UserRepository,
+	codeRepo repository.LoginCodeRepository,
+	mailer *mail.BackgroundMailer,
+	codeExpiry time.Duration,
+	magicLinkURL string,
+) *RequestMagicLinkUseCase {
+	return &RequestMagicLinkUseCase{
+		userRepo:     userRepo,
+		codeRepo:     codeRepo,
+		mailer:       mailer,
+		codeExpiry:   codeExpiry,
+		magicLinkURL: magicLinkURL,
+	}
+}
+
+// Execute sends a sign-in link if the email belongs to an active account
+// SECURITY: Unknown and inactive addresses succeed silently, and the email
+// is sent in the background - the caller can't tell whether an email is
+// registered from the response or how long it took
+func (uc *RequestMagicLinkUseCase) Execute(ctx context.Context, emailAddress string) error {
+	// Step 1: Find active user
+	user, err := findPasswordlessUser(ctx, uc.userRepo, emailAddress)
+	if err != nil || user == nil {
+		return err
+	}
+
+	// Step 2: Create and store token (hashed)
+	rawToken, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate magic link token: %w", err)
+	}
+
+	if err := storeLoginCode(ctx, uc.codeRepo, user, entity.LoginCodeKindMagicLink, security.HashToken(rawToken), uc.codeExpiry); err != nil {
+		return err
+	}
+
+	// Step 3: Email the link (the raw token only ever exists in the email)
+	link := uc.magicLinkURL + "?token=" + url.QueryEscape(rawToken)
+
+	uc.mailer.Send(ctx, mail.Message{
+		To:      user.Email().String(),
+		Subject: "Your sign-in link",
+		Body: fmt.Sprintf(
+			"To sign in, open the link below:\n\n%s\n\nThe link expires in %s and works once. If you didn't try to sign in, ignore this email.",
+			link, uc.codeExpiry,
+		),
+		Template: mail.TemplateMagicLink,
+		Data:     mail.TemplateData{Link: link, Expiry: uc.codeExpiry},
+	})
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// RequestPasswordResetUseCase emails a single-use password reset link
+type RequestPasswordResetUseCase struct {
+	userRepo       repository.UserRepository
+	resetTokenRepo repository.PasswordResetTokenRepository
+	mailer         *mail.BackgroundMailer
+	tokenExpiry    time.Duration
+	resetURL       string
+}
+
+// NewRequestPasswordResetUseCase creates a new request password reset use case
+func NewRequestPasswordResetUseCase(
+	userRepo repository.UserRepository,
+	resetTokenRepo repository.PasswordResetTokenRepository,
+	mailer *mail.BackgroundMailer,
+	tokenExpiry time.Duration,
+	resetURL string,
+) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{
+		userRepo:       userRepo,
+		resetTokenRepo: resetTokenRepo,
+		mailer:         mailer,
+		tokenExpiry:    tokenExpiry,
+		resetURL:       resetURL,
+	}
+}
+
+// Execute sends a reset link if the email belongs to an active account
+// SECURITY: Unknown and inactive addresses succeed silently, and the email
+// is sent in the background - the caller can't tell whether an email is
+// registered from the response or how long it took
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, emailAddress string) error {
+	// Step 1: Validate email format
+	email, err := valueobject.NewEmail(emailAddress)
+	if err != nil {
+		return domainErrors.NewInvalidInputError("invalid email format", "email")
+	}
+
+	// Step 2: Find user
+	user, err := uc.userRepo.FindByEmail(ctx, usecase.TenantFromContext(ctx), email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// Step 3: Inactive accounts can't reset
+	if !user.CanLogin() {
+		return nil
+	}
+
+	// Step 4: Invalidate older reset links
+	if err := uc.resetTokenRepo.DeleteByUserID(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to invalidate reset tokens: %w", err)
+	}
+
+	// Step 5: Create and store token (hashed)
+	rawToken, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate reset token: %w", err)
+	}
+
+	resetToken, err := entity.NewPasswordResetToken(
+		security.HashToken(rawToken),
+		user.ID(),
+		time.Now().Add(uc.tokenExpiry),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create reset token: %w", err)
+	}
+
+	if err := uc.resetTokenRepo.Create(ctx, resetToken); err != nil {
+		return fmt.Errorf("failed to store reset token: %w", err)
+	}
+
+	// Step 6: Email the link (the raw token only ever exists in the email)
+	link := uc.resetURL + "?token=" + url.QueryEscape(rawToken)
+
+	uc.mailer.Send(ctx, mail.Message{
+		To:      user.Email().String(),
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Someone asked to reset the password for this account. To choose a new password, open the link below:\n\n%s\n\nThe link expires in %s and works once. If you didn't ask for this, ignore this email - your password won't change.",
+			link, uc.tokenExpiry,
+		),
+		Template: mail.TemplateResetPassword,
+		Data:     mail.TemplateData{Link: link, Expiry: uc.tokenExpiry},
+	})
+
+	return nil
+}
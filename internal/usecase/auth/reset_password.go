@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// ResetPasswordUseCase sets a new password from a reset token
+type ResetPasswordUseCase struct {
+	userRepo       repository.UserRepository
+	passwordHasher security.PasswordHasher
+	resetTokenRepo repository.PasswordResetTokenRepository
+	sessions       *SessionManager
+}
+
+// NewResetPasswordUseCase creates a new reset password use case
+func NewResetPasswordUseCase(
+	userRepo repository.UserRepository,
+	passwordHasher security.PasswordHasher,
+	resetTokenRepo repository.PasswordResetTokenRepository,
+	sessions *SessionManager,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		userRepo:       userRepo,
+		passwordHasher: passwordHasher,
+		resetTokenRepo: resetTokenRepo,
+		sessions:       sessions,
+	}
+}
+
+// Execute resets the password and ends every existing session
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, req usecase.ResetPasswordRequest) error {
+	// Step 1: Validate new password
+	// WHY: Before touching the token - a rejected password must not burn the link
+	password, err := valueobject.NewPassword(req.NewPassword)
+	if err != nil {
+		return domainErrors.NewInvalidInputError(err.Error(), "new_password")
+	}
+
+	// Step 2: Look up token
+	tokenHash := security.HashToken(req.Token)
+	resetToken, err := uc.resetTokenRepo.FindByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, repository.ErrTokenNotFound) {
+			return domainErrors.NewUnauthorizedError("invalid or expired reset token")
+		}
+		return fmt.Errorf("failed to find reset token: %w", err)
+	}
+
+	if !resetToken.CanBeUsed() {
+		return domainErrors.NewUnauthorizedError("invalid or expired reset token")
+	}
+
+	// Step 3: Find user
+	user, err := uc.userRepo.FindByID(ctx, resetToken.UserID())
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domainErrors.NewUnauthorizedError("invalid or expired reset token")
+		}
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if !user.CanLogin() {
+		return domainErrors.NewForbiddenError("account is inactive")
+	}
+
+	// Step 4: Consume token
+	// WHY: Atomic - two concurrent resets with one link can't both succeed
+	if err := uc.resetTokenRepo.MarkUsed(ctx, tokenHash); err != nil {
+		if errors.Is(err, repository.ErrTokenAlreadyUsed) {
+			return domainErrors.NewUnauthorizedError("invalid or expired reset token")
+		}
+		return fmt.Errorf("failed to consume reset token: %w", err)
+	}
+
+	// Step 5: Hash and save new password
+	hashedPassword, err := uc.passwordHasher.Hash(password.Hash())
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err := user.UpdatePassword(valueobject.NewPasswordFromHash(hashedPassword)); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	// WHY: The link was delivered to the inbox, which proves ownership
+	user.MarkEmailVerified()
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// Step 6: End existing sessions
+	// SECURITY: Whoever had the old password may hold refresh tokens
+	if err := uc.sessions.EndAll(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return nil
+}
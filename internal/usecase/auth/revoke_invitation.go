@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// RevokeInvitationUseCase cancels a pending invitation (admin operation)
+type RevokeInvitationUseCase struct {
+	invitationRepo repository.InvitationRepository
+	auditLog       *AuditLog
+}
+
+// NewRevokeInvitationUseCase creates a new revoke invitation use case
+func NewRevokeInvitationUseCase(invitationRepo repository.InvitationRepository, auditLog *AuditLog) *RevokeInvitationUseCase {
+	return &RevokeInvitationUseCase{
+		invitationRepo: invitationRepo,
+		auditLog:       auditLog,
+	}
+}
+
+// Execute revokes the invitation so its link stops working
+// NOTE: Idempotent - revoking a revoked or expired invitation succeeds
+func (uc *RevokeInvitationUseCase) Execute(ctx context.Context, req usecase.RevokeInvitationRequest) (*usecase.InvitationDetails, error) {
+	// Step 1: Find invitation
+	invitation, err := uc.invitationRepo.FindByID(ctx, req.InvitationID)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvitationNotFound) {
+			return nil, domainErrors.NewNotFoundError("invitation not found")
+		}
+		return nil, fmt.Errorf("failed to find invitation: %w", err)
+	}
+
+	// SECURITY: Admins only manage their own tenant's invitations
+	// WHY: Not found rather than forbidden - IDs from other tenants stay opaque
+	if !invitation.TenantID().Equals(usecase.TenantFromContext(ctx)) {
+		return nil, domainErrors.NewNotFoundError("invitation not found")
+	}
+
+	// Step 2: Revoke
+	if err := invitation.Revoke(); err != nil {
+		return nil, domainErrors.NewConflictError("invitation already accepted")
+	}
+
+	if err := uc.invitationRepo.Revoke(ctx, invitation.ID()); err != nil {
+		if errors.Is(err, repository.ErrTokenAlreadyUsed) {
+			// Race: accepted between the read and the update
+			return nil, domainErrors.NewConflictError("invitation already accepted")
+		}
+		return nil, fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+
+	// Step 3: Record
+	err = uc.auditLog.Record(ctx, req.ActorID, entity.AuditActionRevokeInvitation, nil, map[string]string{
+		"invitation_id": invitation.ID(),
+		"email":         invitation.Email().String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toInvitationDetails(invitation), nil
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// RevokeOtherSessionsUseCase signs an authenticated user out everywhere
+// except the device making the request
+type RevokeOtherSessionsUseCase struct {
+	userRepo    repository.UserRepository
+	sessionRepo repository.SessionRepository
+	sessions    *SessionManager
+}
+
+// NewRevokeOtherSessionsUseCase creates a new revoke other sessions use case
+func NewRevokeOtherSessionsUseCase(
+	userRepo repository.UserRepository,
+	sessionRepo repository.SessionRepository,
+	sessions *SessionManager,
+) *RevokeOtherSessionsUseCase {
+	return &RevokeOtherSessionsUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		sessions:    sessions,
+	}
+}
+
+// Execute ends every session but the current one
+// NOTE: Refresh tokens from before sessions were recorded have no session
+// to end - they run out on their own
+func (uc *RevokeOtherSessionsUseCase) Execute(ctx context.Context, req usecase.ListSessionsRequest) error {
+	// Step 1: Load caller
+	user, err := loadActiveUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Find their sessions
+	sessions, err := uc.sessionRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return fmt.Errorf("failed to find sessions: %w", err)
+	}
+
+	// Step 3: End all but the caller's
+	for _, session := range sessions {
+		if session.ID() == req.CurrentSessionID {
+			continue
+		}
+		if err := uc.sessions.End(ctx, session); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
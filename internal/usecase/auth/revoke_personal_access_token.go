@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// RevokePersonalAccessTokenUseCase revokes one of an authenticated user's personal access tokens
+type RevokePersonalAccessTokenUseCase struct {
+	userRepo  repository.UserRepository
+	tokenRepo repository.PersonalAccessTokenRepository
+}
+
+// NewRevokePersonalAccessTokenUseCase creates a new revoke personal access token use case
+func NewRevokePersonalAccessTokenUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.PersonalAccessTokenRepository,
+) *RevokePersonalAccessTokenUseCase {
+	return &RevokePersonalAccessTokenUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Execute deletes the token; it stops working immediately
+func (uc *RevokePersonalAccessTokenUseCase) Execute(ctx context.Context, req usecase.RevokePersonalAccessTokenRequest) error {
+	if req.TokenID == "" {
+		return domainErrors.NewInvalidInputError("token ID is required", "token_id")
+	}
+
+	user, err := loadActiveUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.tokenRepo.Delete(ctx, user.ID(), req.TokenID); err != nil {
+		if errors.Is(err, repository.ErrTokenNotFound) {
+			return domainErrors.NewNotFoundError("personal access token not found")
+		}
+		return fmt.Errorf("failed to revoke personal access token: %w", err)
+	}
+
+	return nil
+}
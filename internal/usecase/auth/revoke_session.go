@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// RevokeSessionUseCase signs one of an authenticated user's devices out
+type RevokeSessionUseCase struct {
+	userRepo    repository.UserRepository
+	sessionRepo repository.SessionRepository
+	sessions    *SessionManager
+}
+
+// NewRevokeSessionUseCase creates a new revoke session use case
+func NewRevokeSessionUseCase(
+	userRepo repository.UserRepository,
+	sessionRepo repository.SessionRepository,
+	sessions *SessionManager,
+) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		sessions:    sessions,
+	}
+}
+
+// Execute ends the session; its refresh token and access tokens stop working immediately
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, req usecase.RevokeSessionRequest) error {
+	// Step 1: Validate input
+	if req.SessionID == "" {
+		return domainErrors.NewInvalidInputError("session ID is required", "session_id")
+	}
+
+	// Step 2: Load caller
+	user, err := loadActiveUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Find the session
+	session, err := uc.sessionRepo.FindByID(ctx, req.SessionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return domainErrors.NewNotFoundError("session not found")
+		}
+		return fmt.Errorf("failed to find session: %w", err)
+	}
+
+	// SECURITY: Another user's session looks the same as a missing one
+	if !session.UserID().Equals(user.ID()) {
+		return domainErrors.NewNotFoundError("session not found")
+	}
+
+	// Step 4: End it
+	return uc.sessions.End(ctx, session)
+}
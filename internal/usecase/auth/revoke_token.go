@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// RevokeTokenUseCase implements single-token revocation
+// WHY: Lets clients invalidate a token before it expires
+type RevokeTokenUseCase struct {
+	jwtGenerator     security.JWTGenerator
+	revokedTokenRepo repository.RevokedTokenRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	sessions         *SessionManager
+}
+
+// NewRevokeTokenUseCase creates a new revoke token use case
+func NewRevokeTokenUseCase(
+	jwtGenerator security.JWTGenerator,
+	revokedTokenRepo repository.RevokedTokenRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	sessions *SessionManager,
+) *RevokeTokenUseCase {
+	return &RevokeTokenUseCase{
+		jwtGenerator:     jwtGenerator,
+		revokedTokenRepo: revokedTokenRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		sessions:         sessions,
+	}
+}
+
+// Execute revokes an access or refresh token
+func (uc *RevokeTokenUseCase) Execute(ctx context.Context, tokenString string) error {
+	// Step 1: Validate token (either kind may be revoked)
+	// WHY: Only our own, unexpired tokens need revoking
+	claims, err := uc.jwtGenerator.ValidateToken(tokenString, security.TokenUseAccess)
+	if errors.Is(err, security.ErrWrongTokenUse) {
+		claims, err = uc.jwtGenerator.ValidateToken(tokenString, security.TokenUseRefresh)
+	}
+	if err != nil {
+		return domainErrors.NewUnauthorizedError("invalid token")
+	}
+
+	// Step 2: Revoke it
+	return uc.revoke(ctx, tokenString, claims)
+}
+
+// revoke denylists a validated token and, for refresh tokens, its family
+func (uc *RevokeTokenUseCase) revoke(
+	ctx context.Context,
+	tokenString string,
+	claims *security.Claims,
+) error {
+	// Step 1: Tokens without jti/exp can't be denylisted
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		return domainErrors.NewInvalidInputError("token cannot be revoked", "token")
+	}
+
+	userID, err := valueobject.NewUserIDFromString(claims.UserID)
+	if err != nil {
+		return domainErrors.NewUnauthorizedError("invalid user ID in token")
+	}
+
+	// Step 2: Add jti to denylist until the token would expire anyway
+	entry, err := entity.NewRevokedToken(claims.ID, userID, claims.ExpiresAt.Time)
+	if err != nil {
+		return fmt.Errorf("failed to create revocation entry: %w", err)
+	}
+
+	if err := uc.revokedTokenRepo.Create(ctx, entry); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	// Step 3: If it's a tracked refresh token, end its session
+	// WHY: Tokens already rotated from it must not survive either
+	stored, err := uc.refreshTokenRepo.FindByHash(ctx, security.HashToken(tokenString))
+	if err != nil {
+		if errors.Is(err, repository.ErrTokenNotFound) {
+			return nil // Access token (or untracked) - denylist is enough
+		}
+		return fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	return uc.sessions.EndFamily(ctx, stored.FamilyID())
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// RevokeUserSessionsUseCase signs a user out of every device (admin operation)
+// WHY: For a lost laptop or a stolen token - unlike deactivating, the user
+// can sign straight back in
+type RevokeUserSessionsUseCase struct {
+	userRepo repository.UserRepository
+	sessions *SessionManager
+	auditLog *AuditLog
+}
+
+// NewRevokeUserSessionsUseCase creates a new revoke user sessions use case
+func NewRevokeUserSessionsUseCase(
+	userRepo repository.UserRepository,
+	sessions *SessionManager,
+	auditLog *AuditLog,
+) *RevokeUserSessionsUseCase {
+	return &RevokeUserSessionsUseCase{
+		userRepo: userRepo,
+		sessions: sessions,
+		auditLog: auditLog,
+	}
+}
+
+// Execute ends every session the user has
+func (uc *RevokeUserSessionsUseCase) Execute(ctx context.Context, req usecase.AdminUserRequest) error {
+	// Step 1: Find target
+	user, err := findTargetUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Step 2: End their sessions
+	if err := uc.sessions.EndAll(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	// Step 3: Record
+	return uc.auditLog.Record(ctx, req.ActorID, entity.AuditActionRevokeSessions, user, nil)
+}
@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// SendVerificationUseCase emails a signed, expiring verification link
+type SendVerificationUseCase struct {
+	userRepo        repository.UserRepository
+	jwtGenerator    security.JWTGenerator
+	mailer          mail.Mailer
+	tokenExpiry     time.Duration
+	verificationURL string
+}
+
+// NewSendVerificationUseCase creates a new send verification use case
+func NewSendVerificationUseCase(
+	userRepo repository.UserRepository,
+	jwtGenerator security.JWTGenerator,
+	mailer mail.Mailer,
+	tokenExpiry time.Duration,
+	verificationURL string,
+) *SendVerificationUseCase {
+	return &SendVerificationUseCase{
+		userRepo:        userRepo,
+		jwtGenerator:    jwtGenerator,
+		mailer:          mailer,
+		tokenExpiry:     tokenExpiry,
+		verificationURL: verificationURL,
+	}
+}
+
+// Execute (re)sends the verification email for an address
+// SECURITY: Unknown, inactive and already verified addresses succeed
+// silently - the response must not reveal which emails are registered
+func (uc *SendVerificationUseCase) Execute(ctx context.Context, emailAddress string) error {
+	// Step 1: Validate email format
+	email, err := valueobject.NewEmail(emailAddress)
+	if err != nil {
+		return domainErrors.NewInvalidInputError("invalid email format", "email")
+	}
+
+	// Step 2: Find user
+	user, err := uc.userRepo.FindByEmail(ctx, usecase.TenantFromContext(ctx), email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// Step 3: Skip accounts that don't need verifying
+	if user.IsEmailVerified() || !user.CanLogin() {
+		return nil
+	}
+
+	// Step 4: Send
+	return uc.send(ctx, user)
+}
+
+// send emails a verification link to the user's current address
+func (uc *SendVerificationUseCase) send(ctx context.Context, user *entity.User) error {
+	link, err := uc.link(user.ID(), user.Email())
+	if err != nil {
+		return err
+	}
+
+	err = uc.mailer.Send(ctx, mail.Message{
+		To:      user.Email().String(),
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Confirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s. If you didn't create an account, ignore this email.",
+			link, uc.tokenExpiry,
+		),
+		Template: mail.TemplateVerifyEmail,
+		Data:     mail.TemplateData{Link: link, Expiry: uc.tokenExpiry},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	return nil
+}
+
+// sendEmailChange tells the current address about a pending email change
+// and asks the new address to confirm it
+func (uc *SendVerificationUseCase) sendEmailChange(ctx context.Context, user *entity.User) error {
+	// Step 1: Notice to the current address
+	// SECURITY: If the password was stolen, the owner hears about the change
+	// before it can take effect
+	err := uc.mailer.Send(ctx, mail.Message{
+		To:      user.Email().String(),
+		Subject: "Your email address is being changed",
+		Body: fmt.Sprintf(
+			"Someone asked to change your account's email address to %s. The change only takes effect if the link sent to that address is opened within %s.\n\nIf this wasn't you, change your password now - the request needed your current one.",
+			user.PendingEmail(), uc.tokenExpiry,
+		),
+		Template: mail.TemplateEmailChangeNotice,
+		Data:     mail.TemplateData{Email: user.PendingEmail().String(), Expiry: uc.tokenExpiry},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send email change notice: %w", err)
+	}
+
+	// Step 2: Confirmation link to the new address
+	// WHY: Bound to the pending address - VerifyEmail only moves the account
+	// to the address the link was sent to
+	link, err := uc.link(user.ID(), user.PendingEmail())
+	if err != nil {
+		return err
+	}
+
+	err = uc.mailer.Send(ctx, mail.Message{
+		To:      user.PendingEmail().String(),
+		Subject: "Confirm your new email address",
+		Body: fmt.Sprintf(
+			"Confirm this address for your account by opening the link below:\n\n%s\n\nThe link expires in %s. Until then, the account keeps its current address. If you didn't ask for this, ignore this email.",
+			link, uc.tokenExpiry,
+		),
+		Template: mail.TemplateConfirmEmailChange,
+		Data:     mail.TemplateData{Link: link, Expiry: uc.tokenExpiry},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send email change confirmation: %w", err)
+	}
+
+	return nil
+}
+
+// link builds a verification link for one of the user's addresses
+// WHY: Signed token bound to the address - no server-side state needed,
+// and a link for an old address stops working once the email changes
+func (uc *SendVerificationUseCase) link(userID valueobject.UserID, email valueobject.Email) (string, error) {
+	token, err := uc.jwtGenerator.GenerateActionToken(
+		userID,
+		email,
+		security.TokenUseEmailVerification,
+		uc.tokenExpiry,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification token: %w", err)
+	}
+
+	return uc.verificationURL + "?token=" + url.QueryEscape(token), nil
+}
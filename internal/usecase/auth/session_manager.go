@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// SessionManager ends sessions together with their refresh tokens
+// WHY: A session outlives its refresh token only as a confusing entry in
+// the user's device list - every flow that signs someone out must end both
+type SessionManager struct {
+	refreshTokenRepo repository.RefreshTokenRepository
+	sessionRepo      repository.SessionRepository
+}
+
+// NewSessionManager creates a new session manager
+func NewSessionManager(
+	refreshTokenRepo repository.RefreshTokenRepository,
+	sessionRepo repository.SessionRepository,
+) *SessionManager {
+	return &SessionManager{
+		refreshTokenRepo: refreshTokenRepo,
+		sessionRepo:      sessionRepo,
+	}
+}
+
+// End ends one session: its refresh tokens are revoked and its access
+// tokens stop validating
+func (m *SessionManager) End(ctx context.Context, session *entity.Session) error {
+	if err := m.refreshTokenRepo.RevokeFamily(ctx, session.FamilyID()); err != nil {
+		return fmt.Errorf("failed to revoke token family: %w", err)
+	}
+	if err := m.sessionRepo.Delete(ctx, session.ID()); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+// EndByID ends the session with the given ID (already ended is not an error)
+func (m *SessionManager) EndByID(ctx context.Context, sessionID string) error {
+	session, err := m.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to find session: %w", err)
+	}
+	return m.End(ctx, session)
+}
+
+// EndFamily ends the session a refresh token family belongs to
+func (m *SessionManager) EndFamily(ctx context.Context, familyID string) error {
+	if err := m.refreshTokenRepo.RevokeFamily(ctx, familyID); err != nil {
+		return fmt.Errorf("failed to revoke token family: %w", err)
+	}
+	if err := m.sessionRepo.DeleteByFamilyID(ctx, familyID); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+// EndAll ends every session a user has
+// NOTE: Also revokes refresh tokens from before sessions were recorded
+func (m *SessionManager) EndAll(ctx context.Context, userID valueobject.UserID) error {
+	if err := m.refreshTokenRepo.RevokeAllForUser(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+	if err := m.sessionRepo.DeleteByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// SetServiceClientActiveUseCase enables or disables a service client (operator task)
+// NOTE: Inactive clients get no tokens, and the tokens they hold fail validation
+type SetServiceClientActiveUseCase struct {
+	serviceClientRepo repository.ServiceClientRepository
+}
+
+// NewSetServiceClientActiveUseCase creates a new set service client active use case
+func NewSetServiceClientActiveUseCase(serviceClientRepo repository.ServiceClientRepository) *SetServiceClientActiveUseCase {
+	return &SetServiceClientActiveUseCase{
+		serviceClientRepo: serviceClientRepo,
+	}
+}
+
+// Execute sets the client's active flag
+func (uc *SetServiceClientActiveUseCase) Execute(ctx context.Context, clientID string, active bool) (*entity.ServiceClient, error) {
+	// Step 1: Find the client in the context's tenant
+	// SECURITY: Another tenant's client is reported as not found
+	client, err := uc.serviceClientRepo.FindByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, repository.ErrServiceClientNotFound) {
+			return nil, domainErrors.NewNotFoundError("service client not found")
+		}
+		return nil, fmt.Errorf("failed to find service client: %w", err)
+	}
+
+	if !client.TenantID().Equals(usecase.TenantFromContext(ctx)) {
+		return nil, domainErrors.NewNotFoundError("service client not found")
+	}
+
+	// Step 2: Apply and save (only if something changed)
+	if client.IsActive() != active {
+		if active {
+			client.Activate()
+		} else {
+			client.Deactivate()
+		}
+
+		if err := uc.serviceClientRepo.Update(ctx, client); err != nil {
+			return nil, fmt.Errorf("failed to update service client: %w", err)
+		}
+	}
+
+	return client, nil
+}
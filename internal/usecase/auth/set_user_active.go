@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// SetUserActiveUseCase activates or deactivates an account (admin operation)
+// NOTE: Inactive users can't log in, refresh or use their access tokens
+type SetUserActiveUseCase struct {
+	userRepo repository.UserRepository
+	sessions *SessionManager
+	auditLog *AuditLog
+}
+
+// NewSetUserActiveUseCase creates a new set user active use case
+func NewSetUserActiveUseCase(
+	userRepo repository.UserRepository,
+	sessions *SessionManager,
+	auditLog *AuditLog,
+) *SetUserActiveUseCase {
+	return &SetUserActiveUseCase{
+		userRepo: userRepo,
+		sessions: sessions,
+		auditLog: auditLog,
+	}
+}
+
+// Execute sets the account's active flag
+// NOTE: Idempotent - activating an active account succeeds (and is recorded)
+func (uc *SetUserActiveUseCase) Execute(
+	ctx context.Context,
+	req usecase.AdminUserRequest,
+	active bool,
+) (*usecase.UserDetails, error) {
+	// Step 1: Keep admins from locking themselves out
+	if !active && req.ActorID != "" && req.ActorID == req.UserID {
+		return nil, domainErrors.NewForbiddenError("cannot deactivate your own account")
+	}
+
+	// Step 2: Find target
+	user, err := findTargetUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 3: Apply and save (only if something changed)
+	if user.IsActive() != active {
+		if active {
+			user.Activate()
+		} else {
+			user.Deactivate()
+		}
+
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+
+	// Step 4: End existing sessions
+	// WHY: Reactivating the account must not bring old refresh tokens back
+	if !active {
+		if err := uc.sessions.EndAll(ctx, user.ID()); err != nil {
+			return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+	}
+
+	// Step 5: Record
+	action := entity.AuditActionActivateUser
+	if !active {
+		action = entity.AuditActionDeactivateUser
+	}
+	if err := uc.auditLog.Record(ctx, req.ActorID, action, user, nil); err != nil {
+		return nil, err
+	}
+
+	return toUserDetails(user), nil
+}
@@ -17,21 +17,34 @@ import (
 // WHY: Orchestrates signup flow (validation, hashing, storage, token generation)
 type SignupUseCase struct {
 	userRepo       repository.UserRepository
+	orgRepo        repository.OrganizationRepository
 	passwordHasher security.PasswordHasher
-	jwtGenerator   security.JWTGenerator
+	tokenIssuer    *TokenIssuer
+	verification   *SendVerificationUseCase
+
+	requireVerifiedEmail bool // No tokens until the email is verified
+	inviteOnly           bool // Accounts are only created by accepting an invitation
 }
 
 // NewSignupUseCase creates a new signup use case
 // WHY: Dependency injection - all dependencies passed in
 func NewSignupUseCase(
 	userRepo repository.UserRepository,
+	orgRepo repository.OrganizationRepository,
 	passwordHasher security.PasswordHasher,
-	jwtGenerator security.JWTGenerator,
+	tokenIssuer *TokenIssuer,
+	verification *SendVerificationUseCase,
+	requireVerifiedEmail bool,
+	inviteOnly bool,
 ) *SignupUseCase {
 	return &SignupUseCase{
-		userRepo:       userRepo,
-		passwordHasher: passwordHasher,
-		jwtGenerator:   jwtGenerator,
+		userRepo:             userRepo,
+		orgRepo:              orgRepo,
+		passwordHasher:       passwordHasher,
+		tokenIssuer:          tokenIssuer,
+		verification:         verification,
+		requireVerifiedEmail: requireVerifiedEmail,
+		inviteOnly:           inviteOnly,
 	}
 }
 
@@ -41,7 +54,13 @@ func (uc *SignupUseCase) Execute(
 	ctx context.Context,
 	req usecase.SignupRequest,
 ) (*usecase.SignupResponse, error) {
-	// Step 1: Validate input (create value objects)
+	// Step 1: Open registration may be switched off
+	// WHY: Invite-only deployments register through AcceptInvitation
+	if uc.inviteOnly {
+		return nil, domainErrors.NewForbiddenError("signup is by invitation only")
+	}
+
+	// Step 2: Validate input (create value objects)
 	// WHY: Value objects enforce business rules
 	email, err := valueobject.NewEmail(req.Email)
 	if err != nil {
@@ -59,9 +78,19 @@ func (uc *SignupUseCase) Execute(
 		)
 	}
 
-	// Step 2: Check if user already exists
-	// WHY: Business rule - emails must be unique
-	exists, err := uc.userRepo.ExistsByEmail(ctx, email)
+	// Step 3: Resolve the tenant
+	// WHY: Accounts can only be created in organizations that exist
+	tenantID := usecase.TenantFromContext(ctx)
+	if _, err := uc.orgRepo.FindByID(ctx, tenantID); err != nil {
+		if errors.Is(err, repository.ErrOrganizationNotFound) {
+			return nil, domainErrors.NewInvalidInputError("unknown tenant", "tenant_id")
+		}
+		return nil, fmt.Errorf("failed to find organization: %w", err)
+	}
+
+	// Step 4: Check if user already exists
+	// WHY: Business rule - emails must be unique within a tenant
+	exists, err := uc.userRepo.ExistsByEmail(ctx, tenantID, email)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check email existence: %w", err)
 	}
@@ -70,24 +99,24 @@ func (uc *SignupUseCase) Execute(
 		return nil, domainErrors.NewConflictError("email already in use")
 	}
 
-	// Step 3: Hash password
+	// Step 5: Hash password
 	// WHY: Never store plain text passwords
 	hashedPassword, err := uc.passwordHasher.Hash(password.Hash())
 	if err != nil {
 		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
 
-	// Step 4: Create hashed password value object
+	// Step 6: Create hashed password value object
 	hashedPasswordVO := valueobject.NewPasswordFromHash(hashedPassword)
 
-	// Step 5: Create user entity
+	// Step 7: Create user entity
 	// WHY: Entity enforces business rules and generates ID
-	user, err := entity.NewUser(email, hashedPasswordVO)
+	user, err := entity.NewUser(tenantID, email, hashedPasswordVO)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	// Step 6: Save to repository
+	// Step 8: Save to repository
 	// WHY: Persist the user
 	if err := uc.userRepo.Create(ctx, user); err != nil {
 		// Translate repository errors to domain errors
@@ -98,25 +127,34 @@ func (uc *SignupUseCase) Execute(
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	// Step 7: Generate tokens
+	// Step 9: Send verification email
+	// WHY: Best effort - the account exists either way, and a failed send
+	// can be retried through SendVerification
+	_ = uc.verification.send(ctx, user)
+
+	// Step 10: Unverified users get no tokens when verification is required
+	if uc.requireVerifiedEmail {
+		return &usecase.SignupResponse{
+			UserID:               user.ID().String(),
+			Email:                user.Email().String(),
+			VerificationRequired: true,
+		}, nil
+	}
+
+	// Step 11: Generate tokens (starts a new refresh token family)
 	// WHY: User can immediately use the service after signup
-	accessToken, err := uc.jwtGenerator.GenerateAccessToken(user.ID(), user.Email())
+	tokens, err := uc.tokenIssuer.Issue(ctx, user, entity.NewTokenFamilyID())
 	if err != nil {
 		// User is created but token generation failed
 		// Log this for monitoring
-		return nil, fmt.Errorf("failed to generate access token: %w", err)
-	}
-
-	refreshToken, err := uc.jwtGenerator.GenerateRefreshToken(user.ID())
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+		return nil, err
 	}
 
-	// Step 8: Return response
+	// Step 12: Return response
 	return &usecase.SignupResponse{
 		UserID:       user.ID().String(),
 		Email:        user.Email().String(),
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
@@ -0,0 +1,209 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// TokenPair is a freshly issued access/refresh token pair
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	AuthTime     time.Time // When the user signed in (zero if unknown)
+}
+
+// ClientTokens are tokens issued to an OAuth client
+type ClientTokens struct {
+	*TokenPair
+	User  *entity.User         // Who the tokens are for
+	Grant security.ClientGrant // The access token's grant
+	Nonce string               // Authorization code grant only: from the authorization request
+}
+
+// TokenIssuer mints token pairs and records refresh tokens and sessions server-side
+// WHY: Signup, login and refresh must all track refresh tokens the same way
+type TokenIssuer struct {
+	jwtGenerator       security.JWTGenerator
+	refreshTokenRepo   repository.RefreshTokenRepository
+	sessionRepo        repository.SessionRepository
+	sessions           *SessionManager
+	refreshTokenExpiry time.Duration
+}
+
+// NewTokenIssuer creates a new token issuer
+func NewTokenIssuer(
+	jwtGenerator security.JWTGenerator,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	sessionRepo repository.SessionRepository,
+	refreshTokenExpiry time.Duration,
+) *TokenIssuer {
+	return &TokenIssuer{
+		jwtGenerator:       jwtGenerator,
+		refreshTokenRepo:   refreshTokenRepo,
+		sessionRepo:        sessionRepo,
+		sessions:           NewSessionManager(refreshTokenRepo, sessionRepo),
+		refreshTokenExpiry: refreshTokenExpiry,
+	}
+}
+
+// Issue generates a token pair for user and records the refresh token
+// in familyID (use entity.NewTokenFamilyID() to start a new family)
+// NOTE: Only call it once the user has just authenticated - the tokens
+// record now as the sign-in time, and a new session starts
+func (i *TokenIssuer) Issue(
+	ctx context.Context,
+	user *entity.User,
+	familyID string,
+) (*TokenPair, error) {
+	session, err := i.newSession(ctx, user, familyID, "")
+	if err != nil {
+		return nil, err
+	}
+	return i.issue(ctx, user, session, true, time.Now(), nil, nil)
+}
+
+// IssueForClient is Issue for an OAuth client: both tokens carry the
+// client ID as aud and the granted scopes
+// authTime is when the user signed in before consenting
+func (i *TokenIssuer) IssueForClient(
+	ctx context.Context,
+	user *entity.User,
+	familyID string,
+	authTime time.Time,
+	grant security.ClientGrant,
+) (*TokenPair, error) {
+	session, err := i.newSession(ctx, user, familyID, grant.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	return i.issue(ctx, user, session, true, authTime, &grant, &grant)
+}
+
+// reissue is issue for a refresh in familyID: the family's session records
+// the device and time
+// NOTE: Families from before sessions were recorded get one here
+func (i *TokenIssuer) reissue(
+	ctx context.Context,
+	user *entity.User,
+	familyID string,
+	authTime time.Time,
+	accessGrant *security.ClientGrant,
+	refreshGrant *security.ClientGrant,
+) (*TokenPair, error) {
+	session, err := i.sessionRepo.FindByFamilyID(ctx, familyID)
+	if errors.Is(err, repository.ErrSessionNotFound) {
+		var clientID string
+		if refreshGrant != nil {
+			clientID = refreshGrant.ClientID
+		}
+		session, err = i.newSession(ctx, user, familyID, clientID)
+		if err != nil {
+			return nil, err
+		}
+		return i.issue(ctx, user, session, true, authTime, accessGrant, refreshGrant)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find session: %w", err)
+	}
+
+	client := usecase.ClientInfoFromContext(ctx)
+	session.Touch(client.UserAgent, client.IPAddress, time.Now().Add(i.refreshTokenExpiry))
+	return i.issue(ctx, user, session, false, authTime, accessGrant, refreshGrant)
+}
+
+// newSession builds (but doesn't save) a session on the requesting device
+func (i *TokenIssuer) newSession(
+	ctx context.Context,
+	user *entity.User,
+	familyID string,
+	clientID string,
+) (*entity.Session, error) {
+	client := usecase.ClientInfoFromContext(ctx)
+	session, err := entity.NewSession(
+		user.ID(),
+		familyID,
+		clientID,
+		client.UserAgent,
+		client.IPAddress,
+		time.Now().Add(i.refreshTokenExpiry),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	return session, nil
+}
+
+// issue generates and records a token pair in session; nil grants mean
+// first-party tokens
+// WHY: Separate grants let the refresh grant narrow the access token's scope
+// while the refresh token keeps the original scope (RFC 6749 section 6)
+func (i *TokenIssuer) issue(
+	ctx context.Context,
+	user *entity.User,
+	session *entity.Session,
+	newSession bool,
+	authTime time.Time,
+	accessGrant *security.ClientGrant,
+	refreshGrant *security.ClientGrant,
+) (*TokenPair, error) {
+	access := user.Access()
+
+	var accessToken string
+	var err error
+	if accessGrant == nil {
+		accessToken, err = i.jwtGenerator.GenerateAccessToken(user.ID(), user.TenantID(), user.Email(), access.Roles, access.Permissions, authTime, session.ID())
+	} else {
+		accessToken, err = i.jwtGenerator.GenerateClientAccessToken(user.ID(), user.TenantID(), user.Email(), access.Roles, access.Permissions, authTime, session.ID(), *accessGrant)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate access token: %w", err)
+	}
+
+	var refreshToken string
+	if refreshGrant == nil {
+		refreshToken, err = i.jwtGenerator.GenerateRefreshToken(user.ID(), user.TenantID(), authTime)
+	} else {
+		refreshToken, err = i.jwtGenerator.GenerateClientRefreshToken(user.ID(), user.TenantID(), authTime, *refreshGrant)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	// Record refresh token (hashed) so it can be rotated exactly once
+	record, err := entity.NewRefreshToken(
+		security.HashToken(refreshToken),
+		session.FamilyID(),
+		user.ID(),
+		session.ExpiresAt(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create refresh token record: %w", err)
+	}
+
+	if err := i.refreshTokenRepo.Create(ctx, record); err != nil {
+		return nil, fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	// Save the session last, so it never lists a device without tokens
+	if newSession {
+		err = i.sessionRepo.Create(ctx, session)
+	} else {
+		err = i.sessionRepo.Update(ctx, session)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save session: %w", err)
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		AuthTime:     authTime,
+	}, nil
+}
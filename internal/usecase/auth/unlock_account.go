@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// UnlockAccountUseCase clears failed-login counters (admin operation)
+type UnlockAccountUseCase struct {
+	userRepo    repository.UserRepository
+	attemptRepo repository.LoginAttemptRepository
+	auditLog    *AuditLog
+}
+
+// NewUnlockAccountUseCase creates a new unlock account use case
+func NewUnlockAccountUseCase(
+	userRepo repository.UserRepository,
+	attemptRepo repository.LoginAttemptRepository,
+	auditLog *AuditLog,
+) *UnlockAccountUseCase {
+	return &UnlockAccountUseCase{
+		userRepo:    userRepo,
+		attemptRepo: attemptRepo,
+		auditLog:    auditLog,
+	}
+}
+
+// Execute lifts any backoff or lockout on the user, from every IP
+// NOTE: Only the user's own organization - the same email elsewhere keeps its counters
+func (uc *UnlockAccountUseCase) Execute(ctx context.Context, req usecase.AdminUserRequest) error {
+	// Step 1: Find target
+	user, err := findTargetUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Clear the counters
+	if err := uc.attemptRepo.DeleteByEmail(ctx, user.TenantID(), user.Email()); err != nil {
+		return fmt.Errorf("failed to unlock account: %w", err)
+	}
+
+	// Step 3: Record
+	return uc.auditLog.Record(ctx, req.ActorID, entity.AuditActionUnlockUser, user, nil)
+}
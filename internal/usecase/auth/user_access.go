@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// AccessChange is a grant or revoke of one role or permission
+type AccessChange int
+
+const (
+	GrantRole AccessChange = iota
+	RevokeRole
+	GrantPermission
+	RevokePermission
+)
+
+// apply changes user, returning false if it was already in the requested state
+func (c AccessChange) apply(user *entity.User, name string) (bool, error) {
+	switch c {
+	case GrantRole:
+		return user.GrantRole(name)
+	case RevokeRole:
+		return user.RevokeRole(name), nil
+	case GrantPermission:
+		return user.GrantPermission(name)
+	case RevokePermission:
+		return user.RevokePermission(name), nil
+	default:
+		return false, fmt.Errorf("unknown access change %d", c)
+	}
+}
+
+// auditAction names the change in the audit log
+func (c AccessChange) auditAction() entity.AuditAction {
+	switch c {
+	case GrantRole:
+		return entity.AuditActionGrantRole
+	case RevokeRole:
+		return entity.AuditActionRevokeRole
+	case GrantPermission:
+		return entity.AuditActionGrantPermission
+	default:
+		return entity.AuditActionRevokePermission
+	}
+}
+
+// GetUserAccessUseCase returns a user's roles and permissions (admin operation)
+type GetUserAccessUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewGetUserAccessUseCase creates a new get user access use case
+func NewGetUserAccessUseCase(userRepo repository.UserRepository) *GetUserAccessUseCase {
+	return &GetUserAccessUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute looks up the user's grants
+func (uc *GetUserAccessUseCase) Execute(ctx context.Context, userID string) (*usecase.UserAccess, error) {
+	user, err := findTargetUser(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserAccess(user), nil
+}
+
+// UpdateUserAccessUseCase grants or revokes roles and permissions (admin operation)
+// NOTE: Tokens already issued keep their old claims until they expire;
+// ValidateToken and refreshed tokens see the change immediately
+type UpdateUserAccessUseCase struct {
+	userRepo repository.UserRepository
+	auditLog *AuditLog
+}
+
+// NewUpdateUserAccessUseCase creates a new update user access use case
+func NewUpdateUserAccessUseCase(userRepo repository.UserRepository, auditLog *AuditLog) *UpdateUserAccessUseCase {
+	return &UpdateUserAccessUseCase{
+		userRepo: userRepo,
+		auditLog: auditLog,
+	}
+}
+
+// Execute applies change to the target user
+// NOTE: Idempotent - granting a held role or revoking a missing one succeeds
+func (uc *UpdateUserAccessUseCase) Execute(
+	ctx context.Context,
+	req usecase.UserAccessRequest,
+	change AccessChange,
+) (*usecase.UserAccess, error) {
+	// Step 1: Find target
+	user, err := findTargetUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Keep admins from locking themselves out
+	// WHY: With no admin left, only the CLI can grant the role again
+	if req.ActorID != "" && req.ActorID == req.UserID && change == RevokeRole && req.Name == entity.RoleAdmin {
+		return nil, domainErrors.NewForbiddenError("cannot revoke your own admin role")
+	}
+
+	// Step 3: Apply
+	changed, err := change.apply(user, req.Name)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError(err.Error(), "name")
+	}
+
+	// Step 4: Save (only if something changed)
+	if changed {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+
+	// Step 5: Record
+	err = uc.auditLog.Record(ctx, req.ActorID, change.auditAction(), user, map[string]string{
+		"name":    req.Name,
+		"changed": strconv.FormatBool(changed),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserAccess(user), nil
+}
+
+// findTargetUser loads the account an admin operation acts on
+func findTargetUser(ctx context.Context, userRepo repository.UserRepository, userID string) (*entity.User, error) {
+	id, err := valueobject.NewUserIDFromString(userID)
+	if err != nil {
+		return nil, domainErrors.NewInvalidInputError("invalid user ID", "user_id")
+	}
+
+	user, err := userRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, domainErrors.NewNotFoundError("user not found")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// SECURITY: Admins only manage their own tenant's users
+	// WHY: Not found rather than forbidden - IDs from other tenants stay opaque
+	if !user.TenantID().Equals(usecase.TenantFromContext(ctx)) {
+		return nil, domainErrors.NewNotFoundError("user not found")
+	}
+
+	return user, nil
+}
+
+func toUserAccess(user *entity.User) *usecase.UserAccess {
+	access := user.Access()
+	return &usecase.UserAccess{
+		UserID:      user.ID().String(),
+		Roles:       access.Roles,
+		Permissions: access.Permissions,
+	}
+}
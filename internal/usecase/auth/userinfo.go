@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// UserInfoUseCase implements the OpenID Connect UserInfo endpoint
+// NOTE: Only access tokens issued to an OAuth client with the openid scope
+// are accepted (OIDC Core section 5.3)
+type UserInfoUseCase struct {
+	jwtGenerator     security.JWTGenerator
+	userRepo         repository.UserRepository
+	revokedTokenRepo repository.RevokedTokenRepository
+}
+
+// NewUserInfoUseCase creates a new userinfo use case
+func NewUserInfoUseCase(
+	jwtGenerator security.JWTGenerator,
+	userRepo repository.UserRepository,
+	revokedTokenRepo repository.RevokedTokenRepository,
+) *UserInfoUseCase {
+	return &UserInfoUseCase{
+		jwtGenerator:     jwtGenerator,
+		userRepo:         userRepo,
+		revokedTokenRepo: revokedTokenRepo,
+	}
+}
+
+// Execute returns the claims the token's scopes release about its user
+func (uc *UserInfoUseCase) Execute(ctx context.Context, tokenString string) (*usecase.UserInfo, error) {
+	// Step 1: Validate the access token
+	claims, err := uc.jwtGenerator.ValidateToken(tokenString, security.TokenUseAccess)
+	if err != nil {
+		return nil, domainErrors.NewUnauthorizedError("invalid token")
+	}
+
+	// Step 2: Require the openid scope
+	grant, ok := claims.ClientGrant()
+	if !ok || !slices.Contains(grant.Scopes, entity.ScopeOpenID) {
+		return nil, domainErrors.NewForbiddenError("token was not granted the openid scope")
+	}
+
+	// Step 3: Check denylist
+	if claims.ID != "" {
+		revoked, err := uc.revokedTokenRepo.IsRevoked(ctx, claims.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check token revocation: %w", err)
+		}
+		if revoked {
+			return nil, domainErrors.NewUnauthorizedError("token has been revoked")
+		}
+	}
+
+	// Step 4: Load the user
+	userID, err := valueobject.NewUserIDFromString(claims.UserID)
+	if err != nil {
+		return nil, domainErrors.NewUnauthorizedError("invalid user ID in token")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("user not found")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// Step 5: Check the token's tenant and that the user is active
+	// WHY: Relying parties don't send a tenant header; the tenant the token
+	// was issued in must still be the account's
+	tenantID, err := claimedTenant(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tenantID.Equals(user.TenantID()) {
+		return nil, domainErrors.NewUnauthorizedError("token belongs to another tenant")
+	}
+
+	if !user.CanLogin() {
+		return nil, domainErrors.NewUnauthorizedError("account is inactive")
+	}
+
+	// Step 6: Release claims by scope
+	released := userClaims(user, grant.Scopes)
+	info := &usecase.UserInfo{
+		Subject:       user.ID().String(),
+		Email:         released.Email,
+		EmailVerified: released.EmailVerified,
+	}
+	if released.UpdatedAt != 0 {
+		updatedAt := user.UpdatedAt()
+		info.UpdatedAt = &updatedAt
+	}
+
+	return info, nil
+}
+
+// userClaims returns the standard claims scopes release about user
+// WHY: The ID token and the UserInfo endpoint must agree
+func userClaims(user *entity.User, scopes []string) security.UserClaims {
+	var claims security.UserClaims
+
+	if slices.Contains(scopes, entity.ScopeEmail) {
+		verified := user.IsEmailVerified()
+		claims.Email = user.Email().String()
+		claims.EmailVerified = &verified
+	}
+
+	if slices.Contains(scopes, entity.ScopeProfile) {
+		claims.UpdatedAt = user.UpdatedAt().Unix()
+	}
+
+	return claims
+}
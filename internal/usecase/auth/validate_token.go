@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
 	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
 	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
 	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
@@ -15,39 +18,74 @@ import (
 // ValidateTokenUseCase implements token validation
 // WHY: Other services need to verify JWTs
 type ValidateTokenUseCase struct {
-	jwtGenerator security.JWTGenerator
-	userRepo     repository.UserRepository
+	jwtGenerator      security.JWTGenerator
+	userRepo          repository.UserRepository
+	serviceClientRepo repository.ServiceClientRepository
+	revokedTokenRepo  repository.RevokedTokenRepository
+	patRepo           repository.PersonalAccessTokenRepository
+	sessionRepo       repository.SessionRepository
 }
 
 // NewValidateTokenUseCase creates a new validate token use case
 func NewValidateTokenUseCase(
 	jwtGenerator security.JWTGenerator,
 	userRepo repository.UserRepository,
+	serviceClientRepo repository.ServiceClientRepository,
+	revokedTokenRepo repository.RevokedTokenRepository,
+	patRepo repository.PersonalAccessTokenRepository,
+	sessionRepo repository.SessionRepository,
 ) *ValidateTokenUseCase {
 	return &ValidateTokenUseCase{
-		jwtGenerator: jwtGenerator,
-		userRepo:     userRepo,
+		jwtGenerator:      jwtGenerator,
+		userRepo:          userRepo,
+		serviceClientRepo: serviceClientRepo,
+		revokedTokenRepo:  revokedTokenRepo,
+		patRepo:           patRepo,
+		sessionRepo:       sessionRepo,
 	}
 }
 
-// Execute validates a JWT token
+// Execute validates a JWT or personal access token
 func (uc *ValidateTokenUseCase) Execute(
 	ctx context.Context,
 	tokenString string,
 ) (*usecase.TokenClaims, error) {
-	// Step 1: Validate JWT signature and claims
-	claims, err := uc.jwtGenerator.ValidateToken(tokenString)
+	// Step 1: Personal access tokens are opaque - look them up instead
+	if entity.IsPersonalAccessToken(tokenString) {
+		return uc.validatePersonalAccessToken(ctx, tokenString)
+	}
+
+	// Step 2: Validate JWT signature and claims
+	// SECURITY: Refresh tokens are rejected here (token_use must be access)
+	claims, err := uc.jwtGenerator.ValidateToken(tokenString, security.TokenUseAccess)
 	if err != nil {
 		return nil, domainErrors.NewUnauthorizedError("invalid token")
 	}
 
-	// Step 2: Parse user ID
+	// Step 3: Check denylist
+	// WHY: Token may have been revoked (logout) before it expired
+	if claims.ID != "" {
+		revoked, err := uc.revokedTokenRepo.IsRevoked(ctx, claims.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check token revocation: %w", err)
+		}
+		if revoked {
+			return nil, domainErrors.NewUnauthorizedError("token has been revoked")
+		}
+	}
+
+	// Step 4: Service tokens have no user - check the client instead
+	if clientID, ok := claims.ServiceClientID(); ok {
+		return uc.validateServiceToken(ctx, claims, clientID)
+	}
+
+	// Step 5: Parse user ID
 	userID, err := valueobject.NewUserIDFromString(claims.UserID)
 	if err != nil {
 		return nil, domainErrors.NewUnauthorizedError("invalid user ID in token")
 	}
 
-	// Step 3: Verify user still exists and is active
+	// Step 6: Verify user still exists and is active
 	// WHY: User might be deleted or deactivated after token issued
 	user, err := uc.userRepo.FindByID(ctx, userID)
 	if err != nil {
@@ -57,14 +95,187 @@ func (uc *ValidateTokenUseCase) Execute(
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
 
-	// Step 4: Check if user can still login
+	// Step 7: Check the token belongs to this tenant
+	// SECURITY: A token from one organization must not work in another
+	if err := checkTokenTenant(ctx, claims, user); err != nil {
+		return nil, err
+	}
+
+	// Step 8: Check if user can still login
+	if !user.CanLogin() {
+		return nil, domainErrors.NewForbiddenError("account is inactive")
+	}
+
+	// Step 9: Check the session is still signed in
+	// WHY: Ending a session (sign out elsewhere, admin action) must not wait
+	// for its access tokens to expire
+	if err := uc.checkSession(ctx, claims, user); err != nil {
+		return nil, err
+	}
+
+	// Step 10: Return validated claims
+	// WHY: Current grants, not the token's - a revoked role stops working here
+	// immediately instead of when the token expires
+	access := user.Access()
+	grant, _ := claims.ClientGrant()
+	return &usecase.TokenClaims{
+		UserID:      claims.UserID,
+		TenantID:    user.TenantID().String(),
+		Email:       claims.Email,
+		Roles:       access.Roles,
+		Permissions: access.Permissions,
+		ClientID:    grant.ClientID,
+		Scopes:      grant.Scopes,
+		SessionID:   claims.SessionID,
+		AuthTime:    authTime(claims),
+	}, nil
+}
+
+// checkSession rejects tokens whose session has ended
+// NOTE: Tokens issued before sessions were recorded have no sid and pass
+func (uc *ValidateTokenUseCase) checkSession(ctx context.Context, claims *security.Claims, user *entity.User) error {
+	if claims.SessionID == "" {
+		return nil
+	}
+
+	session, err := uc.sessionRepo.FindByID(ctx, claims.SessionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return domainErrors.NewUnauthorizedError("session has ended")
+		}
+		return fmt.Errorf("failed to find session: %w", err)
+	}
+
+	if !session.UserID().Equals(user.ID()) {
+		return domainErrors.NewUnauthorizedError("invalid token")
+	}
+
+	return nil
+}
+
+// validateServiceToken checks a service client's token against its client
+// WHY: A deactivated client's tokens stop working immediately, like a
+// deactivated user's
+func (uc *ValidateTokenUseCase) validateServiceToken(
+	ctx context.Context,
+	claims *security.Claims,
+	clientID string,
+) (*usecase.TokenClaims, error) {
+	client, err := uc.serviceClientRepo.FindByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, repository.ErrServiceClientNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("client not found")
+		}
+		return nil, fmt.Errorf("failed to find service client: %w", err)
+	}
+
+	// SECURITY: Same tenant rules as user tokens
+	tokenTenant, err := claimedTenant(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tokenTenant.Equals(usecase.TenantFromContext(ctx)) || !tokenTenant.Equals(client.TenantID()) {
+		return nil, domainErrors.NewUnauthorizedError("token belongs to another tenant")
+	}
+
+	if !client.IsActive() {
+		return nil, domainErrors.NewForbiddenError("client is inactive")
+	}
+
+	return &usecase.TokenClaims{
+		TenantID: client.TenantID().String(),
+		ClientID: client.ID(),
+		Scopes:   strings.Fields(claims.Scope),
+	}, nil
+}
+
+// validatePersonalAccessToken checks a personal access token against its record and owner
+// WHY: Deleting the record revokes the token, and a deactivated owner's
+// tokens stop working like their JWTs do
+func (uc *ValidateTokenUseCase) validatePersonalAccessToken(
+	ctx context.Context,
+	tokenString string,
+) (*usecase.TokenClaims, error) {
+	token, err := uc.patRepo.FindByHash(ctx, security.HashToken(tokenString))
+	if err != nil {
+		if errors.Is(err, repository.ErrTokenNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("invalid token")
+		}
+		return nil, fmt.Errorf("failed to find personal access token: %w", err)
+	}
+
+	// NOTE: The TTL monitor deletes expired tokens, but only every minute or so
+	if token.IsExpired() {
+		return nil, domainErrors.NewUnauthorizedError("token has expired")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, token.UserID())
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("user not found")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// SECURITY: Same tenant rules as JWTs
+	if !token.TenantID().Equals(usecase.TenantFromContext(ctx)) || !token.TenantID().Equals(user.TenantID()) {
+		return nil, domainErrors.NewUnauthorizedError("token belongs to another tenant")
+	}
+
 	if !user.CanLogin() {
 		return nil, domainErrors.NewForbiddenError("account is inactive")
 	}
 
-	// Step 5: Return validated claims
+	// WHY: The owner's current grants narrowed by the token's scopes - a
+	// role the owner loses is lost by their tokens too
+	access := token.Restrict(user.Access())
 	return &usecase.TokenClaims{
-		UserID: claims.UserID,
-		Email:  claims.Email,
+		UserID:      user.ID().String(),
+		TenantID:    user.TenantID().String(),
+		Email:       user.Email().String(),
+		Roles:       access.Roles,
+		Permissions: access.Permissions,
+		Scopes:      token.Scopes(),
+
+		PersonalAccessTokenID: token.ID(),
 	}, nil
 }
+
+// checkTokenTenant rejects tokens used outside the tenant they were issued in
+// NOTE: Tokens issued before multi-tenancy have no tenant_id claim and
+// count as the default tenant's, so they keep working through the upgrade
+func checkTokenTenant(ctx context.Context, claims *security.Claims, user *entity.User) error {
+	tokenTenant, err := claimedTenant(claims)
+	if err != nil {
+		return err
+	}
+
+	// The request's tenant and the account's tenant must both match
+	if !tokenTenant.Equals(usecase.TenantFromContext(ctx)) || !tokenTenant.Equals(user.TenantID()) {
+		return domainErrors.NewUnauthorizedError("token belongs to another tenant")
+	}
+
+	return nil
+}
+
+// claimedTenant returns the tenant a token was issued in
+func claimedTenant(claims *security.Claims) (valueobject.TenantID, error) {
+	if claims.TenantID == "" {
+		return valueobject.DefaultTenantID(), nil
+	}
+
+	tenantID, err := valueobject.NewTenantID(claims.TenantID)
+	if err != nil {
+		return valueobject.TenantID{}, domainErrors.NewUnauthorizedError("invalid tenant in token")
+	}
+	return tenantID, nil
+}
+
+// authTime returns when the user signed in (zero for tokens without auth_time)
+func authTime(claims *security.Claims) time.Time {
+	if claims.AuthTime == nil {
+		return time.Time{}
+	}
+	return claims.AuthTime.Time
+}
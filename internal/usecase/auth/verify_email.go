@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// VerifyEmailUseCase marks an email as verified from a verification token,
+// or completes a pending email change
+type VerifyEmailUseCase struct {
+	userRepo     repository.UserRepository
+	jwtGenerator security.JWTGenerator
+	sessions     *SessionManager
+}
+
+// NewVerifyEmailUseCase creates a new verify email use case
+func NewVerifyEmailUseCase(
+	userRepo repository.UserRepository,
+	jwtGenerator security.JWTGenerator,
+	sessions *SessionManager,
+) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		userRepo:     userRepo,
+		jwtGenerator: jwtGenerator,
+		sessions:     sessions,
+	}
+}
+
+// Execute verifies the email the token was issued for
+// NOTE: A token for the pending address moves the account to it
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, token string) error {
+	// Step 1: Validate token
+	// SECURITY: Only email verification tokens - never access/refresh tokens
+	claims, err := uc.jwtGenerator.ValidateToken(token, security.TokenUseEmailVerification)
+	if err != nil {
+		return domainErrors.NewUnauthorizedError("invalid or expired verification token")
+	}
+
+	// Step 2: Parse user ID
+	userID, err := valueobject.NewUserIDFromString(claims.UserID)
+	if err != nil {
+		return domainErrors.NewUnauthorizedError("invalid verification token")
+	}
+
+	// Step 3: Find user
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domainErrors.NewUnauthorizedError("invalid verification token")
+		}
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// Step 4: Token for the pending address confirms the email change
+	pending := user.PendingEmail()
+	if !pending.IsEmpty() && claims.Email == pending.String() {
+		return uc.confirmEmailChange(ctx, user)
+	}
+
+	// Step 5: Otherwise it must be for the user's current address
+	// WHY: After an email change, links sent to the old address are void
+	if claims.Email != user.Email().String() {
+		return domainErrors.NewUnauthorizedError("verification token is no longer valid")
+	}
+
+	// Step 6: Already verified - nothing to do
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	// Step 7: Mark verified and save
+	user.MarkEmailVerified()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return nil
+}
+
+// confirmEmailChange moves the user to the pending address
+func (uc *VerifyEmailUseCase) confirmEmailChange(ctx context.Context, user *entity.User) error {
+	if err := user.ConfirmEmailChange(); err != nil {
+		return fmt.Errorf("failed to confirm email change: %w", err)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		if errors.Is(err, repository.ErrUserAlreadyExists) {
+			// Address taken since the change was requested
+			return domainErrors.NewConflictError("email already in use")
+		}
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// SECURITY: Existing tokens carry the old email claim
+	if err := uc.sessions.EndAll(ctx, user.ID()); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// VerifyLoginCodeUseCase exchanges an emailed code or magic link for a login
+type VerifyLoginCodeUseCase struct {
+	userRepo     repository.UserRepository
+	codeRepo     repository.LoginCodeRepository
+	jwtGenerator security.JWTGenerator
+	tokenIssuer  *TokenIssuer
+	throttle     *LoginThrottle
+
+	mfaChallengeExpiry time.Duration // Time allowed for the second factor
+}
+
+// NewVerifyLoginCodeUseCase creates a new verify login code use case
+func NewVerifyLoginCodeUseCase(
+	userRepo repository.UserRepository,
+	codeRepo repository.LoginCodeRepository,
+	jwtGenerator security.JWTGenerator,
+	tokenIssuer *TokenIssuer,
+	throttle *LoginThrottle,
+	mfaChallengeExpiry time.Duration,
+) *VerifyLoginCodeUseCase {
+	return &VerifyLoginCodeUseCase{
+		userRepo:           userRepo,
+		codeRepo:           codeRepo,
+		jwtGenerator:       jwtGenerator,
+		tokenIssuer:        tokenIssuer,
+		throttle:           throttle,
+		mfaChallengeExpiry: mfaChallengeExpiry,
+	}
+}
+
+// Execute consumes the code and logs the user in
+// NOTE: Returns the same response as LoginUseCase - including the MFA step,
+// since an inbox is only one factor
+func (uc *VerifyLoginCodeUseCase) Execute(
+	ctx context.Context,
+	req usecase.VerifyLoginCodeRequest,
+) (*usecase.LoginResponse, error) {
+	// Step 1: Consume code
+	var (
+		loginCode *entity.LoginCode
+		err       error
+	)
+	if req.Email == "" {
+		loginCode, err = uc.consume(ctx, security.HashToken(req.Code), entity.LoginCodeKindMagicLink)
+	} else {
+		loginCode, err = uc.consumeOTP(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Find user
+	user, err := uc.userRepo.FindByID(ctx, loginCode.UserID())
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("invalid or expired login code")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// SECURITY: A code sent to a previous address must not log in
+	if !user.Email().Equals(loginCode.Email()) {
+		return nil, domainErrors.NewUnauthorizedError("invalid or expired login code")
+	}
+
+	// Step 3: Check if user is active
+	if !user.CanLogin() {
+		return nil, domainErrors.NewForbiddenError("account is inactive")
+	}
+
+	// Step 4: The code was delivered to the inbox, which proves ownership
+	if !user.IsEmailVerified() {
+		user.MarkEmailVerified()
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+
+	// Step 5: Require the second factor if enrolled, otherwise issue tokens
+	return completeLogin(ctx, user, uc.jwtGenerator, uc.tokenIssuer, uc.mfaChallengeExpiry)
+}
+
+// consumeOTP consumes a 6-digit code, counting wrong guesses toward the lockout
+// SECURITY: Short codes are guessable - they share the password lockout
+func (uc *VerifyLoginCodeUseCase) consumeOTP(
+	ctx context.Context,
+	req usecase.VerifyLoginCodeRequest,
+) (*entity.LoginCode, error) {
+	email, err := valueobject.NewEmail(req.Email)
+	if err != nil {
+		return nil, domainErrors.NewUnauthorizedError("invalid or expired login code")
+	}
+
+	if err := uc.throttle.Reserve(ctx, email, req.IPAddress); err != nil {
+		return nil, err
+	}
+
+	loginCode, err := uc.consume(ctx, security.HashLoginCode(email.String(), req.Code), entity.LoginCodeKindOTP)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.throttle.Reset(ctx, email, req.IPAddress); err != nil {
+		return nil, err
+	}
+
+	return loginCode, nil
+}
+
+// consume atomically takes a code of the expected kind
+func (uc *VerifyLoginCodeUseCase) consume(
+	ctx context.Context,
+	codeHash string,
+	kind entity.LoginCodeKind,
+) (*entity.LoginCode, error) {
+	loginCode, err := uc.codeRepo.Consume(ctx, codeHash)
+	if err != nil {
+		if errors.Is(err, repository.ErrTokenNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("invalid or expired login code")
+		}
+		return nil, fmt.Errorf("failed to consume login code: %w", err)
+	}
+
+	// WHY: The TTL index deletes expired codes lazily
+	if loginCode.Kind() != kind || loginCode.IsExpired() {
+		return nil, domainErrors.NewUnauthorizedError("invalid or expired login code")
+	}
+
+	return loginCode, nil
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+)
+
+// VerifyMFAUseCase completes a login that requires a second factor
+type VerifyMFAUseCase struct {
+	userRepo     repository.UserRepository
+	jwtGenerator security.JWTGenerator
+	cipher       security.SecretCipher
+	tokenIssuer  *TokenIssuer
+	throttle     *LoginThrottle
+}
+
+// NewVerifyMFAUseCase creates a new verify MFA use case
+func NewVerifyMFAUseCase(
+	userRepo repository.UserRepository,
+	jwtGenerator security.JWTGenerator,
+	cipher security.SecretCipher,
+	tokenIssuer *TokenIssuer,
+	throttle *LoginThrottle,
+) *VerifyMFAUseCase {
+	return &VerifyMFAUseCase{
+		userRepo:     userRepo,
+		jwtGenerator: jwtGenerator,
+		cipher:       cipher,
+		tokenIssuer:  tokenIssuer,
+		throttle:     throttle,
+	}
+}
+
+// Execute exchanges an MFA challenge token and a code for a token pair
+func (uc *VerifyMFAUseCase) Execute(
+	ctx context.Context,
+	req usecase.VerifyMFARequest,
+) (*usecase.LoginResponse, error) {
+	// Step 1: Validate challenge token
+	// SECURITY: Only challenge tokens - proves the password step passed
+	claims, err := uc.jwtGenerator.ValidateToken(req.MFAToken, security.TokenUseMFAChallenge)
+	if err != nil {
+		return nil, domainErrors.NewUnauthorizedError("invalid or expired mfa token")
+	}
+
+	userID, err := valueobject.NewUserIDFromString(claims.UserID)
+	if err != nil {
+		return nil, domainErrors.NewUnauthorizedError("invalid mfa token")
+	}
+
+	email, err := valueobject.NewEmail(claims.Email)
+	if err != nil {
+		return nil, domainErrors.NewUnauthorizedError("invalid mfa token")
+	}
+
+	// Step 2: Count the attempt, enforcing backoff and lockout
+	// WHY: 6-digit codes are guessable without it - shares the password counters
+	if err := uc.throttle.Reserve(ctx, email, req.IPAddress); err != nil {
+		return nil, err
+	}
+
+	// Step 3: Find user
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, domainErrors.NewUnauthorizedError("invalid mfa token")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if !user.CanLogin() {
+		return nil, domainErrors.NewForbiddenError("account is inactive")
+	}
+
+	// WHY: The challenge was issued for the address the user logged in with
+	if claims.Email != user.Email().String() {
+		return nil, domainErrors.NewUnauthorizedError("invalid mfa token")
+	}
+
+	// Step 4: Verify code
+	ok, err := verifySecondFactor(ctx, uc.userRepo, user, uc.cipher, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domainErrors.NewUnauthorizedError("invalid verification code")
+	}
+
+	if err := uc.throttle.Reset(ctx, email, req.IPAddress); err != nil {
+		return nil, err
+	}
+
+	// Step 5: Generate tokens
+	tokens, err := uc.tokenIssuer.Issue(ctx, user, entity.NewTokenFamilyID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.LoginResponse{
+		UserID:       user.ID().String(),
+		Email:        user.Email().String(),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import "context"
+
+type clientInfoKey struct{}
+
+// ClientInfo describes the device a request came from
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+// WithClientInfo records the requesting device
+// WHY: Every flow that signs someone in records it on the session, so the
+// delivery layer sets it once instead of on each request type
+func WithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
+
+// ClientInfoFromContext returns the info set by WithClientInfo (zero if none)
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return info
+}
@@ -1,12 +1,73 @@
 package usecase
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type AuthUseCase interface {
 	Signup(ctx context.Context, req SignupRequest) (*SignupResponse, error)
 	Login(ctx context.Context, req LoginRequest) (*LoginResponse, error)
 	ValidateToken(ctx context.Context, token string) (*TokenClaims, error)
 	RefreshToken(ctx context.Context, refreshToken string) (*RefreshResponse, error)
+	Logout(ctx context.Context, req LogoutRequest) error
+	RevokeToken(ctx context.Context, token string) error
+	SendVerification(ctx context.Context, email string) error
+	VerifyEmail(ctx context.Context, token string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, req ResetPasswordRequest) error
+	ChangePassword(ctx context.Context, req ChangePasswordRequest) (*ChangePasswordResponse, error)
+	ChangeEmail(ctx context.Context, req ChangeEmailRequest) (*ChangeEmailResponse, error)
+	VerifyMFA(ctx context.Context, req VerifyMFARequest) (*LoginResponse, error)
+	EnrollMFA(ctx context.Context, req EnrollMFARequest) (*EnrollMFAResponse, error)
+	ConfirmMFA(ctx context.Context, req ConfirmMFARequest) (*ConfirmMFAResponse, error)
+	DisableMFA(ctx context.Context, req DisableMFARequest) error
+	BeginPasskeyRegistration(ctx context.Context, req BeginPasskeyRegistrationRequest) (*PasskeyChallengeResponse, error)
+	FinishPasskeyRegistration(ctx context.Context, req FinishPasskeyRegistrationRequest) (*PasskeyInfo, error)
+	BeginPasskeyLogin(ctx context.Context) (*PasskeyChallengeResponse, error)
+	FinishPasskeyLogin(ctx context.Context, req FinishPasskeyLoginRequest) (*LoginResponse, error)
+	ListPasskeys(ctx context.Context, userID string) ([]PasskeyInfo, error)
+	DeletePasskey(ctx context.Context, req DeletePasskeyRequest) error
+	RequestLoginCode(ctx context.Context, email string) error
+	RequestMagicLink(ctx context.Context, email string) error
+	VerifyLoginCode(ctx context.Context, req VerifyLoginCodeRequest) (*LoginResponse, error)
+	GetUserAccess(ctx context.Context, userID string) (*UserAccess, error)
+	GrantRole(ctx context.Context, req UserAccessRequest) (*UserAccess, error)
+	RevokeRole(ctx context.Context, req UserAccessRequest) (*UserAccess, error)
+	GrantPermission(ctx context.Context, req UserAccessRequest) (*UserAccess, error)
+	RevokePermission(ctx context.Context, req UserAccessRequest) (*UserAccess, error)
+	ListUsers(ctx context.Context, req ListUsersRequest) (*UserList, error)
+	GetUser(ctx context.Context, req GetUserRequest) (*UserDetails, error)
+	ActivateUser(ctx context.Context, req AdminUserRequest) (*UserDetails, error)
+	DeactivateUser(ctx context.Context, req AdminUserRequest) (*UserDetails, error)
+	ForcePasswordReset(ctx context.Context, req AdminUserRequest) error
+	RevokeUserSessions(ctx context.Context, req AdminUserRequest) error
+	UnlockAccount(ctx context.Context, req AdminUserRequest) error
+	DeleteUser(ctx context.Context, req AdminUserRequest) error
+
+	// Invitations
+	CreateInvitation(ctx context.Context, req CreateInvitationRequest) (*InvitationDetails, error)
+	ListInvitations(ctx context.Context, actorID string) ([]InvitationDetails, error)
+	RevokeInvitation(ctx context.Context, req RevokeInvitationRequest) (*InvitationDetails, error)
+	AcceptInvitation(ctx context.Context, req AcceptInvitationRequest) (*AcceptInvitationResponse, error)
+
+	// Personal access tokens
+	CreatePersonalAccessToken(ctx context.Context, req CreatePersonalAccessTokenRequest) (*CreatePersonalAccessTokenResponse, error)
+	ListPersonalAccessTokens(ctx context.Context, userID string) ([]PersonalAccessTokenInfo, error)
+	RevokePersonalAccessToken(ctx context.Context, req RevokePersonalAccessTokenRequest) error
+
+	// Sessions
+	ListSessions(ctx context.Context, req ListSessionsRequest) ([]SessionInfo, error)
+	RevokeSession(ctx context.Context, req RevokeSessionRequest) error
+	RevokeOtherSessions(ctx context.Context, req ListSessionsRequest) error
+
+	// OAuth 2.0 authorization server
+	CheckAuthorization(ctx context.Context, req AuthorizeRequest) (*AuthorizationDetails, error)
+	Authorize(ctx context.Context, req ConsentRequest) (*AuthorizeResponse, error)
+	OAuthToken(ctx context.Context, req OAuthTokenRequest) (*OAuthTokenResponse, error)
+
+	// OpenID Connect
+	UserInfo(ctx context.Context, token string) (*UserInfo, error)
 }
 
 // SignupRequest contains signup data
@@ -16,31 +77,50 @@ type SignupRequest struct {
 }
 
 // SignupResponse contains signup result
+// NOTE: Tokens are empty when VerificationRequired is set
 type SignupResponse struct {
-	UserID       string
-	Email        string
-	AccessToken  string
-	RefreshToken string
+	UserID               string
+	Email                string
+	AccessToken          string
+	RefreshToken         string
+	VerificationRequired bool
 }
 
 // LoginRequest contains login credentials
 type LoginRequest struct {
-	Email    string
-	Password string
+	Email     string
+	Password  string
+	IPAddress string // Client IP for per-client lockout (optional)
 }
 
 // LoginResponse contains login result with tokens
+// NOTE: If MFARequired, there are no tokens - call VerifyMFA with MFAToken
 type LoginResponse struct {
 	UserID       string
 	Email        string
 	AccessToken  string
 	RefreshToken string
+	MFARequired  bool
+	MFAToken     string // Short-lived challenge token for the second step
 }
 
 // TokenClaims contains validated token data
+// NOTE: Roles and Permissions are the user's current grants, which may be
+// newer than those embedded in the token
+// NOTE: Service tokens (client_credentials) have no user - UserID, Email,
+// Roles and Permissions are empty and ClientID is the service client
 type TokenClaims struct {
-	UserID string
-	Email  string
+	UserID      string
+	TenantID    string
+	Email       string
+	Roles       []string
+	Permissions []string
+	ClientID    string    // OAuth or service client the token was issued to (empty for first-party tokens)
+	Scopes      []string  // Scopes granted to ClientID, or to the personal access token
+	SessionID   string    // Session the token belongs to (empty for personal access and service tokens)
+	AuthTime    time.Time // When the user signed in (zero for tokens issued before it was recorded)
+
+	PersonalAccessTokenID string // Personal access token the claims came from (empty for JWTs)
 }
 
 // RefreshResponse contains new tokens
@@ -48,3 +128,383 @@ type RefreshResponse struct {
 	AccessToken  string
 	RefreshToken string
 }
+
+// LogoutRequest contains the tokens to revoke on logout
+type LogoutRequest struct {
+	AccessToken  string
+	RefreshToken string // Optional - also revokes the refresh token family
+}
+
+// ResetPasswordRequest contains a reset token and the new password
+type ResetPasswordRequest struct {
+	Token       string
+	NewPassword string
+}
+
+// ChangePasswordRequest contains an authenticated password change
+type ChangePasswordRequest struct {
+	UserID          string // From the validated access token
+	CurrentPassword string
+	NewPassword     string
+}
+
+// ChangePasswordResponse contains a fresh token pair
+// NOTE: All other sessions are signed out
+type ChangePasswordResponse struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// ChangeEmailRequest contains an authenticated email change
+type ChangeEmailRequest struct {
+	UserID          string // From the validated access token
+	CurrentPassword string
+	NewEmail        string
+}
+
+// ChangeEmailResponse contains the current email and the one awaiting confirmation
+type ChangeEmailResponse struct {
+	Email        string // Unchanged until the new address is confirmed
+	PendingEmail string
+}
+
+// VerifyMFARequest contains the second login step
+type VerifyMFARequest struct {
+	MFAToken  string // From LoginResponse
+	Code      string // TOTP code or recovery code
+	IPAddress string // Client address, for lockout tracking
+}
+
+// EnrollMFARequest starts TOTP enrollment
+type EnrollMFARequest struct {
+	UserID          string // From the validated access token
+	CurrentPassword string
+}
+
+// EnrollMFAResponse contains the new TOTP secret
+type EnrollMFAResponse struct {
+	Secret string // Base32, for manual entry
+	URI    string // otpauth:// URI, for QR codes
+}
+
+// ConfirmMFARequest contains a code proving the authenticator app is set up
+type ConfirmMFARequest struct {
+	UserID string // From the validated access token
+	Code   string
+}
+
+// ConfirmMFAResponse contains one-time recovery codes (shown once)
+type ConfirmMFAResponse struct {
+	RecoveryCodes []string
+}
+
+// DisableMFARequest contains both factors needed to turn MFA off
+type DisableMFARequest struct {
+	UserID          string // From the validated access token
+	CurrentPassword string
+	Code            string // TOTP code or recovery code
+}
+
+// BeginPasskeyRegistrationRequest starts adding a passkey
+type BeginPasskeyRegistrationRequest struct {
+	UserID          string // From the validated access token
+	CurrentPassword string
+}
+
+// PasskeyChallengeResponse starts a WebAuthn ceremony in the browser
+type PasskeyChallengeResponse struct {
+	ChallengeID string // Send back with the finish request
+	Options     []byte // JSON for navigator.credentials.create/get
+}
+
+// FinishPasskeyRegistrationRequest contains the authenticator's attestation
+type FinishPasskeyRegistrationRequest struct {
+	UserID      string // From the validated access token
+	ChallengeID string
+	Name        string // Optional label ("MacBook", "YubiKey")
+	Credential  []byte // PublicKeyCredential JSON from the browser
+}
+
+// FinishPasskeyLoginRequest contains the authenticator's assertion
+type FinishPasskeyLoginRequest struct {
+	ChallengeID string
+	Credential  []byte // PublicKeyCredential JSON from the browser
+}
+
+// PasskeyInfo describes a registered passkey
+type PasskeyInfo struct {
+	ID         string // Credential ID, base64url
+	Name       string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+}
+
+// DeletePasskeyRequest removes one of the caller's passkeys
+type DeletePasskeyRequest struct {
+	UserID    string // From the validated access token
+	PasskeyID string
+}
+
+// CreatePersonalAccessTokenRequest creates a token for scripts and CI
+type CreatePersonalAccessTokenRequest struct {
+	UserID          string // From the validated access token
+	CurrentPassword string
+	Name            string        // Label ("CI deploy")
+	Scopes          []string      // Which of the user's roles and permissions the token carries
+	ExpiresIn       time.Duration // 0 = default lifetime
+}
+
+// CreatePersonalAccessTokenResponse contains the new token (shown once)
+type CreatePersonalAccessTokenResponse struct {
+	PersonalAccessTokenInfo
+	Token string
+}
+
+// PersonalAccessTokenInfo describes a personal access token
+// SECURITY: No token - only its hash is stored
+type PersonalAccessTokenInfo struct {
+	ID        string
+	Name      string
+	Scopes    []string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// RevokePersonalAccessTokenRequest revokes one of the caller's tokens
+type RevokePersonalAccessTokenRequest struct {
+	UserID  string // From the validated access token
+	TokenID string
+}
+
+// ListSessionsRequest identifies the caller and the session they are using
+type ListSessionsRequest struct {
+	UserID           string // From the validated access token
+	CurrentSessionID string // From the validated access token (empty for tokens without a session)
+}
+
+// SessionInfo describes a signed-in device
+type SessionInfo struct {
+	ID         string
+	ClientID   string // OAuth client the session was started by (empty for first-party sign-ins)
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time // Sign-in
+	LastSeenAt time.Time // Last token refresh
+	ExpiresAt  time.Time // Unless refreshed again
+	Current    bool      // The session making the request
+}
+
+// RevokeSessionRequest signs one of the caller's devices out
+type RevokeSessionRequest struct {
+	UserID    string // From the validated access token
+	SessionID string
+}
+
+// VerifyLoginCodeRequest exchanges an emailed code for a login
+// NOTE: Email is required with a 6-digit code and omitted with a magic link token
+type VerifyLoginCodeRequest struct {
+	Email     string
+	Code      string // 6-digit code or magic link token
+	IPAddress string // Client address, for lockout tracking
+}
+
+// UserAccessRequest grants or revokes one role or permission (admin operation)
+type UserAccessRequest struct {
+	ActorID string // Admin making the change, from the validated access token (empty for CLI)
+	UserID  string // Account being changed
+	Name    string // Role or permission name
+}
+
+// UserAccess contains a user's roles and permissions
+type UserAccess struct {
+	UserID      string
+	Roles       []string
+	Permissions []string
+}
+
+// ListUsersRequest pages through all users, newest first (admin operation)
+type ListUsersRequest struct {
+	ActorID string // Admin listing, from the validated access token
+	Offset  int
+	Limit   int // 1-100, defaults to 20
+}
+
+// UserList is one page of users
+type UserList struct {
+	Users  []UserDetails
+	Total  int64 // All users, not just this page
+	Offset int
+	Limit  int
+}
+
+// GetUserRequest looks up one user by ID or email (admin operation)
+// NOTE: Exactly one of UserID and Email must be set
+type GetUserRequest struct {
+	ActorID string
+	UserID  string
+	Email   string
+}
+
+// AdminUserRequest names the account an admin action targets
+type AdminUserRequest struct {
+	ActorID string // Admin acting, from the validated access token
+	UserID  string // Account acted on
+}
+
+// UserDetails is an account as seen by admins
+// SECURITY: No password hash, MFA secrets or recovery codes
+type UserDetails struct {
+	ID                    string
+	TenantID              string
+	Email                 string
+	IsActive              bool
+	EmailVerified         bool
+	MFAEnabled            bool
+	PasswordResetRequired bool
+	Roles                 []string
+	Permissions           []string
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// ImportUserRecord is an account migrated from another system (operator task)
+// NOTE: PasswordHash is stored as is and checked in its original format on
+// first login. Firebase exports keep the salt apart, so their records need
+// HashFormat "firebase-scrypt" and PasswordSalt; other formats are
+// recognized from the hash itself
+type ImportUserRecord struct {
+	Email         string
+	EmailVerified bool
+	PasswordHash  string
+	PasswordSalt  string // Firebase only
+	HashFormat    string // Empty, or "firebase-scrypt"
+}
+
+// ImportUsersResult reports what an import created and skipped
+type ImportUsersResult struct {
+	Imported int
+	Failed   []ImportFailure
+}
+
+// ImportFailure is a record that wasn't imported
+type ImportFailure struct {
+	Index  int // Position in the imported records, from 0
+	Email  string
+	Reason string
+}
+
+// CreateInvitationRequest invites an email address into the caller's tenant (admin operation)
+type CreateInvitationRequest struct {
+	ActorID string // Admin inviting, from the validated access token (empty for CLI)
+	Email   string
+	Role    string // Granted when the invitation is accepted
+}
+
+// RevokeInvitationRequest cancels a pending invitation (admin operation)
+type RevokeInvitationRequest struct {
+	ActorID      string // Admin revoking, from the validated access token
+	InvitationID string
+}
+
+// InvitationDetails is an invitation as seen by admins
+// SECURITY: No token - it only exists in the invitation email
+type InvitationDetails struct {
+	ID         string
+	TenantID   string
+	Email      string
+	Role       string
+	Status     string // pending, accepted, revoked or expired
+	InvitedBy  string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	AcceptedAt *time.Time
+	AcceptedBy string
+}
+
+// AcceptInvitationRequest joins an organization from an invitation link
+// NOTE: Password is the new account's password, or the existing account's
+// current password when the invited email is already registered
+type AcceptInvitationRequest struct {
+	Token     string
+	Password  string
+	IPAddress string // Client address, for lockout tracking
+}
+
+// AcceptInvitationResponse contains the result of accepting an invitation
+// NOTE: Tokens are only issued for new accounts - existing accounts sign in
+// as usual, so MFA still applies
+type AcceptInvitationResponse struct {
+	UserID         string
+	Email          string
+	Role           string
+	AccountCreated bool
+	AccessToken    string
+	RefreshToken   string
+}
+
+// AuthorizeRequest is an OAuth authorization request (RFC 6749 section 4.1.1)
+// NOTE: Only response_type=code with a PKCE S256 challenge is supported
+type AuthorizeRequest struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string // Space-delimited (empty = every scope the client is registered for)
+	State               string // Opaque; returned to the client unchanged
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Nonce               string // OpenID Connect: echoed in the ID token
+}
+
+// AuthorizationDetails describes a valid authorization request for the consent screen
+type AuthorizationDetails struct {
+	ClientID    string
+	ClientName  string
+	RedirectURI string
+	Scopes      []string // Scopes the user is asked to grant
+}
+
+// ConsentRequest is the signed-in user's answer on the consent screen
+type ConsentRequest struct {
+	UserID   string    // From the validated access token
+	AuthTime time.Time // From the validated access token
+	Approve  bool
+	AuthorizeRequest
+}
+
+// AuthorizeResponse tells the consent screen where to send the user
+// NOTE: RedirectURI carries code and state on approval, or
+// error=access_denied on denial
+type AuthorizeResponse struct {
+	RedirectURI string
+}
+
+// OAuthTokenRequest is a token endpoint request (RFC 6749 sections 4.1.3 and 6)
+type OAuthTokenRequest struct {
+	GrantType    string // authorization_code, refresh_token or client_credentials
+	Code         string
+	RedirectURI  string
+	CodeVerifier string // PKCE
+	RefreshToken string
+	Scope        string // refresh_token only: narrows the access token
+	ClientID     string
+	ClientSecret string // Confidential clients only
+}
+
+// OAuthTokenResponse is a successful token response (RFC 6749 section 5.1)
+type OAuthTokenResponse struct {
+	AccessToken  string
+	TokenType    string
+	ExpiresIn    int64 // Access token lifetime in seconds
+	RefreshToken string
+	Scope        string
+	IDToken      string // OpenID Connect: set when the openid scope was granted
+}
+
+// UserInfo is the OpenID Connect UserInfo response (OIDC Core section 5.3)
+// NOTE: Claims the token's scopes don't cover are left empty
+type UserInfo struct {
+	Subject       string
+	Email         string     // email scope
+	EmailVerified *bool      // email scope
+	UpdatedAt     *time.Time // profile scope
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+type tenantKey struct{}
+
+// WithTenant records which organization a request is for
+// WHY: Set once by the delivery layer (header/metadata) so every use case
+// scopes lookups the same way without a TenantID field on each request
+func WithTenant(ctx context.Context, tenantID valueobject.TenantID) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantID)
+}
+
+// TenantFromContext returns the tenant set by WithTenant (the default tenant if none)
+func TenantFromContext(ctx context.Context) valueobject.TenantID {
+	tenantID, ok := ctx.Value(tenantKey{}).(valueobject.TenantID)
+	if !ok || tenantID.IsEmpty() {
+		return valueobject.DefaultTenantID()
+	}
+	return tenantID
+}
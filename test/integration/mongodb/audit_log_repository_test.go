@@ -0,0 +1,38 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// TestAuditLogRepository_Append tests that events are stored as written
+func TestAuditLogRepository_Append(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	collection := testDB.Database().Collection("audit_log")
+	require.NoError(t, mongodbpkg.CreateAuditLogIndexes(ctx, collection))
+	repo := mongodbpkg.NewAuditLogRepository(testDB.Database())
+
+	event, err := entity.NewAuditEvent(valueobject.DefaultTenantID(), "", entity.AuditActionGrantRole, "user-1", "user@example.com", map[string]string{"name": "admin"})
+	require.NoError(t, err)
+	require.NoError(t, repo.Append(ctx, event))
+
+	var doc mongodbpkg.AuditEventDocument
+	require.NoError(t, collection.FindOne(ctx, bson.M{"_id": event.ID()}).Decode(&doc))
+	assert.Equal(t, valueobject.DefaultTenant, doc.TenantID)
+	assert.Equal(t, entity.AuditActorSystem, doc.ActorID)
+	assert.Equal(t, "access.grant_role", doc.Action)
+	assert.Equal(t, "user-1", doc.TargetUserID)
+	assert.Equal(t, "user@example.com", doc.TargetEmail)
+	assert.Equal(t, map[string]string{"name": "admin"}, doc.Details)
+}
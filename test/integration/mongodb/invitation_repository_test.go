@@ -0,0 +1,100 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInvitationRepository_Lifecycle tests store, list, accept and revoke
+func TestInvitationRepository_Lifecycle(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreateInvitationIndexes(ctx, testDB.Database().Collection("invitations")))
+	repo := mongodbpkg.NewInvitationRepository(testDB.Database())
+
+	acme, err := valueobject.NewTenantID("acme")
+	require.NoError(t, err)
+
+	newInvitation := func(t *testing.T, raw string, tenantID valueobject.TenantID) *entity.Invitation {
+		t.Helper()
+		email, _ := valueobject.NewEmail(raw + "@example.com")
+		invitation, err := entity.NewInvitation(tenantID, email, "editor", security.HashToken(raw), "admin", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, invitation))
+		return invitation
+	}
+
+	t.Run("success - round trip", func(t *testing.T) {
+		invitation := newInvitation(t, "invite-1", acme)
+
+		found, err := repo.FindByHash(ctx, invitation.TokenHash())
+		require.NoError(t, err)
+		assert.Equal(t, invitation.ID(), found.ID())
+		assert.Equal(t, acme, found.TenantID())
+		assert.Equal(t, "editor", found.Role())
+		assert.Equal(t, entity.InvitationPending, found.Status())
+
+		_, err = repo.FindByID(ctx, "missing")
+		assert.True(t, errors.Is(err, repository.ErrInvitationNotFound))
+	})
+
+	t.Run("success - accepted exactly once", func(t *testing.T) {
+		invitation := newInvitation(t, "invite-2", acme)
+		userID := valueobject.NewUserID()
+
+		require.NoError(t, repo.MarkAccepted(ctx, invitation.ID(), userID))
+		err := repo.MarkAccepted(ctx, invitation.ID(), valueobject.NewUserID())
+		assert.True(t, errors.Is(err, repository.ErrTokenAlreadyUsed))
+
+		found, err := repo.FindByID(ctx, invitation.ID())
+		require.NoError(t, err)
+		assert.Equal(t, entity.InvitationAccepted, found.Status())
+		assert.Equal(t, userID.String(), found.AcceptedBy())
+
+		// Accepted invitations can't be revoked
+		err = repo.Revoke(ctx, invitation.ID())
+		assert.True(t, errors.Is(err, repository.ErrTokenAlreadyUsed))
+	})
+
+	t.Run("success - revoked can't be accepted", func(t *testing.T) {
+		invitation := newInvitation(t, "invite-3", acme)
+
+		require.NoError(t, repo.Revoke(ctx, invitation.ID()))
+		require.NoError(t, repo.Revoke(ctx, invitation.ID()), "revoking twice is fine")
+
+		err := repo.MarkAccepted(ctx, invitation.ID(), valueobject.NewUserID())
+		assert.True(t, errors.Is(err, repository.ErrTokenAlreadyUsed))
+
+		found, err := repo.FindByID(ctx, invitation.ID())
+		require.NoError(t, err)
+		assert.Equal(t, entity.InvitationRevoked, found.Status())
+	})
+
+	t.Run("success - list by tenant", func(t *testing.T) {
+		other := newInvitation(t, "invite-4", valueobject.DefaultTenantID())
+
+		invitations, err := repo.ListByTenant(ctx, acme)
+		require.NoError(t, err)
+		require.Len(t, invitations, 3)
+		for i, invitation := range invitations {
+			assert.Equal(t, acme, invitation.TenantID())
+			assert.NotEqual(t, other.ID(), invitation.ID())
+			if i > 0 {
+				assert.False(t, invitation.CreatedAt().After(invitations[i-1].CreatedAt()), "newest first")
+			}
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package mongodb_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLoginAttemptRepository_Counters tests counting, reset and unlock
+func TestLoginAttemptRepository_Counters(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreateLoginAttemptIndexes(ctx, testDB.Database().Collection("login_attempts")))
+	repo := mongodbpkg.NewLoginAttemptRepository(testDB.Database())
+
+	email, _ := valueobject.NewEmail("user@example.com")
+	tenantID := valueobject.DefaultTenantID()
+	otherTenant, _ := valueobject.NewTenantID("acme")
+	accountKey := entity.AccountAttemptKey(tenantID, email)
+	clientKey := entity.ClientAttemptKey(tenantID, email, "10.0.0.1")
+	otherKey := entity.AccountAttemptKey(otherTenant, email)
+	expiresAt := time.Now().Add(time.Hour)
+
+	t.Run("success - concurrent attempts each see a different count", func(t *testing.T) {
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		seen := make(map[int]bool)
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				previous, err := repo.RecordAttempt(ctx, accountKey, tenantID, email, expiresAt)
+				assert.NoError(t, err)
+
+				count := 0
+				if previous != nil {
+					count = previous.Failures()
+				}
+				mu.Lock()
+				seen[count] = true
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		assert.Len(t, seen, 10, "no two attempts may read the same count")
+
+		attempts, err := repo.FindByKeys(ctx, []string{accountKey, clientKey})
+		require.NoError(t, err)
+		require.Len(t, attempts, 1)
+		assert.Equal(t, 10, attempts[0].Failures())
+	})
+
+	t.Run("success - delete by keys", func(t *testing.T) {
+		_, err := repo.RecordAttempt(ctx, clientKey, tenantID, email, expiresAt)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.DeleteByKeys(ctx, []string{clientKey}))
+
+		attempts, err := repo.FindByKeys(ctx, []string{accountKey, clientKey})
+		require.NoError(t, err)
+		require.Len(t, attempts, 1)
+		assert.Equal(t, accountKey, attempts[0].Key())
+	})
+
+	t.Run("success - delete by email clears every counter in the tenant", func(t *testing.T) {
+		_, err := repo.RecordAttempt(ctx, clientKey, tenantID, email, expiresAt)
+		require.NoError(t, err)
+		_, err = repo.RecordAttempt(ctx, otherKey, otherTenant, email, expiresAt)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.DeleteByEmail(ctx, tenantID, email))
+
+		attempts, err := repo.FindByKeys(ctx, []string{accountKey, clientKey, otherKey})
+		require.NoError(t, err)
+		require.Len(t, attempts, 1)
+		assert.Equal(t, otherKey, attempts[0].Key())
+		assert.True(t, attempts[0].TenantID().Equals(otherTenant))
+	})
+}
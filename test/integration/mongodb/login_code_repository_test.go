@@ -0,0 +1,61 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLoginCodeRepository_Lifecycle tests store, consume and invalidate
+func TestLoginCodeRepository_Lifecycle(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreateLoginCodeIndexes(ctx, testDB.Database().Collection("login_codes")))
+	repo := mongodbpkg.NewLoginCodeRepository(testDB.Database())
+
+	email, _ := valueobject.NewEmail("user@example.com")
+	newCode := func(t *testing.T, raw string, userID valueobject.UserID) *entity.LoginCode {
+		t.Helper()
+		code, err := entity.NewLoginCode(security.HashToken(raw), entity.LoginCodeKindMagicLink, userID, email, time.Now().Add(10*time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, code))
+		return code
+	}
+
+	t.Run("success - consumed exactly once", func(t *testing.T) {
+		code := newCode(t, "code-1", valueobject.NewUserID())
+
+		found, err := repo.Consume(ctx, code.CodeHash())
+		require.NoError(t, err)
+		assert.Equal(t, entity.LoginCodeKindMagicLink, found.Kind())
+		assert.True(t, found.Email().Equals(email))
+
+		_, err = repo.Consume(ctx, code.CodeHash())
+		assert.True(t, errors.Is(err, repository.ErrTokenNotFound))
+	})
+
+	t.Run("success - delete by user", func(t *testing.T) {
+		userID := valueobject.NewUserID()
+		first := newCode(t, "code-2", userID)
+		other := newCode(t, "code-3", valueobject.NewUserID())
+
+		require.NoError(t, repo.DeleteByUserID(ctx, userID))
+
+		_, err := repo.Consume(ctx, first.CodeHash())
+		assert.True(t, errors.Is(err, repository.ErrTokenNotFound))
+		_, err = repo.Consume(ctx, other.CodeHash())
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,125 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOAuthClientRepository_RoundTrip tests storing and loading a client
+func TestOAuthClientRepository_RoundTrip(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	repo := mongodbpkg.NewOAuthClientRepository(testDB.Database())
+
+	client, err := entity.NewOAuthClient(
+		valueobject.DefaultTenantID(),
+		"Photo App",
+		security.HashToken("secret"),
+		[]string{"https://client.example.com/callback"},
+		[]string{"profile", "photos:read"},
+	)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, client))
+
+	found, err := repo.FindByID(ctx, client.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "Photo App", found.Name())
+	assert.True(t, found.IsConfidential())
+	assert.Equal(t, client.RedirectURIs(), found.RedirectURIs())
+	assert.Equal(t, client.Scopes(), found.Scopes())
+
+	_, err = repo.FindByID(ctx, "missing")
+	assert.True(t, errors.Is(err, repository.ErrOAuthClientNotFound))
+}
+
+// TestAuthorizationCodeRepository_SingleUse tests redeeming a code
+func TestAuthorizationCodeRepository_SingleUse(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreateAuthorizationCodeIndexes(ctx, testDB.Database().Collection("authorization_codes")))
+	repo := mongodbpkg.NewAuthorizationCodeRepository(testDB.Database())
+
+	code, err := entity.NewAuthorizationCode(
+		security.HashToken("code"),
+		"client-1",
+		valueobject.NewUserID(),
+		valueobject.DefaultTenantID(),
+		"https://client.example.com/callback",
+		true,
+		[]string{"profile"},
+		security.PKCEChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"),
+		"n-0S6_WzA2Mj",
+		time.Now().Add(-time.Minute),
+		time.Now().Add(time.Minute),
+	)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, code))
+
+	found, err := repo.FindByHash(ctx, code.CodeHash())
+	require.NoError(t, err)
+	assert.Equal(t, "client-1", found.ClientID())
+	assert.Equal(t, code.CodeChallenge(), found.CodeChallenge())
+	assert.Equal(t, "n-0S6_WzA2Mj", found.Nonce())
+	assert.True(t, found.RedirectURISent())
+	assert.WithinDuration(t, code.AuthTime(), found.AuthTime(), time.Millisecond)
+	assert.False(t, found.IsUsed())
+
+	require.NoError(t, repo.MarkUsed(ctx, code.CodeHash(), "family-1"))
+	err = repo.MarkUsed(ctx, code.CodeHash(), "family-2")
+	assert.True(t, errors.Is(err, repository.ErrTokenAlreadyUsed))
+
+	// The first redemption's family is kept for replay revocation
+	found, err = repo.FindByHash(ctx, code.CodeHash())
+	require.NoError(t, err)
+	assert.True(t, found.IsUsed())
+	assert.Equal(t, "family-1", found.FamilyID())
+
+	_, err = repo.FindByHash(ctx, "missing")
+	assert.True(t, errors.Is(err, repository.ErrTokenNotFound))
+}
+
+// TestServiceClientRepository_RoundTrip tests storing, deactivating and loading a service client
+func TestServiceClientRepository_RoundTrip(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	repo := mongodbpkg.NewServiceClientRepository(testDB.Database())
+
+	client, err := entity.NewServiceClient(
+		valueobject.DefaultTenantID(),
+		"Billing Worker",
+		security.HashToken("secret"),
+		[]string{"billing:read", "invoices:write"},
+	)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, client))
+
+	client.Deactivate()
+	require.NoError(t, repo.Update(ctx, client))
+
+	found, err := repo.FindByID(ctx, client.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "Billing Worker", found.Name())
+	assert.Equal(t, client.SecretHash(), found.SecretHash())
+	assert.Equal(t, client.Scopes(), found.Scopes())
+	assert.False(t, found.IsActive())
+
+	_, err = repo.FindByID(ctx, "missing")
+	assert.True(t, errors.Is(err, repository.ErrServiceClientNotFound))
+}
@@ -0,0 +1,53 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationRepository(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreateOrganizationIndexes(ctx, testDB.Database().Collection("organizations")))
+	repo := mongodbpkg.NewOrganizationRepository(testDB.Database())
+
+	tenantID, err := valueobject.NewTenantID("acme")
+	require.NoError(t, err)
+	org, err := entity.NewOrganization(tenantID, "Acme Corp")
+	require.NoError(t, err)
+
+	t.Run("create and find", func(t *testing.T) {
+		require.NoError(t, repo.Create(ctx, org))
+
+		found, err := repo.FindByID(ctx, tenantID)
+		require.NoError(t, err)
+		assert.Equal(t, tenantID, found.ID())
+		assert.Equal(t, "Acme Corp", found.Name())
+	})
+
+	t.Run("duplicate ID", func(t *testing.T) {
+		again, err := entity.NewOrganization(tenantID, "Another Acme")
+		require.NoError(t, err)
+
+		err = repo.Create(ctx, again)
+		assert.True(t, errors.Is(err, repository.ErrOrganizationExists))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		missing, _ := valueobject.NewTenantID("missing")
+
+		_, err := repo.FindByID(ctx, missing)
+		assert.True(t, errors.Is(err, repository.ErrOrganizationNotFound))
+	})
+}
@@ -0,0 +1,123 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPasskeyRepository_Lifecycle tests create, find, update and delete
+func TestPasskeyRepository_Lifecycle(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreatePasskeyIndexes(ctx, testDB.Database().Collection("passkeys")))
+	repo := mongodbpkg.NewPasskeyRepository(testDB.Database())
+
+	newPasskey := func(t *testing.T, id string, userID valueobject.UserID) *entity.Passkey {
+		t.Helper()
+		passkey, err := entity.NewPasskey(userID, "Laptop", entity.PasskeyCredential{
+			ID:         []byte(id),
+			PublicKey:  []byte("public-key"),
+			Transports: []string{"internal"},
+		})
+		require.NoError(t, err)
+		return passkey
+	}
+
+	t.Run("success - round trip", func(t *testing.T) {
+		userID := valueobject.NewUserID()
+		passkey := newPasskey(t, "credential-1", userID)
+		require.NoError(t, repo.Create(ctx, passkey))
+
+		passkey.RecordUse(7, true)
+		require.NoError(t, repo.Update(ctx, passkey))
+
+		found, err := repo.FindByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, passkey.ID(), found[0].ID())
+		assert.Equal(t, "Laptop", found[0].Name())
+		assert.Equal(t, uint32(7), found[0].Credential().SignCount)
+		assert.True(t, found[0].Credential().BackupState)
+		assert.Equal(t, []string{"internal"}, found[0].Credential().Transports)
+		assert.NotNil(t, found[0].LastUsedAt())
+	})
+
+	t.Run("error - duplicate credential", func(t *testing.T) {
+		require.NoError(t, repo.Create(ctx, newPasskey(t, "credential-2", valueobject.NewUserID())))
+
+		err := repo.Create(ctx, newPasskey(t, "credential-2", valueobject.NewUserID()))
+		assert.True(t, errors.Is(err, repository.ErrPasskeyExists))
+	})
+
+	t.Run("error - delete another user's passkey", func(t *testing.T) {
+		owner := valueobject.NewUserID()
+		require.NoError(t, repo.Create(ctx, newPasskey(t, "credential-3", owner)))
+
+		err := repo.Delete(ctx, valueobject.NewUserID(), []byte("credential-3"))
+		assert.True(t, errors.Is(err, repository.ErrPasskeyNotFound))
+
+		require.NoError(t, repo.Delete(ctx, owner, []byte("credential-3")))
+		found, err := repo.FindByUserID(ctx, owner)
+		require.NoError(t, err)
+		assert.Empty(t, found)
+	})
+
+	t.Run("success - delete all of a user's passkeys", func(t *testing.T) {
+		owner := valueobject.NewUserID()
+		other := valueobject.NewUserID()
+		require.NoError(t, repo.Create(ctx, newPasskey(t, "credential-4", owner)))
+		require.NoError(t, repo.Create(ctx, newPasskey(t, "credential-5", owner)))
+		require.NoError(t, repo.Create(ctx, newPasskey(t, "credential-6", other)))
+
+		require.NoError(t, repo.DeleteByUserID(ctx, owner))
+
+		found, err := repo.FindByUserID(ctx, owner)
+		require.NoError(t, err)
+		assert.Empty(t, found)
+
+		found, err = repo.FindByUserID(ctx, other)
+		require.NoError(t, err)
+		assert.Len(t, found, 1)
+	})
+}
+
+// TestPasskeyChallengeRepository_Consume tests that challenges are single use
+func TestPasskeyChallengeRepository_Consume(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreatePasskeyChallengeIndexes(ctx, testDB.Database().Collection("passkey_challenges")))
+	repo := mongodbpkg.NewPasskeyChallengeRepository(testDB.Database())
+
+	challenge, err := entity.NewPasskeyChallenge(
+		security.HashToken("challenge-1"),
+		entity.PasskeyCeremonyLogin,
+		valueobject.UserID{},
+		[]byte(`{"challenge":"abc"}`),
+		time.Now().Add(time.Minute),
+	)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, challenge))
+
+	found, err := repo.Consume(ctx, challenge.IDHash())
+	require.NoError(t, err)
+	assert.Equal(t, entity.PasskeyCeremonyLogin, found.Ceremony())
+	assert.Equal(t, challenge.Session(), found.Session())
+
+	_, err = repo.Consume(ctx, challenge.IDHash())
+	assert.True(t, errors.Is(err, repository.ErrTokenNotFound))
+}
@@ -0,0 +1,60 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPasswordResetTokenRepository_Lifecycle tests store, consume and invalidate
+func TestPasswordResetTokenRepository_Lifecycle(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreatePasswordResetTokenIndexes(ctx, testDB.Database().Collection("password_reset_tokens")))
+	repo := mongodbpkg.NewPasswordResetTokenRepository(testDB.Database())
+
+	newToken := func(t *testing.T, raw string, userID valueobject.UserID) *entity.PasswordResetToken {
+		t.Helper()
+		token, err := entity.NewPasswordResetToken(security.HashToken(raw), userID, time.Now().Add(30*time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, token))
+		return token
+	}
+
+	t.Run("success - consumed exactly once", func(t *testing.T) {
+		token := newToken(t, "reset-1", valueobject.NewUserID())
+
+		require.NoError(t, repo.MarkUsed(ctx, token.TokenHash()))
+		err := repo.MarkUsed(ctx, token.TokenHash())
+		assert.True(t, errors.Is(err, repository.ErrTokenAlreadyUsed))
+
+		found, err := repo.FindByHash(ctx, token.TokenHash())
+		require.NoError(t, err)
+		assert.True(t, found.IsUsed())
+	})
+
+	t.Run("success - delete by user", func(t *testing.T) {
+		userID := valueobject.NewUserID()
+		first := newToken(t, "reset-2", userID)
+		other := newToken(t, "reset-3", valueobject.NewUserID())
+
+		require.NoError(t, repo.DeleteByUserID(ctx, userID))
+
+		_, err := repo.FindByHash(ctx, first.TokenHash())
+		assert.True(t, errors.Is(err, repository.ErrTokenNotFound))
+		_, err = repo.FindByHash(ctx, other.TokenHash())
+		assert.NoError(t, err)
+	})
+}
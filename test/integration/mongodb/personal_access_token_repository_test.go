@@ -0,0 +1,96 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPersonalAccessTokenRepository_Lifecycle tests create, find and delete
+func TestPersonalAccessTokenRepository_Lifecycle(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreatePersonalAccessTokenIndexes(ctx, testDB.Database().Collection("personal_access_tokens")))
+	repo := mongodbpkg.NewPersonalAccessTokenRepository(testDB.Database())
+
+	newToken := func(t *testing.T, secret string, userID valueobject.UserID) *entity.PersonalAccessToken {
+		t.Helper()
+		token, err := entity.NewPersonalAccessToken(
+			security.HashToken(secret),
+			userID,
+			valueobject.DefaultTenantID(),
+			"CI deploy",
+			[]string{"deploy"},
+			time.Now().Add(time.Hour),
+		)
+		require.NoError(t, err)
+		return token
+	}
+
+	t.Run("success - round trip", func(t *testing.T) {
+		userID := valueobject.NewUserID()
+		token := newToken(t, "lpat_one", userID)
+		require.NoError(t, repo.Create(ctx, token))
+
+		found, err := repo.FindByHash(ctx, security.HashToken("lpat_one"))
+		require.NoError(t, err)
+		assert.Equal(t, token.ID(), found.ID())
+		assert.Equal(t, "CI deploy", found.Name())
+		assert.Equal(t, []string{"deploy"}, found.Scopes())
+		assert.True(t, userID.Equals(found.UserID()))
+		assert.WithinDuration(t, token.ExpiresAt(), found.ExpiresAt(), time.Second)
+
+		listed, err := repo.FindByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, token.ID(), listed[0].ID())
+	})
+
+	t.Run("error - unknown token", func(t *testing.T) {
+		_, err := repo.FindByHash(ctx, security.HashToken("lpat_missing"))
+		assert.True(t, errors.Is(err, repository.ErrTokenNotFound))
+	})
+
+	t.Run("error - delete another user's token", func(t *testing.T) {
+		owner := valueobject.NewUserID()
+		token := newToken(t, "lpat_two", owner)
+		require.NoError(t, repo.Create(ctx, token))
+
+		err := repo.Delete(ctx, valueobject.NewUserID(), token.ID())
+		assert.True(t, errors.Is(err, repository.ErrTokenNotFound))
+
+		require.NoError(t, repo.Delete(ctx, owner, token.ID()))
+		_, err = repo.FindByHash(ctx, security.HashToken("lpat_two"))
+		assert.True(t, errors.Is(err, repository.ErrTokenNotFound))
+	})
+
+	t.Run("success - delete all of a user's tokens", func(t *testing.T) {
+		owner := valueobject.NewUserID()
+		other := valueobject.NewUserID()
+		require.NoError(t, repo.Create(ctx, newToken(t, "lpat_three", owner)))
+		require.NoError(t, repo.Create(ctx, newToken(t, "lpat_four", owner)))
+		require.NoError(t, repo.Create(ctx, newToken(t, "lpat_five", other)))
+
+		require.NoError(t, repo.DeleteByUserID(ctx, owner))
+
+		found, err := repo.FindByUserID(ctx, owner)
+		require.NoError(t, err)
+		assert.Empty(t, found)
+
+		found, err = repo.FindByUserID(ctx, other)
+		require.NoError(t, err)
+		assert.Len(t, found, 1)
+	})
+}
@@ -0,0 +1,120 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRefreshToken(t *testing.T, raw, familyID string, userID valueobject.UserID) *entity.RefreshToken {
+	t.Helper()
+
+	token, err := entity.NewRefreshToken(security.HashToken(raw), familyID, userID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	return token
+}
+
+// TestRefreshTokenRepository_CreateAndFind tests storing and loading tokens
+func TestRefreshTokenRepository_CreateAndFind(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreateRefreshTokenIndexes(ctx, testDB.Database().Collection("refresh_tokens")))
+	repo := mongodbpkg.NewRefreshTokenRepository(testDB.Database())
+
+	t.Run("success - token found by hash", func(t *testing.T) {
+		userID := valueobject.NewUserID()
+		token := newTestRefreshToken(t, "raw-token-1", entity.NewTokenFamilyID(), userID)
+		require.NoError(t, repo.Create(ctx, token))
+
+		found, err := repo.FindByHash(ctx, token.TokenHash())
+
+		require.NoError(t, err)
+		assert.Equal(t, token.FamilyID(), found.FamilyID())
+		assert.True(t, found.UserID().Equals(userID))
+		assert.False(t, found.IsUsed())
+		assert.False(t, found.IsRevoked())
+	})
+
+	t.Run("error - token not found", func(t *testing.T) {
+		found, err := repo.FindByHash(ctx, security.HashToken("never-stored"))
+
+		require.Error(t, err)
+		assert.Nil(t, found)
+		assert.True(t, errors.Is(err, repository.ErrTokenNotFound))
+	})
+}
+
+// TestRefreshTokenRepository_MarkUsed tests single-use rotation
+func TestRefreshTokenRepository_MarkUsed(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	repo := mongodbpkg.NewRefreshTokenRepository(testDB.Database())
+
+	token := newTestRefreshToken(t, "raw-token-2", entity.NewTokenFamilyID(), valueobject.NewUserID())
+	require.NoError(t, repo.Create(ctx, token))
+
+	// First rotation wins
+	err := repo.MarkUsed(ctx, token.TokenHash(), "next-hash")
+	require.NoError(t, err)
+
+	found, err := repo.FindByHash(ctx, token.TokenHash())
+	require.NoError(t, err)
+	assert.True(t, found.IsUsed())
+	assert.Equal(t, "next-hash", found.ReplacedBy())
+
+	// Second rotation loses
+	err = repo.MarkUsed(ctx, token.TokenHash(), "other-hash")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, repository.ErrTokenAlreadyUsed))
+}
+
+// TestRefreshTokenRepository_RevokeFamily tests revoking every token in a family
+func TestRefreshTokenRepository_RevokeFamily(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	repo := mongodbpkg.NewRefreshTokenRepository(testDB.Database())
+
+	userID := valueobject.NewUserID()
+	familyID := entity.NewTokenFamilyID()
+	first := newTestRefreshToken(t, "family-token-1", familyID, userID)
+	second := newTestRefreshToken(t, "family-token-2", familyID, userID)
+	other := newTestRefreshToken(t, "other-family-token", entity.NewTokenFamilyID(), userID)
+
+	for _, token := range []*entity.RefreshToken{first, second, other} {
+		require.NoError(t, repo.Create(ctx, token))
+	}
+
+	// Act
+	require.NoError(t, repo.RevokeFamily(ctx, familyID))
+
+	// Assert
+	for _, token := range []*entity.RefreshToken{first, second} {
+		found, err := repo.FindByHash(ctx, token.TokenHash())
+		require.NoError(t, err)
+		assert.True(t, found.IsRevoked())
+	}
+
+	found, err := repo.FindByHash(ctx, other.TokenHash())
+	require.NoError(t, err)
+	assert.False(t, found.IsRevoked(), "other families must be untouched")
+
+	// Revoked tokens can no longer be rotated
+	err = repo.MarkUsed(ctx, first.TokenHash(), "next-hash")
+	assert.True(t, errors.Is(err, repository.ErrTokenAlreadyUsed))
+}
@@ -0,0 +1,112 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSessionRepository_Lifecycle tests create, refresh, list and end
+func TestSessionRepository_Lifecycle(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	require.NoError(t, mongodbpkg.CreateSessionIndexes(ctx, testDB.Database().Collection("sessions")))
+	repo := mongodbpkg.NewSessionRepository(testDB.Database())
+
+	newSession := func(t *testing.T, userID valueobject.UserID) *entity.Session {
+		t.Helper()
+		session, err := entity.NewSession(userID, entity.NewTokenFamilyID(), "", "Firefox", "203.0.113.7", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, session))
+		return session
+	}
+
+	t.Run("success - round trip", func(t *testing.T) {
+		userID := valueobject.NewUserID()
+		session := newSession(t, userID)
+
+		found, err := repo.FindByID(ctx, session.ID())
+		require.NoError(t, err)
+		assert.True(t, userID.Equals(found.UserID()))
+		assert.Equal(t, session.FamilyID(), found.FamilyID())
+		assert.Equal(t, "Firefox", found.UserAgent())
+		assert.Equal(t, "203.0.113.7", found.IPAddress())
+		assert.WithinDuration(t, session.ExpiresAt(), found.ExpiresAt(), time.Second)
+
+		found, err = repo.FindByFamilyID(ctx, session.FamilyID())
+		require.NoError(t, err)
+		assert.Equal(t, session.ID(), found.ID())
+	})
+
+	t.Run("success - update records the refresh", func(t *testing.T) {
+		session := newSession(t, valueobject.NewUserID())
+
+		session.Touch("Safari", "198.51.100.2", time.Now().Add(2*time.Hour))
+		require.NoError(t, repo.Update(ctx, session))
+
+		found, err := repo.FindByID(ctx, session.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "Safari", found.UserAgent())
+		assert.Equal(t, "198.51.100.2", found.IPAddress())
+		assert.WithinDuration(t, session.LastSeenAt(), found.LastSeenAt(), time.Second)
+		assert.WithinDuration(t, session.ExpiresAt(), found.ExpiresAt(), time.Second)
+	})
+
+	t.Run("success - list most recently seen first", func(t *testing.T) {
+		userID := valueobject.NewUserID()
+		older := newSession(t, userID)
+		newer := newSession(t, userID)
+		newSession(t, valueobject.NewUserID())
+
+		older.Touch("", "", time.Now().Add(time.Hour))
+		require.NoError(t, repo.Update(ctx, older))
+
+		listed, err := repo.FindByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, listed, 2)
+		assert.Equal(t, older.ID(), listed[0].ID())
+		assert.Equal(t, newer.ID(), listed[1].ID())
+	})
+
+	t.Run("error - unknown session", func(t *testing.T) {
+		_, err := repo.FindByID(ctx, "missing")
+		assert.True(t, errors.Is(err, repository.ErrSessionNotFound))
+
+		_, err = repo.FindByFamilyID(ctx, "missing")
+		assert.True(t, errors.Is(err, repository.ErrSessionNotFound))
+
+		session, _ := entity.NewSession(valueobject.NewUserID(), "family", "", "", "", time.Now().Add(time.Hour))
+		assert.True(t, errors.Is(repo.Update(ctx, session), repository.ErrSessionNotFound))
+	})
+
+	t.Run("success - end sessions", func(t *testing.T) {
+		userID := valueobject.NewUserID()
+		one := newSession(t, userID)
+		two := newSession(t, userID)
+		newSession(t, userID)
+
+		require.NoError(t, repo.Delete(ctx, one.ID()))
+		require.NoError(t, repo.Delete(ctx, one.ID()), "already ended is not an error")
+		require.NoError(t, repo.DeleteByFamilyID(ctx, two.FamilyID()))
+
+		listed, err := repo.FindByUserID(ctx, userID)
+		require.NoError(t, err)
+		assert.Len(t, listed, 1)
+
+		require.NoError(t, repo.DeleteByUserID(ctx, userID))
+		listed, err = repo.FindByUserID(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, listed)
+	})
+}
@@ -0,0 +1,116 @@
+package mongodb_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// TestSigningKeyStore_Lifecycle tests add, promote and retire in MongoDB
+func TestSigningKeyStore_Lifecycle(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	cipher, err := security.NewAESCipherFromBase64(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	require.NoError(t, err)
+	store := mongodbpkg.NewSigningKeyStore(testDB.Database(), cipher)
+
+	first, err := security.GenerateStoredKey(security.AlgorithmES256)
+	require.NoError(t, err)
+	second, err := security.GenerateStoredKey(security.AlgorithmES256)
+	require.NoError(t, err)
+
+	// Add and promote the first key
+	require.NoError(t, store.Add(ctx, first))
+	require.NoError(t, store.Promote(ctx, first.ID))
+
+	err = store.Add(ctx, first)
+	assert.True(t, errors.Is(err, security.ErrKeyExists))
+
+	// Rotate to the second key
+	require.NoError(t, store.Add(ctx, second))
+	require.NoError(t, store.Promote(ctx, second.ID))
+
+	ring := security.NewKeyRing(security.NewHMACSigningKey("", []byte("test-secret-key-at-least-32-characters")))
+	require.NoError(t, security.ReloadKeyRing(ctx, ring, store))
+	assert.Equal(t, second.ID, ring.Active().ID())
+	assert.Len(t, ring.Keys(), 2)
+
+	// Retire rules
+	err = store.Retire(ctx, second.ID)
+	assert.True(t, errors.Is(err, security.ErrRetireActiveKey))
+
+	require.NoError(t, store.Retire(ctx, first.ID))
+	require.NoError(t, security.ReloadKeyRing(ctx, ring, store))
+	assert.Len(t, ring.Keys(), 1)
+
+	err = store.Retire(ctx, "missing")
+	assert.True(t, errors.Is(err, security.ErrKeyNotFound))
+}
+
+// TestSigningKeyStore_EncryptsMaterial tests that private keys are never stored in plain text
+func TestSigningKeyStore_EncryptsMaterial(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	cipher, err := security.NewAESCipherFromBase64(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	require.NoError(t, err)
+	store := mongodbpkg.NewSigningKeyStore(testDB.Database(), cipher)
+	collection := testDB.Database().Collection("signing_keys")
+
+	key, err := security.GenerateStoredKey(security.AlgorithmES256)
+	require.NoError(t, err)
+	require.NoError(t, store.Add(ctx, key))
+
+	// Stored sealed
+	var doc mongodbpkg.SigningKeyDocument
+	require.NoError(t, collection.FindOne(ctx, bson.M{"_id": key.ID}).Decode(&doc))
+	assert.Empty(t, doc.Material)
+	assert.NotEmpty(t, doc.EncryptedMaterial)
+	assert.NotContains(t, doc.EncryptedMaterial, "PRIVATE KEY")
+
+	// Read back in the clear
+	keys, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, key.Material, keys[0].Material)
+
+	// Another encryption key can't read it
+	otherCipher, err := security.NewAESCipherFromBase64(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+	require.NoError(t, err)
+	_, err = mongodbpkg.NewSigningKeyStore(testDB.Database(), otherCipher).List(ctx)
+	assert.Error(t, err)
+
+	// Keys written before encryption are sealed when read
+	legacy, err := security.GenerateStoredKey(security.AlgorithmES256)
+	require.NoError(t, err)
+	_, err = collection.InsertOne(ctx, mongodbpkg.SigningKeyDocument{
+		ID:        legacy.ID,
+		Algorithm: legacy.Algorithm,
+		Material:  legacy.Material,
+		Status:    string(security.KeyStatusVerify),
+		CreatedAt: legacy.CreatedAt,
+	})
+	require.NoError(t, err)
+
+	keys, err = store.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, legacy.Material, keys[1].Material)
+
+	doc = mongodbpkg.SigningKeyDocument{}
+	require.NoError(t, collection.FindOne(ctx, bson.M{"_id": legacy.ID}).Decode(&doc))
+	assert.Empty(t, doc.Material)
+	assert.NotEmpty(t, doc.EncryptedMaterial)
+}
@@ -6,12 +6,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
 	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
 	mongodbpkg "github.com/Ruseigha/LabukaAuth/internal/infrastructure/persistence/mongodb"
 	"github.com/Ruseigha/LabukaAuth/internal/repository"
 	"github.com/Ruseigha/LabukaAuth/test/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func TestUserRepository_Create(t *testing.T) {
@@ -115,7 +119,7 @@ func TestUserRepository_FindByEmail(t *testing.T) {
 		emailVO, _ := valueobject.NewEmail(email)
 
 		// Act
-		found, err := repo.FindByEmail(ctx, emailVO)
+		found, err := repo.FindByEmail(ctx, valueobject.DefaultTenantID(), emailVO)
 
 		// Assert
 		require.NoError(t, err)
@@ -135,7 +139,7 @@ func TestUserRepository_FindByEmail(t *testing.T) {
 		emailVO, _ := valueobject.NewEmail("casetest@example.com")
 
 		// Act
-		found, err := repo.FindByEmail(ctx, emailVO)
+		found, err := repo.FindByEmail(ctx, valueobject.DefaultTenantID(), emailVO)
 
 		// Assert
 		require.NoError(t, err)
@@ -148,7 +152,7 @@ func TestUserRepository_FindByEmail(t *testing.T) {
 		emailVO, _ := valueobject.NewEmail("notfound@example.com")
 
 		// Act
-		found, err := repo.FindByEmail(ctx, emailVO)
+		found, err := repo.FindByEmail(ctx, valueobject.DefaultTenantID(), emailVO)
 
 		// Assert
 		require.Error(t, err)
@@ -189,6 +193,37 @@ func TestUserRepository_Update(t *testing.T) {
 		assert.Equal(t, "updated@example.com", found.Email().String())
 	})
 
+	t.Run("success - pending email change", func(t *testing.T) {
+		// Arrange
+		testDB.CleanCollection(t)
+		user := testutil.CreateTestUser(t, "original@example.com", "SecureP@ss123")
+		err := repo.Create(ctx, user)
+		require.NoError(t, err)
+
+		newEmail, _ := valueobject.NewEmail("pending@example.com")
+		require.NoError(t, user.RequestEmailChange(newEmail))
+
+		// Act
+		err = repo.Update(ctx, user)
+
+		// Assert
+		require.NoError(t, err)
+
+		found, err := repo.FindByID(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "original@example.com", found.Email().String())
+		assert.Equal(t, "pending@example.com", found.PendingEmail().String())
+
+		// Confirming clears it
+		require.NoError(t, found.ConfirmEmailChange())
+		require.NoError(t, repo.Update(ctx, found))
+
+		found, err = repo.FindByID(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "pending@example.com", found.Email().String())
+		assert.True(t, found.PendingEmail().IsEmpty())
+	})
+
 	t.Run("success - deactivate user", func(t *testing.T) {
 		// Arrange
 		testDB.CleanCollection(t)
@@ -211,6 +246,61 @@ func TestUserRepository_Update(t *testing.T) {
 		assert.False(t, found.IsActive())
 	})
 
+	t.Run("success - mfa round trip", func(t *testing.T) {
+		// Arrange
+		testDB.CleanCollection(t)
+		user := testutil.CreateTestUser(t, "mfa@example.com", "SecureP@ss123")
+		err := repo.Create(ctx, user)
+		require.NoError(t, err)
+
+		require.NoError(t, user.BeginMFAEnrollment("encrypted-secret"))
+		require.NoError(t, user.ConfirmMFAEnrollment([]string{"hash-1", "hash-2"}, 42))
+
+		// Act
+		err = repo.Update(ctx, user)
+
+		// Assert
+		require.NoError(t, err)
+
+		found, err := repo.FindByID(ctx, user.ID())
+		require.NoError(t, err)
+		assert.True(t, found.IsMFAEnabled())
+		assert.Equal(t, user.MFA(), found.MFA())
+
+		// Disabling removes the field
+		user.DisableMFA()
+		require.NoError(t, repo.Update(ctx, user))
+
+		found, err = repo.FindByID(ctx, user.ID())
+		require.NoError(t, err)
+		assert.False(t, found.IsMFAEnabled())
+	})
+
+	t.Run("success - roles and forced reset round trip", func(t *testing.T) {
+		// Arrange
+		testDB.CleanCollection(t)
+		user := testutil.CreateTestUser(t, "admin@example.com", "SecureP@ss123")
+		require.NoError(t, repo.Create(ctx, user))
+
+		_, err := user.GrantRole(entity.RoleAdmin)
+		require.NoError(t, err)
+		_, err = user.GrantPermission("orders:write")
+		require.NoError(t, err)
+		user.RequirePasswordReset()
+
+		// Act
+		err = repo.Update(ctx, user)
+
+		// Assert
+		require.NoError(t, err)
+
+		found, err := repo.FindByID(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []string{entity.RoleAdmin}, found.Access().Roles)
+		assert.Equal(t, []string{"orders:write"}, found.Access().Permissions)
+		assert.True(t, found.PasswordResetRequired())
+	})
+
 	t.Run("error - user not found", func(t *testing.T) {
 		// Arrange
 		testDB.CleanCollection(t)
@@ -226,6 +316,54 @@ func TestUserRepository_Update(t *testing.T) {
 	})
 }
 
+// TestUserRepository_UseMFACodes tests that TOTP steps and recovery codes are used once
+func TestUserRepository_UseMFACodes(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	repo := mongodbpkg.NewUserRepository(testDB.Database())
+	ctx := context.Background()
+
+	user := testutil.CreateTestUser(t, "mfa@example.com", "SecureP@ss123")
+	require.NoError(t, user.BeginMFAEnrollment("encrypted-secret"))
+	require.NoError(t, user.ConfirmMFAEnrollment([]string{"hash-1", "hash-2"}, 42))
+	require.NoError(t, repo.Create(ctx, user))
+
+	t.Run("totp step", func(t *testing.T) {
+		require.NoError(t, repo.UseTOTPStep(ctx, user.ID(), 43))
+
+		err := repo.UseTOTPStep(ctx, user.ID(), 43)
+		assert.True(t, errors.Is(err, repository.ErrMFACodeUsed))
+		err = repo.UseTOTPStep(ctx, user.ID(), 42)
+		assert.True(t, errors.Is(err, repository.ErrMFACodeUsed))
+
+		found, err := repo.FindByID(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Equal(t, int64(43), found.MFA().LastUsedStep)
+	})
+
+	t.Run("recovery code", func(t *testing.T) {
+		require.NoError(t, repo.UseRecoveryCode(ctx, user.ID(), "hash-1"))
+
+		err := repo.UseRecoveryCode(ctx, user.ID(), "hash-1")
+		assert.True(t, errors.Is(err, repository.ErrMFACodeUsed))
+
+		found, err := repo.FindByID(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hash-2"}, found.MFA().RecoveryCodes)
+	})
+
+	t.Run("mfa disabled", func(t *testing.T) {
+		user.DisableMFA()
+		require.NoError(t, repo.Update(ctx, user))
+
+		err := repo.UseTOTPStep(ctx, user.ID(), 100)
+		assert.True(t, errors.Is(err, repository.ErrMFACodeUsed))
+		err = repo.UseRecoveryCode(ctx, user.ID(), "hash-2")
+		assert.True(t, errors.Is(err, repository.ErrMFACodeUsed))
+	})
+}
+
 // TestUserRepository_Delete tests deleting users
 func TestUserRepository_Delete(t *testing.T) {
 	testDB := testutil.SetupMongoDB(t)
@@ -287,7 +425,7 @@ func TestUserRepository_ExistsByEmail(t *testing.T) {
 		emailVO, _ := valueobject.NewEmail(email)
 
 		// Act
-		exists, err := repo.ExistsByEmail(ctx, emailVO)
+		exists, err := repo.ExistsByEmail(ctx, valueobject.DefaultTenantID(), emailVO)
 
 		// Assert
 		require.NoError(t, err)
@@ -300,7 +438,7 @@ func TestUserRepository_ExistsByEmail(t *testing.T) {
 		emailVO, _ := valueobject.NewEmail("notexists@example.com")
 
 		// Act
-		exists, err := repo.ExistsByEmail(ctx, emailVO)
+		exists, err := repo.ExistsByEmail(ctx, valueobject.DefaultTenantID(), emailVO)
 
 		// Assert
 		require.NoError(t, err)
@@ -329,14 +467,14 @@ func TestUserRepository_List(t *testing.T) {
 		}
 
 		// Act - Get first 3
-		users, err := repo.List(ctx, 0, 3)
+		users, err := repo.List(ctx, valueobject.DefaultTenantID(), 0, 3)
 
 		// Assert
 		require.NoError(t, err)
 		assert.Len(t, users, 3)
 
 		// Act - Get next 2
-		users, err = repo.List(ctx, 3, 3)
+		users, err = repo.List(ctx, valueobject.DefaultTenantID(), 3, 3)
 
 		// Assert
 		require.NoError(t, err)
@@ -348,7 +486,7 @@ func TestUserRepository_List(t *testing.T) {
 		testDB.CleanCollection(t)
 
 		// Act
-		users, err := repo.List(ctx, 0, 10)
+		users, err := repo.List(ctx, valueobject.DefaultTenantID(), 0, 10)
 
 		// Assert
 		require.NoError(t, err)
@@ -376,7 +514,7 @@ func TestUserRepository_Count(t *testing.T) {
 		}
 
 		// Act
-		count, err := repo.Count(ctx)
+		count, err := repo.Count(ctx, valueobject.DefaultTenantID())
 
 		// Assert
 		require.NoError(t, err)
@@ -388,10 +526,103 @@ func TestUserRepository_Count(t *testing.T) {
 		testDB.CleanCollection(t)
 
 		// Act
-		count, err := repo.Count(ctx)
+		count, err := repo.Count(ctx, valueobject.DefaultTenantID())
 
 		// Assert
 		require.NoError(t, err)
 		assert.Equal(t, int64(0), count)
 	})
 }
+
+// TestUserRepository_TenantScoping tests that emails are unique per tenant only
+func TestUserRepository_TenantScoping(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	repo := mongodbpkg.NewUserRepository(testDB.Database())
+	ctx := context.Background()
+
+	acme, err := valueobject.NewTenantID("acme")
+	require.NoError(t, err)
+	email, _ := valueobject.NewEmail("shared@example.com")
+	password := valueobject.NewPasswordFromHash("hashed_SecureP@ss123")
+
+	defaultUser, err := entity.NewUser(valueobject.DefaultTenantID(), email, password)
+	require.NoError(t, err)
+	acmeUser, err := entity.NewUser(acme, email, password)
+	require.NoError(t, err)
+
+	// Same email in two tenants is allowed
+	require.NoError(t, repo.Create(ctx, defaultUser))
+	require.NoError(t, repo.Create(ctx, acmeUser))
+
+	// But not twice in one tenant
+	duplicate, err := entity.NewUser(acme, email, password)
+	require.NoError(t, err)
+	assert.True(t, errors.Is(repo.Create(ctx, duplicate), repository.ErrUserAlreadyExists))
+
+	// Lookups stay inside the tenant
+	found, err := repo.FindByEmail(ctx, acme, email)
+	require.NoError(t, err)
+	assert.Equal(t, acmeUser.ID(), found.ID())
+	assert.Equal(t, acme, found.TenantID())
+
+	count, err := repo.Count(ctx, acme)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	users, err := repo.List(ctx, valueobject.DefaultTenantID(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, defaultUser.ID(), users[0].ID())
+}
+
+// TestCreateIndexes_MigratesUsersToTenants tests upgrading a pre-tenancy database
+func TestCreateIndexes_MigratesUsersToTenants(t *testing.T) {
+	testDB := testutil.SetupMongoDB(t)
+	defer testDB.Cleanup(t)
+
+	ctx := context.Background()
+	collection := testDB.Database().Collection("users")
+
+	// Recreate the old layout: global unique email index, no tenant_id
+	require.NoError(t, mongodbpkg.DropIndexes(ctx, collection))
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "email", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("email_unique_idx"),
+	})
+	require.NoError(t, err)
+
+	legacyID := valueobject.NewUserID().String()
+	_, err = collection.InsertOne(ctx, bson.M{
+		"_id":        legacyID,
+		"email":      "legacy@example.com",
+		"password":   "hashed_SecureP@ss123",
+		"created_at": time.Now().UTC(),
+		"updated_at": time.Now().UTC(),
+		"is_active":  true,
+	})
+	require.NoError(t, err)
+
+	// Act (twice - the migration must be idempotent)
+	require.NoError(t, mongodbpkg.CreateIndexes(ctx, collection))
+	require.NoError(t, mongodbpkg.CreateIndexes(ctx, collection))
+
+	// The user moved into the default tenant
+	var doc mongodbpkg.UserDocument
+	require.NoError(t, collection.FindOne(ctx, bson.M{"_id": legacyID}).Decode(&doc))
+	assert.Equal(t, valueobject.DefaultTenant, doc.TenantID)
+
+	// And the old index is gone
+	cursor, err := collection.Indexes().List(ctx)
+	require.NoError(t, err)
+	var indexes []bson.M
+	require.NoError(t, cursor.All(ctx, &indexes))
+
+	names := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		names = append(names, index["name"].(string))
+	}
+	assert.NotContains(t, names, "email_unique_idx")
+	assert.Contains(t, names, "tenant_email_unique_idx")
+}
@@ -24,7 +24,7 @@ func CreateTestUser(t *testing.T, email, password string) *entity.User {
 		t.Fatalf("Failed to create test password: %v", err)
 	}
 
-	user, err := entity.NewUser(emailVO, passwordVO)
+	user, err := entity.NewUser(valueobject.DefaultTenantID(), emailVO, passwordVO)
 	if err != nil {
 		t.Fatalf("Failed to create test user: %v", err)
 	}
@@ -55,11 +55,17 @@ func CreateTestUserWithID(t *testing.T, id, email, password string, isActive boo
 
 	return entity.ReconstructUser(
 		userID,
+		valueobject.DefaultTenantID(),
 		emailVO,
 		passwordVO,
 		now,
 		now,
 		isActive,
+		nil,
+		valueobject.Email{},
+		entity.MFA{},
+		entity.Access{},
+		false,
 	)
 }
 
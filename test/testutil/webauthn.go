@@ -0,0 +1,178 @@
+package testutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+)
+
+// SoftAuthenticator is an in-memory WebAuthn authenticator
+// WHY: Exercises the real verifier end to end without a browser
+type SoftAuthenticator struct {
+	RPID   string
+	Origin string // Origin the "browser" reports in client data
+
+	// SignCount is the counter sent with the next assertion
+	SignCount uint32
+
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+}
+
+// NewSoftAuthenticator creates an authenticator with a fresh P-256 key
+func NewSoftAuthenticator(t *testing.T, rpID, origin string) *SoftAuthenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate authenticator key: %v", err)
+	}
+
+	credentialID := make([]byte, 16)
+	if _, err := rand.Read(credentialID); err != nil {
+		t.Fatalf("Failed to generate credential ID: %v", err)
+	}
+
+	return &SoftAuthenticator{RPID: rpID, Origin: origin, key: key, credentialID: credentialID}
+}
+
+// CredentialID returns the credential ID, base64url encoded
+func (a *SoftAuthenticator) CredentialID() string {
+	return base64.RawURLEncoding.EncodeToString(a.credentialID)
+}
+
+// Create answers creation options like navigator.credentials.create()
+// with "none" attestation
+func (a *SoftAuthenticator) Create(t *testing.T, options []byte) []byte {
+	t.Helper()
+
+	var creation struct {
+		PublicKey struct {
+			Challenge string `json:"challenge"`
+			User      struct {
+				ID string `json:"id"`
+			} `json:"user"`
+		} `json:"publicKey"`
+	}
+	if err := json.Unmarshal(options, &creation); err != nil {
+		t.Fatalf("Failed to parse creation options: %v", err)
+	}
+
+	userHandle, err := base64.RawURLEncoding.DecodeString(creation.PublicKey.User.ID)
+	if err != nil {
+		t.Fatalf("Failed to decode user handle: %v", err)
+	}
+	a.userHandle = userHandle
+
+	coseKey, err := webauthncbor.Marshal(map[int]any{
+		1:  2,  // kty: EC2
+		3:  -7, // alg: ES256
+		-1: 1,  // crv: P-256
+		-2: a.key.PublicKey.X.FillBytes(make([]byte, 32)),
+		-3: a.key.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		t.Fatalf("Failed to encode public key: %v", err)
+	}
+
+	// Attested credential data: AAGUID | ID length | ID | public key
+	authData := a.authenticatorData(0x45) // UP | UV | AT
+	authData = append(authData, make([]byte, 16)...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, coseKey...)
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		t.Fatalf("Failed to encode attestation: %v", err)
+	}
+
+	return a.credentialJSON(t, map[string]string{
+		"clientDataJSON":    encode(a.clientData(t, "webauthn.create", creation.PublicKey.Challenge)),
+		"attestationObject": encode(attestation),
+	})
+}
+
+// Get answers request options like navigator.credentials.get()
+func (a *SoftAuthenticator) Get(t *testing.T, options []byte) []byte {
+	t.Helper()
+
+	var request struct {
+		PublicKey struct {
+			Challenge string `json:"challenge"`
+		} `json:"publicKey"`
+	}
+	if err := json.Unmarshal(options, &request); err != nil {
+		t.Fatalf("Failed to parse request options: %v", err)
+	}
+
+	a.SignCount++
+	authData := a.authenticatorData(0x05) // UP | UV
+	clientData := a.clientData(t, "webauthn.get", request.PublicKey.Challenge)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatalf("Failed to sign assertion: %v", err)
+	}
+
+	return a.credentialJSON(t, map[string]string{
+		"clientDataJSON":    encode(clientData),
+		"authenticatorData": encode(authData),
+		"signature":         encode(signature),
+		"userHandle":        encode(a.userHandle),
+	})
+}
+
+// authenticatorData builds RP ID hash | flags | sign count
+func (a *SoftAuthenticator) authenticatorData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	data := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, a.SignCount)
+}
+
+func (a *SoftAuthenticator) clientData(t *testing.T, ceremony, challenge string) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]string{
+		"type":      ceremony,
+		"challenge": challenge,
+		"origin":    a.Origin,
+	})
+	if err != nil {
+		t.Fatalf("Failed to encode client data: %v", err)
+	}
+	return data
+}
+
+func (a *SoftAuthenticator) credentialJSON(t *testing.T, response map[string]string) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{
+		"id":       a.CredentialID(),
+		"rawId":    a.CredentialID(),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		t.Fatalf("Failed to encode credential: %v", err)
+	}
+	return data
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,98 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/delivery/grpc/handler"
+	"github.com/Ruseigha/LabukaAuth/internal/delivery/grpc/proto/proto"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubAuthService answers ValidateToken with fixed claims and records MFA calls
+// NOTE: Methods the tests don't use panic through the nil embedded interface
+type stubAuthService struct {
+	usecase.AuthUseCase
+
+	claims *usecase.TokenClaims
+
+	confirmMFACalls int
+	disableMFACalls int
+}
+
+func (s *stubAuthService) ValidateToken(ctx context.Context, token string) (*usecase.TokenClaims, error) {
+	return s.claims, nil
+}
+
+func (s *stubAuthService) ConfirmMFA(ctx context.Context, req usecase.ConfirmMFARequest) (*usecase.ConfirmMFAResponse, error) {
+	s.confirmMFACalls++
+	return &usecase.ConfirmMFAResponse{}, nil
+}
+
+func (s *stubAuthService) DisableMFA(ctx context.Context, req usecase.DisableMFARequest) error {
+	s.disableMFACalls++
+	return nil
+}
+
+// TestAuthHandler_RejectsClientTokens tests that OAuth client tokens can't change MFA
+func TestAuthHandler_RejectsClientTokens(t *testing.T) {
+	service := &stubAuthService{claims: &usecase.TokenClaims{
+		UserID:   "user-1",
+		ClientID: "third-party-app",
+		Scopes:   []string{"openid"},
+	}}
+	h := handler.NewAuthHandler(service)
+
+	_, err := h.ConfirmMFA(context.Background(), &proto.ConfirmMFARequest{AccessToken: "token", Code: "123456"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = h.DisableMFA(context.Background(), &proto.DisableMFARequest{
+		AccessToken:     "token",
+		CurrentPassword: "SecureP@ss123",
+		Code:            "123456",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	assert.Equal(t, 0, service.confirmMFACalls)
+	assert.Equal(t, 0, service.disableMFACalls)
+}
+
+// TestAuthHandler_AllowsUserTokens tests that the user's own tokens still work
+func TestAuthHandler_AllowsUserTokens(t *testing.T) {
+	service := &stubAuthService{claims: &usecase.TokenClaims{UserID: "user-1"}}
+	h := handler.NewAuthHandler(service)
+
+	_, err := h.ConfirmMFA(context.Background(), &proto.ConfirmMFARequest{AccessToken: "token", Code: "123456"})
+	require.NoError(t, err)
+
+	_, err = h.DisableMFA(context.Background(), &proto.DisableMFARequest{
+		AccessToken:     "token",
+		CurrentPassword: "SecureP@ss123",
+		Code:            "123456",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, service.confirmMFACalls)
+	assert.Equal(t, 1, service.disableMFACalls)
+}
+
+// TestAuthHandler_RejectsPersonalAccessTokens tests that personal access tokens can't change MFA
+func TestAuthHandler_RejectsPersonalAccessTokens(t *testing.T) {
+	service := &stubAuthService{claims: &usecase.TokenClaims{
+		UserID:                "user-1",
+		PersonalAccessTokenID: "pat-1",
+	}}
+	h := handler.NewAuthHandler(service)
+
+	_, err := h.DisableMFA(context.Background(), &proto.DisableMFARequest{
+		AccessToken:     "lpat_token",
+		CurrentPassword: "SecureP@ss123",
+		Code:            "123456",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, 0, service.disableMFACalls)
+}
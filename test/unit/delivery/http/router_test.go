@@ -0,0 +1,105 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	deliveryhttp "github.com/Ruseigha/LabukaAuth/internal/delivery/http"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubAuthService answers ValidateToken with fixed claims and records the calls routes reach
+// NOTE: Methods the tests don't use panic through the nil embedded interface
+type stubAuthService struct {
+	usecase.AuthUseCase
+
+	claims *usecase.TokenClaims
+
+	authorizeCalls int
+	listUsersCalls int
+}
+
+func (s *stubAuthService) ValidateToken(ctx context.Context, token string) (*usecase.TokenClaims, error) {
+	return s.claims, nil
+}
+
+func (s *stubAuthService) Authorize(ctx context.Context, req usecase.ConsentRequest) (*usecase.AuthorizeResponse, error) {
+	s.authorizeCalls++
+	return &usecase.AuthorizeResponse{RedirectURI: "https://client.example.com/callback?code=x"}, nil
+}
+
+func (s *stubAuthService) ListUsers(ctx context.Context, req usecase.ListUsersRequest) (*usecase.UserList, error) {
+	s.listUsersCalls++
+	return &usecase.UserList{}, nil
+}
+
+// serve sends a request with a bearer token through the full router
+func serve(service *stubAuthService, method, path, body string) *httptest.ResponseRecorder {
+	router := deliveryhttp.SetupRouter(service, nil, "test", "", "")
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer lpat_token")
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+// patClaims are the claims of a personal access token scoped to the admin role
+func patClaims() *usecase.TokenClaims {
+	return &usecase.TokenClaims{
+		UserID:                "user-1",
+		Roles:                 []string{entity.RoleAdmin},
+		Scopes:                []string{entity.RoleAdmin},
+		PersonalAccessTokenID: "pat-1",
+	}
+}
+
+// TestRouter_PersonalAccessTokenCantConsent tests that a PAT can't approve OAuth consent
+func TestRouter_PersonalAccessTokenCantConsent(t *testing.T) {
+	service := &stubAuthService{claims: patClaims()}
+
+	rec := serve(service, http.MethodPost, "/api/v1/oauth/consent", `{"approve":true,"client_id":"app"}`)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, 0, service.authorizeCalls)
+}
+
+// TestRouter_PersonalAccessTokenAccountRoutes tests that a PAT can't manage the account
+func TestRouter_PersonalAccessTokenAccountRoutes(t *testing.T) {
+	routes := []struct{ method, path string }{
+		{http.MethodPost, "/api/v1/auth/logout"},
+		{http.MethodPost, "/api/v1/auth/password/change"},
+		{http.MethodPost, "/api/v1/auth/mfa/disable"},
+		{http.MethodPost, "/api/v1/auth/passkeys/register/begin"},
+		{http.MethodPost, "/api/v1/auth/tokens"},
+		{http.MethodPost, "/api/v1/auth/sessions/revoke-others"},
+	}
+
+	for _, route := range routes {
+		t.Run(route.path, func(t *testing.T) {
+			rec := serve(&stubAuthService{claims: patClaims()}, route.method, route.path, `{}`)
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		})
+	}
+}
+
+// TestRouter_PersonalAccessTokenAdminRoutes tests that admin routes accept a PAT scoped to the role
+func TestRouter_PersonalAccessTokenAdminRoutes(t *testing.T) {
+	service := &stubAuthService{claims: patClaims()}
+
+	rec := serve(service, http.MethodGet, "/api/v1/admin/users", "")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 1, service.listUsersCalls)
+
+	// Without the scope, the role check refuses it
+	service.claims.Roles = nil
+	rec = serve(service, http.MethodGet, "/api/v1/admin/users", "")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
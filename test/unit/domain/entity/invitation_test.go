@@ -0,0 +1,120 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+func newTestInvitation(t *testing.T, expiresAt time.Time) *entity.Invitation {
+	t.Helper()
+
+	email, _ := valueobject.NewEmail("new@example.com")
+	invitation, err := entity.NewInvitation(valueobject.DefaultTenantID(), email, "editor", "hash", "", expiresAt)
+	if err != nil {
+		t.Fatalf("NewInvitation() unexpected error = %v", err)
+	}
+	return invitation
+}
+
+func TestNewInvitation(t *testing.T) {
+	invitation := newTestInvitation(t, time.Now().Add(time.Hour))
+
+	if invitation.ID() == "" {
+		t.Error("Invitation ID should be generated")
+	}
+
+	if invitation.InvitedBy() != entity.AuditActorSystem {
+		t.Errorf("Invitation InvitedBy = %q, want %q", invitation.InvitedBy(), entity.AuditActorSystem)
+	}
+
+	if invitation.Status() != entity.InvitationPending {
+		t.Errorf("Invitation status = %q, want %q", invitation.Status(), entity.InvitationPending)
+	}
+}
+
+func TestNewInvitation_InvalidInputs(t *testing.T) {
+	email, _ := valueobject.NewEmail("new@example.com")
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		tenantID  valueobject.TenantID
+		email     valueobject.Email
+		role      string
+		tokenHash string
+		expiresAt time.Time
+	}{
+		{name: "empty tenant", tenantID: valueobject.TenantID{}, email: email, role: "editor", tokenHash: "hash", expiresAt: future},
+		{name: "empty email", tenantID: valueobject.DefaultTenantID(), email: valueobject.Email{}, role: "editor", tokenHash: "hash", expiresAt: future},
+		{name: "invalid role", tenantID: valueobject.DefaultTenantID(), email: email, role: "Editor!", tokenHash: "hash", expiresAt: future},
+		{name: "empty token hash", tenantID: valueobject.DefaultTenantID(), email: email, role: "editor", tokenHash: "", expiresAt: future},
+		{name: "already expired", tenantID: valueobject.DefaultTenantID(), email: email, role: "editor", tokenHash: "hash", expiresAt: time.Now().Add(-time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := entity.NewInvitation(tt.tenantID, tt.email, tt.role, tt.tokenHash, "admin", tt.expiresAt)
+			if err == nil {
+				t.Error("NewInvitation() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestInvitation_Accept(t *testing.T) {
+	invitation := newTestInvitation(t, time.Now().Add(time.Hour))
+	userID := valueobject.NewUserID()
+
+	if err := invitation.MarkAccepted(userID); err != nil {
+		t.Fatalf("MarkAccepted() unexpected error = %v", err)
+	}
+
+	if invitation.Status() != entity.InvitationAccepted {
+		t.Errorf("Invitation status = %q, want %q", invitation.Status(), entity.InvitationAccepted)
+	}
+
+	if invitation.AcceptedBy() != userID.String() {
+		t.Errorf("Invitation AcceptedBy = %q, want %q", invitation.AcceptedBy(), userID.String())
+	}
+
+	if err := invitation.MarkAccepted(valueobject.NewUserID()); err == nil {
+		t.Error("MarkAccepted() twice expected error, got nil")
+	}
+
+	if err := invitation.Revoke(); err == nil {
+		t.Error("Revoke() after accept expected error, got nil")
+	}
+}
+
+func TestInvitation_Revoke(t *testing.T) {
+	invitation := newTestInvitation(t, time.Now().Add(time.Hour))
+
+	if err := invitation.Revoke(); err != nil {
+		t.Fatalf("Revoke() unexpected error = %v", err)
+	}
+
+	if invitation.Status() != entity.InvitationRevoked {
+		t.Errorf("Invitation status = %q, want %q", invitation.Status(), entity.InvitationRevoked)
+	}
+
+	if invitation.CanBeAccepted() {
+		t.Error("Revoked invitation should not be acceptable")
+	}
+}
+
+func TestInvitation_Expired(t *testing.T) {
+	email, _ := valueobject.NewEmail("new@example.com")
+	past := time.Now().Add(-time.Minute)
+	invitation := entity.ReconstructInvitation("id", valueobject.DefaultTenantID(), email, "editor", "hash", "admin", past.Add(-time.Hour), past, nil, "", nil)
+
+	if invitation.Status() != entity.InvitationExpired {
+		t.Errorf("Invitation status = %q, want %q", invitation.Status(), entity.InvitationExpired)
+	}
+
+	if err := invitation.MarkAccepted(valueobject.NewUserID()); err == nil {
+		t.Error("MarkAccepted() on expired invitation expected error, got nil")
+	}
+}
@@ -0,0 +1,171 @@
+package entity_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+func newTestOAuthClient(t *testing.T) *entity.OAuthClient {
+	t.Helper()
+
+	client, err := entity.NewOAuthClient(
+		valueobject.DefaultTenantID(),
+		"Photo App",
+		"",
+		[]string{"https://client.example.com/callback", "com.example.app:/oauth"},
+		[]string{"profile", "photos:read", "profile"},
+	)
+	if err != nil {
+		t.Fatalf("NewOAuthClient() unexpected error = %v", err)
+	}
+	return client
+}
+
+func TestNewOAuthClient(t *testing.T) {
+	client := newTestOAuthClient(t)
+
+	if client.ID() == "" {
+		t.Error("Client ID should be generated")
+	}
+
+	if client.IsConfidential() {
+		t.Error("Client without a secret should be public")
+	}
+
+	if want := []string{"photos:read", "profile"}; !reflect.DeepEqual(client.Scopes(), want) {
+		t.Errorf("Client scopes = %v, want %v", client.Scopes(), want)
+	}
+}
+
+func TestNewOAuthClient_InvalidInputs(t *testing.T) {
+	valid := []string{"https://client.example.com/callback"}
+
+	tests := []struct {
+		name         string
+		clientName   string
+		redirectURIs []string
+		scopes       []string
+	}{
+		{name: "empty name", clientName: " ", redirectURIs: valid, scopes: []string{"profile"}},
+		{name: "no redirect URIs", clientName: "App", scopes: []string{"profile"}},
+		{name: "relative redirect URI", clientName: "App", redirectURIs: []string{"/callback"}, scopes: []string{"profile"}},
+		{name: "redirect URI with fragment", clientName: "App", redirectURIs: []string{"https://client.example.com/cb#x"}, scopes: []string{"profile"}},
+		{name: "no scopes", clientName: "App", redirectURIs: valid},
+		{name: "invalid scope", clientName: "App", redirectURIs: valid, scopes: []string{`bad"scope`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := entity.NewOAuthClient(valueobject.DefaultTenantID(), tt.clientName, "", tt.redirectURIs, tt.scopes)
+			if err == nil {
+				t.Error("NewOAuthClient() expected error")
+			}
+		})
+	}
+}
+
+func TestOAuthClient_HasRedirectURI(t *testing.T) {
+	client := newTestOAuthClient(t)
+
+	tests := []struct {
+		uri  string
+		want bool
+	}{
+		{uri: "https://client.example.com/callback", want: true},
+		{uri: "com.example.app:/oauth", want: true},
+		{uri: "https://client.example.com/callback/", want: false},
+		{uri: "https://client.example.com/callback?x=1", want: false},
+		{uri: "https://client.example.com", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := client.HasRedirectURI(tt.uri); got != tt.want {
+			t.Errorf("HasRedirectURI(%q) = %v, want %v", tt.uri, got, tt.want)
+		}
+	}
+}
+
+func TestOAuthClient_ResolveScopes(t *testing.T) {
+	client := newTestOAuthClient(t)
+
+	scopes, err := client.ResolveScopes(nil)
+	if err != nil || !reflect.DeepEqual(scopes, client.Scopes()) {
+		t.Errorf("ResolveScopes(nil) = %v, %v; want all client scopes", scopes, err)
+	}
+
+	scopes, err = client.ResolveScopes([]string{"profile"})
+	if err != nil || !reflect.DeepEqual(scopes, []string{"profile"}) {
+		t.Errorf("ResolveScopes(profile) = %v, %v", scopes, err)
+	}
+
+	if _, err := client.ResolveScopes([]string{"profile", "admin"}); err == nil {
+		t.Error("ResolveScopes() should reject scopes the client isn't registered for")
+	}
+}
+
+func TestParseScope(t *testing.T) {
+	got := entity.ParseScope("  profile photos:read\tprofile ")
+	want := []string{"profile", "photos:read"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseScope() = %v, want %v", got, want)
+	}
+
+	if entity.FormatScope(got) != "profile photos:read" {
+		t.Errorf("FormatScope() = %q", entity.FormatScope(got))
+	}
+}
+
+func TestAuthorizationCode_MarkUsed(t *testing.T) {
+	code, err := entity.NewAuthorizationCode(
+		"hash",
+		"client-1",
+		valueobject.NewUserID(),
+		valueobject.DefaultTenantID(),
+		"https://client.example.com/callback",
+		true,
+		[]string{"profile"},
+		"challenge",
+		"",
+		time.Time{},
+		time.Now().Add(time.Minute),
+	)
+	if err != nil {
+		t.Fatalf("NewAuthorizationCode() unexpected error = %v", err)
+	}
+
+	if err := code.MarkUsed("family-1"); err != nil {
+		t.Fatalf("MarkUsed() unexpected error = %v", err)
+	}
+
+	if !code.IsUsed() || code.FamilyID() != "family-1" {
+		t.Errorf("Code used = %v, family = %q; want used in family-1", code.IsUsed(), code.FamilyID())
+	}
+
+	if err := code.MarkUsed("family-2"); err == nil {
+		t.Error("MarkUsed() twice should fail")
+	}
+}
+
+func TestNewAuthorizationCode_RequiresChallenge(t *testing.T) {
+	_, err := entity.NewAuthorizationCode(
+		"hash",
+		"client-1",
+		valueobject.NewUserID(),
+		valueobject.DefaultTenantID(),
+		"https://client.example.com/callback",
+		true,
+		nil,
+		"",
+		"",
+		time.Time{},
+		time.Now().Add(time.Minute),
+	)
+	if err == nil {
+		t.Error("NewAuthorizationCode() without a PKCE challenge should fail")
+	}
+}
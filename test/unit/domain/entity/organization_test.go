@@ -0,0 +1,56 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+func TestNewOrganization(t *testing.T) {
+	tenantID, _ := valueobject.NewTenantID("acme")
+
+	org, err := entity.NewOrganization(tenantID, "  Acme Corp ")
+	if err != nil {
+		t.Fatalf("NewOrganization() unexpected error = %v", err)
+	}
+
+	if !org.ID().Equals(tenantID) {
+		t.Errorf("Organization ID = %v, want %v", org.ID(), tenantID)
+	}
+
+	if org.Name() != "Acme Corp" {
+		t.Errorf("Organization name = %q, want %q", org.Name(), "Acme Corp")
+	}
+
+	if org.CreatedAt().IsZero() {
+		t.Error("Organization CreatedAt should be set")
+	}
+}
+
+func TestNewOrganization_InvalidInputs(t *testing.T) {
+	tenantID, _ := valueobject.NewTenantID("acme")
+
+	tests := []struct {
+		name     string
+		tenantID valueobject.TenantID
+		orgName  string
+	}{
+		{name: "empty ID", tenantID: valueobject.TenantID{}, orgName: "Acme"},
+		{name: "empty name", tenantID: tenantID, orgName: "   "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			org, err := entity.NewOrganization(tt.tenantID, tt.orgName)
+
+			if err == nil {
+				t.Error("NewOrganization() expected error, got nil")
+			}
+
+			if org != nil {
+				t.Error("NewOrganization() should return nil organization on error")
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package entity_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+func TestNewPersonalAccessToken(t *testing.T) {
+	token, err := entity.NewPersonalAccessToken(
+		"token_hash",
+		valueobject.NewUserID(),
+		valueobject.DefaultTenantID(),
+		" CI deploy ",
+		[]string{"deploy", "admin", "deploy"},
+		time.Now().Add(time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("NewPersonalAccessToken() unexpected error = %v", err)
+	}
+
+	if token.ID() == "" {
+		t.Error("Token ID should be generated")
+	}
+
+	if token.Name() != "CI deploy" {
+		t.Errorf("Token name = %q, want %q", token.Name(), "CI deploy")
+	}
+
+	if want := []string{"admin", "deploy"}; !reflect.DeepEqual(token.Scopes(), want) {
+		t.Errorf("Token scopes = %v, want %v", token.Scopes(), want)
+	}
+
+	if token.IsExpired() {
+		t.Error("New token should not be expired")
+	}
+}
+
+func TestNewPersonalAccessToken_InvalidInputs(t *testing.T) {
+	userID := valueobject.NewUserID()
+	tenantID := valueobject.DefaultTenantID()
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		tokenHash string
+		userID    valueobject.UserID
+		tenantID  valueobject.TenantID
+		tokenName string
+		scopes    []string
+		expiresAt time.Time
+	}{
+		{name: "no hash", userID: userID, tenantID: tenantID, tokenName: "CI", expiresAt: future},
+		{name: "no user", tokenHash: "hash", tenantID: tenantID, tokenName: "CI", expiresAt: future},
+		{name: "no tenant", tokenHash: "hash", userID: userID, tokenName: "CI", expiresAt: future},
+		{name: "empty name", tokenHash: "hash", userID: userID, tenantID: tenantID, tokenName: " ", expiresAt: future},
+		{name: "long name", tokenHash: "hash", userID: userID, tenantID: tenantID, tokenName: string(make([]byte, 65)), expiresAt: future},
+		{name: "invalid scope", tokenHash: "hash", userID: userID, tenantID: tenantID, tokenName: "CI", scopes: []string{"bad scope"}, expiresAt: future},
+		{name: "already expired", tokenHash: "hash", userID: userID, tenantID: tenantID, tokenName: "CI", expiresAt: time.Now().Add(-time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := entity.NewPersonalAccessToken(tt.tokenHash, tt.userID, tt.tenantID, tt.tokenName, tt.scopes, tt.expiresAt)
+			if err == nil {
+				t.Error("NewPersonalAccessToken() expected error")
+			}
+		})
+	}
+}
+
+func TestPersonalAccessToken_Restrict(t *testing.T) {
+	token, err := entity.NewPersonalAccessToken(
+		"token_hash",
+		valueobject.NewUserID(),
+		valueobject.DefaultTenantID(),
+		"CI",
+		[]string{"deploy", "billing:read"},
+		time.Now().Add(time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("NewPersonalAccessToken() unexpected error = %v", err)
+	}
+
+	restricted := token.Restrict(entity.Access{
+		Roles:       []string{"admin", "deploy"},
+		Permissions: []string{"billing:write"},
+	})
+
+	if want := []string{"deploy"}; !reflect.DeepEqual(restricted.Roles, want) {
+		t.Errorf("Restrict() roles = %v, want %v", restricted.Roles, want)
+	}
+	if len(restricted.Permissions) != 0 {
+		t.Errorf("Restrict() permissions = %v, want none", restricted.Permissions)
+	}
+}
+
+func TestIsPersonalAccessToken(t *testing.T) {
+	if !entity.IsPersonalAccessToken(entity.PersonalAccessTokenPrefix + "abc") {
+		t.Error("IsPersonalAccessToken() should accept prefixed tokens")
+	}
+	if entity.IsPersonalAccessToken("eyJhbGciOiJIUzI1NiJ9.e30.sig") {
+		t.Error("IsPersonalAccessToken() should reject JWTs")
+	}
+}
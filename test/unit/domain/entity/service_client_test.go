@@ -0,0 +1,89 @@
+package entity_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+func TestNewServiceClient(t *testing.T) {
+	client, err := entity.NewServiceClient(
+		valueobject.DefaultTenantID(),
+		"Billing Worker",
+		"secret_hash",
+		[]string{"invoices:write", "billing:read", "invoices:write"},
+	)
+	if err != nil {
+		t.Fatalf("NewServiceClient() unexpected error = %v", err)
+	}
+
+	if client.ID() == "" {
+		t.Error("Client ID should be generated")
+	}
+
+	if !client.IsActive() {
+		t.Error("New client should be active")
+	}
+
+	if want := []string{"billing:read", "invoices:write"}; !reflect.DeepEqual(client.Scopes(), want) {
+		t.Errorf("Client scopes = %v, want %v", client.Scopes(), want)
+	}
+
+	client.Deactivate()
+	if client.IsActive() {
+		t.Error("Deactivate() should make the client inactive")
+	}
+
+	client.Activate()
+	if !client.IsActive() {
+		t.Error("Activate() should make the client active")
+	}
+}
+
+func TestNewServiceClient_InvalidInputs(t *testing.T) {
+	tests := []struct {
+		name       string
+		tenantID   valueobject.TenantID
+		clientName string
+		secretHash string
+		scopes     []string
+	}{
+		{name: "no tenant", clientName: "Worker", secretHash: "hash", scopes: []string{"billing:read"}},
+		{name: "empty name", tenantID: valueobject.DefaultTenantID(), clientName: " ", secretHash: "hash", scopes: []string{"billing:read"}},
+		{name: "no secret", tenantID: valueobject.DefaultTenantID(), clientName: "Worker", scopes: []string{"billing:read"}},
+		{name: "no scopes", tenantID: valueobject.DefaultTenantID(), clientName: "Worker", secretHash: "hash"},
+		{name: "invalid scope", tenantID: valueobject.DefaultTenantID(), clientName: "Worker", secretHash: "hash", scopes: []string{"bad scope"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := entity.NewServiceClient(tt.tenantID, tt.clientName, tt.secretHash, tt.scopes)
+			if err == nil {
+				t.Error("NewServiceClient() expected error")
+			}
+		})
+	}
+}
+
+func TestServiceClient_ResolveScopes(t *testing.T) {
+	client, err := entity.NewServiceClient(valueobject.DefaultTenantID(), "Worker", "hash", []string{"billing:read", "invoices:write"})
+	if err != nil {
+		t.Fatalf("NewServiceClient() unexpected error = %v", err)
+	}
+
+	scopes, err := client.ResolveScopes(nil)
+	if err != nil || !reflect.DeepEqual(scopes, client.Scopes()) {
+		t.Errorf("ResolveScopes(nil) = %v, %v; want all client scopes", scopes, err)
+	}
+
+	scopes, err = client.ResolveScopes([]string{"billing:read"})
+	if err != nil || !reflect.DeepEqual(scopes, []string{"billing:read"}) {
+		t.Errorf("ResolveScopes(billing:read) = %v, %v", scopes, err)
+	}
+
+	if _, err := client.ResolveScopes([]string{"billing:read", "admin"}); err == nil {
+		t.Error("ResolveScopes() should reject scopes the client isn't registered for")
+	}
+}
@@ -0,0 +1,98 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+func TestNewSession(t *testing.T) {
+	userID := valueobject.NewUserID()
+	session, err := entity.NewSession(userID, "family", "", "Firefox", "203.0.113.7", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("NewSession() unexpected error = %v", err)
+	}
+
+	if session.ID() == "" {
+		t.Error("Session ID should be generated")
+	}
+
+	if !session.UserID().Equals(userID) || session.FamilyID() != "family" {
+		t.Error("Session should belong to the user and token family")
+	}
+
+	if session.UserAgent() != "Firefox" || session.IPAddress() != "203.0.113.7" {
+		t.Errorf("Session device = %q from %q, want Firefox from 203.0.113.7", session.UserAgent(), session.IPAddress())
+	}
+
+	if !session.LastSeenAt().Equal(session.CreatedAt()) {
+		t.Error("New session should be last seen when created")
+	}
+
+	if session.IsExpired() {
+		t.Error("New session should not be expired")
+	}
+}
+
+func TestNewSession_InvalidInputs(t *testing.T) {
+	userID := valueobject.NewUserID()
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		userID    valueobject.UserID
+		familyID  string
+		expiresAt time.Time
+	}{
+		{name: "no user", familyID: "family", expiresAt: future},
+		{name: "no family", userID: userID, expiresAt: future},
+		{name: "expired", userID: userID, familyID: "family", expiresAt: time.Now().Add(-time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := entity.NewSession(tt.userID, tt.familyID, "", "", "", tt.expiresAt); err == nil {
+				t.Error("NewSession() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestNewSession_TruncatesUserAgent(t *testing.T) {
+	session, err := entity.NewSession(valueobject.NewUserID(), "family", "", strings.Repeat("a", 2000), "", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("NewSession() unexpected error = %v", err)
+	}
+
+	if len(session.UserAgent()) != 512 {
+		t.Errorf("User agent length = %d, want 512", len(session.UserAgent()))
+	}
+}
+
+func TestSession_Touch(t *testing.T) {
+	session, _ := entity.NewSession(valueobject.NewUserID(), "family", "", "Firefox", "203.0.113.7", time.Now().Add(time.Hour))
+	createdAt := session.CreatedAt()
+	later := time.Now().Add(2 * time.Hour)
+
+	time.Sleep(time.Millisecond)
+	session.Touch("", "198.51.100.2", later)
+
+	if session.UserAgent() != "Firefox" {
+		t.Errorf("Unknown user agent should keep the previous one, got %q", session.UserAgent())
+	}
+
+	if session.IPAddress() != "198.51.100.2" {
+		t.Errorf("IP address = %q, want 198.51.100.2", session.IPAddress())
+	}
+
+	if !session.LastSeenAt().After(createdAt) || !session.CreatedAt().Equal(createdAt) {
+		t.Error("Touch should update last seen and keep created")
+	}
+
+	if !session.ExpiresAt().Equal(later.UTC()) {
+		t.Errorf("Expiry = %v, want %v", session.ExpiresAt(), later)
+	}
+}
@@ -23,7 +23,7 @@ func createValidTestUser(t *testing.T) *entity.User {
 		t.Fatalf("Failed to create test password: %v", err)
 	}
 
-	user, err := entity.NewUser(email, password)
+	user, err := entity.NewUser(valueobject.DefaultTenantID(), email, password)
 	if err != nil {
 		t.Fatalf("Failed to create test user: %v", err)
 	}
@@ -35,7 +35,7 @@ func TestNewUser_Success(t *testing.T) {
 	email, _ := valueobject.NewEmail("test@example.com")
 	password, _ := valueobject.NewPassword("SecureP@ss123")
 
-	user, err := entity.NewUser(email, password)
+	user, err := entity.NewUser(valueobject.DefaultTenantID(), email, password)
 
 	if err != nil {
 		t.Errorf("NewUser() unexpected error = %v", err)
@@ -70,6 +70,25 @@ func TestNewUser_Success(t *testing.T) {
 	if !user.IsActive() {
 		t.Error("New user should be active by default")
 	}
+
+	if !user.TenantID().Equals(valueobject.DefaultTenantID()) {
+		t.Errorf("User tenant = %v, want %v", user.TenantID(), valueobject.DefaultTenantID())
+	}
+}
+
+func TestNewUser_RequiresTenant(t *testing.T) {
+	email, _ := valueobject.NewEmail("test@example.com")
+	password, _ := valueobject.NewPassword("SecureP@ss123")
+
+	user, err := entity.NewUser(valueobject.TenantID{}, email, password)
+
+	if err == nil {
+		t.Error("NewUser() expected error for empty tenant, got nil")
+	}
+
+	if user != nil {
+		t.Error("NewUser() should return nil user on error")
+	}
 }
 
 func TestNewUser_InvalidInputs(t *testing.T) {
@@ -106,7 +125,7 @@ func TestNewUser_InvalidInputs(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			user, err := entity.NewUser(tt.email, tt.password)
+			user, err := entity.NewUser(valueobject.DefaultTenantID(), tt.email, tt.password)
 
 			if tt.wantError && err == nil {
 				t.Error("NewUser() expected error, got nil")
@@ -247,6 +266,150 @@ func TestUser_CanLogin(t *testing.T) {
 	}
 }
 
+func TestUser_MarkEmailVerified(t *testing.T) {
+	user := createValidTestUser(t)
+
+	if user.IsEmailVerified() {
+		t.Fatal("New user should not have a verified email")
+	}
+
+	user.MarkEmailVerified()
+
+	if !user.IsEmailVerified() {
+		t.Fatal("MarkEmailVerified() should verify the email")
+	}
+
+	verifiedAt := *user.EmailVerifiedAt()
+	user.MarkEmailVerified()
+
+	if !user.EmailVerifiedAt().Equal(verifiedAt) {
+		t.Error("MarkEmailVerified() should keep the first verification time")
+	}
+}
+
+func TestUser_UpdateEmail_ClearsVerification(t *testing.T) {
+	user := createValidTestUser(t)
+	user.MarkEmailVerified()
+
+	// Same address - still verified
+	if err := user.UpdateEmail(user.Email()); err != nil {
+		t.Fatalf("UpdateEmail() unexpected error: %v", err)
+	}
+	if !user.IsEmailVerified() {
+		t.Error("UpdateEmail() with the same address should keep verification")
+	}
+
+	// New address - must be verified again
+	newEmail, _ := valueobject.NewEmail("new@example.com")
+	if err := user.UpdateEmail(newEmail); err != nil {
+		t.Fatalf("UpdateEmail() unexpected error: %v", err)
+	}
+	if user.IsEmailVerified() {
+		t.Error("UpdateEmail() with a new address should clear verification")
+	}
+}
+
+func TestUser_EmailChange(t *testing.T) {
+	user := createValidTestUser(t)
+	original := user.Email()
+
+	if err := user.ConfirmEmailChange(); err == nil {
+		t.Error("ConfirmEmailChange() expected error with no change pending")
+	}
+	if err := user.RequestEmailChange(original); err == nil {
+		t.Error("RequestEmailChange() expected error for the current address")
+	}
+
+	// Requested - the account keeps its address
+	newEmail, _ := valueobject.NewEmail("new@example.com")
+	if err := user.RequestEmailChange(newEmail); err != nil {
+		t.Fatalf("RequestEmailChange() unexpected error: %v", err)
+	}
+	if !user.Email().Equals(original) {
+		t.Error("RequestEmailChange() should not change the email")
+	}
+	if !user.PendingEmail().Equals(newEmail) {
+		t.Error("RequestEmailChange() should record the pending email")
+	}
+
+	// Confirmed - moved and verified
+	if err := user.ConfirmEmailChange(); err != nil {
+		t.Fatalf("ConfirmEmailChange() unexpected error: %v", err)
+	}
+	if !user.Email().Equals(newEmail) {
+		t.Error("ConfirmEmailChange() should switch to the pending email")
+	}
+	if !user.PendingEmail().IsEmpty() {
+		t.Error("ConfirmEmailChange() should clear the pending email")
+	}
+	if !user.IsEmailVerified() {
+		t.Error("ConfirmEmailChange() should mark the new email verified")
+	}
+}
+
+func TestUser_MFAEnrollment(t *testing.T) {
+	user := createValidTestUser(t)
+
+	if err := user.ConfirmMFAEnrollment([]string{"hash"}, 1); err == nil {
+		t.Error("ConfirmMFAEnrollment() without a pending secret should fail")
+	}
+
+	if err := user.BeginMFAEnrollment("encrypted"); err != nil {
+		t.Fatalf("BeginMFAEnrollment() unexpected error: %v", err)
+	}
+	if user.IsMFAEnabled() {
+		t.Error("MFA should not be enabled before confirmation")
+	}
+
+	if err := user.ConfirmMFAEnrollment([]string{"hash"}, 100); err != nil {
+		t.Fatalf("ConfirmMFAEnrollment() unexpected error: %v", err)
+	}
+	if !user.IsMFAEnabled() || user.MFA().PendingSecret != "" {
+		t.Error("ConfirmMFAEnrollment() should enable the pending secret")
+	}
+
+	if err := user.BeginMFAEnrollment("other"); err == nil {
+		t.Error("BeginMFAEnrollment() should fail while MFA is enabled")
+	}
+
+	user.DisableMFA()
+	if user.IsMFAEnabled() || len(user.MFA().RecoveryCodes) != 0 {
+		t.Error("DisableMFA() should clear the secret and recovery codes")
+	}
+}
+
+func TestUser_AcceptTOTPStep(t *testing.T) {
+	user := createValidTestUser(t)
+	_ = user.BeginMFAEnrollment("encrypted")
+	_ = user.ConfirmMFAEnrollment(nil, 100)
+
+	if user.AcceptTOTPStep(100) {
+		t.Error("AcceptTOTPStep() should reject the step used to confirm enrollment")
+	}
+	if !user.AcceptTOTPStep(101) {
+		t.Error("AcceptTOTPStep() should accept a new step")
+	}
+	if user.AcceptTOTPStep(101) || user.AcceptTOTPStep(99) {
+		t.Error("AcceptTOTPStep() should reject used and older steps")
+	}
+}
+
+func TestUser_UseRecoveryCode(t *testing.T) {
+	user := createValidTestUser(t)
+	_ = user.BeginMFAEnrollment("encrypted")
+	_ = user.ConfirmMFAEnrollment([]string{"a", "b"}, 0)
+
+	if !user.UseRecoveryCode("b") {
+		t.Error("UseRecoveryCode() should accept an unused code")
+	}
+	if user.UseRecoveryCode("b") {
+		t.Error("UseRecoveryCode() should reject a used code")
+	}
+	if got := user.MFA().RecoveryCodes; len(got) != 1 || got[0] != "a" {
+		t.Errorf("remaining recovery codes = %v, want [a]", got)
+	}
+}
+
 func TestUser_Validate(t *testing.T) {
 	user := createValidTestUser(t)
 
@@ -263,7 +426,7 @@ func TestReconstructUser(t *testing.T) {
 	updatedAt := time.Now().UTC()
 	isActive := true
 
-	user := entity.ReconstructUser(id, email, password, createdAt, updatedAt, isActive)
+	user := entity.ReconstructUser(id, valueobject.DefaultTenantID(), email, password, createdAt, updatedAt, isActive, nil, valueobject.Email{}, entity.MFA{}, entity.Access{}, false)
 
 	if user == nil {
 		t.Fatal("ReconstructUser() returned nil")
@@ -281,3 +444,63 @@ func TestReconstructUser(t *testing.T) {
 		t.Error("Reconstructed user isActive mismatch")
 	}
 }
+
+func TestUser_GrantAndRevokeRole(t *testing.T) {
+	user := createValidTestUser(t)
+
+	changed, err := user.GrantRole("admin")
+	if err != nil || !changed {
+		t.Fatalf("GrantRole() = %v, %v; want true, nil", changed, err)
+	}
+	if !user.HasRole("admin") {
+		t.Error("HasRole() should report a granted role")
+	}
+
+	if changed, _ := user.GrantRole("admin"); changed {
+		t.Error("GrantRole() of a held role should report no change")
+	}
+
+	if !user.RevokeRole("admin") || user.HasRole("admin") {
+		t.Error("RevokeRole() should remove a held role")
+	}
+	if user.RevokeRole("admin") {
+		t.Error("RevokeRole() of a missing role should report no change")
+	}
+}
+
+func TestUser_GrantPermission(t *testing.T) {
+	user := createValidTestUser(t)
+
+	if _, err := user.GrantPermission("orders:write"); err != nil {
+		t.Fatalf("GrantPermission() unexpected error: %v", err)
+	}
+	if !user.HasPermission("orders:write") {
+		t.Error("HasPermission() should report a granted permission")
+	}
+
+	// Access() returns a copy
+	user.Access().Permissions[0] = "tampered"
+	if !user.HasPermission("orders:write") {
+		t.Error("modifying Access() should not change the user")
+	}
+
+	if !user.RevokePermission("orders:write") || user.HasPermission("orders:write") {
+		t.Error("RevokePermission() should remove a held permission")
+	}
+}
+
+func TestValidateAccessName(t *testing.T) {
+	valid := []string{"admin", "billing_viewer", "orders:write", "api.read-only"}
+	for _, name := range valid {
+		if err := entity.ValidateAccessName(name); err != nil {
+			t.Errorf("ValidateAccessName(%q) unexpected error: %v", name, err)
+		}
+	}
+
+	invalid := []string{"", "Admin", "1role", "has space", "a/b"}
+	for _, name := range invalid {
+		if err := entity.ValidateAccessName(name); err == nil {
+			t.Errorf("ValidateAccessName(%q) should fail", name)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+func TestNewTenantID_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "simple slug", input: "acme", want: "acme"},
+		{name: "with hyphen and digits", input: "shop-eu-2", want: "shop-eu-2"},
+		{name: "normalized", input: "  Acme ", want: "acme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := valueobject.NewTenantID(tt.input)
+
+			if err != nil {
+				t.Fatalf("NewTenantID() unexpected error = %v", err)
+			}
+			if id.String() != tt.want {
+				t.Errorf("NewTenantID() = %q, want %q", id.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTenantID_Invalid(t *testing.T) {
+	inputs := []string{"", "-acme", "acme_corp", "acme.com", "a b"}
+
+	for _, input := range inputs {
+		if _, err := valueobject.NewTenantID(input); err == nil {
+			t.Errorf("NewTenantID(%q) should fail", input)
+		}
+	}
+}
+
+func TestDefaultTenantID(t *testing.T) {
+	id := valueobject.DefaultTenantID()
+
+	if id.String() != valueobject.DefaultTenant {
+		t.Errorf("DefaultTenantID() = %q, want %q", id.String(), valueobject.DefaultTenant)
+	}
+
+	parsed, _ := valueobject.NewTenantID(valueobject.DefaultTenant)
+	if !id.Equals(parsed) {
+		t.Error("DefaultTenantID() should equal the parsed default tenant")
+	}
+}
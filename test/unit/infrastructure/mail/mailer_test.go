@@ -0,0 +1,218 @@
+package mail_test
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	netmail "net/mail"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBuiltinTemplates_Render tests that every built-in template renders in every locale
+func TestBuiltinTemplates_Render(t *testing.T) {
+	templates, err := mail.NewBuiltinTemplates("en")
+	require.NoError(t, err)
+
+	data := mail.TemplateData{Link: "https://app.example.com/x?token=a&b", Code: "123456", Expiry: 10 * time.Minute}
+	names := []string{mail.TemplateVerifyEmail, mail.TemplateConfirmEmailChange, mail.TemplateEmailChangeNotice, mail.TemplateResetPassword, mail.TemplateLoginCode, mail.TemplateMagicLink, mail.TemplateInvitation}
+
+	for _, locale := range []string{"en", "fr"} {
+		for _, name := range names {
+			rendered, err := templates.Render(name, locale, data)
+			require.NoError(t, err, "%s/%s", locale, name)
+			assert.NotEmpty(t, rendered.Subject, "%s/%s", locale, name)
+			assert.Contains(t, rendered.HTMLBody, `lang="`+locale+`"`)
+			assert.Contains(t, rendered.Body, "10m0s")
+		}
+	}
+
+	// Links are HTML-escaped in the HTML part but not in the text part
+	rendered, err := templates.Render(mail.TemplateMagicLink, "en", data)
+	require.NoError(t, err)
+	assert.Contains(t, rendered.Body, "token=a&b")
+	assert.Contains(t, rendered.HTMLBody, "token=a&amp;b")
+}
+
+// TestTemplates_LocaleFallback tests region, language and default fallback
+func TestTemplates_LocaleFallback(t *testing.T) {
+	fsys := fstest.MapFS{
+		"en/hello.txt":     {Data: []byte(`{{define "subject"}}Hello{{end}}{{define "text"}}hi{{end}}`)},
+		"en/hello.html":    {Data: []byte(`<p>hi</p>`)},
+		"pt/hello.txt":     {Data: []byte(`{{define "subject"}}Olá{{end}}{{define "text"}}oi{{end}}`)},
+		"pt/hello.html":    {Data: []byte(`<p>oi</p>`)},
+		"pt-BR/hello.txt":  {Data: []byte(`{{define "subject"}}Oi{{end}}{{define "text"}}e aí{{end}}`)},
+		"pt-BR/hello.html": {Data: []byte(`<p>e aí</p>`)},
+	}
+
+	templates, err := mail.NewTemplates(fsys, "en")
+	require.NoError(t, err)
+
+	tests := []struct {
+		locale  string
+		subject string
+	}{
+		{locale: "pt-BR", subject: "Oi"},
+		{locale: "pt_br", subject: "Oi"},
+		{locale: "pt-PT", subject: "Olá"},
+		{locale: "de", subject: "Hello"},
+		{locale: "", subject: "Hello"},
+	}
+
+	for _, tt := range tests {
+		rendered, err := templates.Render("hello", tt.locale, nil)
+		require.NoError(t, err)
+		assert.Equal(t, tt.subject, rendered.Subject, "locale %q", tt.locale)
+	}
+
+	_, err = templates.Render("missing", "en", nil)
+	assert.ErrorIs(t, err, mail.ErrTemplateNotFound)
+}
+
+// TestNewTemplates_Invalid tests that broken template sets fail at load time
+func TestNewTemplates_Invalid(t *testing.T) {
+	// No templates for the default locale
+	_, err := mail.NewTemplates(fstest.MapFS{
+		"fr/hello.txt":  {Data: []byte(`{{define "subject"}}x{{end}}{{define "text"}}x{{end}}`)},
+		"fr/hello.html": {Data: []byte(`x`)},
+	}, "en")
+	assert.Error(t, err)
+
+	// Missing subject block
+	_, err = mail.NewTemplates(fstest.MapFS{
+		"en/hello.txt":  {Data: []byte(`{{define "text"}}x{{end}}`)},
+		"en/hello.html": {Data: []byte(`x`)},
+	}, "en")
+	assert.Error(t, err)
+
+	// Missing HTML half
+	_, err = mail.NewTemplates(fstest.MapFS{
+		"en/hello.txt": {Data: []byte(`{{define "subject"}}x{{end}}{{define "text"}}x{{end}}`)},
+	}, "en")
+	assert.Error(t, err)
+}
+
+// TestFileMailer_Send tests that templated messages land in the outbox as valid MIME
+func TestFileMailer_Send(t *testing.T) {
+	dir := t.TempDir()
+	fileMailer, err := mail.NewFileMailer(dir, "LabukaAuth <no-reply@example.com>")
+	require.NoError(t, err)
+
+	templates, err := mail.NewBuiltinTemplates("en")
+	require.NoError(t, err)
+	mailer := mail.NewTemplateMailer(fileMailer, templates)
+
+	ctx := mail.WithLocale(context.Background(), mail.PreferredLocale("fr-CA,fr;q=0.9,en;q=0.8"))
+	err = mailer.Send(ctx, mail.Message{
+		To:       "user@example.com",
+		Subject:  "fallback",
+		Body:     "fallback",
+		Template: mail.TemplateLoginCode,
+		Data:     mail.TemplateData{Code: "123456", Expiry: 10 * time.Minute},
+	})
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	f, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer f.Close()
+
+	msg, err := netmail.ReadMessage(f)
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Votre code de connexion", subject)
+	assert.Equal(t, `"LabukaAuth" <no-reply@example.com>`, msg.Header.Get("From"))
+	assert.Equal(t, "<user@example.com>", msg.Header.Get("To"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	var contentTypes []string
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(part) // Decodes quoted-printable
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "123456")
+		contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+	}
+	assert.Equal(t, []string{"text/plain; charset=utf-8", "text/html; charset=utf-8"}, contentTypes)
+}
+
+// TestFileMailer_RejectsHeaderInjection tests that malformed headers are refused
+func TestFileMailer_RejectsHeaderInjection(t *testing.T) {
+	dir := t.TempDir()
+	mailer, err := mail.NewFileMailer(dir, "no-reply@example.com")
+	require.NoError(t, err)
+
+	err = mailer.Send(context.Background(), mail.Message{
+		To:      "user@example.com",
+		Subject: "Hello\r\nBcc: victim@example.com",
+		Body:    "x",
+	})
+	assert.ErrorIs(t, err, mail.ErrInvalidMessage)
+
+	err = mailer.Send(context.Background(), mail.Message{
+		To:      "user@example.com\r\nBcc: victim@example.com",
+		Subject: "Hello",
+		Body:    "x",
+	})
+	assert.ErrorIs(t, err, mail.ErrInvalidMessage)
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"))
+	assert.Empty(t, files, "nothing should be written")
+}
+
+// TestPreferredLocale tests Accept-Language parsing
+func TestPreferredLocale(t *testing.T) {
+	assert.Equal(t, "fr-CA", mail.PreferredLocale("fr-CA,fr;q=0.9"))
+	assert.Equal(t, "de", mail.PreferredLocale(" de ; q=1"))
+	assert.Equal(t, "", mail.PreferredLocale("*"))
+	assert.Equal(t, "", mail.PreferredLocale(""))
+}
+
+// failingMailer fails every send after recording it was called
+type failingMailer struct {
+	calls   int
+	blocked chan struct{}
+}
+
+func (m *failingMailer) Send(ctx context.Context, msg mail.Message) error {
+	<-m.blocked
+	m.calls++
+	return io.ErrUnexpectedEOF
+}
+
+// TestBackgroundMailer_Send tests that sending neither waits for nor reports delivery
+func TestBackgroundMailer_Send(t *testing.T) {
+	inner := &failingMailer{blocked: make(chan struct{})}
+	mailer := mail.NewBackgroundMailer(inner)
+
+	// Returns while the transport is still blocked, and outlives the request
+	ctx, cancel := context.WithCancel(context.Background())
+	mailer.Send(ctx, mail.Message{To: "user@example.com", Subject: "Hi"})
+	cancel()
+
+	close(inner.blocked)
+	mailer.Wait()
+	assert.Equal(t, 1, inner.calls)
+}
@@ -0,0 +1,129 @@
+package security_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestJWTGenerator_IDToken tests the OpenID Connect claims of an ID token
+func TestJWTGenerator_IDToken(t *testing.T) {
+	// Arrange
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	key, err := security.ParseSigningKey("", security.AlgorithmES256, encodePKCS8(t, privateKey))
+	require.NoError(t, err)
+	generator := security.NewJWTGenerator(security.NewKeyRing(key), 15*time.Minute, time.Hour, "auth-service")
+
+	userID := valueobject.NewUserID()
+	authTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	verified := true
+
+	// Act
+	tokenString, err := generator.GenerateIDToken(security.IDToken{
+		Issuer:      "https://auth.example.com",
+		UserID:      userID,
+		ClientID:    "client-1",
+		Nonce:       "n-0S6_WzA2Mj",
+		AuthTime:    authTime,
+		AccessToken: "access-token",
+		UserClaims:  security.UserClaims{Email: "user@example.com", EmailVerified: &verified},
+	})
+	require.NoError(t, err)
+
+	// Assert - verifies with the public key
+	claims := &security.IDTokenClaims{}
+	_, err = jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		return &privateKey.PublicKey, nil
+	}, jwt.WithValidMethods([]string{security.AlgorithmES256}))
+	require.NoError(t, err)
+
+	sum := sha256.Sum256([]byte("access-token"))
+	assert.Equal(t, "https://auth.example.com", claims.Issuer, "OIDC issuer, not the access token issuer")
+	assert.Equal(t, userID.String(), claims.Subject)
+	assert.Equal(t, jwt.ClaimStrings{"client-1"}, claims.Audience)
+	assert.Equal(t, "n-0S6_WzA2Mj", claims.Nonce)
+	assert.Equal(t, authTime.Unix(), claims.AuthTime.Unix())
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(sum[:16]), claims.AccessTokenHash)
+	assert.Equal(t, "user@example.com", claims.Email)
+	require.NotNil(t, claims.EmailVerified)
+	assert.True(t, *claims.EmailVerified)
+	assert.Zero(t, claims.UpdatedAt, "profile claims weren't released")
+}
+
+// TestJWTGenerator_IDTokenEdDSAHash tests at_hash uses SHA-512 for Ed25519 keys
+func TestJWTGenerator_IDTokenEdDSAHash(t *testing.T) {
+	key, err := security.ParseSigningKey("", security.AlgorithmEdDSA, generatePEM(t, security.AlgorithmEdDSA))
+	require.NoError(t, err)
+	generator := security.NewJWTGenerator(security.NewKeyRing(key), 15*time.Minute, time.Hour, "auth-service")
+
+	tokenString, err := generator.GenerateIDToken(security.IDToken{
+		Issuer:      "https://auth.example.com",
+		UserID:      valueobject.NewUserID(),
+		ClientID:    "client-1",
+		AccessToken: "access-token",
+	})
+	require.NoError(t, err)
+
+	claims := &security.IDTokenClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(tokenString, claims)
+	require.NoError(t, err)
+
+	sum := sha512.Sum512([]byte("access-token"))
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(sum[:32]), claims.AccessTokenHash)
+	assert.Nil(t, claims.AuthTime, "unknown auth_time is left out")
+	assert.Empty(t, claims.Nonce)
+}
+
+// TestJWTGenerator_IDTokenNeedsAsymmetricKey tests that HS256 can't sign ID tokens
+func TestJWTGenerator_IDTokenNeedsAsymmetricKey(t *testing.T) {
+	generator := newTestGenerator()
+
+	_, err := generator.GenerateIDToken(security.IDToken{
+		Issuer:   "https://auth.example.com",
+		UserID:   valueobject.NewUserID(),
+		ClientID: "client-1",
+	})
+
+	assert.Error(t, err)
+}
+
+// TestJWTGenerator_AuthTime tests that tokens carry the sign-in time
+func TestJWTGenerator_AuthTime(t *testing.T) {
+	generator := newTestGenerator()
+	userID := valueobject.NewUserID()
+	email, _ := valueobject.NewEmail("user@example.com")
+	authTime := time.Now().Add(-time.Hour)
+
+	accessToken, err := generator.GenerateAccessToken(userID, valueobject.DefaultTenantID(), email, nil, nil, authTime, "")
+	require.NoError(t, err)
+	refreshToken, err := generator.GenerateRefreshToken(userID, valueobject.DefaultTenantID(), authTime)
+	require.NoError(t, err)
+	unknown, err := generator.GenerateRefreshToken(userID, valueobject.DefaultTenantID(), time.Time{})
+	require.NoError(t, err)
+
+	accessClaims, err := generator.ValidateToken(accessToken, security.TokenUseAccess)
+	require.NoError(t, err)
+	require.NotNil(t, accessClaims.AuthTime)
+	assert.Equal(t, authTime.Unix(), accessClaims.AuthTime.Unix())
+
+	refreshClaims, err := generator.ValidateToken(refreshToken, security.TokenUseRefresh)
+	require.NoError(t, err)
+	require.NotNil(t, refreshClaims.AuthTime)
+	assert.Equal(t, authTime.Unix(), refreshClaims.AuthTime.Unix())
+
+	unknownClaims, err := generator.ValidateToken(unknown, security.TokenUseRefresh)
+	require.NoError(t, err)
+	assert.Nil(t, unknownClaims.AuthTime)
+}
@@ -0,0 +1,141 @@
+package security_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// firebaseConfig holds the sample project parameters from Firebase's scrypt reference
+var firebaseConfig = config.PasswordConfig{
+	FirebaseSignerKey:     "jxspr8Ki0RYycVU8zykbdLGjFQ3McFUH0uiiTvC8pVMXAn210wjLNmdZJzxUECKbm0QsEmYUSDzZvpjeJ9WmXA==",
+	FirebaseSaltSeparator: "Bw==",
+	FirebaseRounds:        8,
+	FirebaseMemCost:       14,
+}
+
+// TestDjangoPBKDF2Verifier tests checking Django's pbkdf2_sha256 hashes
+func TestDjangoPBKDF2Verifier(t *testing.T) {
+	verifier := security.NewDjangoPBKDF2Verifier()
+
+	// hashlib.pbkdf2_hmac("sha256", b"SecureP@ss123", b"somesalt", 1000), as Django stores it
+	hash := "pbkdf2_sha256$1000$somesalt$yT9h7HgeYZwL1A9BG9yz4yFDH7s4reu0YLFhOIs5kAc="
+
+	assert.True(t, verifier.Recognizes(hash))
+	assert.NoError(t, verifier.Compare(hash, "SecureP@ss123"))
+	assert.True(t, errors.Is(verifier.Compare(hash, "WrongP@ss123"), security.ErrPasswordMismatch))
+}
+
+// TestDjangoPBKDF2Verifier_MalformedHash tests hashes that can't be parsed
+func TestDjangoPBKDF2Verifier_MalformedHash(t *testing.T) {
+	verifier := security.NewDjangoPBKDF2Verifier()
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{name: "missing parts", hash: "pbkdf2_sha256$1000$somesalt"},
+		{name: "invalid iterations", hash: "pbkdf2_sha256$many$somesalt$yT9h7HgeYZwL1A9BG9yz4yFDH7s4reu0YLFhOIs5kAc="},
+		{name: "excessive iterations", hash: "pbkdf2_sha256$999999999$somesalt$yT9h7HgeYZwL1A9BG9yz4yFDH7s4reu0YLFhOIs5kAc="},
+		{name: "empty salt", hash: "pbkdf2_sha256$1000$$yT9h7HgeYZwL1A9BG9yz4yFDH7s4reu0YLFhOIs5kAc="},
+		{name: "invalid key", hash: "pbkdf2_sha256$1000$somesalt$!!!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifier.Compare(tt.hash, "SecureP@ss123")
+			assert.Error(t, err)
+			assert.False(t, errors.Is(err, security.ErrPasswordMismatch))
+		})
+	}
+}
+
+// TestDjangoBcryptVerifier tests checking Django's bcrypt and bcrypt_sha256 hashes
+func TestDjangoBcryptVerifier(t *testing.T) {
+	verifier := security.NewDjangoBcryptVerifier()
+
+	plain, err := bcrypt.GenerateFromPassword([]byte("SecureP@ss123"), 4)
+	require.NoError(t, err)
+
+	digest := sha256.Sum256([]byte("SecureP@ss123"))
+	prehashed, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(digest[:])), 4)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{name: "bcrypt", hash: "bcrypt$" + string(plain)},
+		{name: "bcrypt_sha256", hash: "bcrypt_sha256$" + string(prehashed)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, verifier.Recognizes(tt.hash))
+			assert.NoError(t, verifier.Compare(tt.hash, "SecureP@ss123"))
+			assert.True(t, errors.Is(verifier.Compare(tt.hash, "WrongP@ss123"), security.ErrPasswordMismatch))
+		})
+	}
+
+	// A bare bcrypt hash is the native bcrypt hasher's
+	assert.False(t, verifier.Recognizes(string(plain)))
+	assert.False(t, verifier.Recognizes("bcrypt$not-a-bcrypt-hash"))
+}
+
+// TestFirebaseScryptVerifier tests Firebase's modified scrypt against its reference vector
+func TestFirebaseScryptVerifier(t *testing.T) {
+	verifier, err := security.NewFirebaseScryptVerifierFromConfig(firebaseConfig)
+	require.NoError(t, err)
+
+	hash, err := security.FirebaseScryptHash(
+		"42xEC+ixf3L2lw==",
+		"lSrfV15cpx95/sZS2W9c9Kp6i/LVgQNDNC/qzrCnh1SAyZvqmZqAjTdn3aoItz+VHjoZilo78198JAdRuid5lQ==",
+	)
+	require.NoError(t, err)
+
+	assert.True(t, verifier.Recognizes(hash))
+	assert.NoError(t, verifier.Compare(hash, "user1password"))
+	assert.True(t, errors.Is(verifier.Compare(hash, "user2password"), security.ErrPasswordMismatch))
+}
+
+// TestFirebaseScryptHash tests building the stored form of an exported hash
+func TestFirebaseScryptHash(t *testing.T) {
+	_, err := security.FirebaseScryptHash("", "bFNyZlYxNWNweA==")
+	assert.Error(t, err, "salt is required")
+
+	_, err = security.FirebaseScryptHash("42xEC+ixf3L2lw==", "not base64!")
+	assert.Error(t, err)
+}
+
+// TestMultiHasher_ImportedFormats tests that imported hashes verify and are flagged for an upgrade
+func TestMultiHasher_ImportedFormats(t *testing.T) {
+	cfg := firebaseConfig
+	cfg.Algorithm = "argon2id"
+	cfg.BcryptCost = 4
+	cfg.Argon2Memory, cfg.Argon2Time, cfg.Argon2Parallelism = 1024, 1, 1
+
+	hasher, err := security.NewPasswordHasherFromConfig(cfg)
+	require.NoError(t, err)
+
+	django := "pbkdf2_sha256$1000$somesalt$yT9h7HgeYZwL1A9BG9yz4yFDH7s4reu0YLFhOIs5kAc="
+	firebase := "$firebase-scrypt$42xEC+ixf3L2lw==$lSrfV15cpx95/sZS2W9c9Kp6i/LVgQNDNC/qzrCnh1SAyZvqmZqAjTdn3aoItz+VHjoZilo78198JAdRuid5lQ=="
+
+	assert.NoError(t, hasher.Compare(django, "SecureP@ss123"))
+	assert.NoError(t, hasher.Compare(firebase, "user1password"))
+	assert.True(t, hasher.NeedsRehash(django))
+	assert.True(t, hasher.NeedsRehash(firebase))
+
+	// Without the project's signer key Firebase hashes can't be verified
+	cfg.FirebaseSignerKey = ""
+	hasher, err = security.NewPasswordHasherFromConfig(cfg)
+	require.NoError(t, err)
+	assert.False(t, hasher.Recognizes(firebase))
+	assert.True(t, errors.Is(hasher.Compare(firebase, "user1password"), security.ErrUnknownHashFormat))
+}
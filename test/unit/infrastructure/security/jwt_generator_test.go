@@ -0,0 +1,188 @@
+package security_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "test-secret-key-at-least-32-characters"
+
+func newTestGenerator() *security.JWTGeneratorImpl {
+	return security.NewJWTGenerator(security.NewKeyRing(security.NewHMACSigningKey("", []byte(testSecret))), 15*time.Minute, time.Hour, "auth-service-test")
+}
+
+// TestJWTGenerator_TokenUse tests that each token only validates as its own kind
+func TestJWTGenerator_TokenUse(t *testing.T) {
+	generator := newTestGenerator()
+	userID := valueobject.NewUserID()
+	email, _ := valueobject.NewEmail("user@example.com")
+
+	accessToken, err := generator.GenerateAccessToken(userID, valueobject.DefaultTenantID(), email, nil, nil, time.Now(), "")
+	require.NoError(t, err)
+
+	refreshToken, err := generator.GenerateRefreshToken(userID, valueobject.DefaultTenantID(), time.Now())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		token       string
+		expectedUse security.TokenUse
+		wantErr     bool
+	}{
+		{name: "access token as access", token: accessToken, expectedUse: security.TokenUseAccess},
+		{name: "refresh token as refresh", token: refreshToken, expectedUse: security.TokenUseRefresh},
+		{name: "refresh token as access", token: refreshToken, expectedUse: security.TokenUseAccess, wantErr: true},
+		{name: "access token as refresh", token: accessToken, expectedUse: security.TokenUseRefresh, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := generator.ValidateToken(tt.token, tt.expectedUse)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, claims)
+				assert.True(t, errors.Is(err, security.ErrWrongTokenUse))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedUse, claims.TokenUse)
+			assert.Equal(t, userID.String(), claims.UserID)
+			assert.NotEmpty(t, claims.ID, "every token carries a jti")
+		})
+	}
+}
+
+// TestJWTGenerator_MissingTokenUse tests tokens signed without a token_use claim
+// WHY: Tokens minted before the claim existed must not pass as either kind
+func TestJWTGenerator_MissingTokenUse(t *testing.T) {
+	generator := newTestGenerator()
+
+	legacy := jwt.NewWithClaims(jwt.SigningMethodHS256, security.Claims{
+		UserID: valueobject.NewUserID().String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+	tokenString, err := legacy.SignedString([]byte(testSecret))
+	require.NoError(t, err)
+
+	for _, use := range []security.TokenUse{security.TokenUseAccess, security.TokenUseRefresh} {
+		claims, err := generator.ValidateToken(tokenString, use)
+		assert.Nil(t, claims)
+		assert.True(t, errors.Is(err, security.ErrWrongTokenUse))
+	}
+}
+
+// TestJWTGenerator_UniqueTokenIDs tests that tokens minted back-to-back differ
+func TestJWTGenerator_UniqueTokenIDs(t *testing.T) {
+	generator := newTestGenerator()
+	userID := valueobject.NewUserID()
+
+	first, err := generator.GenerateRefreshToken(userID, valueobject.DefaultTenantID(), time.Now())
+	require.NoError(t, err)
+	second, err := generator.GenerateRefreshToken(userID, valueobject.DefaultTenantID(), time.Now())
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+}
+
+// TestJWTGenerator_AccessClaims tests that roles and permissions ride in access tokens only
+func TestJWTGenerator_AccessClaims(t *testing.T) {
+	generator := newTestGenerator()
+	userID := valueobject.NewUserID()
+	email, _ := valueobject.NewEmail("user@example.com")
+
+	accessToken, err := generator.GenerateAccessToken(userID, valueobject.DefaultTenantID(), email, []string{"admin"}, []string{"orders:write"}, time.Now(), "")
+	require.NoError(t, err)
+
+	claims, err := generator.ValidateToken(accessToken, security.TokenUseAccess)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admin"}, claims.Roles)
+	assert.Equal(t, []string{"orders:write"}, claims.Permissions)
+
+	refreshToken, err := generator.GenerateRefreshToken(userID, valueobject.DefaultTenantID(), time.Now())
+	require.NoError(t, err)
+
+	claims, err = generator.ValidateToken(refreshToken, security.TokenUseRefresh)
+	require.NoError(t, err)
+	assert.Empty(t, claims.Roles)
+	assert.Empty(t, claims.Permissions)
+}
+
+// TestJWTGenerator_ClientGrant tests the aud and scope claims of OAuth client tokens
+func TestJWTGenerator_ClientGrant(t *testing.T) {
+	generator := newTestGenerator()
+	userID := valueobject.NewUserID()
+	email, _ := valueobject.NewEmail("user@example.com")
+	grant := security.ClientGrant{ClientID: "client-1", Scopes: []string{"profile", "photos:read"}}
+
+	accessToken, err := generator.GenerateClientAccessToken(userID, valueobject.DefaultTenantID(), email, nil, nil, time.Now(), "", grant)
+	require.NoError(t, err)
+	refreshToken, err := generator.GenerateClientRefreshToken(userID, valueobject.DefaultTenantID(), time.Now(), grant)
+	require.NoError(t, err)
+
+	for token, use := range map[string]security.TokenUse{accessToken: security.TokenUseAccess, refreshToken: security.TokenUseRefresh} {
+		claims, err := generator.ValidateToken(token, use)
+		require.NoError(t, err)
+		assert.Equal(t, jwt.ClaimStrings{"client-1"}, claims.Audience)
+		assert.Equal(t, "profile photos:read", claims.Scope)
+
+		got, ok := claims.ClientGrant()
+		assert.True(t, ok)
+		assert.Equal(t, grant, got)
+	}
+
+	// First-party tokens have neither claim
+	firstParty, err := generator.GenerateAccessToken(userID, valueobject.DefaultTenantID(), email, nil, nil, time.Now(), "")
+	require.NoError(t, err)
+	claims, err := generator.ValidateToken(firstParty, security.TokenUseAccess)
+	require.NoError(t, err)
+	_, ok := claims.ClientGrant()
+	assert.False(t, ok)
+	assert.Empty(t, claims.Scope)
+}
+
+// TestJWTGenerator_ServiceAccessToken tests the claims of client_credentials tokens
+func TestJWTGenerator_ServiceAccessToken(t *testing.T) {
+	generator := newTestGenerator()
+
+	tokenString, err := generator.GenerateServiceAccessToken("service-1", valueobject.DefaultTenantID(), []string{"billing:read", "invoices:write"})
+	require.NoError(t, err)
+
+	claims, err := generator.ValidateToken(tokenString, security.TokenUseAccess)
+	require.NoError(t, err)
+	assert.Equal(t, "service-1", claims.Subject)
+	assert.Equal(t, "service-1", claims.ClientID)
+	assert.Empty(t, claims.UserID, "no user behind a service token")
+	assert.Empty(t, claims.Audience)
+	assert.Equal(t, "billing:read invoices:write", claims.Scope)
+	assert.Equal(t, valueobject.DefaultTenant, claims.TenantID)
+
+	clientID, ok := claims.ServiceClientID()
+	assert.True(t, ok)
+	assert.Equal(t, "service-1", clientID)
+	_, ok = claims.ClientGrant()
+	assert.False(t, ok, "not a delegated OAuth client token")
+
+	// Refresh tokens are never issued to service clients
+	_, err = generator.ValidateToken(tokenString, security.TokenUseRefresh)
+	assert.ErrorIs(t, err, security.ErrWrongTokenUse)
+
+	// User tokens aren't service tokens
+	email, _ := valueobject.NewEmail("user@example.com")
+	userToken, err := generator.GenerateAccessToken(valueobject.NewUserID(), valueobject.DefaultTenantID(), email, nil, nil, time.Now(), "")
+	require.NoError(t, err)
+	userClaims, err := generator.ValidateToken(userToken, security.TokenUseAccess)
+	require.NoError(t, err)
+	_, ok = userClaims.ServiceClientID()
+	assert.False(t, ok)
+}
@@ -0,0 +1,173 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// issueAccessToken signs an access token for a throwaway user
+func issueAccessToken(t *testing.T, generator *security.JWTGeneratorImpl) string {
+	t.Helper()
+
+	email, _ := valueobject.NewEmail("user@example.com")
+	token, err := generator.GenerateAccessToken(valueobject.NewUserID(), valueobject.DefaultTenantID(), email, nil, nil, time.Now(), "")
+	require.NoError(t, err)
+	return token
+}
+
+func tokenKeyID(t *testing.T, tokenString string) string {
+	t.Helper()
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(tokenString, &security.Claims{})
+	require.NoError(t, err)
+	kid, _ := parsed.Header["kid"].(string)
+	return kid
+}
+
+// TestKeyRing_Rotation tests add, promote and retire without a restart
+func TestKeyRing_Rotation(t *testing.T) {
+	// Arrange - a generator backed by a bootstrapped file key store
+	ctx := context.Background()
+	store := security.NewFileKeyStore(filepath.Join(t.TempDir(), "keys.json"))
+
+	first, err := security.GenerateStoredKey(security.AlgorithmES256)
+	require.NoError(t, err)
+	_, err = security.BootstrapKeyStore(ctx, store, first)
+	require.NoError(t, err)
+
+	ring := security.NewKeyRing(security.NewHMACSigningKey("", []byte(testSecret)))
+	require.NoError(t, security.ReloadKeyRing(ctx, ring, store))
+	generator := security.NewJWTGenerator(ring, 15*time.Minute, time.Hour, "auth-service-test")
+
+	oldKid := ring.Active().ID()
+	assert.Equal(t, first.ID, oldKid)
+	oldToken := issueAccessToken(t, generator)
+
+	// Step 1: Add a new key - still signing with the old one
+	next, err := security.GenerateStoredKey(security.AlgorithmEdDSA)
+	require.NoError(t, err)
+	require.NoError(t, store.Add(ctx, next))
+	require.NoError(t, security.ReloadKeyRing(ctx, ring, store))
+
+	assert.Equal(t, oldKid, ring.Active().ID())
+	assert.Len(t, generator.JWKS().Keys, 2, "new key published before it signs")
+
+	// Step 2: Promote - new tokens use the new key, old tokens still verify
+	require.NoError(t, store.Promote(ctx, next.ID))
+	require.NoError(t, security.ReloadKeyRing(ctx, ring, store))
+
+	newToken := issueAccessToken(t, generator)
+	assert.Equal(t, next.ID, tokenKeyID(t, newToken))
+
+	_, err = generator.ValidateToken(oldToken, security.TokenUseAccess)
+	assert.NoError(t, err, "tokens signed before rotation stay valid")
+	_, err = generator.ValidateToken(newToken, security.TokenUseAccess)
+	assert.NoError(t, err)
+
+	// Step 3: Retire the old key - its tokens stop verifying
+	require.NoError(t, store.Retire(ctx, oldKid))
+	require.NoError(t, security.ReloadKeyRing(ctx, ring, store))
+
+	_, err = generator.ValidateToken(oldToken, security.TokenUseAccess)
+	assert.Error(t, err)
+	_, err = generator.ValidateToken(newToken, security.TokenUseAccess)
+	assert.NoError(t, err)
+	assert.Len(t, generator.JWKS().Keys, 1)
+
+	// Retired keys lose their material
+	keys, err := store.List(ctx)
+	require.NoError(t, err)
+	for _, key := range keys {
+		if key.ID == oldKid {
+			assert.Equal(t, security.KeyStatusRetired, key.Status)
+			assert.Empty(t, key.Material)
+		}
+	}
+}
+
+// TestKeyRing_UnknownKeyID tests that tokens naming an unknown kid are rejected
+func TestKeyRing_UnknownKeyID(t *testing.T) {
+	// Arrange - same secret, different kid
+	signer := security.NewJWTGenerator(
+		security.NewKeyRing(security.NewHMACSigningKey("other-kid", []byte(testSecret))),
+		15*time.Minute, time.Hour, "auth-service-test",
+	)
+	verifier := security.NewJWTGenerator(
+		security.NewKeyRing(security.NewHMACSigningKey("current-kid", []byte(testSecret))),
+		15*time.Minute, time.Hour, "auth-service-test",
+	)
+
+	token, err := signer.GenerateRefreshToken(valueobject.NewUserID(), valueobject.DefaultTenantID(), time.Now())
+	require.NoError(t, err)
+
+	// Act
+	claims, err := verifier.ValidateToken(token, security.TokenUseRefresh)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, claims)
+}
+
+// TestKeyStore_Rules tests the store's lifecycle guards
+func TestKeyStore_Rules(t *testing.T) {
+	ctx := context.Background()
+	store := security.NewFileKeyStore(filepath.Join(t.TempDir(), "keys.json"))
+
+	first, err := security.GenerateStoredKey(security.AlgorithmES256)
+	require.NoError(t, err)
+	created, err := security.BootstrapKeyStore(ctx, store, first)
+	require.NoError(t, err)
+	require.True(t, created)
+	activeKid := first.ID
+
+	t.Run("cannot retire the active key", func(t *testing.T) {
+		err := store.Retire(ctx, activeKid)
+		assert.True(t, errors.Is(err, security.ErrRetireActiveKey))
+	})
+
+	t.Run("cannot add a duplicate kid", func(t *testing.T) {
+		duplicate, err := security.GenerateStoredKey(security.AlgorithmES256)
+		require.NoError(t, err)
+		duplicate.ID = activeKid
+
+		err = store.Add(ctx, duplicate)
+		assert.True(t, errors.Is(err, security.ErrKeyExists))
+	})
+
+	t.Run("cannot promote an unknown key", func(t *testing.T) {
+		err := store.Promote(ctx, "missing")
+		assert.True(t, errors.Is(err, security.ErrKeyNotFound))
+	})
+
+	t.Run("bootstrap is a no-op once keys exist", func(t *testing.T) {
+		key, err := security.GenerateStoredKey(security.AlgorithmHS256)
+		require.NoError(t, err)
+
+		created, err := security.BootstrapKeyStore(ctx, store, key)
+		require.NoError(t, err)
+		assert.False(t, created)
+	})
+}
+
+// TestReloadKeyRing_KeepsRingOnError tests that a broken store never breaks signing
+func TestReloadKeyRing_KeepsRingOnError(t *testing.T) {
+	ctx := context.Background()
+	ring := security.NewKeyRing(security.NewHMACSigningKey("", []byte(testSecret)))
+
+	// Empty store - no active key
+	store := security.NewFileKeyStore(filepath.Join(t.TempDir(), "keys.json"))
+
+	err := security.ReloadKeyRing(ctx, ring, store)
+
+	assert.Error(t, err)
+	assert.Equal(t, security.AlgorithmHS256, ring.Active().Algorithm())
+}
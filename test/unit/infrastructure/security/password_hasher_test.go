@@ -0,0 +1,139 @@
+package security_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testArgon2Params keeps hashing fast in tests
+var testArgon2Params = security.Argon2Params{Memory: 1024, Time: 1, Parallelism: 1}
+
+// TestArgon2Hasher_RoundTrip tests hashing and verifying a password
+func TestArgon2Hasher_RoundTrip(t *testing.T) {
+	hasher := security.NewArgon2Hasher(testArgon2Params)
+
+	hash, err := hasher.Hash("SecureP@ss123")
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"), hash)
+	assert.True(t, hasher.Recognizes(hash))
+	assert.NoError(t, hasher.Compare(hash, "SecureP@ss123"))
+	assert.True(t, errors.Is(hasher.Compare(hash, "WrongP@ss123"), security.ErrPasswordMismatch))
+	assert.False(t, hasher.NeedsRehash(hash))
+
+	// Salted - the same password hashes differently
+	again, err := hasher.Hash("SecureP@ss123")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, again)
+}
+
+// TestArgon2Hasher_NeedsRehash tests detecting outdated parameters
+func TestArgon2Hasher_NeedsRehash(t *testing.T) {
+	hash, err := security.NewArgon2Hasher(testArgon2Params).Hash("SecureP@ss123")
+	require.NoError(t, err)
+
+	stronger := security.NewArgon2Hasher(security.Argon2Params{Memory: 2048, Time: 1, Parallelism: 1})
+	assert.True(t, stronger.NeedsRehash(hash))
+
+	// Old parameters still verify - they are recorded in the hash
+	assert.NoError(t, stronger.Compare(hash, "SecureP@ss123"))
+}
+
+// TestArgon2Hasher_MalformedHash tests hashes that can't be parsed
+func TestArgon2Hasher_MalformedHash(t *testing.T) {
+	hasher := security.NewArgon2Hasher(testArgon2Params)
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{name: "wrong variant", hash: "$argon2i$v=19$m=1024,t=1,p=1$c2FsdHNhbHQ$a2V5"},
+		{name: "unsupported version", hash: "$argon2id$v=16$m=1024,t=1,p=1$c2FsdHNhbHQ$a2V5"},
+		{name: "missing parameters", hash: "$argon2id$v=19$m=1024$c2FsdHNhbHQ$a2V5"},
+		{name: "zero passes", hash: "$argon2id$v=19$m=1024,t=0,p=1$c2FsdHNhbHQ$a2V5"},
+		{name: "excessive memory", hash: "$argon2id$v=19$m=99999999,t=1,p=1$c2FsdHNhbHQ$a2V5"},
+		{name: "invalid salt", hash: "$argon2id$v=19$m=1024,t=1,p=1$!!!$a2V5"},
+		{name: "missing key", hash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdHNhbHQ$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, hasher.Compare(tt.hash, "SecureP@ss123"))
+			assert.True(t, hasher.NeedsRehash(tt.hash))
+		})
+	}
+}
+
+// TestBcryptHasher_NeedsRehash tests detecting a changed cost
+func TestBcryptHasher_NeedsRehash(t *testing.T) {
+	hasher := security.NewBcryptHasher(4)
+	hash, err := hasher.Hash("SecureP@ss123")
+	require.NoError(t, err)
+
+	assert.True(t, hasher.Recognizes(hash))
+	assert.False(t, hasher.NeedsRehash(hash))
+	assert.True(t, security.NewBcryptHasher(5).NeedsRehash(hash))
+	assert.True(t, errors.Is(hasher.Compare(hash, "WrongP@ss123"), security.ErrPasswordMismatch))
+}
+
+// TestMultiHasher_UpgradesLegacyHashes tests verifying and flagging hashes from the old algorithm
+func TestMultiHasher_UpgradesLegacyHashes(t *testing.T) {
+	bcryptHasher := security.NewBcryptHasher(4)
+	hasher := security.NewMultiHasher(security.NewArgon2Hasher(testArgon2Params), bcryptHasher)
+
+	legacy, err := bcryptHasher.Hash("SecureP@ss123")
+	require.NoError(t, err)
+
+	// Legacy hashes still verify, and are flagged for an upgrade
+	assert.NoError(t, hasher.Compare(legacy, "SecureP@ss123"))
+	assert.True(t, errors.Is(hasher.Compare(legacy, "WrongP@ss123"), security.ErrPasswordMismatch))
+	assert.True(t, hasher.NeedsRehash(legacy))
+
+	// New hashes use the current algorithm
+	current, err := hasher.Hash("SecureP@ss123")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(current, "$argon2id$"))
+	assert.NoError(t, hasher.Compare(current, "SecureP@ss123"))
+	assert.False(t, hasher.NeedsRehash(current))
+}
+
+// TestMultiHasher_UnknownFormat tests hashes no algorithm recognizes
+func TestMultiHasher_UnknownFormat(t *testing.T) {
+	hasher := security.NewMultiHasher(security.NewArgon2Hasher(testArgon2Params), security.NewBcryptHasher(4))
+
+	err := hasher.Compare("5f4dcc3b5aa765d61d8327deb882cf99", "password")
+
+	assert.True(t, errors.Is(err, security.ErrUnknownHashFormat))
+}
+
+// TestNewPasswordHasherFromConfig tests choosing the algorithm for new hashes
+func TestNewPasswordHasherFromConfig(t *testing.T) {
+	cfg := config.PasswordConfig{BcryptCost: 4, Argon2Memory: 1024, Argon2Time: 1, Argon2Parallelism: 1}
+
+	cfg.Algorithm = "argon2id"
+	hasher, err := security.NewPasswordHasherFromConfig(cfg)
+	require.NoError(t, err)
+	argon2Hash, err := hasher.Hash("SecureP@ss123")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(argon2Hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	// Switching back to bcrypt keeps argon2id hashes working
+	cfg.Algorithm = "bcrypt"
+	hasher, err = security.NewPasswordHasherFromConfig(cfg)
+	require.NoError(t, err)
+	bcryptHash, err := hasher.Hash("SecureP@ss123")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(bcryptHash, "$2a$04$"))
+	assert.NoError(t, hasher.Compare(argon2Hash, "SecureP@ss123"))
+	assert.True(t, hasher.NeedsRehash(argon2Hash))
+
+	cfg.Algorithm = "md5"
+	_, err = security.NewPasswordHasherFromConfig(cfg)
+	assert.Error(t, err)
+}
@@ -0,0 +1,146 @@
+package security_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pepperKey returns a 32-byte key filled with b
+func pepperKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+// pepperConfig returns a fast hashing config with the given pepper keys
+func pepperConfig(peppers string, version int) config.PasswordConfig {
+	return config.PasswordConfig{
+		Algorithm:         "argon2id",
+		BcryptCost:        4,
+		Argon2Memory:      1024,
+		Argon2Time:        1,
+		Argon2Parallelism: 1,
+		Peppers:           peppers,
+		PepperVersion:     version,
+	}
+}
+
+// TestPepperedHasher_RoundTrip tests hashing and verifying with a pepper
+func TestPepperedHasher_RoundTrip(t *testing.T) {
+	inner := security.NewArgon2Hasher(testArgon2Params)
+	hasher, err := security.NewPepperedHasher(inner, map[int][]byte{1: pepperKey(1)}, 1)
+	require.NoError(t, err)
+
+	hash, err := hasher.Hash("SecureP@ss123")
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(hash, "$pepper$v=1$argon2id$v=19$"), hash)
+	assert.NoError(t, hasher.Compare(hash, "SecureP@ss123"))
+	assert.True(t, errors.Is(hasher.Compare(hash, "WrongP@ss123"), security.ErrPasswordMismatch))
+	assert.False(t, hasher.NeedsRehash(hash))
+	assert.True(t, hasher.Recognizes(hash))
+
+	// SECURITY: The inner hash alone doesn't verify the password
+	assert.Error(t, inner.Compare(strings.TrimPrefix(hash, "$pepper$v=1"), "SecureP@ss123"))
+
+	// Or with another key
+	other, err := security.NewPepperedHasher(inner, map[int][]byte{1: pepperKey(2)}, 1)
+	require.NoError(t, err)
+	assert.True(t, errors.Is(other.Compare(hash, "SecureP@ss123"), security.ErrPasswordMismatch))
+}
+
+// TestPepperedHasher_Rotation tests verifying and upgrading hashes made with an older key
+func TestPepperedHasher_Rotation(t *testing.T) {
+	inner := security.NewArgon2Hasher(testArgon2Params)
+	v1, err := security.NewPepperedHasher(inner, map[int][]byte{1: pepperKey(1)}, 1)
+	require.NoError(t, err)
+	oldHash, err := v1.Hash("SecureP@ss123")
+	require.NoError(t, err)
+
+	v2, err := security.NewPepperedHasher(inner, map[int][]byte{1: pepperKey(1), 2: pepperKey(2)}, 2)
+	require.NoError(t, err)
+
+	assert.NoError(t, v2.Compare(oldHash, "SecureP@ss123"))
+	assert.True(t, v2.NeedsRehash(oldHash))
+
+	newHash, err := v2.Hash("SecureP@ss123")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(newHash, "$pepper$v=2$"))
+	assert.False(t, v2.NeedsRehash(newHash))
+
+	// Once the old key is removed its hashes no longer verify
+	v2Only, err := security.NewPepperedHasher(inner, map[int][]byte{2: pepperKey(2)}, 2)
+	require.NoError(t, err)
+	assert.True(t, errors.Is(v2Only.Compare(oldHash, "SecureP@ss123"), security.ErrUnknownPepperVersion))
+	assert.False(t, v2Only.Recognizes(oldHash))
+}
+
+// TestPepperedHasher_UnpepperedHash tests hashes from before peppering was enabled
+func TestPepperedHasher_UnpepperedHash(t *testing.T) {
+	hasher, err := security.NewPasswordHasherFromConfig(pepperConfig("1:"+base64.StdEncoding.EncodeToString(pepperKey(1)), 1))
+	require.NoError(t, err)
+
+	bcryptHash, err := security.NewBcryptHasher(4).Hash("SecureP@ss123")
+	require.NoError(t, err)
+
+	assert.True(t, hasher.Recognizes(bcryptHash))
+	assert.NoError(t, hasher.Compare(bcryptHash, "SecureP@ss123"))
+	assert.True(t, hasher.NeedsRehash(bcryptHash))
+}
+
+// TestNewPasswordHasherFromConfig_PepperFile tests loading pepper keys from a file
+func TestNewPasswordHasherFromConfig_PepperFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "peppers")
+	contents := "# retired after the 2026 rotation\n" +
+		"1:" + base64.StdEncoding.EncodeToString(pepperKey(1)) + "\n\n" +
+		"2:" + base64.StdEncoding.EncodeToString(pepperKey(2)) + "\n"
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+	cfg := pepperConfig("", 2)
+	cfg.PepperFile = path
+	hasher, err := security.NewPasswordHasherFromConfig(cfg)
+	require.NoError(t, err)
+
+	hash, err := hasher.Hash("SecureP@ss123")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$pepper$v=2$argon2id$"))
+	assert.NoError(t, hasher.Compare(hash, "SecureP@ss123"))
+}
+
+// TestNewPasswordHasherFromConfig_InvalidPeppers tests rejecting bad pepper keys at startup
+func TestNewPasswordHasherFromConfig_InvalidPeppers(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(pepperKey(1))
+
+	tests := []struct {
+		name    string
+		peppers string
+		version int
+	}{
+		{name: "missing version", peppers: key, version: 1},
+		{name: "invalid version", peppers: "one:" + key, version: 1},
+		{name: "duplicate version", peppers: "1:" + key + ",1:" + key, version: 1},
+		{name: "not base64", peppers: "1:not base64!", version: 1},
+		{name: "short key", peppers: "1:" + base64.StdEncoding.EncodeToString([]byte("short")), version: 1},
+		{name: "no key for current version", peppers: "1:" + key, version: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := security.NewPasswordHasherFromConfig(pepperConfig(tt.peppers, tt.version))
+			assert.Error(t, err)
+		})
+	}
+
+	cfg := pepperConfig("", 1)
+	cfg.PepperFile = filepath.Join(t.TempDir(), "missing")
+	_, err := security.NewPasswordHasherFromConfig(cfg)
+	assert.Error(t, err)
+}
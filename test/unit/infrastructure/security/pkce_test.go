@@ -0,0 +1,43 @@
+package security_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPKCEChallenge tests the RFC 7636 appendix B example
+func TestPKCEChallenge(t *testing.T) {
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	assert.Equal(t, challenge, security.PKCEChallenge(verifier))
+	assert.True(t, security.ValidPKCEChallenge(challenge))
+	assert.True(t, security.VerifyPKCE(challenge, verifier))
+}
+
+// TestVerifyPKCE_Rejects tests verifiers that must not match
+func TestVerifyPKCE_Rejects(t *testing.T) {
+	challenge := security.PKCEChallenge(strings.Repeat("b", 43))
+	assert.False(t, security.VerifyPKCE(challenge, strings.Repeat("a", 43)), "different verifier")
+
+	// Malformed verifiers fail even against their own challenge
+	tests := []struct {
+		name     string
+		verifier string
+	}{
+		{name: "too short", verifier: strings.Repeat("a", 42)},
+		{name: "too long", verifier: strings.Repeat("a", 129)},
+		{name: "invalid characters", verifier: strings.Repeat("a", 42) + "+"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.False(t, security.VerifyPKCE(security.PKCEChallenge(tt.verifier), tt.verifier))
+		})
+	}
+
+	assert.False(t, security.ValidPKCEChallenge("plain-verifier"))
+}
@@ -0,0 +1,189 @@
+package security_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// encodePKCS8 returns a PEM-encoded PKCS#8 private key
+func encodePKCS8(t *testing.T, key any) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+// generatePEM creates a fresh private key for algorithm
+func generatePEM(t *testing.T, algorithm string) []byte {
+	t.Helper()
+
+	switch algorithm {
+	case security.AlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		return encodePKCS8(t, key)
+	case security.AlgorithmES256:
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		return encodePKCS8(t, key)
+	case security.AlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		return encodePKCS8(t, key)
+	default:
+		t.Fatalf("unsupported algorithm %s", algorithm)
+		return nil
+	}
+}
+
+// TestSigningKey_Asymmetric tests signing and verifying with each algorithm
+func TestSigningKey_Asymmetric(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		kty       string
+	}{
+		{algorithm: security.AlgorithmRS256, kty: "RSA"},
+		{algorithm: security.AlgorithmES256, kty: "EC"},
+		{algorithm: security.AlgorithmEdDSA, kty: "OKP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			// Arrange - load from disk like the server does
+			path := filepath.Join(t.TempDir(), "key.pem")
+			require.NoError(t, os.WriteFile(path, generatePEM(t, tt.algorithm), 0o600))
+
+			key, err := security.LoadSigningKey("", tt.algorithm, path)
+			require.NoError(t, err)
+			assert.NotEmpty(t, key.ID(), "kid should be derived from the key")
+
+			generator := security.NewJWTGenerator(security.NewKeyRing(key), 15*time.Minute, time.Hour, "auth-service-test")
+			email, _ := valueobject.NewEmail("user@example.com")
+
+			// Act
+			tokenString, err := generator.GenerateAccessToken(valueobject.NewUserID(), valueobject.DefaultTenantID(), email, nil, nil, time.Now(), "")
+			require.NoError(t, err)
+
+			// Assert - header names the key
+			parsed, _, err := jwt.NewParser().ParseUnverified(tokenString, &security.Claims{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.algorithm, parsed.Header["alg"])
+			assert.Equal(t, key.ID(), parsed.Header["kid"])
+
+			_, err = generator.ValidateToken(tokenString, security.TokenUseAccess)
+			assert.NoError(t, err)
+
+			// Assert - JWKS publishes the public key
+			jwks := generator.JWKS()
+			require.Len(t, jwks.Keys, 1)
+			assert.Equal(t, tt.kty, jwks.Keys[0].Kty)
+			assert.Equal(t, key.ID(), jwks.Keys[0].Kid)
+			assert.Equal(t, tt.algorithm, jwks.Keys[0].Alg)
+			assert.Equal(t, "sig", jwks.Keys[0].Use)
+		})
+	}
+}
+
+// TestSigningKey_ExplicitKeyID tests that a configured kid wins over the thumbprint
+func TestSigningKey_ExplicitKeyID(t *testing.T) {
+	key, err := security.ParseSigningKey("key-2024-01", security.AlgorithmES256, generatePEM(t, security.AlgorithmES256))
+
+	require.NoError(t, err)
+	assert.Equal(t, "key-2024-01", key.ID())
+}
+
+// TestSigningKey_StableThumbprint tests that the same key always gets the same kid
+func TestSigningKey_StableThumbprint(t *testing.T) {
+	pemData := generatePEM(t, security.AlgorithmEdDSA)
+
+	first, err := security.ParseSigningKey("", security.AlgorithmEdDSA, pemData)
+	require.NoError(t, err)
+	second, err := security.ParseSigningKey("", security.AlgorithmEdDSA, pemData)
+	require.NoError(t, err)
+
+	assert.Equal(t, first.ID(), second.ID())
+}
+
+// TestSigningKey_AlgorithmMismatch tests that the key type must match the algorithm
+func TestSigningKey_AlgorithmMismatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm string
+		pemData   []byte
+	}{
+		{name: "EC key as RS256", algorithm: security.AlgorithmRS256, pemData: generatePEM(t, security.AlgorithmES256)},
+		{name: "RSA key as ES256", algorithm: security.AlgorithmES256, pemData: generatePEM(t, security.AlgorithmRS256)},
+		{name: "EC key as EdDSA", algorithm: security.AlgorithmEdDSA, pemData: generatePEM(t, security.AlgorithmES256)},
+		{name: "HS256 is not asymmetric", algorithm: security.AlgorithmHS256, pemData: generatePEM(t, security.AlgorithmES256)},
+		{name: "not PEM", algorithm: security.AlgorithmES256, pemData: []byte("not a key")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := security.ParseSigningKey("", tt.algorithm, tt.pemData)
+
+			assert.Error(t, err)
+			assert.Nil(t, key)
+		})
+	}
+}
+
+// TestSigningKey_RejectsAlgorithmConfusion tests that an HS256 token keyed with
+// the published public key is rejected by an RS256 generator
+func TestSigningKey_RejectsAlgorithmConfusion(t *testing.T) {
+	// Arrange
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	key, err := security.ParseSigningKey("", security.AlgorithmRS256, encodePKCS8(t, rsaKey))
+	require.NoError(t, err)
+	generator := security.NewJWTGenerator(security.NewKeyRing(key), 15*time.Minute, time.Hour, "auth-service-test")
+
+	publicDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, security.Claims{
+		UserID:   valueobject.NewUserID().String(),
+		TokenUse: security.TokenUseAccess,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+	forged.Header["kid"] = key.ID()
+	forgedString, err := forged.SignedString(publicPEM)
+	require.NoError(t, err)
+
+	// Act
+	claims, err := generator.ValidateToken(forgedString, security.TokenUseAccess)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, claims)
+}
+
+// TestSigningKey_HMACNotPublished tests that HS256 secrets never appear in JWKS
+func TestSigningKey_HMACNotPublished(t *testing.T) {
+	generator := newTestGenerator()
+
+	jwks := generator.JWKS()
+
+	assert.NotNil(t, jwks.Keys)
+	assert.Empty(t, jwks.Keys)
+}
@@ -0,0 +1,115 @@
+package security_test
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfc6238Secret is the SHA1 test key from RFC 6238 Appendix B
+var rfc6238Secret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+// TestTOTPCode_RFC6238 tests against the RFC 6238 SHA1 vectors (last 6 digits)
+func TestTOTPCode_RFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+	}
+
+	for _, tt := range tests {
+		code, err := security.TOTPCode(rfc6238Secret, time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.code, code, "t=%d", tt.unix)
+	}
+}
+
+// TestValidateTOTP_Skew tests that one period of clock drift is tolerated
+func TestValidateTOTP_Skew(t *testing.T) {
+	secret, err := security.GenerateTOTPSecret()
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	code, err := security.TOTPCode(secret, now)
+	require.NoError(t, err)
+
+	step, ok := security.ValidateTOTP(secret, code, now.Add(30*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, now.Unix()/30, step)
+
+	_, ok = security.ValidateTOTP(secret, code, now.Add(90*time.Second))
+	assert.False(t, ok, "codes from two periods ago should be rejected")
+
+	_, ok = security.ValidateTOTP(secret, "12345", now)
+	assert.False(t, ok)
+}
+
+// TestTOTPURI tests the authenticator app URI
+func TestTOTPURI(t *testing.T) {
+	uri := security.TOTPURI("LabukaAuth", "user@example.com", "JBSWY3DPEHPK3PXP")
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/LabukaAuth:user@example.com", parsed.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", parsed.Query().Get("secret"))
+	assert.Equal(t, "LabukaAuth", parsed.Query().Get("issuer"))
+}
+
+// TestRecoveryCodes tests generation and typing-tolerant hashing
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := security.GenerateRecoveryCodes(10)
+	require.NoError(t, err)
+	require.Len(t, codes, 10)
+
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Len(t, code, 19)
+		assert.False(t, seen[code], "codes should be unique")
+		seen[code] = true
+	}
+
+	code := codes[0]
+	typed := strings.ToUpper(strings.ReplaceAll(code, "-", " "))
+	assert.Equal(t, security.HashRecoveryCode(code), security.HashRecoveryCode(typed))
+	assert.NotEqual(t, security.HashRecoveryCode(code), security.HashRecoveryCode(codes[1]))
+}
+
+// TestAESCipher tests encryption round-trips and associated data binding
+func TestAESCipher(t *testing.T) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	cipher, err := security.NewAESCipherFromBase64(base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+
+	ciphertext, err := cipher.Encrypt("secret", "user-1")
+	require.NoError(t, err)
+	assert.NotContains(t, ciphertext, "secret")
+
+	plaintext, err := cipher.Decrypt(ciphertext, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", plaintext)
+
+	// SECURITY: A ciphertext copied to another user must not decrypt
+	_, err = cipher.Decrypt(ciphertext, "user-2")
+	assert.Error(t, err)
+
+	_, err = security.NewAESCipher(key[:16])
+	assert.Error(t, err, "only AES-256 keys are accepted")
+}
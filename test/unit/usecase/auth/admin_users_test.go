@@ -0,0 +1,319 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adminID = "7d2c1f0e-6a51-4c1e-9a0b-3f7f6c2d9e10"
+
+// adminRequest is an admin acting on user
+func adminRequest(user *entity.User) usecase.AdminUserRequest {
+	return usecase.AdminUserRequest{ActorID: adminID, UserID: user.ID().String()}
+}
+
+func TestListUsers_PagingAndAudit(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	var gotLimit int
+	env.userRepo.ListFunc = func(ctx context.Context, tenantID valueobject.TenantID, offset, limit int) ([]*entity.User, error) {
+		gotLimit = limit
+		return []*entity.User{env.user}, nil
+	}
+	listUC := auth.NewListUsersUseCase(env.userRepo, env.auditLog())
+
+	// Act
+	list, err := listUC.Execute(context.Background(), usecase.ListUsersRequest{ActorID: adminID, Offset: -5, Limit: 1000})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, auth.MaxUserPageSize, gotLimit)
+	assert.Equal(t, 0, list.Offset)
+	assert.Equal(t, int64(1), list.Total)
+	require.Len(t, list.Users, 1)
+	assert.Equal(t, "user@example.com", list.Users[0].Email)
+
+	event := env.lastEvent(t)
+	assert.Equal(t, entity.AuditActionListUsers, event.Action())
+	assert.Equal(t, adminID, event.ActorID())
+	assert.Equal(t, "100", event.Details()["limit"])
+}
+
+func TestListUsers_DefaultPageSize(t *testing.T) {
+	env := newTestEnv(t)
+	listUC := auth.NewListUsersUseCase(env.userRepo, env.auditLog())
+
+	list, err := listUC.Execute(context.Background(), usecase.ListUsersRequest{ActorID: adminID})
+
+	require.NoError(t, err)
+	assert.Equal(t, auth.DefaultUserPageSize, list.Limit)
+}
+
+func TestGetUser_ByIDAndEmail(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	getUC := auth.NewGetUserUseCase(env.userRepo, env.auditLog())
+	ctx := context.Background()
+
+	// Act
+	byID, err := getUC.Execute(ctx, usecase.GetUserRequest{ActorID: adminID, UserID: env.user.ID().String()})
+	require.NoError(t, err)
+	byEmail, err := getUC.Execute(ctx, usecase.GetUserRequest{ActorID: adminID, Email: "USER@example.com"})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "user@example.com", byID.Email)
+	assert.Equal(t, byID.ID, byEmail.ID)
+	assert.Len(t, env.auditRepo.Events, 2)
+	assert.Equal(t, entity.AuditActionViewUser, env.lastEvent(t).Action())
+	assert.Equal(t, env.user.ID().String(), env.lastEvent(t).TargetUserID())
+}
+
+func TestGetUser_InvalidLookup(t *testing.T) {
+	env := newTestEnv(t)
+	getUC := auth.NewGetUserUseCase(env.userRepo, env.auditLog())
+	ctx := context.Background()
+
+	_, err := getUC.Execute(ctx, usecase.GetUserRequest{ActorID: adminID})
+	assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+
+	_, err = getUC.Execute(ctx, usecase.GetUserRequest{ActorID: adminID, UserID: env.user.ID().String(), Email: "user@example.com"})
+	assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+
+	_, err = getUC.Execute(ctx, usecase.GetUserRequest{ActorID: adminID, Email: "nobody@example.com"})
+	assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+
+	assert.Empty(t, env.auditRepo.Events, "failed lookups are not recorded")
+}
+
+func TestGetUser_AuditFailureHidesUser(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	env.auditRepo.AppendFunc = func(ctx context.Context, event *entity.AuditEvent) error {
+		return errors.New("database unavailable")
+	}
+	getUC := auth.NewGetUserUseCase(env.userRepo, env.auditLog())
+
+	// Act
+	user, err := getUC.Execute(context.Background(), usecase.GetUserRequest{ActorID: adminID, UserID: env.user.ID().String()})
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, user)
+}
+
+func TestSetUserActive_DeactivateAndActivate(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	setActiveUC := auth.NewSetUserActiveUseCase(env.userRepo, env.sessions(), env.auditLog())
+	ctx := context.Background()
+
+	// Act & Assert - deactivate
+	details, err := setActiveUC.Execute(ctx, adminRequest(env.user), false)
+	require.NoError(t, err)
+	assert.False(t, details.IsActive)
+	assert.False(t, env.user.CanLogin())
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeAllForUserCalls)
+	assert.Equal(t, entity.AuditActionDeactivateUser, env.lastEvent(t).Action())
+
+	// Act & Assert - activate
+	details, err = setActiveUC.Execute(ctx, adminRequest(env.user), true)
+	require.NoError(t, err)
+	assert.True(t, details.IsActive)
+	assert.Equal(t, entity.AuditActionActivateUser, env.lastEvent(t).Action())
+	assert.Equal(t, 2, env.userRepo.UpdateCalls)
+}
+
+func TestSetUserActive_CannotDeactivateSelf(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	setActiveUC := auth.NewSetUserActiveUseCase(env.userRepo, env.sessions(), env.auditLog())
+	req := adminRequest(env.user)
+	req.ActorID = req.UserID
+
+	// Act
+	_, err := setActiveUC.Execute(context.Background(), req, false)
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+	assert.True(t, env.user.IsActive())
+}
+
+func TestForcePasswordReset(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	requestResetUC := auth.NewRequestPasswordResetUseCase(env.userRepo, &mocks.MockPasswordResetTokenRepository{}, env.background, time.Hour, "https://app.example.com/reset")
+	forceUC := auth.NewForcePasswordResetUseCase(env.userRepo, env.sessions(), requestResetUC, env.auditLog())
+
+	// Act
+	err := forceUC.Execute(context.Background(), adminRequest(env.user))
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, env.user.PasswordResetRequired())
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeAllForUserCalls)
+	assert.Equal(t, "user@example.com", env.lastMail(t).To)
+	assert.Len(t, env.mailer.Sent, 1)
+	assert.Equal(t, entity.AuditActionForcePasswordReset, env.lastEvent(t).Action())
+
+	// A new password clears the flag
+	require.NoError(t, env.user.UpdatePassword(valueobject.NewPasswordFromHash("hashed_N3wP@ssword")))
+	assert.False(t, env.user.PasswordResetRequired())
+}
+
+func TestForcePasswordReset_BlocksPasswordLogin(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	requestResetUC := auth.NewRequestPasswordResetUseCase(env.userRepo, &mocks.MockPasswordResetTokenRepository{}, env.background, time.Hour, "https://app.example.com/reset")
+	forceUC := auth.NewForcePasswordResetUseCase(env.userRepo, env.sessions(), requestResetUC, env.auditLog())
+	require.NoError(t, forceUC.Execute(context.Background(), adminRequest(env.user)))
+
+	jwtGenerator := &mocks.MockJWTGenerator{}
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, jwtGenerator, newTokenIssuer(jwtGenerator), newLoginThrottle(), false, 5*time.Minute)
+
+	// Act
+	_, err := loginUC.Execute(context.Background(), usecase.LoginRequest{
+		Email:    "user@example.com",
+		Password: testPassword,
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+	assert.Equal(t, 0, jwtGenerator.GenerateAccessTokenCalls)
+}
+
+func TestUnlockAccount(t *testing.T) {
+	// Arrange - locked in the default tenant, and the same email locked in another
+	env := newTestEnv(t)
+	other := mustTenant(t, "acme")
+	env.attemptRepo.SetFailures(entity.AccountAttemptKey(valueobject.DefaultTenantID(), env.user.Email()), "user@example.com", 6, time.Now())
+	env.attemptRepo.SetFailures(entity.ClientAttemptKey(valueobject.DefaultTenantID(), env.user.Email(), "10.0.0.1"), "user@example.com", 4, time.Now())
+	otherKey := entity.AccountAttemptKey(other, env.user.Email())
+	env.attemptRepo.Attempts[otherKey] = entity.ReconstructLoginAttempt(otherKey, other, "user@example.com", 6, time.Now(), time.Now().Add(time.Hour))
+	unlockUC := auth.NewUnlockAccountUseCase(env.userRepo, env.attemptRepo, env.auditLog())
+
+	// Act
+	err := unlockUC.Execute(context.Background(), adminRequest(env.user))
+
+	// Assert - the other organization keeps its counter
+	require.NoError(t, err)
+	assert.Len(t, env.attemptRepo.Attempts, 1)
+	assert.Contains(t, env.attemptRepo.Attempts, otherKey)
+
+	event := env.lastEvent(t)
+	assert.Equal(t, entity.AuditActionUnlockUser, event.Action())
+	assert.Equal(t, adminID, event.ActorID())
+	assert.Equal(t, env.user.ID().String(), event.TargetUserID())
+}
+
+func TestUnlockAccount_OtherTenant(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	unlockUC := auth.NewUnlockAccountUseCase(env.userRepo, env.attemptRepo, env.auditLog())
+	ctx := usecase.WithTenant(context.Background(), mustTenant(t, "acme"))
+
+	// Act
+	err := unlockUC.Execute(ctx, adminRequest(env.user))
+
+	// Assert
+	assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+	assert.Equal(t, 0, env.attemptRepo.DeleteByEmailCalls)
+	assert.Empty(t, env.auditRepo.Events)
+}
+
+func TestDeleteUser(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	resetTokenRepo := &mocks.MockPasswordResetTokenRepository{}
+	loginCodeRepo := &mocks.MockLoginCodeRepository{}
+	passkeyRepo := &mocks.MockPasskeyRepository{}
+	patRepo := &mocks.MockPersonalAccessTokenRepository{}
+	deleteUC := auth.NewDeleteUserUseCase(
+		env.userRepo,
+		env.sessions(),
+		resetTokenRepo,
+		loginCodeRepo,
+		passkeyRepo,
+		patRepo,
+		env.attemptRepo,
+		env.auditLog(),
+	)
+
+	// Act
+	err := deleteUC.Execute(context.Background(), adminRequest(env.user))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, env.userRepo.DeleteCalls)
+	assert.Empty(t, env.users)
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeAllForUserCalls)
+	assert.Equal(t, 1, resetTokenRepo.DeleteByUserIDCalls)
+	assert.Equal(t, 1, loginCodeRepo.DeleteByUserIDCalls)
+	assert.Equal(t, 1, passkeyRepo.DeleteByUserIDCalls)
+	assert.Equal(t, 1, patRepo.DeleteByUserIDCalls)
+	assert.Equal(t, 1, env.attemptRepo.DeleteByEmailCalls)
+
+	event := env.lastEvent(t)
+	assert.Equal(t, entity.AuditActionDeleteUser, event.Action())
+	assert.Equal(t, env.user.ID().String(), event.TargetUserID())
+	assert.Equal(t, "user@example.com", event.TargetEmail())
+}
+
+func TestDeleteUser_CannotDeleteSelf(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	deleteUC := newDeleteUserUseCase(env)
+	req := adminRequest(env.user)
+	req.ActorID = req.UserID
+
+	// Act
+	err := deleteUC.Execute(context.Background(), req)
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+	assert.Equal(t, 0, env.userRepo.DeleteCalls)
+}
+
+func TestDeleteUser_NotFound(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	deleteUC := newDeleteUserUseCase(env)
+	req := adminRequest(env.user)
+	req.UserID = valueobject.NewUserID().String()
+
+	// Act
+	err := deleteUC.Execute(context.Background(), req)
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+	assert.Empty(t, env.auditRepo.Events)
+}
+
+// newDeleteUserUseCase builds a delete use case for tests that don't inspect the cleanup
+func newDeleteUserUseCase(env *testEnv) *auth.DeleteUserUseCase {
+	return auth.NewDeleteUserUseCase(
+		env.userRepo,
+		env.sessions(),
+		&mocks.MockPasswordResetTokenRepository{},
+		&mocks.MockLoginCodeRepository{},
+		&mocks.MockPasskeyRepository{},
+		&mocks.MockPersonalAccessTokenRepository{},
+		env.attemptRepo,
+		env.auditLog(),
+	)
+}
@@ -0,0 +1,328 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// credentialsLockoutPolicy shares the login counters without backoff before the lockout
+var credentialsLockoutPolicy = func() auth.LockoutPolicy {
+	policy := testLockoutPolicy
+	policy.FreeAttempts = policy.AccountThreshold
+	return policy
+}()
+
+// TestChangePassword_Success tests changing the password with the current one
+func TestChangePassword_Success(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	changePasswordUC := auth.NewChangePasswordUseCase(env.userRepo, env.hasher, newLoginThrottle(), env.sessions(), env.tokenIssuer())
+
+	// Act
+	resp, err := changePasswordUC.Execute(context.Background(), usecase.ChangePasswordRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		NewPassword:     "NewP@ssw0rd123",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.AccessToken)
+	assert.NotEmpty(t, resp.RefreshToken)
+	assert.Equal(t, "hashed_NewP@ssw0rd123", env.user.Password().Hash())
+	assert.Equal(t, 1, env.userRepo.UpdateCalls)
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeAllForUserCalls)
+	assert.Equal(t, 1, env.refreshTokenRepo.CreateCalls, "caller gets a new session")
+}
+
+// TestChangePassword_WrongCurrentPassword tests that the current password is required
+func TestChangePassword_WrongCurrentPassword(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	changePasswordUC := auth.NewChangePasswordUseCase(env.userRepo, env.hasher, newLoginThrottle(), env.sessions(), env.tokenIssuer())
+
+	// Act
+	resp, err := changePasswordUC.Execute(context.Background(), usecase.ChangePasswordRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: "Wrong@Passw0rd",
+		NewPassword:     "NewP@ssw0rd123",
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, "hashed_"+testPassword, env.user.Password().Hash())
+	assert.Equal(t, 0, env.userRepo.UpdateCalls)
+	assert.Equal(t, 0, env.refreshTokenRepo.RevokeAllForUserCalls)
+}
+
+// TestChangePassword_WeakPassword tests new password validation
+func TestChangePassword_WeakPassword(t *testing.T) {
+	env := newTestEnv(t)
+	changePasswordUC := auth.NewChangePasswordUseCase(env.userRepo, env.hasher, newLoginThrottle(), env.sessions(), env.tokenIssuer())
+
+	_, err := changePasswordUC.Execute(context.Background(), usecase.ChangePasswordRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		NewPassword:     "weak",
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+	assert.Equal(t, 0, env.userRepo.UpdateCalls)
+}
+
+// TestChangeEmail_Success tests that a change waits for the new address to confirm it
+func TestChangeEmail_Success(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	env.user.MarkEmailVerified()
+	verification := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+	changeEmailUC := auth.NewChangeEmailUseCase(env.userRepo, env.hasher, newLoginThrottle(), verification)
+
+	// Act
+	resp, err := changeEmailUC.Execute(context.Background(), usecase.ChangeEmailRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		NewEmail:        "new@example.com",
+	})
+
+	// Assert - nothing changes until the new address confirms
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", resp.Email)
+	assert.Equal(t, "new@example.com", resp.PendingEmail)
+	assert.Equal(t, "user@example.com", env.user.Email().String())
+	assert.Equal(t, "new@example.com", env.user.PendingEmail().String())
+	assert.True(t, env.user.IsEmailVerified())
+	assert.Equal(t, 1, env.userRepo.UpdateCalls)
+	assert.Equal(t, 0, env.refreshTokenRepo.RevokeAllForUserCalls, "sessions last until the change is confirmed")
+
+	// The current address is told, the new one gets the link
+	require.Len(t, env.mailer.Sent, 2)
+	assert.Equal(t, "user@example.com", env.mailer.Sent[0].To)
+	assert.Equal(t, mail.TemplateEmailChangeNotice, env.mailer.Sent[0].Template)
+	assert.Contains(t, env.mailer.Sent[0].Body, "new@example.com")
+	assert.Equal(t, "new@example.com", env.mailer.Sent[1].To)
+	assert.Equal(t, mail.TemplateConfirmEmailChange, env.mailer.Sent[1].Template)
+}
+
+// TestChangeEmail_Confirm tests that following the link moves the account to the new address
+func TestChangeEmail_Confirm(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	verification := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+	changeEmailUC := auth.NewChangeEmailUseCase(env.userRepo, env.hasher, newLoginThrottle(), verification)
+	verifyUC := auth.NewVerifyEmailUseCase(env.userRepo, env.jwt, env.sessions())
+
+	_, err := changeEmailUC.Execute(context.Background(), usecase.ChangeEmailRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		NewEmail:        "new@example.com",
+	})
+	require.NoError(t, err)
+
+	// Act
+	err = verifyUC.Execute(context.Background(), env.lastLinkToken(t))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "new@example.com", env.user.Email().String())
+	assert.True(t, env.user.PendingEmail().IsEmpty())
+	assert.True(t, env.user.IsEmailVerified(), "the link proves the new address")
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeAllForUserCalls, "tokens carry the old email")
+}
+
+// TestChangeEmail_ConfirmSuperseded tests that a newer request voids the earlier link
+func TestChangeEmail_ConfirmSuperseded(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	verification := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+	changeEmailUC := auth.NewChangeEmailUseCase(env.userRepo, env.hasher, newLoginThrottle(), verification)
+	verifyUC := auth.NewVerifyEmailUseCase(env.userRepo, env.jwt, env.sessions())
+
+	changeTo := func(newEmail string) {
+		_, err := changeEmailUC.Execute(context.Background(), usecase.ChangeEmailRequest{
+			UserID:          env.user.ID().String(),
+			CurrentPassword: testPassword,
+			NewEmail:        newEmail,
+		})
+		require.NoError(t, err)
+	}
+
+	changeTo("typo@example.com")
+	staleToken := env.lastLinkToken(t)
+	changeTo("new@example.com")
+
+	// Act
+	err := verifyUC.Execute(context.Background(), staleToken)
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, "user@example.com", env.user.Email().String())
+	assert.Equal(t, "new@example.com", env.user.PendingEmail().String())
+}
+
+// TestChangeEmail_Conflict tests the unique-email rule
+func TestChangeEmail_Conflict(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	env.userRepo.ExistsByEmailFunc = func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error) {
+		return true, nil
+	}
+	verification := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+	changeEmailUC := auth.NewChangeEmailUseCase(env.userRepo, env.hasher, newLoginThrottle(), verification)
+
+	// Act
+	resp, err := changeEmailUC.Execute(context.Background(), usecase.ChangeEmailRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		NewEmail:        "taken@example.com",
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrConflict))
+	assert.True(t, env.user.PendingEmail().IsEmpty())
+	assert.Equal(t, 0, env.mailer.SendCalls)
+}
+
+// TestChangeEmail_ConfirmConflict tests an address taken between the request and the confirmation
+func TestChangeEmail_ConfirmConflict(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	verification := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+	changeEmailUC := auth.NewChangeEmailUseCase(env.userRepo, env.hasher, newLoginThrottle(), verification)
+	verifyUC := auth.NewVerifyEmailUseCase(env.userRepo, env.jwt, env.sessions())
+
+	_, err := changeEmailUC.Execute(context.Background(), usecase.ChangeEmailRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		NewEmail:        "new@example.com",
+	})
+	require.NoError(t, err)
+	env.userRepo.UpdateFunc = func(ctx context.Context, user *entity.User) error {
+		return repository.NewUserAlreadyExistsError("Update", nil)
+	}
+
+	// Act
+	err = verifyUC.Execute(context.Background(), env.lastLinkToken(t))
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrConflict))
+	assert.Equal(t, 0, env.refreshTokenRepo.RevokeAllForUserCalls)
+}
+
+// TestChangeEmail_WrongCurrentPassword tests that the current password is required
+func TestChangeEmail_WrongCurrentPassword(t *testing.T) {
+	env := newTestEnv(t)
+	verification := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+	changeEmailUC := auth.NewChangeEmailUseCase(env.userRepo, env.hasher, newLoginThrottle(), verification)
+
+	_, err := changeEmailUC.Execute(context.Background(), usecase.ChangeEmailRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: "Wrong@Passw0rd",
+		NewEmail:        "new@example.com",
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.True(t, env.user.PendingEmail().IsEmpty())
+	assert.Equal(t, 0, env.mailer.SendCalls)
+}
+
+// TestChangeEmail_SameAddress tests that the new email must differ
+func TestChangeEmail_SameAddress(t *testing.T) {
+	env := newTestEnv(t)
+	env.user.MarkEmailVerified()
+	verification := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+	changeEmailUC := auth.NewChangeEmailUseCase(env.userRepo, env.hasher, newLoginThrottle(), verification)
+
+	_, err := changeEmailUC.Execute(context.Background(), usecase.ChangeEmailRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		NewEmail:        "user@example.com",
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+	assert.True(t, env.user.IsEmailVerified())
+}
+
+// TestChangePassword_WrongCurrentPasswordLocksAccount tests that a stolen
+// access token can't be used to guess the password without limit
+func TestChangePassword_WrongCurrentPasswordLocksAccount(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	throttle := env.throttle(credentialsLockoutPolicy)
+	changePasswordUC := auth.NewChangePasswordUseCase(env.userRepo, env.hasher, throttle, env.sessions(), env.tokenIssuer())
+	verification := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+	changeEmailUC := auth.NewChangeEmailUseCase(env.userRepo, env.hasher, throttle, verification)
+
+	ctx := usecase.WithClientInfo(context.Background(), usecase.ClientInfo{IPAddress: "10.0.0.1"})
+	changePassword := func(current string) error {
+		_, err := changePasswordUC.Execute(ctx, usecase.ChangePasswordRequest{
+			UserID:          env.user.ID().String(),
+			CurrentPassword: current,
+			NewPassword:     "NewP@ssw0rd123",
+		})
+		return err
+	}
+
+	// Act - wrong guesses up to the client threshold
+	for i := 0; i < testLockoutPolicy.ClientThreshold; i++ {
+		require.True(t, errors.Is(changePassword("Guess@Passw0rd"), domainErrors.ErrUnauthorized))
+	}
+	err := changePassword(testPassword)
+
+	// Assert - locked like login, even with the right password
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrAccountLocked))
+	_, ok := domainErrors.RetryAfter(err)
+	assert.True(t, ok)
+	assert.Equal(t, "hashed_"+testPassword, env.user.Password().Hash())
+	assert.Contains(t, env.attemptRepo.Attempts, entity.AccountAttemptKey(valueobject.DefaultTenantID(), env.user.Email()))
+
+	// Changing the email is locked too
+	_, err = changeEmailUC.Execute(ctx, usecase.ChangeEmailRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		NewEmail:        "new@example.com",
+	})
+	assert.True(t, errors.Is(err, domainErrors.ErrAccountLocked))
+}
+
+// TestChangePassword_SuccessResetsFailures tests that the right password clears the counters
+func TestChangePassword_SuccessResetsFailures(t *testing.T) {
+	// Arrange - one wrong guess counted
+	env := newTestEnv(t)
+	changePasswordUC := auth.NewChangePasswordUseCase(env.userRepo, env.hasher, env.throttle(credentialsLockoutPolicy), env.sessions(), env.tokenIssuer())
+	req := usecase.ChangePasswordRequest{UserID: env.user.ID().String(), CurrentPassword: "Guess@Passw0rd", NewPassword: "NewP@ssw0rd123"}
+
+	_, err := changePasswordUC.Execute(context.Background(), req)
+	require.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	require.NotEmpty(t, env.attemptRepo.Attempts)
+
+	// Act
+	req.CurrentPassword = testPassword
+	_, err = changePasswordUC.Execute(context.Background(), req)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, env.attemptRepo.Attempts)
+}
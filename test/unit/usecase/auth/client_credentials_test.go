@@ -0,0 +1,154 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newServiceClient registers a service client and returns its secret
+func newServiceClient(t *testing.T, env *testEnv) (*entity.ServiceClient, string) {
+	t.Helper()
+
+	createUC := auth.NewCreateServiceClientUseCase(&mocks.MockOrganizationRepository{}, env.serviceClientRepo)
+	client, secret, err := createUC.Execute(context.Background(), "Billing Worker", []string{"billing:read", "invoices:write"})
+	require.NoError(t, err)
+	require.NotEmpty(t, secret)
+	assert.NotEqual(t, secret, client.SecretHash())
+	return client, secret
+}
+
+// clientCredentials requests a token with the client_credentials grant
+func clientCredentials(tokenUC *auth.OAuthTokenUseCase, clientID, secret, scope string) (*usecase.OAuthTokenResponse, error) {
+	return tokenUC.Execute(context.Background(), usecase.OAuthTokenRequest{
+		GrantType:    auth.GrantTypeClientCredentials,
+		Scope:        scope,
+		ClientID:     clientID,
+		ClientSecret: secret,
+	})
+}
+
+// TestOAuthToken_ClientCredentials tests issuing and validating a service token
+func TestOAuthToken_ClientCredentials(t *testing.T) {
+	env := newTestEnv(t)
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, &mocks.MockPersonalAccessTokenRepository{}, env.sessionRepo)
+	client, secret := newServiceClient(t, env)
+
+	resp, err := clientCredentials(tokenUC, client.ID(), secret, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer", resp.TokenType)
+	assert.Equal(t, "billing:read invoices:write", resp.Scope)
+	assert.Empty(t, resp.RefreshToken, "no refresh token for client_credentials")
+	assert.Empty(t, resp.IDToken)
+
+	claims, err := validateUC.Execute(context.Background(), resp.AccessToken)
+	require.NoError(t, err)
+	assert.Empty(t, claims.UserID)
+	assert.Equal(t, client.ID(), claims.ClientID)
+	assert.Equal(t, []string{"billing:read", "invoices:write"}, claims.Scopes)
+	assert.Equal(t, valueobject.DefaultTenant, claims.TenantID)
+}
+
+// TestOAuthToken_ClientCredentialsNarrowScope tests requesting a subset of scopes
+func TestOAuthToken_ClientCredentialsNarrowScope(t *testing.T) {
+	env := newTestEnv(t)
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	client, secret := newServiceClient(t, env)
+
+	resp, err := clientCredentials(tokenUC, client.ID(), secret, "billing:read")
+	require.NoError(t, err)
+	assert.Equal(t, "billing:read", resp.Scope)
+
+	_, err = clientCredentials(tokenUC, client.ID(), secret, "billing:read admin")
+	assert.Equal(t, domainErrors.OAuthInvalidScope, domainErrors.OAuthCode(err))
+}
+
+// TestOAuthToken_ClientCredentialsRejected tests client authentication failures
+func TestOAuthToken_ClientCredentialsRejected(t *testing.T) {
+	env := newTestEnv(t)
+	oauthClient := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile")
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	client, secret := newServiceClient(t, env)
+
+	tests := []struct {
+		name     string
+		clientID string
+		secret   string
+	}{
+		{name: "no secret", clientID: client.ID()},
+		{name: "wrong secret", clientID: client.ID(), secret: "wrong"},
+		{name: "unknown client", clientID: "missing", secret: secret},
+		{name: "OAuth client", clientID: oauthClient.ID()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := clientCredentials(tokenUC, tt.clientID, tt.secret, "")
+
+			assert.Equal(t, domainErrors.OAuthInvalidClient, domainErrors.OAuthCode(err))
+			assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+		})
+	}
+}
+
+// TestOAuthToken_ClientCredentialsInactive tests disabling a service client
+func TestOAuthToken_ClientCredentialsInactive(t *testing.T) {
+	env := newTestEnv(t)
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, &mocks.MockPersonalAccessTokenRepository{}, env.sessionRepo)
+	client, secret := newServiceClient(t, env)
+	resp, err := clientCredentials(tokenUC, client.ID(), secret, "")
+	require.NoError(t, err)
+
+	setActiveUC := auth.NewSetServiceClientActiveUseCase(env.serviceClientRepo)
+	_, err = setActiveUC.Execute(context.Background(), client.ID(), false)
+	require.NoError(t, err)
+
+	// Tokens already issued stop validating
+	_, err = validateUC.Execute(context.Background(), resp.AccessToken)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+
+	// And no new ones are issued
+	_, err = clientCredentials(tokenUC, client.ID(), secret, "")
+	assert.Equal(t, domainErrors.OAuthInvalidClient, domainErrors.OAuthCode(err))
+}
+
+// TestValidateToken_ServiceTokenOtherTenant tests the tenant check on service tokens
+func TestValidateToken_ServiceTokenOtherTenant(t *testing.T) {
+	env := newTestEnv(t)
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, &mocks.MockPersonalAccessTokenRepository{}, env.sessionRepo)
+	client, secret := newServiceClient(t, env)
+	resp, err := clientCredentials(tokenUC, client.ID(), secret, "")
+	require.NoError(t, err)
+
+	acme, _ := valueobject.NewTenantID("acme")
+	_, err = validateUC.Execute(usecase.WithTenant(context.Background(), acme), resp.AccessToken)
+
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestSetServiceClientActive_OtherTenant tests that operators only manage their tenant's clients
+func TestSetServiceClientActive_OtherTenant(t *testing.T) {
+	env := newTestEnv(t)
+	client, _ := newServiceClient(t, env)
+
+	acme, _ := valueobject.NewTenantID("acme")
+	setActiveUC := auth.NewSetServiceClientActiveUseCase(env.serviceClientRepo)
+	_, err := setActiveUC.Execute(usecase.WithTenant(context.Background(), acme), client.ID(), false)
+
+	assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+	assert.True(t, client.IsActive())
+}
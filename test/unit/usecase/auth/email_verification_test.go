@@ -0,0 +1,136 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEmailVerification_Success tests sending and following a verification link
+func TestEmailVerification_Success(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	sendUC := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+	verifyUC := auth.NewVerifyEmailUseCase(env.userRepo, env.jwt, env.sessions())
+	require.NoError(t, sendUC.Execute(context.Background(), "user@example.com"))
+	assert.Equal(t, "user@example.com", env.mailer.Sent[0].To)
+
+	// Act
+	err := verifyUC.Execute(context.Background(), env.lastLinkToken(t))
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, env.user.IsEmailVerified())
+	assert.Equal(t, 1, env.userRepo.UpdateCalls)
+
+	// Verified users are not mailed again
+	require.NoError(t, sendUC.Execute(context.Background(), "user@example.com"))
+	assert.Len(t, env.mailer.Sent, 1)
+}
+
+// TestSendVerification_UnknownEmail tests that unknown addresses look the same
+func TestSendVerification_UnknownEmail(t *testing.T) {
+	env := newTestEnv(t)
+	sendUC := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+
+	err := sendUC.Execute(context.Background(), "nobody@example.com")
+
+	// SECURITY: No error, no mail - indistinguishable from a registered address
+	require.NoError(t, err)
+	assert.Equal(t, 0, env.mailer.SendCalls)
+}
+
+// TestVerifyEmail_RejectsAccessToken tests that other token kinds can't verify
+func TestVerifyEmail_RejectsAccessToken(t *testing.T) {
+	env := newTestEnv(t)
+	verifyUC := auth.NewVerifyEmailUseCase(env.userRepo, env.jwt, env.sessions())
+	accessToken, err := env.jwt.GenerateAccessToken(env.user.ID(), env.user.TenantID(), env.user.Email(), nil, nil, time.Now(), "")
+	require.NoError(t, err)
+
+	err = verifyUC.Execute(context.Background(), accessToken)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.False(t, env.user.IsEmailVerified())
+}
+
+// TestVerifyEmail_EmailChanged tests that links for a previous address stop working
+func TestVerifyEmail_EmailChanged(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	sendUC := auth.NewSendVerificationUseCase(env.userRepo, env.jwt, env.mailer, time.Hour, "https://app.example.com/verify-email")
+	verifyUC := auth.NewVerifyEmailUseCase(env.userRepo, env.jwt, env.sessions())
+	require.NoError(t, sendUC.Execute(context.Background(), "user@example.com"))
+	token := env.lastLinkToken(t)
+
+	newEmail, _ := valueobject.NewEmail("new@example.com")
+	require.NoError(t, env.user.UpdateEmail(newEmail))
+
+	// Act
+	err := verifyUC.Execute(context.Background(), token)
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.False(t, env.user.IsEmailVerified())
+}
+
+// TestLoginUseCase_RequireVerifiedEmail tests the unverified login policy
+func TestLoginUseCase_RequireVerifiedEmail(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	mockJWT := &mocks.MockJWTGenerator{}
+	loginUC := auth.NewLoginUseCase(env.userRepo, &mocks.MockPasswordHasher{}, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), true, 5*time.Minute)
+	req := usecase.LoginRequest{Email: "user@example.com", Password: testPassword}
+
+	// Act - unverified
+	resp, err := loginUC.Execute(context.Background(), req)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+	assert.Equal(t, 0, mockJWT.GenerateAccessTokenCalls)
+
+	// Act - verified
+	env.user.MarkEmailVerified()
+	resp, err = loginUC.Execute(context.Background(), req)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.AccessToken)
+}
+
+// TestSignupUseCase_RequireVerifiedEmail tests that signup withholds tokens
+func TestSignupUseCase_RequireVerifiedEmail(t *testing.T) {
+	// Arrange
+	mockRepo := &mocks.MockUserRepository{}
+	mockJWT := &mocks.MockJWTGenerator{}
+	mailer := &mocks.MockMailer{}
+	verification := auth.NewSendVerificationUseCase(mockRepo, mockJWT, mailer, time.Hour, "https://app.example.com/verify-email")
+
+	signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, &mocks.MockPasswordHasher{}, newTokenIssuer(mockJWT), verification, true, false)
+
+	// Act
+	resp, err := signupUC.Execute(context.Background(), usecase.SignupRequest{
+		Email:    "new@example.com",
+		Password: "SecureP@ss123",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, resp.VerificationRequired)
+	assert.Empty(t, resp.AccessToken)
+	assert.Empty(t, resp.RefreshToken)
+	assert.Equal(t, 0, mockJWT.GenerateAccessTokenCalls)
+	assert.Equal(t, 1, mailer.SendCalls)
+}
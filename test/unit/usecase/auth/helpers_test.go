@@ -0,0 +1,263 @@
+package auth_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// newTokenIssuer builds a token issuer around the given JWT mock
+// WHY: Most use case tests only care about the JWT generator calls
+func newTokenIssuer(jwtGenerator *mocks.MockJWTGenerator) *auth.TokenIssuer {
+	return auth.NewTokenIssuer(jwtGenerator, &mocks.MockRefreshTokenRepository{}, &mocks.MockSessionRepository{}, time.Hour)
+}
+
+// newSessionManager builds a session manager around the given refresh token mock
+// WHY: Sign-out tests assert on the refresh token calls
+func newSessionManager(refreshTokenRepo *mocks.MockRefreshTokenRepository) *auth.SessionManager {
+	return auth.NewSessionManager(refreshTokenRepo, &mocks.MockSessionRepository{})
+}
+
+// newSendVerification builds a send verification use case with a discarding mailer
+func newSendVerification(userRepo *mocks.MockUserRepository, jwtGenerator *mocks.MockJWTGenerator) *auth.SendVerificationUseCase {
+	return auth.NewSendVerificationUseCase(userRepo, jwtGenerator, &mocks.MockMailer{}, time.Hour, "https://app.example.com/verify-email")
+}
+
+// newLoginThrottle builds a disabled login throttle
+// WHY: Only the lockout tests care about failed-attempt counting
+func newLoginThrottle() *auth.LoginThrottle {
+	return auth.NewLoginThrottle(&mocks.MockLoginAttemptRepository{}, auth.LockoutPolicy{})
+}
+
+// testPassword is the stored user's password (MockPasswordHasher hashes it to "hashed_" + testPassword)
+const testPassword = "SecureP@ss123"
+
+// testEnv is the Arrange step shared by the use case tests: one stored
+// user and in-memory mocks for the dependencies use cases have in common
+// WHY: The stored account and its repository stubs are the same in every
+// test file, so they live here once. Each test still builds the use case
+// it exercises inline from these mocks - the wiring under test stays in
+// the test, and tests reach into the mocks directly for their assertions
+type testEnv struct {
+	user  *entity.User   // user@example.com in the default tenant, password testPassword
+	users []*entity.User // Every stored account (user first)
+
+	userRepo         *mocks.MockUserRepository // Finds, creates and lists users
+	hasher           *mocks.MockPasswordHasher
+	jwt              *security.JWTGeneratorImpl // Real HMAC-signed tokens
+	cipher           security.SecretCipher
+	refreshTokenRepo *mocks.MockRefreshTokenRepository
+	sessionRepo      *mocks.MockSessionRepository
+	attemptRepo      *mocks.MockLoginAttemptRepository
+	auditRepo        *mocks.MockAuditLogRepository
+	mailer           *mocks.MockMailer
+	background       *mail.BackgroundMailer // Sends through mailer
+
+	oauthClientRepo   *mocks.MockOAuthClientRepository
+	authCodeRepo      *mocks.MockAuthorizationCodeRepository
+	serviceClientRepo *mocks.MockServiceClientRepository
+
+	passkeyRepo   *mocks.MockPasskeyRepository
+	challengeRepo *mocks.MockPasskeyChallengeRepository
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	cipher, err := security.NewAESCipherFromBase64(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	require.NoError(t, err)
+
+	env := &testEnv{
+		hasher:           &mocks.MockPasswordHasher{},
+		jwt:              security.NewJWTGenerator(security.NewKeyRing(security.NewHMACSigningKey("", []byte("test-secret-key-at-least-32-characters"))), 15*time.Minute, time.Hour, "test"),
+		cipher:           cipher,
+		refreshTokenRepo: &mocks.MockRefreshTokenRepository{},
+		sessionRepo:      &mocks.MockSessionRepository{},
+		attemptRepo:      &mocks.MockLoginAttemptRepository{},
+		auditRepo:        &mocks.MockAuditLogRepository{},
+		mailer:           &mocks.MockMailer{},
+
+		oauthClientRepo:   &mocks.MockOAuthClientRepository{},
+		authCodeRepo:      &mocks.MockAuthorizationCodeRepository{},
+		serviceClientRepo: &mocks.MockServiceClientRepository{},
+
+		passkeyRepo:   &mocks.MockPasskeyRepository{},
+		challengeRepo: &mocks.MockPasskeyChallengeRepository{},
+	}
+	env.background = mail.NewBackgroundMailer(env.mailer)
+
+	env.userRepo = &mocks.MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, id valueobject.UserID) (*entity.User, error) {
+			for _, user := range env.users {
+				if user.ID().Equals(id) {
+					return user, nil
+				}
+			}
+			return nil, repository.ErrUserNotFound
+		},
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (*entity.User, error) {
+			if user := env.findByEmail(tenantID, email); user != nil {
+				return user, nil
+			}
+			return nil, repository.ErrUserNotFound
+		},
+		CreateFunc: func(ctx context.Context, user *entity.User) error {
+			if env.findByEmail(user.TenantID(), user.Email()) != nil {
+				return repository.ErrUserAlreadyExists
+			}
+			env.users = append(env.users, user)
+			return nil
+		},
+		DeleteFunc: func(ctx context.Context, id valueobject.UserID) error {
+			for i, user := range env.users {
+				if user.ID().Equals(id) {
+					env.users = append(env.users[:i], env.users[i+1:]...)
+					return nil
+				}
+			}
+			return repository.ErrUserNotFound
+		},
+		ListFunc: func(ctx context.Context, tenantID valueobject.TenantID, offset, limit int) ([]*entity.User, error) {
+			return env.inTenant(tenantID), nil
+		},
+		CountFunc: func(ctx context.Context, tenantID valueobject.TenantID) (int64, error) {
+			return int64(len(env.inTenant(tenantID))), nil
+		},
+	}
+
+	env.user = env.addUser(t, valueobject.DefaultTenantID(), "user@example.com")
+	return env
+}
+
+// addUser stores an active account with password testPassword
+func (env *testEnv) addUser(t *testing.T, tenantID valueobject.TenantID, emailAddress string) *entity.User {
+	t.Helper()
+
+	email, err := valueobject.NewEmail(emailAddress)
+	require.NoError(t, err)
+	user, err := entity.NewUser(tenantID, email, valueobject.NewPasswordFromHash("hashed_"+testPassword))
+	require.NoError(t, err)
+
+	env.users = append(env.users, user)
+	return user
+}
+
+func (env *testEnv) findByEmail(tenantID valueobject.TenantID, email valueobject.Email) *entity.User {
+	for _, user := range env.users {
+		if user.TenantID().Equals(tenantID) && user.Email().Equals(email) {
+			return user
+		}
+	}
+	return nil
+}
+
+// storedUser returns the account stored under emailAddress in the default tenant, or nil
+func (env *testEnv) storedUser(t *testing.T, emailAddress string) *entity.User {
+	t.Helper()
+
+	email, err := valueobject.NewEmail(emailAddress)
+	require.NoError(t, err)
+	return env.findByEmail(valueobject.DefaultTenantID(), email)
+}
+
+func (env *testEnv) inTenant(tenantID valueobject.TenantID) []*entity.User {
+	var users []*entity.User
+	for _, user := range env.users {
+		if user.TenantID().Equals(tenantID) {
+			users = append(users, user)
+		}
+	}
+	return users
+}
+
+// tokenIssuer issues real tokens into the env's refresh token and session mocks
+func (env *testEnv) tokenIssuer() *auth.TokenIssuer {
+	return auth.NewTokenIssuer(env.jwt, env.refreshTokenRepo, env.sessionRepo, time.Hour)
+}
+
+// storedRefreshToken returns the record stored for a raw refresh token
+func (env *testEnv) storedRefreshToken(t *testing.T, refreshToken string) *entity.RefreshToken {
+	t.Helper()
+
+	token, err := env.refreshTokenRepo.FindByHash(context.Background(), security.HashToken(refreshToken))
+	require.NoError(t, err)
+	return token
+}
+
+// sessions ends sessions in the env's refresh token and session mocks
+func (env *testEnv) sessions() *auth.SessionManager {
+	return auth.NewSessionManager(env.refreshTokenRepo, env.sessionRepo)
+}
+
+// throttle counts attempts in the env's attempt mock under policy
+func (env *testEnv) throttle(policy auth.LockoutPolicy) *auth.LoginThrottle {
+	return auth.NewLoginThrottle(env.attemptRepo, policy)
+}
+
+// refreshTokens rotates the env's refresh tokens
+func (env *testEnv) refreshTokens() *auth.RefreshTokenUseCase {
+	return auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+}
+
+// clientCredentials issues tokens to the env's service clients
+func (env *testEnv) clientCredentials() *auth.ClientCredentialsUseCase {
+	return auth.NewClientCredentialsUseCase(env.serviceClientRepo, env.jwt, 15*time.Minute)
+}
+
+// validator checks access tokens against the env's sessions, like the auth middleware
+func (env *testEnv) validator() *auth.ValidateTokenUseCase {
+	return auth.NewValidateTokenUseCase(
+		env.jwt,
+		env.userRepo,
+		env.serviceClientRepo,
+		&mocks.MockRevokedTokenRepository{},
+		&mocks.MockPersonalAccessTokenRepository{},
+		env.sessionRepo,
+	)
+}
+
+// auditLog records into the env's audit mock
+func (env *testEnv) auditLog() *auth.AuditLog {
+	return auth.NewAuditLog(env.auditRepo)
+}
+
+// lastEvent returns the most recent audit event
+func (env *testEnv) lastEvent(t *testing.T) *entity.AuditEvent {
+	t.Helper()
+	require.NotEmpty(t, env.auditRepo.Events, "expected an audit event")
+	return env.auditRepo.Events[len(env.auditRepo.Events)-1]
+}
+
+// lastMail waits for background sends and returns the most recent email
+func (env *testEnv) lastMail(t *testing.T) mail.Message {
+	t.Helper()
+	env.background.Wait()
+	require.NotEmpty(t, env.mailer.Sent, "expected an email")
+	return env.mailer.Sent[len(env.mailer.Sent)-1]
+}
+
+// lastLinkToken extracts the token from the link in the most recent email
+func (env *testEnv) lastLinkToken(t *testing.T) string {
+	t.Helper()
+
+	body := env.lastMail(t).Body
+	start := strings.Index(body, "?token=")
+	require.GreaterOrEqual(t, start, 0, "mail should contain a link")
+	raw := strings.Fields(body[start+len("?token="):])[0]
+
+	token, err := url.QueryUnescape(raw)
+	require.NoError(t, err)
+	return token
+}
@@ -0,0 +1,223 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/config"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// djangoHash is Django's pbkdf2_sha256 hash of "SecureP@ss123"
+const djangoHash = "pbkdf2_sha256$1000$somesalt$yT9h7HgeYZwL1A9BG9yz4yFDH7s4reu0YLFhOIs5kAc="
+
+// newImportHasher builds the real hasher, able to verify Firebase scrypt hashes
+func newImportHasher(t *testing.T) security.HashAlgorithm {
+	t.Helper()
+
+	hasher, err := security.NewPasswordHasherFromConfig(config.PasswordConfig{
+		Algorithm:             "argon2id",
+		BcryptCost:            4,
+		Argon2Memory:          1024,
+		Argon2Time:            1,
+		Argon2Parallelism:     1,
+		FirebaseSignerKey:     "jxspr8Ki0RYycVU8zykbdLGjFQ3McFUH0uiiTvC8pVMXAn210wjLNmdZJzxUECKbm0QsEmYUSDzZvpjeJ9WmXA==",
+		FirebaseSaltSeparator: "Bw==",
+		FirebaseRounds:        8,
+		FirebaseMemCost:       14,
+	})
+	require.NoError(t, err)
+	return hasher
+}
+
+// TestImportUsers_ForeignHashes tests importing each supported hash format
+func TestImportUsers_ForeignHashes(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	importUC := auth.NewImportUsersUseCase(env.userRepo, &mocks.MockOrganizationRepository{}, newImportHasher(t), env.auditLog())
+
+	// Act
+	result, err := importUC.Execute(context.Background(), []usecase.ImportUserRecord{
+		{Email: "django@example.com", PasswordHash: djangoHash, EmailVerified: true},
+		{
+			Email:        "firebase@example.com",
+			PasswordHash: "lSrfV15cpx95/sZS2W9c9Kp6i/LVgQNDNC/qzrCnh1SAyZvqmZqAjTdn3aoItz+VHjoZilo78198JAdRuid5lQ==",
+			PasswordSalt: "42xEC+ixf3L2lw==",
+			HashFormat:   auth.HashFormatFirebaseScrypt,
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Imported)
+	assert.Empty(t, result.Failed)
+
+	django := env.storedUser(t, "django@example.com")
+	require.NotNil(t, django)
+	assert.Equal(t, djangoHash, django.Password().Hash(), "imported unchanged")
+	assert.True(t, django.IsEmailVerified())
+	assert.Equal(t, valueobject.DefaultTenantID(), django.TenantID())
+
+	firebase := env.storedUser(t, "firebase@example.com")
+	require.NotNil(t, firebase)
+	assert.True(t, strings.HasPrefix(firebase.Password().Hash(), "$firebase-scrypt$42xEC+ixf3L2lw==$"))
+	assert.False(t, firebase.IsEmailVerified())
+
+	// The import is audited once
+	require.Len(t, env.auditRepo.Events, 1)
+	assert.Equal(t, entity.AuditActionImportUsers, env.auditRepo.Events[0].Action())
+	assert.Equal(t, "2", env.auditRepo.Events[0].Details()["imported"])
+}
+
+// TestImportUsers_SkipsBadRecords tests that bad records are reported without stopping the import
+func TestImportUsers_SkipsBadRecords(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	importUC := auth.NewImportUsersUseCase(env.userRepo, &mocks.MockOrganizationRepository{}, newImportHasher(t), env.auditLog())
+
+	// Act
+	result, err := importUC.Execute(context.Background(), []usecase.ImportUserRecord{
+		{Email: "not-an-email", PasswordHash: djangoHash},
+		{Email: "md5@example.com", PasswordHash: "5f4dcc3b5aa765d61d8327deb882cf99"},
+		{Email: "nohash@example.com"},
+		{Email: "nosalt@example.com", PasswordHash: "bFNyZlYxNWNweA==", HashFormat: auth.HashFormatFirebaseScrypt},
+		{Email: "format@example.com", PasswordHash: djangoHash, HashFormat: "md5"},
+		{Email: "imported@example.com", PasswordHash: djangoHash},
+		{Email: "imported@example.com", PasswordHash: djangoHash},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Imported)
+	require.Len(t, result.Failed, 6)
+
+	var indexes []int
+	for _, failure := range result.Failed {
+		indexes = append(indexes, failure.Index)
+		assert.NotEmpty(t, failure.Reason)
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 6}, indexes)
+	assert.Equal(t, "unsupported password hash format", result.Failed[1].Reason)
+	assert.Equal(t, "email already in use", result.Failed[5].Reason)
+}
+
+// TestImportUsers_FirebaseNotConfigured tests rejecting hashes the server couldn't verify
+func TestImportUsers_FirebaseNotConfigured(t *testing.T) {
+	hasher, err := security.NewPasswordHasherFromConfig(config.PasswordConfig{
+		Algorithm:         "argon2id",
+		BcryptCost:        4,
+		Argon2Memory:      1024,
+		Argon2Time:        1,
+		Argon2Parallelism: 1,
+	})
+	require.NoError(t, err)
+
+	userRepo := &mocks.MockUserRepository{}
+	importUC := auth.NewImportUsersUseCase(userRepo, &mocks.MockOrganizationRepository{}, hasher, auth.NewAuditLog(&mocks.MockAuditLogRepository{}))
+
+	result, err := importUC.Execute(context.Background(), []usecase.ImportUserRecord{{
+		Email:        "firebase@example.com",
+		PasswordHash: "lSrfV15cpx95/sZS2W9c9Kp6i/LVgQNDNC/qzrCnh1SAyZvqmZqAjTdn3aoItz+VHjoZilo78198JAdRuid5lQ==",
+		PasswordSalt: "42xEC+ixf3L2lw==",
+		HashFormat:   auth.HashFormatFirebaseScrypt,
+	}})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Imported)
+	assert.Len(t, result.Failed, 1)
+	assert.Equal(t, 0, userRepo.CreateCalls)
+}
+
+// TestImportUsers_UnknownTenant tests importing into an organization that doesn't exist
+func TestImportUsers_UnknownTenant(t *testing.T) {
+	env := newTestEnv(t)
+	orgRepo := &mocks.MockOrganizationRepository{
+		FindByIDFunc: func(ctx context.Context, id valueobject.TenantID) (*entity.Organization, error) {
+			return nil, repository.ErrOrganizationNotFound
+		},
+	}
+	importUC := auth.NewImportUsersUseCase(env.userRepo, orgRepo, newImportHasher(t), env.auditLog())
+
+	_, err := importUC.Execute(context.Background(), []usecase.ImportUserRecord{{Email: "imported@example.com", PasswordHash: djangoHash}})
+
+	assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+	assert.Nil(t, env.storedUser(t, "imported@example.com"))
+}
+
+// TestImportUsers_StopsOnRepositoryError tests that storage failures end the import
+func TestImportUsers_StopsOnRepositoryError(t *testing.T) {
+	env := newTestEnv(t)
+	importUC := auth.NewImportUsersUseCase(env.userRepo, &mocks.MockOrganizationRepository{}, newImportHasher(t), env.auditLog())
+	env.userRepo.CreateFunc = func(ctx context.Context, user *entity.User) error {
+		return errors.New("connection reset")
+	}
+
+	result, err := importUC.Execute(context.Background(), []usecase.ImportUserRecord{
+		{Email: "one@example.com", PasswordHash: djangoHash},
+		{Email: "two@example.com", PasswordHash: djangoHash},
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 0, result.Imported)
+	assert.Equal(t, 1, env.userRepo.CreateCalls)
+	assert.Len(t, env.auditRepo.Events, 1, "partial imports are audited too")
+}
+
+// TestImportUsers_UpgradedOnFirstLogin tests that imported users log in with
+// their old password and get a native hash
+func TestImportUsers_UpgradedOnFirstLogin(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	hasher := newImportHasher(t)
+	importUC := auth.NewImportUsersUseCase(env.userRepo, &mocks.MockOrganizationRepository{}, hasher, env.auditLog())
+	_, err := importUC.Execute(context.Background(), []usecase.ImportUserRecord{
+		{Email: "django@example.com", PasswordHash: djangoHash},
+		{
+			Email:        "firebase@example.com",
+			PasswordHash: "lSrfV15cpx95/sZS2W9c9Kp6i/LVgQNDNC/qzrCnh1SAyZvqmZqAjTdn3aoItz+VHjoZilo78198JAdRuid5lQ==",
+			PasswordSalt: "42xEC+ixf3L2lw==",
+			HashFormat:   auth.HashFormatFirebaseScrypt,
+		},
+	})
+	require.NoError(t, err)
+
+	mockJWT := &mocks.MockJWTGenerator{}
+	loginUC := auth.NewLoginUseCase(env.userRepo, hasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
+
+	tests := []struct {
+		email    string
+		password string
+	}{
+		{email: "django@example.com", password: "SecureP@ss123"},
+		{email: "firebase@example.com", password: "user1password"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			_, err := loginUC.Execute(context.Background(), usecase.LoginRequest{Email: tt.email, Password: "WrongP@ss123"})
+			assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+
+			resp, err := loginUC.Execute(context.Background(), usecase.LoginRequest{Email: tt.email, Password: tt.password})
+			require.NoError(t, err)
+			assert.NotEmpty(t, resp.AccessToken)
+
+			// Replaced by a native hash that still accepts the password
+			hash := env.storedUser(t, tt.email).Password().Hash()
+			assert.True(t, strings.HasPrefix(hash, "$argon2id$"), hash)
+			assert.NoError(t, hasher.Compare(hash, tt.password))
+			assert.False(t, hasher.NeedsRehash(hash))
+		})
+	}
+}
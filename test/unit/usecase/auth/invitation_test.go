@@ -0,0 +1,339 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// invite creates an invitation in the default tenant and returns it with its raw token
+func invite(t *testing.T, env *testEnv, createUC *auth.CreateInvitationUseCase, email, role string) (*usecase.InvitationDetails, string) {
+	t.Helper()
+
+	invitation, err := createUC.Execute(context.Background(), usecase.CreateInvitationRequest{
+		ActorID: adminID,
+		Email:   email,
+		Role:    role,
+	})
+	require.NoError(t, err)
+
+	data, ok := env.lastMail(t).Data.(mail.TemplateData)
+	require.True(t, ok)
+	link, err := url.Parse(data.Link)
+	require.NoError(t, err)
+	return invitation, link.Query().Get("token")
+}
+
+// TestCreateInvitation_EmailsLink tests creating an invitation
+func TestCreateInvitation_EmailsLink(t *testing.T) {
+	env := newTestEnv(t)
+	invitationRepo := &mocks.MockInvitationRepository{}
+	createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+
+	invitation, token := invite(t, env, createUC, "new@example.com", "editor")
+
+	assert.Equal(t, string(entity.InvitationPending), invitation.Status)
+	assert.Equal(t, "editor", invitation.Role)
+	assert.Equal(t, adminID, invitation.InvitedBy)
+	assert.Equal(t, valueobject.DefaultTenant, invitation.TenantID)
+
+	msg := env.mailer.Sent[0]
+	assert.Equal(t, "new@example.com", msg.To)
+	assert.Equal(t, mail.TemplateInvitation, msg.Template)
+	data, ok := msg.Data.(mail.TemplateData)
+	require.True(t, ok)
+	assert.Equal(t, "editor", data.Role)
+	assert.Contains(t, data.Link, "tenant="+valueobject.DefaultTenant)
+
+	// SECURITY: Only the hash is stored
+	stored := invitationRepo.Invitations[invitation.ID]
+	require.NotNil(t, stored)
+	assert.Equal(t, security.HashToken(token), stored.TokenHash())
+
+	require.Len(t, env.auditRepo.Events, 1)
+	assert.Equal(t, entity.AuditActionCreateInvitation, env.auditRepo.Events[0].Action())
+}
+
+// TestCreateInvitation_InvalidInput tests validation
+func TestCreateInvitation_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		role  string
+	}{
+		{"bad email", "not-an-email", "editor"},
+		{"bad role", "new@example.com", "Not A Role"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			invitationRepo := &mocks.MockInvitationRepository{}
+			createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+
+			_, err := createUC.Execute(context.Background(), usecase.CreateInvitationRequest{
+				ActorID: adminID,
+				Email:   tt.email,
+				Role:    tt.role,
+			})
+
+			assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+			assert.Equal(t, 0, invitationRepo.CreateCalls)
+			assert.Empty(t, env.mailer.Sent)
+		})
+	}
+}
+
+// TestAcceptInvitation_CreatesAccount tests accepting as someone without an account
+func TestAcceptInvitation_CreatesAccount(t *testing.T) {
+	env := newTestEnv(t)
+	invitationRepo := &mocks.MockInvitationRepository{}
+	createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+	acceptUC := auth.NewAcceptInvitationUseCase(env.userRepo, invitationRepo, env.hasher, env.tokenIssuer(), newLoginThrottle(), env.auditLog())
+	invitation, token := invite(t, env, createUC, "new@example.com", "editor")
+
+	resp, err := acceptUC.Execute(context.Background(), usecase.AcceptInvitationRequest{
+		Token:    token,
+		Password: testPassword,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.AccountCreated)
+	assert.NotEmpty(t, resp.AccessToken)
+	assert.NotEmpty(t, resp.RefreshToken)
+
+	user := env.storedUser(t, "new@example.com")
+	require.NotNil(t, user)
+	assert.Equal(t, resp.UserID, user.ID().String())
+	assert.True(t, user.HasRole("editor"))
+	assert.True(t, user.IsEmailVerified(), "the link was delivered to the inbox")
+	assert.Equal(t, "hashed_"+testPassword, user.Password().Hash())
+
+	stored := invitationRepo.Invitations[invitation.ID]
+	assert.Equal(t, entity.InvitationAccepted, stored.Status())
+	assert.Equal(t, resp.UserID, stored.AcceptedBy())
+
+	last := env.lastEvent(t)
+	assert.Equal(t, entity.AuditActionAcceptInvitation, last.Action())
+	assert.Equal(t, resp.UserID, last.ActorID())
+}
+
+// TestAcceptInvitation_LinksExistingAccount tests accepting with an existing account
+func TestAcceptInvitation_LinksExistingAccount(t *testing.T) {
+	env := newTestEnv(t)
+	invitationRepo := &mocks.MockInvitationRepository{}
+	createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+	acceptUC := auth.NewAcceptInvitationUseCase(env.userRepo, invitationRepo, env.hasher, env.tokenIssuer(), newLoginThrottle(), env.auditLog())
+	user := env.addUser(t, valueobject.DefaultTenantID(), "member@example.com")
+	_, token := invite(t, env, createUC, "member@example.com", "editor")
+
+	t.Run("wrong password", func(t *testing.T) {
+		_, err := acceptUC.Execute(context.Background(), usecase.AcceptInvitationRequest{
+			Token:    token,
+			Password: "WrongP@ss123",
+		})
+
+		assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+		assert.False(t, user.HasRole("editor"))
+		assert.Equal(t, 0, invitationRepo.MarkAcceptedCalls, "a failed attempt must not burn the link")
+	})
+
+	t.Run("current password", func(t *testing.T) {
+		resp, err := acceptUC.Execute(context.Background(), usecase.AcceptInvitationRequest{
+			Token:    token,
+			Password: testPassword,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, resp.AccountCreated)
+		assert.Empty(t, resp.AccessToken, "existing accounts sign in as usual")
+		assert.Equal(t, user.ID().String(), resp.UserID)
+		assert.True(t, user.HasRole("editor"))
+		assert.Equal(t, 1, env.userRepo.UpdateCalls)
+		assert.Equal(t, 0, env.userRepo.CreateCalls)
+	})
+}
+
+// TestAcceptInvitation_SingleUse tests that an invitation works only once
+func TestAcceptInvitation_SingleUse(t *testing.T) {
+	env := newTestEnv(t)
+	invitationRepo := &mocks.MockInvitationRepository{}
+	createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+	acceptUC := auth.NewAcceptInvitationUseCase(env.userRepo, invitationRepo, env.hasher, env.tokenIssuer(), newLoginThrottle(), env.auditLog())
+	_, token := invite(t, env, createUC, "new@example.com", "editor")
+	req := usecase.AcceptInvitationRequest{Token: token, Password: testPassword}
+	_, err := acceptUC.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	_, err = acceptUC.Execute(context.Background(), req)
+
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestAcceptInvitation_WeakPasswordKeepsInvitation tests that a rejected password doesn't burn the link
+func TestAcceptInvitation_WeakPasswordKeepsInvitation(t *testing.T) {
+	env := newTestEnv(t)
+	invitationRepo := &mocks.MockInvitationRepository{}
+	createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+	acceptUC := auth.NewAcceptInvitationUseCase(env.userRepo, invitationRepo, env.hasher, env.tokenIssuer(), newLoginThrottle(), env.auditLog())
+	invitation, token := invite(t, env, createUC, "new@example.com", "editor")
+
+	_, err := acceptUC.Execute(context.Background(), usecase.AcceptInvitationRequest{Token: token, Password: "weak"})
+
+	assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+	assert.Equal(t, entity.InvitationPending, invitationRepo.Invitations[invitation.ID].Status())
+	assert.Nil(t, env.storedUser(t, "new@example.com"))
+}
+
+// TestAcceptInvitation_Unusable tests revoked, expired, unknown and cross-tenant invitations
+func TestAcceptInvitation_Unusable(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(t *testing.T, env *testEnv, invitationRepo *mocks.MockInvitationRepository, createUC *auth.CreateInvitationUseCase) (context.Context, string)
+	}{
+		{
+			name: "unknown token",
+			setup: func(t *testing.T, env *testEnv, invitationRepo *mocks.MockInvitationRepository, createUC *auth.CreateInvitationUseCase) (context.Context, string) {
+				return context.Background(), "no-such-token"
+			},
+		},
+		{
+			name: "revoked",
+			setup: func(t *testing.T, env *testEnv, invitationRepo *mocks.MockInvitationRepository, createUC *auth.CreateInvitationUseCase) (context.Context, string) {
+				invitation, token := invite(t, env, createUC, "new@example.com", "editor")
+				revokeUC := auth.NewRevokeInvitationUseCase(invitationRepo, env.auditLog())
+				_, err := revokeUC.Execute(context.Background(), usecase.RevokeInvitationRequest{ActorID: adminID, InvitationID: invitation.ID})
+				require.NoError(t, err)
+				return context.Background(), token
+			},
+		},
+		{
+			name: "expired",
+			setup: func(t *testing.T, env *testEnv, invitationRepo *mocks.MockInvitationRepository, createUC *auth.CreateInvitationUseCase) (context.Context, string) {
+				email, _ := valueobject.NewEmail("new@example.com")
+				past := time.Now().Add(-time.Hour)
+				invitationRepo.Invitations = map[string]*entity.Invitation{
+					"expired": entity.ReconstructInvitation("expired", valueobject.DefaultTenantID(), email, "editor",
+						security.HashToken("expired-token"), adminID, past.Add(-time.Hour), past, nil, "", nil),
+				}
+				return context.Background(), "expired-token"
+			},
+		},
+		{
+			name: "other tenant",
+			setup: func(t *testing.T, env *testEnv, invitationRepo *mocks.MockInvitationRepository, createUC *auth.CreateInvitationUseCase) (context.Context, string) {
+				_, token := invite(t, env, createUC, "new@example.com", "editor")
+				return usecase.WithTenant(context.Background(), mustTenant(t, "acme")), token
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			invitationRepo := &mocks.MockInvitationRepository{}
+			createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+			acceptUC := auth.NewAcceptInvitationUseCase(env.userRepo, invitationRepo, env.hasher, env.tokenIssuer(), newLoginThrottle(), env.auditLog())
+			ctx, token := tt.setup(t, env, invitationRepo, createUC)
+
+			resp, err := acceptUC.Execute(ctx, usecase.AcceptInvitationRequest{Token: token, Password: testPassword})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+			assert.Nil(t, env.storedUser(t, "new@example.com"))
+		})
+	}
+}
+
+// TestRevokeInvitation tests revocation rules
+func TestRevokeInvitation(t *testing.T) {
+	t.Run("pending", func(t *testing.T) {
+		env := newTestEnv(t)
+		invitationRepo := &mocks.MockInvitationRepository{}
+		createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+		revokeUC := auth.NewRevokeInvitationUseCase(invitationRepo, env.auditLog())
+		invitation, _ := invite(t, env, createUC, "new@example.com", "editor")
+
+		revoked, err := revokeUC.Execute(context.Background(), usecase.RevokeInvitationRequest{ActorID: adminID, InvitationID: invitation.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, string(entity.InvitationRevoked), revoked.Status)
+		last := env.lastEvent(t)
+		assert.Equal(t, entity.AuditActionRevokeInvitation, last.Action())
+	})
+
+	t.Run("already accepted", func(t *testing.T) {
+		env := newTestEnv(t)
+		invitationRepo := &mocks.MockInvitationRepository{}
+		createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+		revokeUC := auth.NewRevokeInvitationUseCase(invitationRepo, env.auditLog())
+		acceptUC := auth.NewAcceptInvitationUseCase(env.userRepo, invitationRepo, env.hasher, env.tokenIssuer(), newLoginThrottle(), env.auditLog())
+		invitation, token := invite(t, env, createUC, "new@example.com", "editor")
+		_, err := acceptUC.Execute(context.Background(), usecase.AcceptInvitationRequest{Token: token, Password: testPassword})
+		require.NoError(t, err)
+
+		_, err = revokeUC.Execute(context.Background(), usecase.RevokeInvitationRequest{ActorID: adminID, InvitationID: invitation.ID})
+
+		assert.True(t, errors.Is(err, domainErrors.ErrConflict))
+	})
+
+	t.Run("other tenant", func(t *testing.T) {
+		env := newTestEnv(t)
+		invitationRepo := &mocks.MockInvitationRepository{}
+		createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+		revokeUC := auth.NewRevokeInvitationUseCase(invitationRepo, env.auditLog())
+		invitation, _ := invite(t, env, createUC, "new@example.com", "editor")
+		ctx := usecase.WithTenant(context.Background(), mustTenant(t, "acme"))
+
+		_, err := revokeUC.Execute(ctx, usecase.RevokeInvitationRequest{ActorID: adminID, InvitationID: invitation.ID})
+
+		assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+		assert.Equal(t, 0, invitationRepo.RevokeCalls)
+	})
+}
+
+// TestListInvitations_OnlyCallerTenant tests listing scope
+func TestListInvitations_OnlyCallerTenant(t *testing.T) {
+	env := newTestEnv(t)
+	invitationRepo := &mocks.MockInvitationRepository{}
+	createUC := auth.NewCreateInvitationUseCase(&mocks.MockOrganizationRepository{}, invitationRepo, env.mailer, env.auditLog(), 72*time.Hour, "https://app.example.com/accept-invitation")
+	listUC := auth.NewListInvitationsUseCase(invitationRepo, env.auditLog())
+	invite(t, env, createUC, "first@example.com", "editor")
+	invite(t, env, createUC, "second@example.com", "viewer")
+
+	list, err := listUC.Execute(context.Background(), adminID)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	other, err := listUC.Execute(usecase.WithTenant(context.Background(), mustTenant(t, "acme")), adminID)
+	require.NoError(t, err)
+	assert.Empty(t, other)
+}
+
+// TestSignupUseCase_InviteOnly tests that open registration can be switched off
+func TestSignupUseCase_InviteOnly(t *testing.T) {
+	mockRepo := &mocks.MockUserRepository{}
+	mockJWT := &mocks.MockJWTGenerator{}
+	signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, &mocks.MockPasswordHasher{}, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, true)
+
+	resp, err := signupUC.Execute(context.Background(), usecase.SignupRequest{Email: "user@example.com", Password: testPassword})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+	assert.Equal(t, 0, mockRepo.CreateCalls)
+}
@@ -0,0 +1,209 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLockoutPolicy backs off after 2 failures and locks at 4 per client / 6 per account
+var testLockoutPolicy = auth.LockoutPolicy{
+	Enabled:          true,
+	FreeAttempts:     2,
+	BaseDelay:        time.Second,
+	MaxDelay:         time.Minute,
+	AccountThreshold: 6,
+	ClientThreshold:  4,
+	Duration:         15 * time.Minute,
+	Window:           time.Hour,
+}
+
+// login signs user@example.com in from ip
+func login(loginUC *auth.LoginUseCase, password, ip string) error {
+	_, err := loginUC.Execute(context.Background(), usecase.LoginRequest{
+		Email:     "user@example.com",
+		Password:  password,
+		IPAddress: ip,
+	})
+	return err
+}
+
+// TestLogin_ProgressiveBackoff tests that failures beyond the free attempts back off
+func TestLogin_ProgressiveBackoff(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), false, 5*time.Minute)
+
+	// Act - free attempts fail normally
+	for i := 0; i < testLockoutPolicy.FreeAttempts; i++ {
+		err := login(loginUC, "Wrong@Passw0rd", "10.0.0.1")
+		require.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	}
+	// One more failure starts the backoff
+	require.True(t, errors.Is(login(loginUC, "Wrong@Passw0rd", "10.0.0.1"), domainErrors.ErrUnauthorized))
+
+	compareCalls := env.hasher.CompareCalls
+	err := login(loginUC, testPassword, "10.0.0.1")
+
+	// Assert - even the right password is refused while backing off
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrTooManyAttempts))
+	assert.False(t, errors.Is(err, domainErrors.ErrAccountLocked))
+	retryAfter, ok := domainErrors.RetryAfter(err)
+	assert.True(t, ok)
+	assert.LessOrEqual(t, retryAfter, 2*time.Second)
+	assert.Equal(t, compareCalls, env.hasher.CompareCalls, "password must not be checked while blocked")
+}
+
+// TestLogin_BackoffDoubles tests the exponential delay and its cap
+func TestLogin_BackoffDoubles(t *testing.T) {
+	tests := []struct {
+		failures int
+		minWait  time.Duration
+		maxWait  time.Duration
+	}{
+		{failures: 3, minWait: time.Second, maxWait: 2 * time.Second},
+		{failures: 4, minWait: 2 * time.Second, maxWait: 3 * time.Second},
+		{failures: 5, minWait: 4 * time.Second, maxWait: 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		env := newTestEnv(t)
+		loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), false, 5*time.Minute)
+		// Account counter only - below both lockout thresholds
+		env.attemptRepo.SetFailures(entity.AccountAttemptKey(valueobject.DefaultTenantID(), env.user.Email()), env.user.Email().String(), tt.failures, time.Now())
+
+		err := login(loginUC, testPassword, "")
+
+		require.True(t, errors.Is(err, domainErrors.ErrTooManyAttempts), "failures=%d", tt.failures)
+		retryAfter, _ := domainErrors.RetryAfter(err)
+		assert.GreaterOrEqual(t, retryAfter, tt.minWait, "failures=%d", tt.failures)
+		assert.LessOrEqual(t, retryAfter, tt.maxWait, "failures=%d", tt.failures)
+	}
+}
+
+// TestLogin_ClientLockout tests that one IP is locked out without locking the account
+func TestLogin_ClientLockout(t *testing.T) {
+	// Arrange - client counter at its threshold
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), false, 5*time.Minute)
+	env.attemptRepo.SetFailures(entity.ClientAttemptKey(valueobject.DefaultTenantID(), env.user.Email(), "10.0.0.1"), env.user.Email().String(), testLockoutPolicy.ClientThreshold, time.Now())
+
+	// Act
+	err := login(loginUC, testPassword, "10.0.0.1")
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrAccountLocked))
+	retryAfter, _ := domainErrors.RetryAfter(err)
+	assert.Greater(t, retryAfter, 14*time.Minute)
+
+	// Another IP is unaffected
+	assert.NoError(t, login(loginUC, testPassword, "10.0.0.2"))
+}
+
+// TestLogin_AccountLockout tests that failures spread across IPs lock the account
+func TestLogin_AccountLockout(t *testing.T) {
+	// Arrange - each IP stays under the client threshold
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), false, 5*time.Minute)
+	env.attemptRepo.SetFailures(entity.AccountAttemptKey(valueobject.DefaultTenantID(), env.user.Email()), env.user.Email().String(), testLockoutPolicy.AccountThreshold, time.Now())
+
+	// Act
+	err := login(loginUC, testPassword, "10.0.0.99")
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrAccountLocked))
+}
+
+// TestLogin_LockoutExpires tests that the lock lifts after its duration
+func TestLogin_LockoutExpires(t *testing.T) {
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), false, 5*time.Minute)
+	env.attemptRepo.SetFailures(entity.AccountAttemptKey(valueobject.DefaultTenantID(), env.user.Email()), env.user.Email().String(), testLockoutPolicy.AccountThreshold, time.Now().Add(-16*time.Minute))
+
+	err := login(loginUC, testPassword, "10.0.0.1")
+
+	require.NoError(t, err)
+	assert.Empty(t, env.attemptRepo.Attempts, "success resets counters")
+}
+
+// TestLogin_UnknownEmailCounted tests that unknown emails are throttled too
+func TestLogin_UnknownEmailCounted(t *testing.T) {
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), false, 5*time.Minute)
+
+	_, err := loginUC.Execute(context.Background(), usecase.LoginRequest{
+		Email:     "nobody@example.com",
+		Password:  "Wrong@Passw0rd",
+		IPAddress: "10.0.0.1",
+	})
+
+	// SECURITY: Counted like a wrong password - lockouts don't reveal which emails exist
+	require.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, 2, env.attemptRepo.RecordAttemptCalls)
+}
+
+// TestLogin_ConcurrentGuessesReserveAttempts tests that guesses in flight
+// together can't all slip past the limit
+func TestLogin_ConcurrentGuessesReserveAttempts(t *testing.T) {
+	// Arrange - every password check starts another guess before it fails,
+	// so no guess has been judged by the time the next one is throttled
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), false, 5*time.Minute)
+	const guesses = 10
+	started := 0
+	var blocked []error
+	env.hasher.CompareFunc = func(hash, password string) error {
+		for started < guesses {
+			started++
+			if err := login(loginUC, "Wrong@Passw0rd", "10.0.0.1"); !errors.Is(err, domainErrors.ErrUnauthorized) {
+				blocked = append(blocked, err)
+			}
+		}
+		return errors.New("password mismatch")
+	}
+
+	// Act
+	started++
+	err := login(loginUC, "Wrong@Passw0rd", "10.0.0.1")
+
+	// Assert - only the free attempts (plus the one that starts the backoff) got a password check
+	require.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, testLockoutPolicy.FreeAttempts+1, env.hasher.CompareCalls)
+	assert.Len(t, blocked, guesses-testLockoutPolicy.FreeAttempts-1)
+	for _, err := range blocked {
+		assert.True(t, errors.Is(err, domainErrors.ErrTooManyAttempts) || errors.Is(err, domainErrors.ErrAccountLocked))
+	}
+}
+
+// TestLogin_LockoutIsPerTenant tests that the same email in another organization keeps its own counters
+func TestLogin_LockoutIsPerTenant(t *testing.T) {
+	// Arrange - locked in the default tenant
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), false, 5*time.Minute)
+	env.attemptRepo.SetFailures(entity.AccountAttemptKey(valueobject.DefaultTenantID(), env.user.Email()), env.user.Email().String(), testLockoutPolicy.AccountThreshold, time.Now())
+	other := mustTenant(t, "acme")
+	env.addUser(t, other, "user@example.com")
+
+	// Act
+	_, err := loginUC.Execute(usecase.WithTenant(context.Background(), other), usecase.LoginRequest{
+		Email:     env.user.Email().String(),
+		Password:  testPassword,
+		IPAddress: "10.0.0.1",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, errors.Is(login(loginUC, testPassword, "10.0.0.1"), domainErrors.ErrAccountLocked))
+}
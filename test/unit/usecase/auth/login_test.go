@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
 	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
@@ -23,10 +24,10 @@ func TestLoginUseCase_Success(t *testing.T) {
 
 	// Create a user with hashed password
 	hashedPassword := valueobject.NewPasswordFromHash("hashed_SecureP@ss123")
-	user, _ := entity.NewUser(email, hashedPassword)
+	user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, hashedPassword)
 
 	mockRepo := &mocks.MockUserRepository{
-		FindByEmailFunc: func(ctx context.Context, em valueobject.Email) (*entity.User, error) {
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, em valueobject.Email) (*entity.User, error) {
 			if em.Equals(email) {
 				return user, nil
 			}
@@ -46,7 +47,7 @@ func TestLoginUseCase_Success(t *testing.T) {
 
 	mockJWT := &mocks.MockJWTGenerator{} // Uses default behavior
 
-	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT)
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
 
 	req := usecase.LoginRequest{
 		Email:    "user@example.com",
@@ -106,7 +107,7 @@ func TestLoginUseCase_InvalidEmail(t *testing.T) {
 			mockHasher := &mocks.MockPasswordHasher{}
 			mockJWT := &mocks.MockJWTGenerator{}
 
-			loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT)
+			loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
 
 			req := usecase.LoginRequest{
 				Email:    tt.email,
@@ -136,7 +137,7 @@ func TestLoginUseCase_InvalidEmail(t *testing.T) {
 func TestLoginUseCase_UserNotFound(t *testing.T) {
 	// Arrange
 	mockRepo := &mocks.MockUserRepository{
-		FindByEmailFunc: func(ctx context.Context, email valueobject.Email) (*entity.User, error) {
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (*entity.User, error) {
 			return nil, repository.ErrUserNotFound
 		},
 	}
@@ -144,7 +145,7 @@ func TestLoginUseCase_UserNotFound(t *testing.T) {
 	mockHasher := &mocks.MockPasswordHasher{}
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT)
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
 
 	req := usecase.LoginRequest{
 		Email:    "nonexistent@example.com",
@@ -174,10 +175,10 @@ func TestLoginUseCase_WrongPassword(t *testing.T) {
 	// Arrange
 	email, _ := valueobject.NewEmail("user@example.com")
 	hashedPassword := valueobject.NewPasswordFromHash("hashed_SecureP@ss123")
-	user, _ := entity.NewUser(email, hashedPassword)
+	user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, hashedPassword)
 
 	mockRepo := &mocks.MockUserRepository{
-		FindByEmailFunc: func(ctx context.Context, em valueobject.Email) (*entity.User, error) {
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, em valueobject.Email) (*entity.User, error) {
 			return user, nil
 		},
 	}
@@ -191,7 +192,7 @@ func TestLoginUseCase_WrongPassword(t *testing.T) {
 
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT)
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
 
 	req := usecase.LoginRequest{
 		Email:    "user@example.com",
@@ -223,11 +224,11 @@ func TestLoginUseCase_InactiveUser(t *testing.T) {
 	hashedPassword := valueobject.NewPasswordFromHash("hashed_SecureP@ss123")
 
 	// Create user then deactivate
-	user, _ := entity.NewUser(email, hashedPassword)
+	user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, hashedPassword)
 	user.Deactivate() // User is now inactive
 
 	mockRepo := &mocks.MockUserRepository{
-		FindByEmailFunc: func(ctx context.Context, em valueobject.Email) (*entity.User, error) {
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, em valueobject.Email) (*entity.User, error) {
 			return user, nil
 		},
 	}
@@ -240,7 +241,7 @@ func TestLoginUseCase_InactiveUser(t *testing.T) {
 
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT)
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
 
 	req := usecase.LoginRequest{
 		Email:    "inactive@example.com",
@@ -269,10 +270,10 @@ func TestLoginUseCase_CaseInsensitiveEmail(t *testing.T) {
 	// Arrange
 	email, _ := valueobject.NewEmail("user@example.com") // Lowercase
 	hashedPassword := valueobject.NewPasswordFromHash("hashed_SecureP@ss123")
-	user, _ := entity.NewUser(email, hashedPassword)
+	user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, hashedPassword)
 
 	mockRepo := &mocks.MockUserRepository{
-		FindByEmailFunc: func(ctx context.Context, em valueobject.Email) (*entity.User, error) {
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, em valueobject.Email) (*entity.User, error) {
 			// Email value object normalizes to lowercase
 			if em.String() == "user@example.com" {
 				return user, nil
@@ -289,7 +290,7 @@ func TestLoginUseCase_CaseInsensitiveEmail(t *testing.T) {
 
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT)
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
 
 	// Test with different cases
 	testCases := []string{
@@ -321,7 +322,7 @@ func TestLoginUseCase_CaseInsensitiveEmail(t *testing.T) {
 func TestLoginUseCase_RepositoryError(t *testing.T) {
 	// Arrange
 	mockRepo := &mocks.MockUserRepository{
-		FindByEmailFunc: func(ctx context.Context, email valueobject.Email) (*entity.User, error) {
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (*entity.User, error) {
 			return nil, errors.New("database connection lost")
 		},
 	}
@@ -329,7 +330,7 @@ func TestLoginUseCase_RepositoryError(t *testing.T) {
 	mockHasher := &mocks.MockPasswordHasher{}
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT)
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
 
 	req := usecase.LoginRequest{
 		Email:    "user@example.com",
@@ -358,7 +359,7 @@ func TestLoginUseCase_TokenGenerationError(t *testing.T) {
 			name: "access token generation fails",
 			setupMock: func() *mocks.MockJWTGenerator {
 				return &mocks.MockJWTGenerator{
-					GenerateAccessTokenFunc: func(userID valueobject.UserID, email valueobject.Email) (string, error) {
+					GenerateAccessTokenFunc: func(userID valueobject.UserID, tenantID valueobject.TenantID, email valueobject.Email, roles, permissions []string, authTime time.Time, sessionID string) (string, error) {
 						return "", errors.New("signing key not found")
 					},
 				}
@@ -368,10 +369,10 @@ func TestLoginUseCase_TokenGenerationError(t *testing.T) {
 			name: "refresh token generation fails",
 			setupMock: func() *mocks.MockJWTGenerator {
 				return &mocks.MockJWTGenerator{
-					GenerateAccessTokenFunc: func(userID valueobject.UserID, email valueobject.Email) (string, error) {
+					GenerateAccessTokenFunc: func(userID valueobject.UserID, tenantID valueobject.TenantID, email valueobject.Email, roles, permissions []string, authTime time.Time, sessionID string) (string, error) {
 						return "access_token", nil // Success
 					},
-					GenerateRefreshTokenFunc: func(userID valueobject.UserID) (string, error) {
+					GenerateRefreshTokenFunc: func(userID valueobject.UserID, tenantID valueobject.TenantID, authTime time.Time) (string, error) {
 						return "", errors.New("signing key not found")
 					},
 				}
@@ -384,10 +385,10 @@ func TestLoginUseCase_TokenGenerationError(t *testing.T) {
 			// Arrange
 			email, _ := valueobject.NewEmail("user@example.com")
 			hashedPassword := valueobject.NewPasswordFromHash("hashed_SecureP@ss123")
-			user, _ := entity.NewUser(email, hashedPassword)
+			user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, hashedPassword)
 
 			mockRepo := &mocks.MockUserRepository{
-				FindByEmailFunc: func(ctx context.Context, em valueobject.Email) (*entity.User, error) {
+				FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, em valueobject.Email) (*entity.User, error) {
 					return user, nil
 				},
 			}
@@ -400,7 +401,7 @@ func TestLoginUseCase_TokenGenerationError(t *testing.T) {
 
 			mockJWT := tt.setupMock()
 
-			loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT)
+			loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
 
 			req := usecase.LoginRequest{
 				Email:    "user@example.com",
@@ -430,10 +431,10 @@ func TestLoginUseCase_PasswordComparisonTiming(t *testing.T) {
 
 	email, _ := valueobject.NewEmail("user@example.com")
 	hashedPassword := valueobject.NewPasswordFromHash("hashed_SecureP@ss123")
-	user, _ := entity.NewUser(email, hashedPassword)
+	user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, hashedPassword)
 
 	mockRepo := &mocks.MockUserRepository{
-		FindByEmailFunc: func(ctx context.Context, em valueobject.Email) (*entity.User, error) {
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, em valueobject.Email) (*entity.User, error) {
 			return user, nil
 		},
 	}
@@ -450,7 +451,7 @@ func TestLoginUseCase_PasswordComparisonTiming(t *testing.T) {
 
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT)
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
 
 	// Try multiple wrong passwords
 	passwords := []string{
@@ -481,10 +482,10 @@ func TestLoginUseCase_MultipleFailedAttempts(t *testing.T) {
 	// Arrange
 	email, _ := valueobject.NewEmail("user@example.com")
 	hashedPassword := valueobject.NewPasswordFromHash("hashed_SecureP@ss123")
-	user, _ := entity.NewUser(email, hashedPassword)
+	user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, hashedPassword)
 
 	mockRepo := &mocks.MockUserRepository{
-		FindByEmailFunc: func(ctx context.Context, em valueobject.Email) (*entity.User, error) {
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, em valueobject.Email) (*entity.User, error) {
 			return user, nil
 		},
 	}
@@ -497,7 +498,7 @@ func TestLoginUseCase_MultipleFailedAttempts(t *testing.T) {
 
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT)
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
 
 	// Act - Try multiple times
 	for i := 0; i < 5; i++ {
@@ -521,3 +522,106 @@ func TestLoginUseCase_MultipleFailedAttempts(t *testing.T) {
 	// NOTE: Account lockout after N failed attempts should be
 	// implemented as a separate concern (middleware or use case decorator)
 }
+
+// TestLoginUseCase_RehashesOutdatedHash tests upgrading a stored hash after a successful login
+func TestLoginUseCase_RehashesOutdatedHash(t *testing.T) {
+	// Arrange
+	email, _ := valueobject.NewEmail("user@example.com")
+	user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, valueobject.NewPasswordFromHash("legacy_SecureP@ss123"))
+
+	mockRepo := &mocks.MockUserRepository{
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, em valueobject.Email) (*entity.User, error) {
+			return user, nil
+		},
+	}
+
+	mockHasher := &mocks.MockPasswordHasher{
+		CompareFunc: func(hashedPassword, plainPassword string) error {
+			if hashedPassword == "legacy_"+plainPassword {
+				return nil
+			}
+			return mocks.ErrPasswordMismatch
+		},
+		NeedsRehashFunc: func(hashedPassword string) bool {
+			return hashedPassword == "legacy_SecureP@ss123"
+		},
+	}
+
+	mockJWT := &mocks.MockJWTGenerator{}
+
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
+
+	// Act
+	resp, err := loginUC.Execute(context.Background(), usecase.LoginRequest{
+		Email:    "user@example.com",
+		Password: "SecureP@ss123",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.AccessToken)
+	assert.Equal(t, 1, mockHasher.HashCalls)
+	assert.Equal(t, 1, mockRepo.UpdateCalls)
+	assert.Equal(t, "hashed_SecureP@ss123", user.Password().Hash())
+}
+
+// TestLoginUseCase_KeepsCurrentHash tests that up-to-date hashes are left alone
+func TestLoginUseCase_KeepsCurrentHash(t *testing.T) {
+	// Arrange
+	email, _ := valueobject.NewEmail("user@example.com")
+	user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, valueobject.NewPasswordFromHash("hashed_SecureP@ss123"))
+
+	mockRepo := &mocks.MockUserRepository{
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, em valueobject.Email) (*entity.User, error) {
+			return user, nil
+		},
+	}
+
+	mockHasher := &mocks.MockPasswordHasher{}
+	mockJWT := &mocks.MockJWTGenerator{}
+
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
+
+	// Act
+	_, err := loginUC.Execute(context.Background(), usecase.LoginRequest{
+		Email:    "user@example.com",
+		Password: "SecureP@ss123",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockHasher.NeedsRehashCalls)
+	assert.Equal(t, 0, mockHasher.HashCalls)
+	assert.Equal(t, 0, mockRepo.UpdateCalls)
+}
+
+// TestLoginUseCase_WrongPasswordSkipsRehash tests that failed logins never touch the stored hash
+func TestLoginUseCase_WrongPasswordSkipsRehash(t *testing.T) {
+	// Arrange
+	email, _ := valueobject.NewEmail("user@example.com")
+	user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, valueobject.NewPasswordFromHash("hashed_SecureP@ss123"))
+
+	mockRepo := &mocks.MockUserRepository{
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, em valueobject.Email) (*entity.User, error) {
+			return user, nil
+		},
+	}
+
+	mockHasher := &mocks.MockPasswordHasher{
+		NeedsRehashFunc: func(hashedPassword string) bool { return true },
+	}
+	mockJWT := &mocks.MockJWTGenerator{}
+
+	loginUC := auth.NewLoginUseCase(mockRepo, mockHasher, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, 5*time.Minute)
+
+	// Act
+	_, err := loginUC.Execute(context.Background(), usecase.LoginRequest{
+		Email:    "user@example.com",
+		Password: "WrongP@ss123",
+	})
+
+	// Assert
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, 0, mockHasher.HashCalls)
+	assert.Equal(t, 0, mockRepo.UpdateCalls)
+}
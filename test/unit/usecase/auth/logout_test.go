@@ -0,0 +1,186 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// claimsTable maps fake token strings to the claims the JWT mock returns
+type claimsTable map[string]*security.Claims
+
+func (c claimsTable) validate(tokenString string, expectedUse security.TokenUse) (*security.Claims, error) {
+	claims, ok := c[tokenString]
+	if !ok {
+		return nil, errors.New("invalid token")
+	}
+	if claims.TokenUse != expectedUse {
+		return nil, security.ErrWrongTokenUse
+	}
+	return claims, nil
+}
+
+func newTestClaims(userID valueobject.UserID, jti string, use security.TokenUse) *security.Claims {
+	return &security.Claims{
+		UserID:   userID.String(),
+		TokenUse: use,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+// TestLogoutUseCase_RevokesAccessToken tests that logout denylists the jti
+func TestLogoutUseCase_RevokesAccessToken(t *testing.T) {
+	// Arrange
+	userID := valueobject.NewUserID()
+	tokens := claimsTable{"access": newTestClaims(userID, "access-jti", security.TokenUseAccess)}
+
+	mockJWT := &mocks.MockJWTGenerator{ValidateTokenFunc: tokens.validate}
+	revokedRepo := &mocks.MockRevokedTokenRepository{}
+	refreshRepo := &mocks.MockRefreshTokenRepository{}
+
+	logoutUC := auth.NewLogoutUseCase(mockJWT, auth.NewRevokeTokenUseCase(mockJWT, revokedRepo, refreshRepo, newSessionManager(refreshRepo)))
+
+	// Act
+	err := logoutUC.Execute(context.Background(), usecase.LogoutRequest{AccessToken: "access"})
+
+	// Assert
+	require.NoError(t, err)
+	revoked, _ := revokedRepo.IsRevoked(context.Background(), "access-jti")
+	assert.True(t, revoked)
+	assert.Equal(t, 0, refreshRepo.RevokeFamilyCalls)
+}
+
+// TestLogoutUseCase_RevokesRefreshFamily tests logout with a refresh token
+func TestLogoutUseCase_RevokesRefreshFamily(t *testing.T) {
+	// Arrange
+	userID := valueobject.NewUserID()
+	tokens := claimsTable{
+		"access":  newTestClaims(userID, "access-jti", security.TokenUseAccess),
+		"refresh": newTestClaims(userID, "refresh-jti", security.TokenUseRefresh),
+	}
+
+	stored, err := entity.NewRefreshToken(security.HashToken("refresh"), "family-1", userID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	mockJWT := &mocks.MockJWTGenerator{ValidateTokenFunc: tokens.validate}
+	revokedRepo := &mocks.MockRevokedTokenRepository{}
+
+	var revokedFamily string
+	refreshRepo := &mocks.MockRefreshTokenRepository{
+		FindByHashFunc: func(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+			if tokenHash == stored.TokenHash() {
+				return stored, nil
+			}
+			return nil, repository.ErrTokenNotFound
+		},
+		RevokeFamilyFunc: func(ctx context.Context, familyID string) error {
+			revokedFamily = familyID
+			return nil
+		},
+	}
+
+	logoutUC := auth.NewLogoutUseCase(mockJWT, auth.NewRevokeTokenUseCase(mockJWT, revokedRepo, refreshRepo, newSessionManager(refreshRepo)))
+
+	// Act
+	err = logoutUC.Execute(context.Background(), usecase.LogoutRequest{
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, revokedRepo.CreateCalls)
+	assert.Equal(t, "family-1", revokedFamily)
+}
+
+// TestLogoutUseCase_ForeignRefreshToken tests logout with someone else's refresh token
+func TestLogoutUseCase_ForeignRefreshToken(t *testing.T) {
+	// Arrange
+	tokens := claimsTable{
+		"access":  newTestClaims(valueobject.NewUserID(), "access-jti", security.TokenUseAccess),
+		"refresh": newTestClaims(valueobject.NewUserID(), "refresh-jti", security.TokenUseRefresh),
+	}
+
+	mockJWT := &mocks.MockJWTGenerator{ValidateTokenFunc: tokens.validate}
+	revokedRepo := &mocks.MockRevokedTokenRepository{}
+	refreshRepo := &mocks.MockRefreshTokenRepository{}
+
+	logoutUC := auth.NewLogoutUseCase(mockJWT, auth.NewRevokeTokenUseCase(mockJWT, revokedRepo, refreshRepo, newSessionManager(refreshRepo)))
+
+	// Act
+	err := logoutUC.Execute(context.Background(), usecase.LogoutRequest{
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+
+	// Nothing revoked - request was rejected up front
+	assert.Equal(t, 0, revokedRepo.CreateCalls)
+}
+
+// TestRevokeTokenUseCase_InvalidToken tests revoking a token that fails validation
+func TestRevokeTokenUseCase_InvalidToken(t *testing.T) {
+	mockJWT := &mocks.MockJWTGenerator{}
+	revokedRepo := &mocks.MockRevokedTokenRepository{}
+
+	revokeUC := auth.NewRevokeTokenUseCase(mockJWT, revokedRepo, &mocks.MockRefreshTokenRepository{}, newSessionManager(&mocks.MockRefreshTokenRepository{}))
+
+	err := revokeUC.Execute(context.Background(), "garbage")
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, 0, revokedRepo.CreateCalls)
+}
+
+// TestValidateTokenUseCase_RevokedToken tests that revoked tokens fail validation
+func TestValidateTokenUseCase_RevokedToken(t *testing.T) {
+	// Arrange
+	email, _ := valueobject.NewEmail("user@example.com")
+	user, _ := entity.NewUser(valueobject.DefaultTenantID(), email, valueobject.NewPasswordFromHash("hashed_SecureP@ss123"))
+	tokens := claimsTable{"access": newTestClaims(user.ID(), "access-jti", security.TokenUseAccess)}
+
+	mockJWT := &mocks.MockJWTGenerator{ValidateTokenFunc: tokens.validate}
+	mockRepo := &mocks.MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, id valueobject.UserID) (*entity.User, error) {
+			return user, nil
+		},
+	}
+	revokedRepo := &mocks.MockRevokedTokenRepository{}
+
+	validateUC := auth.NewValidateTokenUseCase(mockJWT, mockRepo, &mocks.MockServiceClientRepository{}, revokedRepo, &mocks.MockPersonalAccessTokenRepository{}, &mocks.MockSessionRepository{})
+	revokeUC := auth.NewRevokeTokenUseCase(mockJWT, revokedRepo, &mocks.MockRefreshTokenRepository{}, newSessionManager(&mocks.MockRefreshTokenRepository{}))
+
+	// Token is valid before revocation
+	claims, err := validateUC.Execute(context.Background(), "access")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID().String(), claims.UserID)
+
+	// Act
+	require.NoError(t, revokeUC.Execute(context.Background(), "access"))
+	claims, err = validateUC.Execute(context.Background(), "access")
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, claims)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Contains(t, err.Error(), "revoked")
+}
@@ -0,0 +1,282 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// enableMFA enrolls the stored user and returns the plain secret and recovery codes
+func enableMFA(t *testing.T, env *testEnv) (string, []string) {
+	t.Helper()
+
+	enrolled, err := auth.NewEnrollMFAUseCase(env.userRepo, env.hasher, newLoginThrottle(), env.cipher, "LabukaAuth").Execute(context.Background(), usecase.EnrollMFARequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+	})
+	require.NoError(t, err)
+
+	code, err := security.TOTPCode(enrolled.Secret, time.Now())
+	require.NoError(t, err)
+
+	confirmed, err := auth.NewConfirmMFAUseCase(env.userRepo, env.cipher).Execute(context.Background(), usecase.ConfirmMFARequest{
+		UserID: env.user.ID().String(),
+		Code:   code,
+	})
+	require.NoError(t, err)
+	return enrolled.Secret, confirmed.RecoveryCodes
+}
+
+// mfaChallenge performs the password step and returns the MFA challenge token
+func mfaChallenge(t *testing.T, loginUC *auth.LoginUseCase) string {
+	t.Helper()
+
+	resp, err := loginUC.Execute(context.Background(), usecase.LoginRequest{
+		Email:    "user@example.com",
+		Password: testPassword,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.MFARequired)
+	return resp.MFAToken
+}
+
+// TestMFA_EnrollAndLogin tests enrollment followed by a two-step login
+func TestMFA_EnrollAndLogin(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), newLoginThrottle(), false, 5*time.Minute)
+	verifyUC := auth.NewVerifyMFAUseCase(env.userRepo, env.jwt, env.cipher, env.tokenIssuer(), newLoginThrottle())
+	secret, recoveryCodes := enableMFA(t, env)
+	assert.True(t, env.user.IsMFAEnabled())
+	assert.Len(t, recoveryCodes, 10)
+
+	// Act - password step
+	resp, err := loginUC.Execute(context.Background(), usecase.LoginRequest{
+		Email:    "user@example.com",
+		Password: testPassword,
+	})
+
+	// Assert - tokens withheld
+	require.NoError(t, err)
+	assert.True(t, resp.MFARequired)
+	assert.NotEmpty(t, resp.MFAToken)
+	assert.Empty(t, resp.AccessToken)
+	assert.Empty(t, resp.RefreshToken)
+
+	// Act - second step (next period; the enrollment code can't be replayed)
+	code, err := security.TOTPCode(secret, time.Now().Add(30*time.Second))
+	require.NoError(t, err)
+	tokens, err := verifyUC.Execute(context.Background(), usecase.VerifyMFARequest{
+		MFAToken: resp.MFAToken,
+		Code:     code,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, tokens.AccessToken)
+	assert.NotEmpty(t, tokens.RefreshToken)
+
+	// Same code again is a replay
+	_, err = verifyUC.Execute(context.Background(), usecase.VerifyMFARequest{
+		MFAToken: resp.MFAToken,
+		Code:     code,
+	})
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestVerifyMFA_RecoveryCode tests that recovery codes work exactly once
+func TestVerifyMFA_RecoveryCode(t *testing.T) {
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), newLoginThrottle(), false, 5*time.Minute)
+	verifyUC := auth.NewVerifyMFAUseCase(env.userRepo, env.jwt, env.cipher, env.tokenIssuer(), newLoginThrottle())
+	_, recoveryCodes := enableMFA(t, env)
+	challenge := mfaChallenge(t, loginUC)
+
+	resp, err := verifyUC.Execute(context.Background(), usecase.VerifyMFARequest{
+		MFAToken: challenge,
+		Code:     recoveryCodes[0],
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.AccessToken)
+	assert.Len(t, env.user.MFA().RecoveryCodes, 9)
+
+	_, err = verifyUC.Execute(context.Background(), usecase.VerifyMFARequest{
+		MFAToken: challenge,
+		Code:     recoveryCodes[0],
+	})
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestVerifyMFA_ConcurrentUse tests two verifications that loaded the user
+// before either saved - the store decides which one used the code
+func TestVerifyMFA_ConcurrentUse(t *testing.T) {
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), newLoginThrottle(), false, 5*time.Minute)
+	verifyUC := auth.NewVerifyMFAUseCase(env.userRepo, env.jwt, env.cipher, env.tokenIssuer(), newLoginThrottle())
+	secret, recoveryCodes := enableMFA(t, env)
+	challenge := mfaChallenge(t, loginUC)
+
+	// Every lookup returns the user as stored before the first verification
+	stored := env.user
+	env.userRepo.FindByIDFunc = func(ctx context.Context, id valueobject.UserID) (*entity.User, error) {
+		return entity.ReconstructUser(stored.ID(), stored.TenantID(), stored.Email(), stored.Password(),
+			stored.CreatedAt(), stored.UpdatedAt(), stored.IsActive(), stored.EmailVerifiedAt(), stored.PendingEmail(),
+			stored.MFA(), stored.Access(), stored.PasswordResetRequired()), nil
+	}
+
+	// The store accepts each step and recovery code once
+	lastStep := stored.MFA().LastUsedStep
+	env.userRepo.UseTOTPStepFunc = func(ctx context.Context, id valueobject.UserID, step int64) error {
+		if step <= lastStep {
+			return repository.ErrMFACodeUsed
+		}
+		lastStep = step
+		return nil
+	}
+	used := map[string]bool{}
+	env.userRepo.UseRecoveryCodeFunc = func(ctx context.Context, id valueobject.UserID, codeHash string) error {
+		if used[codeHash] {
+			return repository.ErrMFACodeUsed
+		}
+		used[codeHash] = true
+		return nil
+	}
+
+	totp, err := security.TOTPCode(secret, time.Now().Add(30*time.Second))
+	require.NoError(t, err)
+
+	for _, code := range []string{totp, recoveryCodes[0]} {
+		_, err := verifyUC.Execute(context.Background(), usecase.VerifyMFARequest{MFAToken: challenge, Code: code})
+		require.NoError(t, err)
+
+		_, err = verifyUC.Execute(context.Background(), usecase.VerifyMFARequest{MFAToken: challenge, Code: code})
+		assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	}
+	assert.Equal(t, 2, env.userRepo.UseTOTPStepCalls)
+	assert.Equal(t, 2, env.userRepo.UseRecoveryCodeCalls)
+}
+
+// TestVerifyMFA_RejectsAccessToken tests that only challenge tokens are accepted
+func TestVerifyMFA_RejectsAccessToken(t *testing.T) {
+	env := newTestEnv(t)
+	verifyUC := auth.NewVerifyMFAUseCase(env.userRepo, env.jwt, env.cipher, env.tokenIssuer(), newLoginThrottle())
+	_, recoveryCodes := enableMFA(t, env)
+	accessToken, err := env.jwt.GenerateAccessToken(env.user.ID(), env.user.TenantID(), env.user.Email(), nil, nil, time.Now(), "")
+	require.NoError(t, err)
+
+	_, err = verifyUC.Execute(context.Background(), usecase.VerifyMFARequest{
+		MFAToken: accessToken,
+		Code:     recoveryCodes[0],
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Len(t, env.user.MFA().RecoveryCodes, 10, "code must not be consumed")
+}
+
+// TestConfirmMFA_InvalidCode tests that a wrong code leaves MFA disabled
+func TestConfirmMFA_InvalidCode(t *testing.T) {
+	env := newTestEnv(t)
+	enrollUC := auth.NewEnrollMFAUseCase(env.userRepo, env.hasher, newLoginThrottle(), env.cipher, "LabukaAuth")
+	confirmUC := auth.NewConfirmMFAUseCase(env.userRepo, env.cipher)
+	_, err := enrollUC.Execute(context.Background(), usecase.EnrollMFARequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+	})
+	require.NoError(t, err)
+
+	_, err = confirmUC.Execute(context.Background(), usecase.ConfirmMFARequest{
+		UserID: env.user.ID().String(),
+		Code:   "000000x",
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+	assert.False(t, env.user.IsMFAEnabled())
+}
+
+// TestEnrollMFA_AlreadyEnabled tests that an enabled secret can't be replaced
+func TestEnrollMFA_AlreadyEnabled(t *testing.T) {
+	env := newTestEnv(t)
+	enrollUC := auth.NewEnrollMFAUseCase(env.userRepo, env.hasher, newLoginThrottle(), env.cipher, "LabukaAuth")
+	enableMFA(t, env)
+
+	_, err := enrollUC.Execute(context.Background(), usecase.EnrollMFARequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrConflict))
+}
+
+// TestDisableMFA tests that disabling needs the password and a valid code
+func TestDisableMFA(t *testing.T) {
+	env := newTestEnv(t)
+	loginUC := auth.NewLoginUseCase(env.userRepo, env.hasher, env.jwt, env.tokenIssuer(), newLoginThrottle(), false, 5*time.Minute)
+	disableUC := auth.NewDisableMFAUseCase(env.userRepo, env.hasher, newLoginThrottle(), env.cipher)
+	_, recoveryCodes := enableMFA(t, env)
+	req := usecase.DisableMFARequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		Code:            "aaaa-aaaa-aaaa-aaaa",
+	}
+
+	// Wrong code
+	err := disableUC.Execute(context.Background(), req)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.True(t, env.user.IsMFAEnabled())
+
+	// Valid code
+	req.Code = recoveryCodes[0]
+	require.NoError(t, disableUC.Execute(context.Background(), req))
+	assert.False(t, env.user.IsMFAEnabled())
+
+	// Login no longer needs a second step
+	resp, err := loginUC.Execute(context.Background(), usecase.LoginRequest{
+		Email:    "user@example.com",
+		Password: testPassword,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.MFARequired)
+	assert.NotEmpty(t, resp.AccessToken)
+}
+
+// TestDisableMFA_WrongCodesLockAccount tests that knowing the password
+// doesn't allow unlimited guesses at the second factor
+func TestDisableMFA_WrongCodesLockAccount(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	_, recoveryCodes := enableMFA(t, env)
+	disableUC := auth.NewDisableMFAUseCase(env.userRepo, env.hasher, env.throttle(credentialsLockoutPolicy), env.cipher)
+
+	ctx := usecase.WithClientInfo(context.Background(), usecase.ClientInfo{IPAddress: "10.0.0.1"})
+	req := usecase.DisableMFARequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		Code:            "aaaa-aaaa-aaaa-aaaa",
+	}
+
+	// Act - wrong codes (with the right password) up to the client threshold
+	for i := 0; i < testLockoutPolicy.ClientThreshold; i++ {
+		require.True(t, errors.Is(disableUC.Execute(ctx, req), domainErrors.ErrUnauthorized))
+	}
+	req.Code = recoveryCodes[0]
+	err := disableUC.Execute(ctx, req)
+
+	// Assert - locked, even with a valid code
+	assert.True(t, errors.Is(err, domainErrors.ErrAccountLocked))
+	assert.True(t, env.user.IsMFAEnabled())
+	assert.Len(t, env.user.MFA().RecoveryCodes, 10)
+}
@@ -0,0 +1,456 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	oauthRedirectURI = "https://client.example.com/callback"
+	oauthVerifier    = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk" // RFC 7636 appendix B
+)
+
+// addOAuthClient registers a public client redirecting to oauthRedirectURI
+func addOAuthClient(t *testing.T, env *testEnv, tenantID valueobject.TenantID, name string, scopes ...string) *entity.OAuthClient {
+	t.Helper()
+
+	client, err := entity.NewOAuthClient(tenantID, name, "", []string{oauthRedirectURI}, scopes)
+	require.NoError(t, err)
+	require.NoError(t, env.oauthClientRepo.Create(context.Background(), client))
+	return client
+}
+
+// authorizeRequest returns a valid authorization request for client
+func authorizeRequest(client *entity.OAuthClient) usecase.AuthorizeRequest {
+	return usecase.AuthorizeRequest{
+		ResponseType:        "code",
+		ClientID:            client.ID(),
+		RedirectURI:         oauthRedirectURI,
+		Scope:               "photos:read",
+		State:               "xyz",
+		CodeChallenge:       security.PKCEChallenge(oauthVerifier),
+		CodeChallengeMethod: security.PKCEMethodS256,
+	}
+}
+
+// authorize approves req as the stored user and returns the code from the redirect
+func authorize(t *testing.T, env *testEnv, authorizeUC *auth.AuthorizeUseCase, req usecase.AuthorizeRequest) string {
+	t.Helper()
+
+	resp, err := authorizeUC.Execute(context.Background(), usecase.ConsentRequest{
+		UserID:           env.user.ID().String(),
+		Approve:          true,
+		AuthorizeRequest: req,
+	})
+	require.NoError(t, err)
+
+	query := redirectQuery(t, resp.RedirectURI)
+	assert.Equal(t, "xyz", query.Get("state"))
+	require.NotEmpty(t, query.Get("code"))
+	return query.Get("code")
+}
+
+// exchange redeems code as the public client
+func exchange(tokenUC *auth.OAuthTokenUseCase, client *entity.OAuthClient, code, verifier string) (*usecase.OAuthTokenResponse, error) {
+	return tokenUC.Execute(context.Background(), usecase.OAuthTokenRequest{
+		GrantType:    auth.GrantTypeAuthorizationCode,
+		Code:         code,
+		RedirectURI:  oauthRedirectURI,
+		CodeVerifier: verifier,
+		ClientID:     client.ID(),
+	})
+}
+
+// redirectQuery parses the query of a redirect to the client
+func redirectQuery(t *testing.T, location string) url.Values {
+	t.Helper()
+
+	require.True(t, strings.HasPrefix(location, oauthRedirectURI+"?"), location)
+	u, err := url.Parse(location)
+	require.NoError(t, err)
+	return u.Query()
+}
+
+// TestCheckAuthorization_ValidRequest tests the consent screen details
+func TestCheckAuthorization_ValidRequest(t *testing.T) {
+	env := newTestEnv(t)
+	client := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile", "photos:read")
+	checkUC := auth.NewCheckAuthorizationUseCase(env.oauthClientRepo)
+
+	req := authorizeRequest(client)
+	req.Scope = "" // Defaults to everything the client may request
+	details, err := checkUC.Execute(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Photo App", details.ClientName)
+	assert.Equal(t, oauthRedirectURI, details.RedirectURI)
+	assert.ElementsMatch(t, []string{"profile", "photos:read"}, details.Scopes)
+}
+
+// TestCheckAuthorization_Errors tests which errors go back to the client
+func TestCheckAuthorization_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(req *usecase.AuthorizeRequest)
+		code     string
+		redirect bool
+	}{
+		{"unknown client", func(req *usecase.AuthorizeRequest) { req.ClientID = "nope" }, domainErrors.OAuthInvalidRequest, false},
+		{"unregistered redirect URI", func(req *usecase.AuthorizeRequest) { req.RedirectURI = "https://evil.example.com/callback" }, domainErrors.OAuthInvalidRequest, false},
+		{"redirect URI prefix", func(req *usecase.AuthorizeRequest) { req.RedirectURI = oauthRedirectURI + "/../x" }, domainErrors.OAuthInvalidRequest, false},
+		{"implicit grant", func(req *usecase.AuthorizeRequest) { req.ResponseType = "token" }, domainErrors.OAuthUnsupportedResponseType, true},
+		{"no PKCE", func(req *usecase.AuthorizeRequest) { req.CodeChallenge = "" }, domainErrors.OAuthInvalidRequest, true},
+		{"plain PKCE", func(req *usecase.AuthorizeRequest) { req.CodeChallengeMethod = "plain" }, domainErrors.OAuthInvalidRequest, true},
+		{"scope not allowed", func(req *usecase.AuthorizeRequest) { req.Scope = "photos:read photos:delete" }, domainErrors.OAuthInvalidScope, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			client := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile", "photos:read")
+			checkUC := auth.NewCheckAuthorizationUseCase(env.oauthClientRepo)
+			req := authorizeRequest(client)
+			tt.modify(&req)
+
+			details, err := checkUC.Execute(context.Background(), req)
+
+			require.Error(t, err)
+			assert.Nil(t, details)
+			assert.Equal(t, tt.code, domainErrors.OAuthCode(err))
+
+			location, ok := domainErrors.OAuthRedirect(err)
+			assert.Equal(t, tt.redirect, ok)
+			if tt.redirect {
+				query := redirectQuery(t, location)
+				assert.Equal(t, tt.code, query.Get("error"))
+				assert.Equal(t, "xyz", query.Get("state"))
+			}
+		})
+	}
+}
+
+// TestAuthorize_Deny tests the user refusing consent
+func TestAuthorize_Deny(t *testing.T) {
+	env := newTestEnv(t)
+	client := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile", "photos:read")
+	authorizeUC := auth.NewAuthorizeUseCase(auth.NewCheckAuthorizationUseCase(env.oauthClientRepo), env.userRepo, env.authCodeRepo, time.Minute)
+
+	resp, err := authorizeUC.Execute(context.Background(), usecase.ConsentRequest{
+		UserID:           env.user.ID().String(),
+		Approve:          false,
+		AuthorizeRequest: authorizeRequest(client),
+	})
+
+	require.NoError(t, err)
+	query := redirectQuery(t, resp.RedirectURI)
+	assert.Equal(t, domainErrors.OAuthAccessDenied, query.Get("error"))
+	assert.Equal(t, "xyz", query.Get("state"))
+	assert.Empty(t, query.Get("code"))
+	assert.Equal(t, 0, env.authCodeRepo.CreateCalls)
+}
+
+// TestAuthorize_OtherTenantsClient tests a client from another organization
+func TestAuthorize_OtherTenantsClient(t *testing.T) {
+	env := newTestEnv(t)
+	client := addOAuthClient(t, env, mustTenant(t, "acme"), "Acme App", "profile")
+	authorizeUC := auth.NewAuthorizeUseCase(auth.NewCheckAuthorizationUseCase(env.oauthClientRepo), env.userRepo, env.authCodeRepo, time.Minute)
+
+	req := authorizeRequest(client)
+	req.Scope = ""
+	_, err := authorizeUC.Execute(context.Background(), usecase.ConsentRequest{
+		UserID:           env.user.ID().String(),
+		Approve:          true,
+		AuthorizeRequest: req,
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+	assert.Equal(t, 0, env.authCodeRepo.CreateCalls)
+}
+
+// TestOAuthToken_AuthorizationCode tests the full code + PKCE flow
+func TestOAuthToken_AuthorizationCode(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	client := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile", "photos:read")
+	authorizeUC := auth.NewAuthorizeUseCase(auth.NewCheckAuthorizationUseCase(env.oauthClientRepo), env.userRepo, env.authCodeRepo, time.Minute)
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, &mocks.MockPersonalAccessTokenRepository{}, env.sessionRepo)
+	code := authorize(t, env, authorizeUC, authorizeRequest(client))
+
+	// Act
+	resp, err := exchange(tokenUC, client, code, oauthVerifier)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer", resp.TokenType)
+	assert.Equal(t, int64(900), resp.ExpiresIn)
+	assert.Equal(t, "photos:read", resp.Scope)
+	assert.NotEmpty(t, resp.RefreshToken)
+
+	// Tokens are bound to the client and scopes
+	claims, err := env.jwt.ValidateToken(resp.AccessToken, security.TokenUseAccess)
+	require.NoError(t, err)
+	assert.Equal(t, []string{client.ID()}, []string(claims.Audience))
+	assert.Equal(t, "photos:read", claims.Scope)
+
+	validated, err := validateUC.Execute(context.Background(), resp.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, client.ID(), validated.ClientID)
+	assert.Equal(t, []string{"photos:read"}, validated.Scopes)
+}
+
+// TestOAuthToken_CodeRejected tests codes that must not be redeemed
+func TestOAuthToken_CodeRejected(t *testing.T) {
+	tests := []struct {
+		name string
+		req  func(client *entity.OAuthClient, code string) usecase.OAuthTokenRequest
+		code string
+	}{
+		{
+			name: "wrong verifier",
+			req: func(client *entity.OAuthClient, code string) usecase.OAuthTokenRequest {
+				return usecase.OAuthTokenRequest{GrantType: auth.GrantTypeAuthorizationCode, Code: code, RedirectURI: oauthRedirectURI, CodeVerifier: strings.Repeat("a", 43), ClientID: client.ID()}
+			},
+			code: domainErrors.OAuthInvalidGrant,
+		},
+		{
+			name: "wrong redirect URI",
+			req: func(client *entity.OAuthClient, code string) usecase.OAuthTokenRequest {
+				return usecase.OAuthTokenRequest{GrantType: auth.GrantTypeAuthorizationCode, Code: code, RedirectURI: "https://client.example.com/other", CodeVerifier: oauthVerifier, ClientID: client.ID()}
+			},
+			code: domainErrors.OAuthInvalidGrant,
+		},
+		{
+			name: "redirect URI sent to authorize but not here",
+			req: func(client *entity.OAuthClient, code string) usecase.OAuthTokenRequest {
+				return usecase.OAuthTokenRequest{GrantType: auth.GrantTypeAuthorizationCode, Code: code, CodeVerifier: oauthVerifier, ClientID: client.ID()}
+			},
+			code: domainErrors.OAuthInvalidGrant,
+		},
+		{
+			name: "unknown code",
+			req: func(client *entity.OAuthClient, code string) usecase.OAuthTokenRequest {
+				return usecase.OAuthTokenRequest{GrantType: auth.GrantTypeAuthorizationCode, Code: "not-a-code", RedirectURI: oauthRedirectURI, CodeVerifier: oauthVerifier, ClientID: client.ID()}
+			},
+			code: domainErrors.OAuthInvalidGrant,
+		},
+		{
+			name: "unknown grant type",
+			req: func(client *entity.OAuthClient, code string) usecase.OAuthTokenRequest {
+				return usecase.OAuthTokenRequest{GrantType: "password", ClientID: client.ID()}
+			},
+			code: domainErrors.OAuthUnsupportedGrantType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			env := newTestEnv(t)
+			client := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile", "photos:read")
+			authorizeUC := auth.NewAuthorizeUseCase(auth.NewCheckAuthorizationUseCase(env.oauthClientRepo), env.userRepo, env.authCodeRepo, time.Minute)
+			tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+			code := authorize(t, env, authorizeUC, authorizeRequest(client))
+
+			// Act
+			_, err := tokenUC.Execute(context.Background(), tt.req(client, code))
+
+			// Assert
+			assert.Equal(t, tt.code, domainErrors.OAuthCode(err))
+			assert.Equal(t, 0, env.refreshTokenRepo.CreateCalls)
+		})
+	}
+}
+
+// TestOAuthToken_RedirectURIOmitted tests a flow that never names the redirect URI
+// NOTE: Allowed when the client registered only one
+func TestOAuthToken_RedirectURIOmitted(t *testing.T) {
+	env := newTestEnv(t)
+	client := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile", "photos:read")
+	authorizeUC := auth.NewAuthorizeUseCase(auth.NewCheckAuthorizationUseCase(env.oauthClientRepo), env.userRepo, env.authCodeRepo, time.Minute)
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	req := authorizeRequest(client)
+	req.RedirectURI = ""
+	code := authorize(t, env, authorizeUC, req)
+
+	resp, err := tokenUC.Execute(context.Background(), usecase.OAuthTokenRequest{
+		GrantType:    auth.GrantTypeAuthorizationCode,
+		Code:         code,
+		CodeVerifier: oauthVerifier,
+		ClientID:     client.ID(),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.AccessToken)
+}
+
+// TestOAuthToken_CodeReplayRevokesTokens tests redeeming a code twice
+func TestOAuthToken_CodeReplayRevokesTokens(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	client := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile", "photos:read")
+	authorizeUC := auth.NewAuthorizeUseCase(auth.NewCheckAuthorizationUseCase(env.oauthClientRepo), env.userRepo, env.authCodeRepo, time.Minute)
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	code := authorize(t, env, authorizeUC, authorizeRequest(client))
+
+	resp, err := exchange(tokenUC, client, code, oauthVerifier)
+	require.NoError(t, err)
+
+	// Act
+	_, err = exchange(tokenUC, client, code, oauthVerifier)
+
+	// Assert - the tokens from the first exchange are dead
+	assert.Equal(t, domainErrors.OAuthInvalidGrant, domainErrors.OAuthCode(err))
+	issued := env.storedRefreshToken(t, resp.RefreshToken)
+	assert.True(t, issued.IsRevoked())
+}
+
+// TestOAuthToken_CodeReplayByOtherClient tests a used code presented by a client it wasn't issued to
+// NOTE: Rejected without revoking anything - only the code's own client can trigger that
+func TestOAuthToken_CodeReplayByOtherClient(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	client := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile", "photos:read")
+	other := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Other", "profile")
+	authorizeUC := auth.NewAuthorizeUseCase(auth.NewCheckAuthorizationUseCase(env.oauthClientRepo), env.userRepo, env.authCodeRepo, time.Minute)
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	code := authorize(t, env, authorizeUC, authorizeRequest(client))
+
+	resp, err := exchange(tokenUC, client, code, oauthVerifier)
+	require.NoError(t, err)
+
+	// Act
+	_, err = exchange(tokenUC, other, code, oauthVerifier)
+
+	// Assert
+	assert.Equal(t, domainErrors.OAuthInvalidGrant, domainErrors.OAuthCode(err))
+	issued := env.storedRefreshToken(t, resp.RefreshToken)
+	assert.False(t, issued.IsRevoked())
+}
+
+// TestOAuthToken_ConfidentialClient tests client secret checks
+func TestOAuthToken_ConfidentialClient(t *testing.T) {
+	env := newTestEnv(t)
+	authorizeUC := auth.NewAuthorizeUseCase(auth.NewCheckAuthorizationUseCase(env.oauthClientRepo), env.userRepo, env.authCodeRepo, time.Minute)
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	createUC := auth.NewCreateOAuthClientUseCase(&mocks.MockOrganizationRepository{}, env.oauthClientRepo)
+	client, secret, err := createUC.Execute(context.Background(), "Server App", []string{oauthRedirectURI}, []string{"profile"}, true)
+	require.NoError(t, err)
+	require.NotEmpty(t, secret)
+	assert.True(t, client.IsConfidential())
+	assert.NotEqual(t, secret, client.SecretHash())
+
+	req := authorizeRequest(client)
+	req.Scope = ""
+
+	exchange := func(secret string) error {
+		_, err := tokenUC.Execute(context.Background(), usecase.OAuthTokenRequest{
+			GrantType:    auth.GrantTypeAuthorizationCode,
+			Code:         authorize(t, env, authorizeUC, req),
+			RedirectURI:  oauthRedirectURI,
+			CodeVerifier: oauthVerifier,
+			ClientID:     client.ID(),
+			ClientSecret: secret,
+		})
+		return err
+	}
+
+	err = exchange("")
+	assert.Equal(t, domainErrors.OAuthInvalidClient, domainErrors.OAuthCode(err))
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+
+	err = exchange("wrong")
+	assert.Equal(t, domainErrors.OAuthInvalidClient, domainErrors.OAuthCode(err))
+
+	assert.NoError(t, exchange(secret))
+}
+
+// TestOAuthToken_RefreshGrant tests refreshing client tokens
+func TestOAuthToken_RefreshGrant(t *testing.T) {
+	env := newTestEnv(t)
+	client := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile", "photos:read")
+	authorizeUC := auth.NewAuthorizeUseCase(auth.NewCheckAuthorizationUseCase(env.oauthClientRepo), env.userRepo, env.authCodeRepo, time.Minute)
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	req := authorizeRequest(client)
+	req.Scope = "profile photos:read"
+	tokens, err := exchange(tokenUC, client, authorize(t, env, authorizeUC, req), oauthVerifier)
+	require.NoError(t, err)
+
+	refresh := func(clientID, refreshToken, scope string) (*usecase.OAuthTokenResponse, error) {
+		return tokenUC.Execute(context.Background(), usecase.OAuthTokenRequest{
+			GrantType:    auth.GrantTypeRefreshToken,
+			RefreshToken: refreshToken,
+			Scope:        scope,
+			ClientID:     clientID,
+		})
+	}
+
+	t.Run("narrows access token scope", func(t *testing.T) {
+		resp, err := refresh(client.ID(), tokens.RefreshToken, "profile")
+		require.NoError(t, err)
+		assert.Equal(t, "profile", resp.Scope)
+
+		// The refresh token keeps the original grant
+		claims, err := env.jwt.ValidateToken(resp.RefreshToken, security.TokenUseRefresh)
+		require.NoError(t, err)
+		assert.Equal(t, "profile photos:read", claims.Scope)
+
+		tokens = resp
+	})
+
+	t.Run("can't widen scope", func(t *testing.T) {
+		_, err := refresh(client.ID(), tokens.RefreshToken, "profile photos:delete")
+		assert.Equal(t, domainErrors.OAuthInvalidScope, domainErrors.OAuthCode(err))
+	})
+
+	t.Run("other client", func(t *testing.T) {
+		other := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Other", "profile")
+
+		_, err := refresh(other.ID(), tokens.RefreshToken, "")
+		assert.Equal(t, domainErrors.OAuthInvalidGrant, domainErrors.OAuthCode(err))
+	})
+
+	t.Run("not at first-party refresh", func(t *testing.T) {
+		_, err := env.refreshTokens().Execute(context.Background(), tokens.RefreshToken)
+		assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	})
+
+	t.Run("reuse", func(t *testing.T) {
+		resp, err := refresh(client.ID(), tokens.RefreshToken, "")
+		require.NoError(t, err)
+		assert.Equal(t, "profile photos:read", resp.Scope)
+
+		_, err = refresh(client.ID(), tokens.RefreshToken, "")
+		assert.Equal(t, domainErrors.OAuthInvalidGrant, domainErrors.OAuthCode(err))
+	})
+}
+
+// TestRefreshTokenUseCase_FirstPartyTokenNotForClients tests the refresh grant with a login token
+func TestRefreshTokenUseCase_FirstPartyTokenNotForClients(t *testing.T) {
+	env := newTestEnv(t)
+	client := addOAuthClient(t, env, valueobject.DefaultTenantID(), "Photo App", "profile", "photos:read")
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, "")
+	tokens, err := env.tokenIssuer().Issue(context.Background(), env.user, entity.NewTokenFamilyID())
+	require.NoError(t, err)
+
+	_, err = tokenUC.Execute(context.Background(), usecase.OAuthTokenRequest{
+		GrantType:    auth.GrantTypeRefreshToken,
+		RefreshToken: tokens.RefreshToken,
+		ClientID:     client.ID(),
+	})
+	assert.Equal(t, domainErrors.OAuthInvalidGrant, domainErrors.OAuthCode(err))
+}
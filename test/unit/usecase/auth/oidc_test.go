@@ -0,0 +1,213 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const oidcIssuer = "https://auth.example.com"
+
+// useES256 switches env to an ES256 signing key and returns its public half
+func useES256(t *testing.T, env *testEnv) *ecdsa.PublicKey {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	key, err := security.ParseSigningKey("", security.AlgorithmES256, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+
+	env.jwt = security.NewJWTGenerator(security.NewKeyRing(key), 15*time.Minute, time.Hour, "test")
+	return &privateKey.PublicKey
+}
+
+// addRelyingParty registers a client for the OpenID Connect scopes
+func addRelyingParty(t *testing.T, env *testEnv) *entity.OAuthClient {
+	t.Helper()
+	return addOAuthClient(t, env, valueobject.DefaultTenantID(), "RP", "openid", "email", "profile", "photos:read")
+}
+
+// signIn exchanges a code approved with scope and nonce for tokens
+func signIn(t *testing.T, env *testEnv, client *entity.OAuthClient, authTime time.Time, scope, nonce string) *usecase.OAuthTokenResponse {
+	t.Helper()
+
+	req := authorizeRequest(client)
+	req.Scope = scope
+	req.Nonce = nonce
+
+	authorizeUC := auth.NewAuthorizeUseCase(auth.NewCheckAuthorizationUseCase(env.oauthClientRepo), env.userRepo, env.authCodeRepo, time.Minute)
+	resp, err := authorizeUC.Execute(context.Background(), usecase.ConsentRequest{
+		UserID:           env.user.ID().String(),
+		AuthTime:         authTime,
+		Approve:          true,
+		AuthorizeRequest: req,
+	})
+	require.NoError(t, err)
+
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, oidcIssuer)
+	tokens, err := exchange(tokenUC, client, redirectQuery(t, resp.RedirectURI).Get("code"), oauthVerifier)
+	require.NoError(t, err)
+	return tokens
+}
+
+// idTokenClaims verifies an ID token issued to client with publicKey
+func idTokenClaims(t *testing.T, publicKey *ecdsa.PublicKey, client *entity.OAuthClient, idToken string) *security.IDTokenClaims {
+	t.Helper()
+
+	claims := &security.IDTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (any, error) {
+		return publicKey, nil
+	}, jwt.WithValidMethods([]string{security.AlgorithmES256}), jwt.WithIssuer(oidcIssuer), jwt.WithAudience(client.ID()))
+	require.NoError(t, err)
+	return claims
+}
+
+// TestOIDC_CodeFlowIssuesIDToken tests the ID token from the code grant
+func TestOIDC_CodeFlowIssuesIDToken(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	publicKey := useES256(t, env)
+	client := addRelyingParty(t, env)
+	authTime := time.Now().Add(-time.Hour).Truncate(time.Second) // Signed in before consenting
+
+	// Act
+	tokens := signIn(t, env, client, authTime, "openid email", "n-0S6_WzA2Mj")
+
+	// Assert
+	require.NotEmpty(t, tokens.IDToken)
+	claims := idTokenClaims(t, publicKey, client, tokens.IDToken)
+	assert.Equal(t, env.user.ID().String(), claims.Subject)
+	assert.Equal(t, "n-0S6_WzA2Mj", claims.Nonce)
+	require.NotNil(t, claims.AuthTime)
+	assert.Equal(t, authTime.Unix(), claims.AuthTime.Unix(), "sign-in time, not consent time")
+	assert.NotEmpty(t, claims.AccessTokenHash)
+
+	// email scope releases email claims, but not profile ones
+	assert.Equal(t, "user@example.com", claims.Email)
+	require.NotNil(t, claims.EmailVerified)
+	assert.False(t, *claims.EmailVerified)
+	assert.Zero(t, claims.UpdatedAt)
+}
+
+// TestOIDC_NoIDTokenWithoutOpenIDScope tests plain OAuth requests
+func TestOIDC_NoIDTokenWithoutOpenIDScope(t *testing.T) {
+	env := newTestEnv(t)
+	useES256(t, env)
+	client := addRelyingParty(t, env)
+
+	tokens := signIn(t, env, client, time.Now(), "photos:read", "")
+
+	assert.Empty(t, tokens.IDToken)
+}
+
+// TestOIDC_RefreshKeepsAuthTime tests ID tokens from the refresh grant
+func TestOIDC_RefreshKeepsAuthTime(t *testing.T) {
+	env := newTestEnv(t)
+	publicKey := useES256(t, env)
+	client := addRelyingParty(t, env)
+	authTime := time.Now().Add(-time.Hour).Truncate(time.Second) // Signed in before consenting
+	tokenUC := auth.NewOAuthTokenUseCase(env.oauthClientRepo, env.authCodeRepo, env.userRepo, env.tokenIssuer(), env.refreshTokens(), env.clientCredentials(), env.jwt, 15*time.Minute, oidcIssuer)
+	tokens := signIn(t, env, client, authTime, "openid profile", "n-0S6_WzA2Mj")
+
+	resp, err := tokenUC.Execute(context.Background(), usecase.OAuthTokenRequest{
+		GrantType:    auth.GrantTypeRefreshToken,
+		RefreshToken: tokens.RefreshToken,
+		ClientID:     client.ID(),
+	})
+
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.IDToken)
+	claims := idTokenClaims(t, publicKey, client, resp.IDToken)
+	require.NotNil(t, claims.AuthTime)
+	assert.Equal(t, authTime.Unix(), claims.AuthTime.Unix())
+	assert.Empty(t, claims.Nonce, "nonce belongs to the authentication request")
+	assert.Equal(t, env.user.UpdatedAt().Unix(), claims.UpdatedAt)
+	assert.Empty(t, claims.Email)
+}
+
+// TestOIDC_NonceTooLong tests the nonce bound
+func TestOIDC_NonceTooLong(t *testing.T) {
+	env := newTestEnv(t)
+	checkUC := auth.NewCheckAuthorizationUseCase(env.oauthClientRepo)
+	req := authorizeRequest(addRelyingParty(t, env))
+	req.Scope = "openid"
+	req.Nonce = string(make([]byte, 1024))
+
+	_, err := checkUC.Execute(context.Background(), req)
+
+	assert.Equal(t, domainErrors.OAuthInvalidRequest, domainErrors.OAuthCode(err))
+}
+
+// TestUserInfo tests the UserInfo endpoint
+func TestUserInfo(t *testing.T) {
+	env := newTestEnv(t)
+	useES256(t, env)
+	client := addRelyingParty(t, env)
+	userInfoUC := auth.NewUserInfoUseCase(env.jwt, env.userRepo, &mocks.MockRevokedTokenRepository{})
+
+	t.Run("releases claims by scope", func(t *testing.T) {
+		tokens := signIn(t, env, client, time.Now(), "openid email profile", "")
+
+		info, err := userInfoUC.Execute(context.Background(), tokens.AccessToken)
+
+		require.NoError(t, err)
+		assert.Equal(t, env.user.ID().String(), info.Subject)
+		assert.Equal(t, "user@example.com", info.Email)
+		require.NotNil(t, info.EmailVerified)
+		require.NotNil(t, info.UpdatedAt)
+	})
+
+	t.Run("subject only without email and profile", func(t *testing.T) {
+		tokens := signIn(t, env, client, time.Now(), "openid", "")
+
+		info, err := userInfoUC.Execute(context.Background(), tokens.AccessToken)
+
+		require.NoError(t, err)
+		assert.Equal(t, env.user.ID().String(), info.Subject)
+		assert.Empty(t, info.Email)
+		assert.Nil(t, info.EmailVerified)
+		assert.Nil(t, info.UpdatedAt)
+	})
+
+	t.Run("token without openid scope", func(t *testing.T) {
+		tokens := signIn(t, env, client, time.Now(), "photos:read", "")
+
+		_, err := userInfoUC.Execute(context.Background(), tokens.AccessToken)
+
+		assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+	})
+
+	t.Run("first-party token", func(t *testing.T) {
+		accessToken, err := env.jwt.GenerateAccessToken(env.user.ID(), env.user.TenantID(), env.user.Email(), nil, nil, time.Now(), "")
+		require.NoError(t, err)
+
+		_, err = userInfoUC.Execute(context.Background(), accessToken)
+
+		assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := userInfoUC.Execute(context.Background(), "not-a-token")
+
+		assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	})
+}
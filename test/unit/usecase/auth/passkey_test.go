@@ -0,0 +1,257 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	passkeyRPID   = "example.com"
+	passkeyOrigin = "https://app.example.com"
+)
+
+// newPasskeyVerifier builds the real WebAuthn verifier for passkeyRPID
+func newPasskeyVerifier(t *testing.T) *security.WebAuthnVerifier {
+	t.Helper()
+
+	verifier, err := security.NewWebAuthnVerifier(passkeyRPID, "LabukaAuth", []string{passkeyOrigin}, time.Minute)
+	require.NoError(t, err)
+	return verifier
+}
+
+// registerPasskey adds authenticator to the stored user's account
+func registerPasskey(t *testing.T, env *testEnv, authenticator *testutil.SoftAuthenticator) *usecase.PasskeyInfo {
+	t.Helper()
+
+	verifier := newPasskeyVerifier(t)
+	challenge, err := auth.NewBeginPasskeyRegistrationUseCase(env.userRepo, env.hasher, newLoginThrottle(), env.passkeyRepo, env.challengeRepo, verifier, time.Minute).
+		Execute(context.Background(), usecase.BeginPasskeyRegistrationRequest{
+			UserID:          env.user.ID().String(),
+			CurrentPassword: testPassword,
+		})
+	require.NoError(t, err)
+
+	info, err := auth.NewFinishPasskeyRegistrationUseCase(env.userRepo, env.passkeyRepo, env.challengeRepo, verifier).
+		Execute(context.Background(), usecase.FinishPasskeyRegistrationRequest{
+			UserID:      env.user.ID().String(),
+			ChallengeID: challenge.ChallengeID,
+			Name:        "Laptop",
+			Credential:  authenticator.Create(t, challenge.Options),
+		})
+	require.NoError(t, err)
+	return info
+}
+
+// passkeyLogin runs a full passkey sign-in with authenticator
+func passkeyLogin(t *testing.T, env *testEnv, authenticator *testutil.SoftAuthenticator) (*usecase.LoginResponse, error) {
+	t.Helper()
+
+	verifier := newPasskeyVerifier(t)
+	challenge, err := auth.NewBeginPasskeyLoginUseCase(env.challengeRepo, verifier, time.Minute).Execute(context.Background())
+	require.NoError(t, err)
+
+	return auth.NewFinishPasskeyLoginUseCase(env.userRepo, env.passkeyRepo, env.challengeRepo, verifier, env.tokenIssuer(), false).
+		Execute(context.Background(), usecase.FinishPasskeyLoginRequest{
+			ChallengeID: challenge.ChallengeID,
+			Credential:  authenticator.Get(t, challenge.Options),
+		})
+}
+
+// TestPasskey_RegisterAndLogin tests registering a passkey and signing in with it
+func TestPasskey_RegisterAndLogin(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	authenticator := testutil.NewSoftAuthenticator(t, passkeyRPID, passkeyOrigin)
+	listUC := auth.NewListPasskeysUseCase(env.userRepo, env.passkeyRepo)
+	info := registerPasskey(t, env, authenticator)
+	assert.Equal(t, authenticator.CredentialID(), info.ID)
+	assert.Equal(t, "Laptop", info.Name)
+	assert.Nil(t, info.LastUsedAt)
+
+	// Act
+	resp, err := passkeyLogin(t, env, authenticator)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, env.user.ID().String(), resp.UserID)
+	assert.NotEmpty(t, resp.AccessToken)
+	assert.NotEmpty(t, resp.RefreshToken)
+	assert.False(t, resp.MFARequired)
+
+	passkeys, err := listUC.Execute(context.Background(), env.user.ID().String())
+	require.NoError(t, err)
+	require.Len(t, passkeys, 1)
+	assert.NotNil(t, passkeys[0].LastUsedAt)
+	assert.Equal(t, 1, env.passkeyRepo.UpdateCalls)
+}
+
+// TestBeginPasskeyRegistration_WrongPassword tests that adding a passkey needs the password
+func TestBeginPasskeyRegistration_WrongPassword(t *testing.T) {
+	env := newTestEnv(t)
+	verifier := newPasskeyVerifier(t)
+	beginRegistrationUC := auth.NewBeginPasskeyRegistrationUseCase(env.userRepo, env.hasher, newLoginThrottle(), env.passkeyRepo, env.challengeRepo, verifier, time.Minute)
+
+	resp, err := beginRegistrationUC.Execute(context.Background(), usecase.BeginPasskeyRegistrationRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: "WrongP@ss123",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, 0, env.challengeRepo.CreateCalls)
+}
+
+// TestFinishPasskeyRegistration_Duplicate tests that a credential can't be registered twice
+func TestFinishPasskeyRegistration_Duplicate(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	authenticator := testutil.NewSoftAuthenticator(t, passkeyRPID, passkeyOrigin)
+	verifier := newPasskeyVerifier(t)
+	beginRegistrationUC := auth.NewBeginPasskeyRegistrationUseCase(env.userRepo, env.hasher, newLoginThrottle(), env.passkeyRepo, env.challengeRepo, verifier, time.Minute)
+	finishRegistrationUC := auth.NewFinishPasskeyRegistrationUseCase(env.userRepo, env.passkeyRepo, env.challengeRepo, verifier)
+	registerPasskey(t, env, authenticator)
+
+	challenge, err := beginRegistrationUC.Execute(context.Background(), usecase.BeginPasskeyRegistrationRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+	})
+	require.NoError(t, err)
+
+	// Act
+	_, err = finishRegistrationUC.Execute(context.Background(), usecase.FinishPasskeyRegistrationRequest{
+		UserID:      env.user.ID().String(),
+		ChallengeID: challenge.ChallengeID,
+		Credential:  authenticator.Create(t, challenge.Options),
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrConflict))
+}
+
+// TestFinishPasskeyLogin_ChallengeSingleUse tests that a challenge can't be answered twice
+func TestFinishPasskeyLogin_ChallengeSingleUse(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	authenticator := testutil.NewSoftAuthenticator(t, passkeyRPID, passkeyOrigin)
+	verifier := newPasskeyVerifier(t)
+	beginLoginUC := auth.NewBeginPasskeyLoginUseCase(env.challengeRepo, verifier, time.Minute)
+	finishLoginUC := auth.NewFinishPasskeyLoginUseCase(env.userRepo, env.passkeyRepo, env.challengeRepo, verifier, env.tokenIssuer(), false)
+	registerPasskey(t, env, authenticator)
+
+	challenge, err := beginLoginUC.Execute(context.Background())
+	require.NoError(t, err)
+	req := usecase.FinishPasskeyLoginRequest{
+		ChallengeID: challenge.ChallengeID,
+		Credential:  authenticator.Get(t, challenge.Options),
+	}
+
+	_, err = finishLoginUC.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	// Act - replay
+	resp, err := finishLoginUC.Execute(context.Background(), req)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestFinishPasskeyLogin_WrongOrigin tests that assertions from other sites are rejected
+func TestFinishPasskeyLogin_WrongOrigin(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	authenticator := testutil.NewSoftAuthenticator(t, passkeyRPID, passkeyOrigin)
+	registerPasskey(t, env, authenticator)
+	authenticator.Origin = "https://evil.example.net"
+
+	// Act
+	resp, err := passkeyLogin(t, env, authenticator)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestFinishPasskeyLogin_CloneWarning tests that a sign counter going backwards is rejected
+func TestFinishPasskeyLogin_CloneWarning(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	authenticator := testutil.NewSoftAuthenticator(t, passkeyRPID, passkeyOrigin)
+	registerPasskey(t, env, authenticator)
+	_, err := passkeyLogin(t, env, authenticator)
+	require.NoError(t, err)
+	_, err = passkeyLogin(t, env, authenticator)
+	require.NoError(t, err)
+
+	authenticator.SignCount = 0 // Next assertion reports 1, below the stored 2
+
+	// Act
+	resp, err := passkeyLogin(t, env, authenticator)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestFinishPasskeyLogin_InactiveUser tests that deactivated accounts can't sign in
+func TestFinishPasskeyLogin_InactiveUser(t *testing.T) {
+	env := newTestEnv(t)
+	authenticator := testutil.NewSoftAuthenticator(t, passkeyRPID, passkeyOrigin)
+	registerPasskey(t, env, authenticator)
+	env.user.Deactivate()
+
+	resp, err := passkeyLogin(t, env, authenticator)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+}
+
+// TestDeletePasskey tests removing a passkey
+func TestDeletePasskey(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	authenticator := testutil.NewSoftAuthenticator(t, passkeyRPID, passkeyOrigin)
+	listUC := auth.NewListPasskeysUseCase(env.userRepo, env.passkeyRepo)
+	deleteUC := auth.NewDeletePasskeyUseCase(env.userRepo, env.passkeyRepo)
+	info := registerPasskey(t, env, authenticator)
+
+	// Act
+	err := deleteUC.Execute(context.Background(), usecase.DeletePasskeyRequest{
+		UserID:    env.user.ID().String(),
+		PasskeyID: info.ID,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	passkeys, err := listUC.Execute(context.Background(), env.user.ID().String())
+	require.NoError(t, err)
+	assert.Empty(t, passkeys)
+
+	// Deleted passkeys can't sign in
+	_, err = passkeyLogin(t, env, authenticator)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+
+	// Deleting again is not found
+	err = deleteUC.Execute(context.Background(), usecase.DeletePasskeyRequest{
+		UserID:    env.user.ID().String(),
+		PasskeyID: info.ID,
+	})
+	assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+}
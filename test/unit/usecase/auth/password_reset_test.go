@@ -0,0 +1,187 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPasswordReset_Success tests requesting and using a reset link
+func TestPasswordReset_Success(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	resetTokenRepo := &mocks.MockPasswordResetTokenRepository{}
+	requestUC := auth.NewRequestPasswordResetUseCase(env.userRepo, resetTokenRepo, env.background, 30*time.Minute, "https://app.example.com/reset-password")
+	resetUC := auth.NewResetPasswordUseCase(env.userRepo, env.hasher, resetTokenRepo, env.sessions())
+	require.NoError(t, requestUC.Execute(context.Background(), "user@example.com"))
+	env.background.Wait()
+	assert.Equal(t, "user@example.com", env.mailer.Sent[0].To)
+	token := env.lastLinkToken(t)
+
+	// SECURITY: Only the hash is stored
+	_, stored := resetTokenRepo.Tokens[token]
+	assert.False(t, stored, "raw token must not be stored")
+
+	// Act
+	err := resetUC.Execute(context.Background(), usecase.ResetPasswordRequest{
+		Token:       token,
+		NewPassword: "NewP@ssw0rd123",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "hashed_NewP@ssw0rd123", env.user.Password().Hash())
+	assert.True(t, env.user.IsEmailVerified(), "following the link proves inbox ownership")
+	assert.Equal(t, 1, env.userRepo.UpdateCalls)
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeAllForUserCalls)
+}
+
+// TestPasswordReset_SingleUse tests that a link works only once
+func TestPasswordReset_SingleUse(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	resetTokenRepo := &mocks.MockPasswordResetTokenRepository{}
+	requestUC := auth.NewRequestPasswordResetUseCase(env.userRepo, resetTokenRepo, env.background, 30*time.Minute, "https://app.example.com/reset-password")
+	resetUC := auth.NewResetPasswordUseCase(env.userRepo, env.hasher, resetTokenRepo, env.sessions())
+	require.NoError(t, requestUC.Execute(context.Background(), "user@example.com"))
+	req := usecase.ResetPasswordRequest{Token: env.lastLinkToken(t), NewPassword: "NewP@ssw0rd123"}
+	require.NoError(t, resetUC.Execute(context.Background(), req))
+
+	// Act
+	req.NewPassword = "Other@Passw0rd1"
+	err := resetUC.Execute(context.Background(), req)
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, "hashed_NewP@ssw0rd123", env.user.Password().Hash())
+}
+
+// TestPasswordReset_NewRequestInvalidatesOld tests that only the latest link works
+func TestPasswordReset_NewRequestInvalidatesOld(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	resetTokenRepo := &mocks.MockPasswordResetTokenRepository{}
+	requestUC := auth.NewRequestPasswordResetUseCase(env.userRepo, resetTokenRepo, env.background, 30*time.Minute, "https://app.example.com/reset-password")
+	resetUC := auth.NewResetPasswordUseCase(env.userRepo, env.hasher, resetTokenRepo, env.sessions())
+	require.NoError(t, requestUC.Execute(context.Background(), "user@example.com"))
+	oldToken := env.lastLinkToken(t)
+	require.NoError(t, requestUC.Execute(context.Background(), "user@example.com"))
+
+	// Act
+	err := resetUC.Execute(context.Background(), usecase.ResetPasswordRequest{
+		Token:       oldToken,
+		NewPassword: "NewP@ssw0rd123",
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Len(t, resetTokenRepo.Tokens, 1)
+}
+
+// TestPasswordReset_Expired tests that expired links are rejected
+func TestPasswordReset_Expired(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	resetTokenRepo := &mocks.MockPasswordResetTokenRepository{}
+	resetUC := auth.NewResetPasswordUseCase(env.userRepo, env.hasher, resetTokenRepo, env.sessions())
+	expired := entity.ReconstructPasswordResetToken(
+		"expired-hash", env.user.ID(), time.Now().Add(-time.Hour), time.Now().Add(-time.Minute), nil,
+	)
+	resetTokenRepo.FindByHashFunc = func(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error) {
+		return expired, nil
+	}
+
+	// Act
+	err := resetUC.Execute(context.Background(), usecase.ResetPasswordRequest{
+		Token:       "expired-token",
+		NewPassword: "NewP@ssw0rd123",
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, 0, resetTokenRepo.MarkUsedCalls)
+}
+
+// TestPasswordReset_WeakPassword tests that a rejected password keeps the link usable
+func TestPasswordReset_WeakPassword(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	resetTokenRepo := &mocks.MockPasswordResetTokenRepository{}
+	requestUC := auth.NewRequestPasswordResetUseCase(env.userRepo, resetTokenRepo, env.background, 30*time.Minute, "https://app.example.com/reset-password")
+	resetUC := auth.NewResetPasswordUseCase(env.userRepo, env.hasher, resetTokenRepo, env.sessions())
+	require.NoError(t, requestUC.Execute(context.Background(), "user@example.com"))
+	token := env.lastLinkToken(t)
+
+	// Act
+	err := resetUC.Execute(context.Background(), usecase.ResetPasswordRequest{Token: token, NewPassword: "weak"})
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+	assert.Equal(t, 0, resetTokenRepo.MarkUsedCalls)
+
+	// The same link still works with a valid password
+	err = resetUC.Execute(context.Background(), usecase.ResetPasswordRequest{Token: token, NewPassword: "NewP@ssw0rd123"})
+	assert.NoError(t, err)
+}
+
+// TestRequestPasswordReset_UnknownEmail tests that unknown addresses look the same
+func TestRequestPasswordReset_UnknownEmail(t *testing.T) {
+	env := newTestEnv(t)
+	resetTokenRepo := &mocks.MockPasswordResetTokenRepository{}
+	requestUC := auth.NewRequestPasswordResetUseCase(env.userRepo, resetTokenRepo, env.background, 30*time.Minute, "https://app.example.com/reset-password")
+
+	err := requestUC.Execute(context.Background(), "nobody@example.com")
+	env.background.Wait()
+
+	// SECURITY: No error, no mail - indistinguishable from a registered address
+	require.NoError(t, err)
+	assert.Equal(t, 0, env.mailer.SendCalls)
+	assert.Equal(t, 0, resetTokenRepo.CreateCalls)
+}
+
+// TestRequestPasswordReset_InactiveUser tests that inactive accounts get no link
+func TestRequestPasswordReset_InactiveUser(t *testing.T) {
+	env := newTestEnv(t)
+	resetTokenRepo := &mocks.MockPasswordResetTokenRepository{}
+	requestUC := auth.NewRequestPasswordResetUseCase(env.userRepo, resetTokenRepo, env.background, 30*time.Minute, "https://app.example.com/reset-password")
+	env.user.Deactivate()
+
+	err := requestUC.Execute(context.Background(), "user@example.com")
+	env.background.Wait()
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, env.mailer.SendCalls)
+}
+
+// TestRequestPasswordReset_MailFailure tests that a failed send looks like an unknown address
+func TestRequestPasswordReset_MailFailure(t *testing.T) {
+	env := newTestEnv(t)
+	resetTokenRepo := &mocks.MockPasswordResetTokenRepository{}
+	requestUC := auth.NewRequestPasswordResetUseCase(env.userRepo, resetTokenRepo, env.background, 30*time.Minute, "https://app.example.com/reset-password")
+	env.mailer.SendFunc = func(ctx context.Context, msg mail.Message) error {
+		return errors.New("smtp: connection refused")
+	}
+
+	// SECURITY: Known and unknown addresses get the same answer
+	known := requestUC.Execute(context.Background(), "user@example.com")
+	unknown := requestUC.Execute(context.Background(), "nobody@example.com")
+	env.background.Wait()
+
+	assert.NoError(t, known)
+	assert.Equal(t, unknown, known)
+	assert.Equal(t, 1, env.mailer.SendCalls)
+}
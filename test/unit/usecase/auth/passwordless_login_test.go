@@ -0,0 +1,225 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var loginCodePattern = regexp.MustCompile(`\b\d{6}\b`)
+
+// lastLoginCode extracts the 6-digit code from the most recent email
+func lastLoginCode(t *testing.T, env *testEnv) string {
+	t.Helper()
+
+	code := loginCodePattern.FindString(env.lastMail(t).Body)
+	require.NotEmpty(t, code, "mail should contain a login code")
+	return code
+}
+
+// TestLoginCode_Success tests requesting and redeeming a login code
+func TestLoginCode_Success(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	codeRepo := &mocks.MockLoginCodeRepository{}
+	requestCodeUC := auth.NewRequestLoginCodeUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute)
+	verifyUC := auth.NewVerifyLoginCodeUseCase(env.userRepo, codeRepo, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), 5*time.Minute)
+	require.NoError(t, requestCodeUC.Execute(context.Background(), "user@example.com"))
+	code := lastLoginCode(t, env)
+
+	// Act
+	resp, err := verifyUC.Execute(context.Background(), usecase.VerifyLoginCodeRequest{
+		Email: "user@example.com",
+		Code:  code,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, env.user.ID().String(), resp.UserID)
+	assert.NotEmpty(t, resp.AccessToken)
+	assert.NotEmpty(t, resp.RefreshToken)
+	assert.True(t, env.user.IsEmailVerified(), "receiving the code proves the inbox")
+
+	// Single use
+	_, err = verifyUC.Execute(context.Background(), usecase.VerifyLoginCodeRequest{
+		Email: "user@example.com",
+		Code:  code,
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestMagicLink_Success tests requesting and following a magic link
+func TestMagicLink_Success(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	codeRepo := &mocks.MockLoginCodeRepository{}
+	requestLinkUC := auth.NewRequestMagicLinkUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute, "https://app.example.com/magic-link")
+	verifyUC := auth.NewVerifyLoginCodeUseCase(env.userRepo, codeRepo, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), 5*time.Minute)
+	require.NoError(t, requestLinkUC.Execute(context.Background(), "user@example.com"))
+
+	// Act
+	resp, err := verifyUC.Execute(context.Background(), usecase.VerifyLoginCodeRequest{
+		Code: env.lastLinkToken(t),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, env.user.ID().String(), resp.UserID)
+	assert.NotEmpty(t, resp.AccessToken)
+}
+
+// TestRequestLoginCode_UnknownEmail tests that unknown addresses look the same
+func TestRequestLoginCode_UnknownEmail(t *testing.T) {
+	env := newTestEnv(t)
+	codeRepo := &mocks.MockLoginCodeRepository{}
+	requestCodeUC := auth.NewRequestLoginCodeUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute)
+
+	err := requestCodeUC.Execute(context.Background(), "nobody@example.com")
+
+	// SECURITY: No error, no mail - indistinguishable from a registered address
+	require.NoError(t, err)
+	assert.Equal(t, 0, env.mailer.SendCalls)
+	assert.Equal(t, 0, codeRepo.CreateCalls)
+}
+
+// TestRequestLoginCode_MailFailure tests that a failed send looks like an unknown address
+func TestRequestLoginCode_MailFailure(t *testing.T) {
+	env := newTestEnv(t)
+	codeRepo := &mocks.MockLoginCodeRepository{}
+	requestCodeUC := auth.NewRequestLoginCodeUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute)
+	requestLinkUC := auth.NewRequestMagicLinkUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute, "https://app.example.com/magic-link")
+	env.mailer.SendFunc = func(ctx context.Context, msg mail.Message) error {
+		return errors.New("smtp: connection refused")
+	}
+
+	// SECURITY: Known and unknown addresses get the same answer, for codes and links
+	for _, request := range []func(context.Context, string) error{requestCodeUC.Execute, requestLinkUC.Execute} {
+		known := request(context.Background(), "user@example.com")
+		unknown := request(context.Background(), "nobody@example.com")
+		env.background.Wait()
+
+		assert.NoError(t, known)
+		assert.Equal(t, unknown, known)
+	}
+	assert.Equal(t, 2, env.mailer.SendCalls)
+}
+
+// TestRequestLoginCode_InactiveUser tests that deactivated accounts get no code
+func TestRequestLoginCode_InactiveUser(t *testing.T) {
+	env := newTestEnv(t)
+	codeRepo := &mocks.MockLoginCodeRepository{}
+	requestLinkUC := auth.NewRequestMagicLinkUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute, "https://app.example.com/magic-link")
+	env.user.Deactivate()
+
+	err := requestLinkUC.Execute(context.Background(), "user@example.com")
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, env.mailer.SendCalls)
+}
+
+// TestLoginCode_NewerCodeReplacesOlder tests that only the latest code works
+func TestLoginCode_NewerCodeReplacesOlder(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	codeRepo := &mocks.MockLoginCodeRepository{}
+	requestCodeUC := auth.NewRequestLoginCodeUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute)
+	requestLinkUC := auth.NewRequestMagicLinkUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute, "https://app.example.com/magic-link")
+	verifyUC := auth.NewVerifyLoginCodeUseCase(env.userRepo, codeRepo, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), 5*time.Minute)
+	require.NoError(t, requestLinkUC.Execute(context.Background(), "user@example.com"))
+	oldToken := env.lastLinkToken(t)
+	require.NoError(t, requestCodeUC.Execute(context.Background(), "user@example.com"))
+
+	// Act
+	_, err := verifyUC.Execute(context.Background(), usecase.VerifyLoginCodeRequest{Code: oldToken})
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestLoginCode_WrongEmail tests that a code only works for the address it was sent to
+func TestLoginCode_WrongEmail(t *testing.T) {
+	env := newTestEnv(t)
+	codeRepo := &mocks.MockLoginCodeRepository{}
+	requestCodeUC := auth.NewRequestLoginCodeUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute)
+	verifyUC := auth.NewVerifyLoginCodeUseCase(env.userRepo, codeRepo, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), 5*time.Minute)
+	require.NoError(t, requestCodeUC.Execute(context.Background(), "user@example.com"))
+
+	_, err := verifyUC.Execute(context.Background(), usecase.VerifyLoginCodeRequest{
+		Email: "other@example.com",
+		Code:  lastLoginCode(t, env),
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestLoginCode_GuessingLocksOut tests that wrong codes count toward the lockout
+func TestLoginCode_GuessingLocksOut(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	codeRepo := &mocks.MockLoginCodeRepository{}
+	requestCodeUC := auth.NewRequestLoginCodeUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute)
+	verifyUC := auth.NewVerifyLoginCodeUseCase(env.userRepo, codeRepo, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), 5*time.Minute)
+	require.NoError(t, requestCodeUC.Execute(context.Background(), "user@example.com"))
+	code := lastLoginCode(t, env)
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+
+	// Act - guess until throttled
+	var err error
+	for i := 0; i < testLockoutPolicy.ClientThreshold+1; i++ {
+		_, err = verifyUC.Execute(context.Background(), usecase.VerifyLoginCodeRequest{
+			Email:     "user@example.com",
+			Code:      wrong,
+			IPAddress: "203.0.113.7",
+		})
+	}
+
+	// Assert - the guess made while backing off counted too and locked the client out
+	assert.True(t, errors.Is(err, domainErrors.ErrAccountLocked))
+
+	// Even the right code is refused while blocked
+	_, err = verifyUC.Execute(context.Background(), usecase.VerifyLoginCodeRequest{
+		Email:     "user@example.com",
+		Code:      code,
+		IPAddress: "203.0.113.7",
+	})
+	assert.True(t, errors.Is(err, domainErrors.ErrAccountLocked))
+}
+
+// TestLoginCode_MFAEnabled tests that passwordless login still asks for the second factor
+func TestLoginCode_MFAEnabled(t *testing.T) {
+	// Arrange
+	env := newTestEnv(t)
+	codeRepo := &mocks.MockLoginCodeRepository{}
+	requestLinkUC := auth.NewRequestMagicLinkUseCase(env.userRepo, codeRepo, env.background, 10*time.Minute, "https://app.example.com/magic-link")
+	verifyUC := auth.NewVerifyLoginCodeUseCase(env.userRepo, codeRepo, env.jwt, env.tokenIssuer(), env.throttle(testLockoutPolicy), 5*time.Minute)
+	require.NoError(t, env.user.BeginMFAEnrollment("encrypted-secret"))
+	require.NoError(t, env.user.ConfirmMFAEnrollment([]string{"hash"}, 1))
+	require.NoError(t, requestLinkUC.Execute(context.Background(), "user@example.com"))
+
+	// Act
+	resp, err := verifyUC.Execute(context.Background(), usecase.VerifyLoginCodeRequest{
+		Code: env.lastLinkToken(t),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, resp.MFARequired)
+	assert.NotEmpty(t, resp.MFAToken)
+	assert.Empty(t, resp.AccessToken)
+}
@@ -0,0 +1,224 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const patMaxLifetime = 90 * 24 * time.Hour
+
+// grantDeployAccess gives user the "deploy" role and "billing:read" permission
+func grantDeployAccess(t *testing.T, user *entity.User) {
+	t.Helper()
+
+	_, err := user.GrantRole("deploy")
+	require.NoError(t, err)
+	_, err = user.GrantPermission("billing:read")
+	require.NoError(t, err)
+}
+
+// createPAT makes a token for the stored user with the given scopes and default expiry
+func createPAT(t *testing.T, env *testEnv, patRepo *mocks.MockPersonalAccessTokenRepository, scopes ...string) *usecase.CreatePersonalAccessTokenResponse {
+	t.Helper()
+
+	createUC := auth.NewCreatePersonalAccessTokenUseCase(env.userRepo, env.hasher, newLoginThrottle(), patRepo, patMaxLifetime)
+	resp, err := createUC.Execute(context.Background(), usecase.CreatePersonalAccessTokenRequest{
+		UserID:          env.user.ID().String(),
+		CurrentPassword: testPassword,
+		Name:            "CI deploy",
+		Scopes:          scopes,
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+// TestPersonalAccessToken_CreateAndValidate tests using a token in place of a JWT
+func TestPersonalAccessToken_CreateAndValidate(t *testing.T) {
+	env := newTestEnv(t)
+	patRepo := &mocks.MockPersonalAccessTokenRepository{}
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, patRepo, env.sessionRepo)
+	grantDeployAccess(t, env.user)
+
+	resp := createPAT(t, env, patRepo, "deploy")
+
+	assert.True(t, strings.HasPrefix(resp.Token, entity.PersonalAccessTokenPrefix))
+	assert.Equal(t, "CI deploy", resp.Name)
+	assert.WithinDuration(t, time.Now().Add(30*24*time.Hour), resp.ExpiresAt, time.Minute)
+
+	// Only the hash is stored
+	require.Len(t, patRepo.Tokens, 1)
+	assert.NotContains(t, patRepo.Tokens[0].TokenHash(), resp.Token)
+
+	claims, err := validateUC.Execute(context.Background(), resp.Token)
+	require.NoError(t, err)
+	assert.Equal(t, env.user.ID().String(), claims.UserID)
+	assert.Equal(t, "user@example.com", claims.Email)
+	assert.Equal(t, []string{"deploy"}, claims.Roles)
+	assert.Empty(t, claims.Permissions, "permission not in the token's scopes")
+	assert.Equal(t, []string{"deploy"}, claims.Scopes)
+	assert.Empty(t, claims.ClientID, "accepted by middleware.Auth")
+}
+
+// TestPersonalAccessToken_Unscoped tests a token that only identifies its owner
+func TestPersonalAccessToken_Unscoped(t *testing.T) {
+	env := newTestEnv(t)
+	patRepo := &mocks.MockPersonalAccessTokenRepository{}
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, patRepo, env.sessionRepo)
+	grantDeployAccess(t, env.user)
+
+	resp := createPAT(t, env, patRepo)
+
+	claims, err := validateUC.Execute(context.Background(), resp.Token)
+	require.NoError(t, err)
+	assert.Equal(t, env.user.ID().String(), claims.UserID)
+	assert.Empty(t, claims.Roles)
+	assert.Empty(t, claims.Permissions)
+}
+
+// TestCreatePersonalAccessToken_Rejected tests the checks made before creating a token
+func TestCreatePersonalAccessToken_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		scopes   []string
+		expires  time.Duration
+		wantErr  error
+	}{
+		{name: "wrong password", password: "wrong", wantErr: domainErrors.ErrUnauthorized},
+		{name: "scope the user lacks", password: testPassword, scopes: []string{"admin"}, wantErr: domainErrors.ErrInvalidInput},
+		{name: "expiry over the maximum", password: testPassword, expires: patMaxLifetime + time.Hour, wantErr: domainErrors.ErrInvalidInput},
+		{name: "negative expiry", password: testPassword, expires: -time.Hour, wantErr: domainErrors.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			patRepo := &mocks.MockPersonalAccessTokenRepository{}
+			createUC := auth.NewCreatePersonalAccessTokenUseCase(env.userRepo, env.hasher, newLoginThrottle(), patRepo, patMaxLifetime)
+			grantDeployAccess(t, env.user)
+
+			_, err := createUC.Execute(context.Background(), usecase.CreatePersonalAccessTokenRequest{
+				UserID:          env.user.ID().String(),
+				CurrentPassword: tt.password,
+				Name:            "CI deploy",
+				Scopes:          tt.scopes,
+				ExpiresIn:       tt.expires,
+			})
+
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+			assert.Equal(t, 0, patRepo.CreateCalls)
+		})
+	}
+}
+
+// TestPersonalAccessToken_ListAndRevoke tests that revoked tokens stop working
+func TestPersonalAccessToken_ListAndRevoke(t *testing.T) {
+	env := newTestEnv(t)
+	patRepo := &mocks.MockPersonalAccessTokenRepository{}
+	listUC := auth.NewListPersonalAccessTokensUseCase(env.userRepo, patRepo)
+	revokeUC := auth.NewRevokePersonalAccessTokenUseCase(env.userRepo, patRepo)
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, patRepo, env.sessionRepo)
+	grantDeployAccess(t, env.user)
+	first := createPAT(t, env, patRepo, "deploy")
+	second := createPAT(t, env, patRepo, "billing:read")
+
+	tokens, err := listUC.Execute(context.Background(), env.user.ID().String())
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, first.ID, tokens[0].ID)
+
+	err = revokeUC.Execute(context.Background(), usecase.RevokePersonalAccessTokenRequest{
+		UserID:  env.user.ID().String(),
+		TokenID: first.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = validateUC.Execute(context.Background(), first.Token)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+
+	_, err = validateUC.Execute(context.Background(), second.Token)
+	assert.NoError(t, err)
+
+	// Revoking again finds nothing
+	err = revokeUC.Execute(context.Background(), usecase.RevokePersonalAccessTokenRequest{
+		UserID:  env.user.ID().String(),
+		TokenID: first.ID,
+	})
+	assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+}
+
+// TestPersonalAccessToken_FollowsOwner tests that tokens track the owner's account
+func TestPersonalAccessToken_FollowsOwner(t *testing.T) {
+	env := newTestEnv(t)
+	patRepo := &mocks.MockPersonalAccessTokenRepository{}
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, patRepo, env.sessionRepo)
+	grantDeployAccess(t, env.user)
+	resp := createPAT(t, env, patRepo, "deploy", "billing:read")
+
+	// A role the owner loses is lost by the token too
+	env.user.RevokeRole("deploy")
+	claims, err := validateUC.Execute(context.Background(), resp.Token)
+	require.NoError(t, err)
+	assert.Empty(t, claims.Roles)
+	assert.Equal(t, []string{"billing:read"}, claims.Permissions)
+
+	// And a deactivated owner's tokens stop working
+	env.user.Deactivate()
+	_, err = validateUC.Execute(context.Background(), resp.Token)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+}
+
+// TestPersonalAccessToken_Expired tests that expired tokens are rejected before the TTL removes them
+func TestPersonalAccessToken_Expired(t *testing.T) {
+	env := newTestEnv(t)
+	patRepo := &mocks.MockPersonalAccessTokenRepository{}
+	listUC := auth.NewListPersonalAccessTokensUseCase(env.userRepo, patRepo)
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, patRepo, env.sessionRepo)
+	grantDeployAccess(t, env.user)
+	resp := createPAT(t, env, patRepo, "deploy")
+
+	stored := patRepo.Tokens[0]
+	patRepo.Tokens[0] = entity.ReconstructPersonalAccessToken(
+		stored.ID(),
+		stored.TokenHash(),
+		stored.UserID(),
+		stored.TenantID(),
+		stored.Name(),
+		stored.Scopes(),
+		stored.CreatedAt(),
+		time.Now().Add(-time.Minute),
+	)
+
+	_, err := validateUC.Execute(context.Background(), resp.Token)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+
+	tokens, err := listUC.Execute(context.Background(), env.user.ID().String())
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
+
+// TestPersonalAccessToken_OtherTenant tests the tenant check on personal access tokens
+func TestPersonalAccessToken_OtherTenant(t *testing.T) {
+	env := newTestEnv(t)
+	patRepo := &mocks.MockPersonalAccessTokenRepository{}
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, patRepo, env.sessionRepo)
+	grantDeployAccess(t, env.user)
+	resp := createPAT(t, env, patRepo, "deploy")
+
+	acme, _ := valueobject.NewTenantID("acme")
+	_, err := validateUC.Execute(usecase.WithTenant(context.Background(), acme), resp.Token)
+
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
@@ -0,0 +1,137 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signInForRefresh issues the first token of a new family to the stored user
+func signInForRefresh(t *testing.T, env *testEnv) string {
+	t.Helper()
+
+	tokens, err := env.tokenIssuer().Issue(context.Background(), env.user, entity.NewTokenFamilyID())
+	require.NoError(t, err)
+	return tokens.RefreshToken
+}
+
+// TestRefreshTokenUseCase_RotatesToken tests that a refresh returns a new token
+func TestRefreshTokenUseCase_RotatesToken(t *testing.T) {
+	env := newTestEnv(t)
+	refreshUC := auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+	original := signInForRefresh(t, env)
+
+	resp, err := refreshUC.Execute(context.Background(), original)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.AccessToken)
+	assert.NotEqual(t, original, resp.RefreshToken)
+
+	// Old token is consumed and points at its replacement
+	old := env.storedRefreshToken(t, original)
+	assert.True(t, old.IsUsed())
+	assert.Equal(t, security.HashToken(resp.RefreshToken), old.ReplacedBy())
+
+	// New token stays in the same family
+	next := env.storedRefreshToken(t, resp.RefreshToken)
+	require.NotNil(t, next)
+	assert.Equal(t, old.FamilyID(), next.FamilyID())
+	assert.False(t, next.IsUsed())
+}
+
+// TestRefreshTokenUseCase_ReuseRevokesFamily tests replay of a rotated token
+func TestRefreshTokenUseCase_ReuseRevokesFamily(t *testing.T) {
+	env := newTestEnv(t)
+	refreshUC := auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+	original := signInForRefresh(t, env)
+
+	resp, err := refreshUC.Execute(context.Background(), original)
+	require.NoError(t, err)
+
+	// Act - replay the rotated token
+	_, err = refreshUC.Execute(context.Background(), original)
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Contains(t, err.Error(), "reuse detected")
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeFamilyCalls)
+
+	// The legitimate successor is now revoked too
+	_, err = refreshUC.Execute(context.Background(), resp.RefreshToken)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "revoked")
+}
+
+// TestRefreshTokenUseCase_ConcurrentRotation tests losing the MarkUsed race
+func TestRefreshTokenUseCase_ConcurrentRotation(t *testing.T) {
+	env := newTestEnv(t)
+	refreshUC := auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+	original := signInForRefresh(t, env)
+
+	// Simulate another request consuming the token between lookup and rotation
+	env.refreshTokenRepo.MarkUsedFunc = func(ctx context.Context, tokenHash, replacedBy string) error {
+		return repository.ErrTokenAlreadyUsed
+	}
+
+	resp, err := refreshUC.Execute(context.Background(), original)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "reuse detected")
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeFamilyCalls)
+}
+
+// TestRefreshTokenUseCase_UnknownToken tests a valid JWT with no server-side record
+func TestRefreshTokenUseCase_UnknownToken(t *testing.T) {
+	env := newTestEnv(t)
+	refreshUC := auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+
+	neverIssued, err := env.jwt.GenerateRefreshToken(env.user.ID(), env.user.TenantID(), time.Now())
+	require.NoError(t, err)
+
+	resp, err := refreshUC.Execute(context.Background(), neverIssued)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, 1, env.refreshTokenRepo.FindByHashCalls)
+	assert.Equal(t, 0, env.refreshTokenRepo.CreateCalls)
+}
+
+// TestRefreshTokenUseCase_InvalidJWT tests a token that fails signature checks
+func TestRefreshTokenUseCase_InvalidJWT(t *testing.T) {
+	env := newTestEnv(t)
+	refreshUC := auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+
+	resp, err := refreshUC.Execute(context.Background(), "garbage")
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, 0, env.refreshTokenRepo.FindByHashCalls)
+}
+
+// TestRefreshTokenUseCase_InactiveUser tests refresh for a deactivated account
+func TestRefreshTokenUseCase_InactiveUser(t *testing.T) {
+	env := newTestEnv(t)
+	refreshUC := auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+	original := signInForRefresh(t, env)
+	env.user.Deactivate()
+
+	resp, err := refreshUC.Execute(context.Background(), original)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+	assert.False(t, env.storedRefreshToken(t, original).IsUsed())
+}
@@ -0,0 +1,256 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signInFrom issues tokens as if the user signed in from the given device
+func signInFrom(t *testing.T, env *testEnv, validateUC *auth.ValidateTokenUseCase, userAgent, ipAddress string) (*auth.TokenPair, *usecase.TokenClaims) {
+	t.Helper()
+
+	ctx := usecase.WithClientInfo(context.Background(), usecase.ClientInfo{UserAgent: userAgent, IPAddress: ipAddress})
+	tokens, err := env.tokenIssuer().Issue(ctx, env.user, entity.NewTokenFamilyID())
+	require.NoError(t, err)
+
+	claims, err := validateUC.Execute(context.Background(), tokens.AccessToken)
+	require.NoError(t, err)
+	require.NotEmpty(t, claims.SessionID)
+	return tokens, claims
+}
+
+// TestTokenIssuer_RecordsSession tests that signing in starts a session on the device
+func TestTokenIssuer_RecordsSession(t *testing.T) {
+	env := newTestEnv(t)
+	validateUC := env.validator()
+
+	_, claims := signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+
+	require.Len(t, env.sessionRepo.Sessions, 1)
+	session := env.sessionRepo.Sessions[0]
+	assert.Equal(t, session.ID(), claims.SessionID)
+	assert.True(t, session.UserID().Equals(env.user.ID()))
+	assert.Equal(t, env.refreshTokenRepo.Created[0].FamilyID(), session.FamilyID())
+	assert.Equal(t, "Firefox", session.UserAgent())
+	assert.Equal(t, "203.0.113.7", session.IPAddress())
+}
+
+// TestRefreshTokenUseCase_TouchesSession tests that refreshing keeps the session and records the device
+func TestRefreshTokenUseCase_TouchesSession(t *testing.T) {
+	env := newTestEnv(t)
+	validateUC := env.validator()
+	refreshUC := auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+	tokens, claims := signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+	signedInAt := env.sessionRepo.Sessions[0].LastSeenAt()
+
+	time.Sleep(time.Millisecond)
+	ctx := usecase.WithClientInfo(context.Background(), usecase.ClientInfo{UserAgent: "Firefox", IPAddress: "198.51.100.2"})
+	resp, err := refreshUC.Execute(ctx, tokens.RefreshToken)
+	require.NoError(t, err)
+
+	require.Len(t, env.sessionRepo.Sessions, 1, "refresh continues the session")
+	session := env.sessionRepo.Sessions[0]
+	assert.Equal(t, "198.51.100.2", session.IPAddress())
+	assert.True(t, session.LastSeenAt().After(signedInAt))
+
+	refreshed, err := validateUC.Execute(context.Background(), resp.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, claims.SessionID, refreshed.SessionID)
+}
+
+// TestRefreshTokenUseCase_LegacyFamilyGetsSession tests refreshing a token from before sessions were recorded
+func TestRefreshTokenUseCase_LegacyFamilyGetsSession(t *testing.T) {
+	env := newTestEnv(t)
+	validateUC := env.validator()
+	refreshUC := auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+	tokens, _ := signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+	env.sessionRepo.Sessions = nil
+
+	resp, err := refreshUC.Execute(context.Background(), tokens.RefreshToken)
+	require.NoError(t, err)
+
+	require.Len(t, env.sessionRepo.Sessions, 1)
+	claims, err := validateUC.Execute(context.Background(), resp.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, env.sessionRepo.Sessions[0].ID(), claims.SessionID)
+}
+
+// TestListSessions_MarksCurrent tests listing devices with the caller's flagged
+func TestListSessions_MarksCurrent(t *testing.T) {
+	env := newTestEnv(t)
+	validateUC := env.validator()
+	listUC := auth.NewListSessionsUseCase(env.userRepo, env.sessionRepo)
+	_, laptop := signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+	_, phone := signInFrom(t, env, validateUC, "Safari", "198.51.100.2")
+
+	// Expired sessions linger until the TTL monitor removes them
+	env.sessionRepo.Sessions = append(env.sessionRepo.Sessions, entity.ReconstructSession(
+		"expired", env.user.ID(), "old-family", "", "Chrome", "", time.Now().Add(-2*time.Hour), time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour),
+	))
+
+	sessions, err := listUC.Execute(context.Background(), usecase.ListSessionsRequest{
+		UserID:           env.user.ID().String(),
+		CurrentSessionID: phone.SessionID,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	byID := map[string]usecase.SessionInfo{sessions[0].ID: sessions[0], sessions[1].ID: sessions[1]}
+	assert.True(t, byID[phone.SessionID].Current)
+	assert.Equal(t, "Safari", byID[phone.SessionID].UserAgent)
+	assert.False(t, byID[laptop.SessionID].Current)
+	assert.Equal(t, "203.0.113.7", byID[laptop.SessionID].IPAddress)
+}
+
+// TestRevokeSession_EndsTokens tests that revoking a session stops its tokens immediately
+func TestRevokeSession_EndsTokens(t *testing.T) {
+	env := newTestEnv(t)
+	validateUC := env.validator()
+	revokeUC := auth.NewRevokeSessionUseCase(env.userRepo, env.sessionRepo, env.sessions())
+	tokens, claims := signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+
+	err := revokeUC.Execute(context.Background(), usecase.RevokeSessionRequest{
+		UserID:    env.user.ID().String(),
+		SessionID: claims.SessionID,
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, env.sessionRepo.Sessions)
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeFamilyCalls)
+
+	// Access token stops validating before it expires
+	_, err = validateUC.Execute(context.Background(), tokens.AccessToken)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+}
+
+// TestRevokeSession_NotFound tests revoking unknown and other users' sessions
+func TestRevokeSession_NotFound(t *testing.T) {
+	env := newTestEnv(t)
+	validateUC := env.validator()
+	revokeUC := auth.NewRevokeSessionUseCase(env.userRepo, env.sessionRepo, env.sessions())
+	_, claims := signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+
+	other, _ := entity.NewSession(valueobject.NewUserID(), "other-family", "", "", "", time.Now().Add(time.Hour))
+	env.sessionRepo.Sessions = append(env.sessionRepo.Sessions, other)
+
+	tests := []struct {
+		name      string
+		sessionID string
+		wantErr   error
+	}{
+		{name: "missing ID", sessionID: "", wantErr: domainErrors.ErrInvalidInput},
+		{name: "unknown session", sessionID: "missing", wantErr: domainErrors.ErrNotFound},
+		{name: "another user's session", sessionID: other.ID(), wantErr: domainErrors.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := revokeUC.Execute(context.Background(), usecase.RevokeSessionRequest{
+				UserID:    env.user.ID().String(),
+				SessionID: tt.sessionID,
+			})
+			assert.True(t, errors.Is(err, tt.wantErr))
+		})
+	}
+
+	assert.Len(t, env.sessionRepo.Sessions, 2)
+	_, err := env.sessionRepo.FindByID(context.Background(), claims.SessionID)
+	assert.NoError(t, err)
+}
+
+// TestRevokeOtherSessions_KeepsCurrent tests signing out everywhere else
+func TestRevokeOtherSessions_KeepsCurrent(t *testing.T) {
+	env := newTestEnv(t)
+	validateUC := env.validator()
+	othersUC := auth.NewRevokeOtherSessionsUseCase(env.userRepo, env.sessionRepo, env.sessions())
+	laptopTokens, _ := signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+	phoneTokens, phone := signInFrom(t, env, validateUC, "Safari", "198.51.100.2")
+
+	err := othersUC.Execute(context.Background(), usecase.ListSessionsRequest{
+		UserID:           env.user.ID().String(),
+		CurrentSessionID: phone.SessionID,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, env.sessionRepo.Sessions, 1)
+	assert.Equal(t, phone.SessionID, env.sessionRepo.Sessions[0].ID())
+
+	_, err = validateUC.Execute(context.Background(), laptopTokens.AccessToken)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	_, err = validateUC.Execute(context.Background(), phoneTokens.AccessToken)
+	assert.NoError(t, err)
+}
+
+// TestRevokeUserSessions_EndsAll tests an admin signing a user out of every device
+func TestRevokeUserSessions_EndsAll(t *testing.T) {
+	env := newTestEnv(t)
+	validateUC := env.validator()
+	adminUC := auth.NewRevokeUserSessionsUseCase(env.userRepo, env.sessions(), env.auditLog())
+	laptopTokens, _ := signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+	phoneTokens, _ := signInFrom(t, env, validateUC, "Safari", "198.51.100.2")
+
+	err := adminUC.Execute(context.Background(), usecase.AdminUserRequest{
+		ActorID: valueobject.NewUserID().String(),
+		UserID:  env.user.ID().String(),
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, env.sessionRepo.Sessions)
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeAllForUserCalls)
+	for _, tokens := range []*auth.TokenPair{laptopTokens, phoneTokens} {
+		_, err = validateUC.Execute(context.Background(), tokens.AccessToken)
+		assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	}
+
+	require.Len(t, env.auditRepo.Events, 1)
+	assert.Equal(t, entity.AuditActionRevokeSessions, env.auditRepo.Events[0].Action())
+
+	// The user can sign straight back in
+	signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+}
+
+// TestRevokeUserSessions_OtherTenant tests that admins only act on their tenant's users
+func TestRevokeUserSessions_OtherTenant(t *testing.T) {
+	env := newTestEnv(t)
+	validateUC := env.validator()
+	adminUC := auth.NewRevokeUserSessionsUseCase(env.userRepo, env.sessions(), env.auditLog())
+	signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+
+	acme, _ := valueobject.NewTenantID("acme")
+	err := adminUC.Execute(usecase.WithTenant(context.Background(), acme), usecase.AdminUserRequest{
+		ActorID: valueobject.NewUserID().String(),
+		UserID:  env.user.ID().String(),
+	})
+
+	assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+	assert.Len(t, env.sessionRepo.Sessions, 1)
+}
+
+// TestLogoutUseCase_EndsSession tests that logging out with only the access token ends the session
+func TestLogoutUseCase_EndsSession(t *testing.T) {
+	env := newTestEnv(t)
+	validateUC := env.validator()
+	refreshUC := auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+	logoutUC := auth.NewLogoutUseCase(env.jwt, auth.NewRevokeTokenUseCase(env.jwt, &mocks.MockRevokedTokenRepository{}, env.refreshTokenRepo, env.sessions()))
+	tokens, _ := signInFrom(t, env, validateUC, "Firefox", "203.0.113.7")
+
+	err := logoutUC.Execute(context.Background(), usecase.LogoutRequest{AccessToken: tokens.AccessToken})
+	require.NoError(t, err)
+
+	assert.Empty(t, env.sessionRepo.Sessions)
+	assert.Equal(t, 1, env.refreshTokenRepo.RevokeFamilyCalls, "the session's refresh token is revoked too")
+
+	_, err = refreshUC.Execute(context.Background(), tokens.RefreshToken)
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
 	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
@@ -20,7 +21,7 @@ import (
 func TestSignupUseCase_Success(t *testing.T) {
 	// Arrange
 	mockRepo := &mocks.MockUserRepository{
-		ExistsByEmailFunc: func(ctx context.Context, email valueobject.Email) (bool, error) {
+		ExistsByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error) {
 			return false, nil // Email doesn't exist
 		},
 		CreateFunc: func(ctx context.Context, user *entity.User) error {
@@ -31,7 +32,7 @@ func TestSignupUseCase_Success(t *testing.T) {
 	mockHasher := &mocks.MockPasswordHasher{} // Uses default behavior
 	mockJWT := &mocks.MockJWTGenerator{}      // Uses default behavior
 
-	signupUC := auth.NewSignupUseCase(mockRepo, mockHasher, mockJWT)
+	signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, mockHasher, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, false)
 
 	req := usecase.SignupRequest{
 		Email:    "newuser@example.com",
@@ -88,7 +89,7 @@ func TestSignupUseCase_InvalidEmail(t *testing.T) {
 			mockHasher := &mocks.MockPasswordHasher{}
 			mockJWT := &mocks.MockJWTGenerator{}
 
-			signupUC := auth.NewSignupUseCase(mockRepo, mockHasher, mockJWT)
+			signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, mockHasher, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, false)
 
 			req := usecase.SignupRequest{
 				Email:    tt.email,
@@ -145,7 +146,7 @@ func TestSignupUseCase_InvalidPassword(t *testing.T) {
 			mockHasher := &mocks.MockPasswordHasher{}
 			mockJWT := &mocks.MockJWTGenerator{}
 
-			signupUC := auth.NewSignupUseCase(mockRepo, mockHasher, mockJWT)
+			signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, mockHasher, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, false)
 
 			req := usecase.SignupRequest{
 				Email:    "user@example.com",
@@ -170,14 +171,14 @@ func TestSignupUseCase_InvalidPassword(t *testing.T) {
 func TestSignupUseCase_EmailAlreadyExists(t *testing.T) {
 	// Arrange
 	mockRepo := &mocks.MockUserRepository{
-		ExistsByEmailFunc: func(ctx context.Context, email valueobject.Email) (bool, error) {
+		ExistsByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error) {
 			return true, nil // Email exists!
 		},
 	}
 	mockHasher := &mocks.MockPasswordHasher{}
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	signupUC := auth.NewSignupUseCase(mockRepo, mockHasher, mockJWT)
+	signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, mockHasher, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, false)
 
 	req := usecase.SignupRequest{
 		Email:    "existing@example.com",
@@ -201,7 +202,7 @@ func TestSignupUseCase_EmailAlreadyExists(t *testing.T) {
 func TestSignupUseCase_RepositoryCreateError(t *testing.T) {
 	// Arrange
 	mockRepo := &mocks.MockUserRepository{
-		ExistsByEmailFunc: func(ctx context.Context, email valueobject.Email) (bool, error) {
+		ExistsByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error) {
 			return false, nil
 		},
 		CreateFunc: func(ctx context.Context, user *entity.User) error {
@@ -211,7 +212,7 @@ func TestSignupUseCase_RepositoryCreateError(t *testing.T) {
 	mockHasher := &mocks.MockPasswordHasher{}
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	signupUC := auth.NewSignupUseCase(mockRepo, mockHasher, mockJWT)
+	signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, mockHasher, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, false)
 
 	req := usecase.SignupRequest{
 		Email:    "user@example.com",
@@ -232,7 +233,7 @@ func TestSignupUseCase_RaceCondition(t *testing.T) {
 	// Arrange - Simulates race condition
 	// ExistsByEmail returns false, but Create fails with duplicate
 	mockRepo := &mocks.MockUserRepository{
-		ExistsByEmailFunc: func(ctx context.Context, email valueobject.Email) (bool, error) {
+		ExistsByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error) {
 			return false, nil // Check passes
 		},
 		CreateFunc: func(ctx context.Context, user *entity.User) error {
@@ -243,7 +244,7 @@ func TestSignupUseCase_RaceCondition(t *testing.T) {
 	mockHasher := &mocks.MockPasswordHasher{}
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	signupUC := auth.NewSignupUseCase(mockRepo, mockHasher, mockJWT)
+	signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, mockHasher, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, false)
 
 	req := usecase.SignupRequest{
 		Email:    "user@example.com",
@@ -263,7 +264,7 @@ func TestSignupUseCase_RaceCondition(t *testing.T) {
 func TestSignupUseCase_PasswordHashingError(t *testing.T) {
 	// Arrange
 	mockRepo := &mocks.MockUserRepository{
-		ExistsByEmailFunc: func(ctx context.Context, email valueobject.Email) (bool, error) {
+		ExistsByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error) {
 			return false, nil
 		},
 	}
@@ -274,7 +275,7 @@ func TestSignupUseCase_PasswordHashingError(t *testing.T) {
 	}
 	mockJWT := &mocks.MockJWTGenerator{}
 
-	signupUC := auth.NewSignupUseCase(mockRepo, mockHasher, mockJWT)
+	signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, mockHasher, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, false)
 
 	req := usecase.SignupRequest{
 		Email:    "user@example.com",
@@ -297,7 +298,7 @@ func TestSignupUseCase_PasswordHashingError(t *testing.T) {
 func TestSignupUseCase_TokenGenerationError(t *testing.T) {
 	// Arrange
 	mockRepo := &mocks.MockUserRepository{
-		ExistsByEmailFunc: func(ctx context.Context, email valueobject.Email) (bool, error) {
+		ExistsByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error) {
 			return false, nil
 		},
 		CreateFunc: func(ctx context.Context, user *entity.User) error {
@@ -306,12 +307,12 @@ func TestSignupUseCase_TokenGenerationError(t *testing.T) {
 	}
 	mockHasher := &mocks.MockPasswordHasher{}
 	mockJWT := &mocks.MockJWTGenerator{
-		GenerateAccessTokenFunc: func(userID valueobject.UserID, email valueobject.Email) (string, error) {
+		GenerateAccessTokenFunc: func(userID valueobject.UserID, tenantID valueobject.TenantID, email valueobject.Email, roles, permissions []string, authTime time.Time, sessionID string) (string, error) {
 			return "", errors.New("JWT signing key not found")
 		},
 	}
 
-	signupUC := auth.NewSignupUseCase(mockRepo, mockHasher, mockJWT)
+	signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, mockHasher, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, false)
 
 	req := usecase.SignupRequest{
 		Email:    "user@example.com",
@@ -0,0 +1,209 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustTenant(t *testing.T, id string) valueobject.TenantID {
+	t.Helper()
+	tenantID, err := valueobject.NewTenantID(id)
+	require.NoError(t, err)
+	return tenantID
+}
+
+// TestSignupUseCase_CreatesUserInRequestTenant tests signup scoping
+func TestSignupUseCase_CreatesUserInRequestTenant(t *testing.T) {
+	acme := mustTenant(t, "acme")
+
+	var checkedTenant valueobject.TenantID
+	var created *entity.User
+	mockRepo := &mocks.MockUserRepository{
+		ExistsByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error) {
+			checkedTenant = tenantID
+			return false, nil
+		},
+		CreateFunc: func(ctx context.Context, user *entity.User) error {
+			created = user
+			return nil
+		},
+	}
+	mockJWT := &mocks.MockJWTGenerator{}
+	var tokenTenant valueobject.TenantID
+	mockJWT.GenerateAccessTokenFunc = func(userID valueobject.UserID, tenantID valueobject.TenantID, email valueobject.Email, roles, permissions []string, authTime time.Time, sessionID string) (string, error) {
+		tokenTenant = tenantID
+		return "access_token", nil
+	}
+
+	signupUC := auth.NewSignupUseCase(mockRepo, &mocks.MockOrganizationRepository{}, &mocks.MockPasswordHasher{}, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, false)
+
+	ctx := usecase.WithTenant(context.Background(), acme)
+	_, err := signupUC.Execute(ctx, usecase.SignupRequest{Email: "user@example.com", Password: "SecureP@ss123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, acme, checkedTenant, "email uniqueness is checked within the tenant")
+	require.NotNil(t, created)
+	assert.Equal(t, acme, created.TenantID())
+	assert.Equal(t, acme, tokenTenant, "tokens carry the tenant")
+}
+
+// TestSignupUseCase_UnknownTenant tests signup into an organization that doesn't exist
+func TestSignupUseCase_UnknownTenant(t *testing.T) {
+	mockRepo := &mocks.MockUserRepository{}
+	orgRepo := &mocks.MockOrganizationRepository{
+		FindByIDFunc: func(ctx context.Context, id valueobject.TenantID) (*entity.Organization, error) {
+			return nil, repository.ErrOrganizationNotFound
+		},
+	}
+	mockJWT := &mocks.MockJWTGenerator{}
+
+	signupUC := auth.NewSignupUseCase(mockRepo, orgRepo, &mocks.MockPasswordHasher{}, newTokenIssuer(mockJWT), newSendVerification(mockRepo, mockJWT), false, false)
+
+	ctx := usecase.WithTenant(context.Background(), mustTenant(t, "nope"))
+	resp, err := signupUC.Execute(ctx, usecase.SignupRequest{Email: "user@example.com", Password: "SecureP@ss123"})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+	assert.Equal(t, 0, mockRepo.CreateCalls)
+}
+
+// TestLoginUseCase_LooksUpEmailInRequestTenant tests login scoping
+func TestLoginUseCase_LooksUpEmailInRequestTenant(t *testing.T) {
+	acme := mustTenant(t, "acme")
+
+	var lookedUp valueobject.TenantID
+	mockRepo := &mocks.MockUserRepository{
+		FindByEmailFunc: func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (*entity.User, error) {
+			lookedUp = tenantID
+			return nil, repository.ErrUserNotFound
+		},
+	}
+	mockJWT := &mocks.MockJWTGenerator{}
+	loginUC := auth.NewLoginUseCase(mockRepo, &mocks.MockPasswordHasher{}, mockJWT, newTokenIssuer(mockJWT), newLoginThrottle(), false, time.Minute)
+
+	ctx := usecase.WithTenant(context.Background(), acme)
+	_, err := loginUC.Execute(ctx, usecase.LoginRequest{Email: "user@example.com", Password: "SecureP@ss123"})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, acme, lookedUp)
+}
+
+// TestValidateTokenUseCase_Tenant tests cross-tenant token use
+func TestValidateTokenUseCase_Tenant(t *testing.T) {
+	acme := mustTenant(t, "acme")
+	keyRing := security.NewKeyRing(security.NewHMACSigningKey("", []byte("test-secret-key-at-least-32-characters")))
+	generator := security.NewJWTGenerator(keyRing, 15*time.Minute, time.Hour, "test")
+
+	email, _ := valueobject.NewEmail("user@example.com")
+	user, err := entity.NewUser(acme, email, valueobject.NewPasswordFromHash("hashed_SecureP@ss123"))
+	require.NoError(t, err)
+
+	userRepo := &mocks.MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, id valueobject.UserID) (*entity.User, error) {
+			return user, nil
+		},
+	}
+	validateUC := auth.NewValidateTokenUseCase(generator, userRepo, &mocks.MockServiceClientRepository{}, &mocks.MockRevokedTokenRepository{}, &mocks.MockPersonalAccessTokenRepository{}, &mocks.MockSessionRepository{})
+
+	token, err := generator.GenerateAccessToken(user.ID(), user.TenantID(), user.Email(), nil, nil, time.Now(), "")
+	require.NoError(t, err)
+
+	t.Run("same tenant", func(t *testing.T) {
+		claims, err := validateUC.Execute(usecase.WithTenant(context.Background(), acme), token)
+		require.NoError(t, err)
+		assert.Equal(t, "acme", claims.TenantID)
+	})
+
+	t.Run("other tenant", func(t *testing.T) {
+		ctx := usecase.WithTenant(context.Background(), mustTenant(t, "globex"))
+		claims, err := validateUC.Execute(ctx, token)
+		require.Error(t, err)
+		assert.Nil(t, claims)
+		assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	})
+
+	t.Run("no tenant on request", func(t *testing.T) {
+		// Falls back to the default tenant, which isn't the token's
+		_, err := validateUC.Execute(context.Background(), token)
+		assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	})
+
+	t.Run("claim doesn't match the account", func(t *testing.T) {
+		forged, err := generator.GenerateAccessToken(user.ID(), mustTenant(t, "globex"), user.Email(), nil, nil, time.Now(), "")
+		require.NoError(t, err)
+
+		ctx := usecase.WithTenant(context.Background(), mustTenant(t, "globex"))
+		_, err = validateUC.Execute(ctx, forged)
+		assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	})
+}
+
+// TestValidateTokenUseCase_LegacyTokenWithoutTenant tests tokens issued before multi-tenancy
+func TestValidateTokenUseCase_LegacyTokenWithoutTenant(t *testing.T) {
+	signingKey := security.NewHMACSigningKey("", []byte("test-secret-key-at-least-32-characters"))
+	generator := security.NewJWTGenerator(security.NewKeyRing(signingKey), 15*time.Minute, time.Hour, "test")
+
+	email, _ := valueobject.NewEmail("user@example.com")
+	user, err := entity.NewUser(valueobject.DefaultTenantID(), email, valueobject.NewPasswordFromHash("hashed_SecureP@ss123"))
+	require.NoError(t, err)
+
+	userRepo := &mocks.MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, id valueobject.UserID) (*entity.User, error) {
+			return user, nil
+		},
+	}
+	validateUC := auth.NewValidateTokenUseCase(generator, userRepo, &mocks.MockServiceClientRepository{}, &mocks.MockRevokedTokenRepository{}, &mocks.MockPersonalAccessTokenRepository{}, &mocks.MockSessionRepository{})
+
+	// Same claims as before the tenant_id claim existed
+	now := time.Now()
+	legacy := jwt.NewWithClaims(jwt.SigningMethodHS256, security.Claims{
+		UserID:   user.ID().String(),
+		Email:    user.Email().String(),
+		TokenUse: security.TokenUseAccess,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    "test",
+		},
+	})
+	token, err := legacy.SignedString([]byte("test-secret-key-at-least-32-characters"))
+	require.NoError(t, err)
+
+	claims, err := validateUC.Execute(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.DefaultTenant, claims.TenantID)
+}
+
+// TestAdminUseCases_HideOtherTenantsUsers tests admin operations across tenants
+func TestAdminUseCases_HideOtherTenantsUsers(t *testing.T) {
+	// Arrange - user in the default tenant, admin in another
+	env := newTestEnv(t)
+	getUC := auth.NewGetUserUseCase(env.userRepo, env.auditLog())
+	deleteUC := newDeleteUserUseCase(env)
+	ctx := usecase.WithTenant(context.Background(), mustTenant(t, "acme"))
+
+	// Act & Assert
+	_, err := getUC.Execute(ctx, usecase.GetUserRequest{ActorID: adminID, UserID: env.user.ID().String()})
+	assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+
+	err = deleteUC.Execute(ctx, adminRequest(env.user))
+	assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+	assert.Equal(t, 0, env.userRepo.DeleteCalls)
+	assert.Empty(t, env.auditRepo.Events)
+}
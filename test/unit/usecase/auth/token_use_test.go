@@ -0,0 +1,90 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/Ruseigha/LabukaAuth/test/unit/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// issueRawTokens issues a real access and refresh token pair for the stored user
+// WHY: Straight from the generator, so token_use enforcement is exercised end to end
+func issueRawTokens(t *testing.T, env *testEnv) (accessToken, refreshToken string) {
+	t.Helper()
+
+	accessToken, err := env.jwt.GenerateAccessToken(env.user.ID(), env.user.TenantID(), env.user.Email(), nil, nil, time.Now(), "")
+	require.NoError(t, err)
+	refreshToken, err = env.jwt.GenerateRefreshToken(env.user.ID(), env.user.TenantID(), time.Now())
+	require.NoError(t, err)
+	return accessToken, refreshToken
+}
+
+// TestValidateTokenUseCase_RejectsRefreshToken tests refresh token on a protected endpoint
+func TestValidateTokenUseCase_RejectsRefreshToken(t *testing.T) {
+	env := newTestEnv(t)
+	accessToken, refreshToken := issueRawTokens(t, env)
+	validateUC := auth.NewValidateTokenUseCase(env.jwt, env.userRepo, env.serviceClientRepo, &mocks.MockRevokedTokenRepository{}, &mocks.MockPersonalAccessTokenRepository{}, env.sessionRepo)
+
+	// Access token passes
+	claims, err := validateUC.Execute(context.Background(), accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, env.user.ID().String(), claims.UserID)
+
+	// Refresh token is rejected
+	claims, err = validateUC.Execute(context.Background(), refreshToken)
+	require.Error(t, err)
+	assert.Nil(t, claims)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, 1, env.userRepo.FindByIDCalls, "user lookup only for the access token")
+}
+
+// TestRefreshTokenUseCase_RejectsAccessToken tests access token on /auth/refresh
+func TestRefreshTokenUseCase_RejectsAccessToken(t *testing.T) {
+	env := newTestEnv(t)
+	accessToken, _ := issueRawTokens(t, env)
+	refreshUC := auth.NewRefreshTokenUseCase(env.userRepo, env.jwt, env.refreshTokenRepo, env.tokenIssuer())
+
+	resp, err := refreshUC.Execute(context.Background(), accessToken)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, 0, env.refreshTokenRepo.FindByHashCalls)
+}
+
+// TestLogoutUseCase_RejectsSwappedTokens tests logout with the tokens in the wrong slots
+func TestLogoutUseCase_RejectsSwappedTokens(t *testing.T) {
+	env := newTestEnv(t)
+	accessToken, refreshToken := issueRawTokens(t, env)
+	revokedRepo := &mocks.MockRevokedTokenRepository{}
+	logoutUC := auth.NewLogoutUseCase(env.jwt, auth.NewRevokeTokenUseCase(env.jwt, revokedRepo, env.refreshTokenRepo, env.sessions()))
+
+	err := logoutUC.Execute(context.Background(), usecase.LogoutRequest{
+		AccessToken:  refreshToken,
+		RefreshToken: accessToken,
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrUnauthorized))
+	assert.Equal(t, 0, revokedRepo.CreateCalls)
+}
+
+// TestRevokeTokenUseCase_AcceptsEitherKind tests that revoke takes both token kinds
+func TestRevokeTokenUseCase_AcceptsEitherKind(t *testing.T) {
+	env := newTestEnv(t)
+	accessToken, refreshToken := issueRawTokens(t, env)
+	revokedRepo := &mocks.MockRevokedTokenRepository{}
+	revokeUC := auth.NewRevokeTokenUseCase(env.jwt, revokedRepo, env.refreshTokenRepo, env.sessions())
+
+	require.NoError(t, revokeUC.Execute(context.Background(), accessToken))
+	require.NoError(t, revokeUC.Execute(context.Background(), refreshToken))
+
+	assert.Equal(t, 2, revokedRepo.CreateCalls)
+}
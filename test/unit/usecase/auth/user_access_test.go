@@ -0,0 +1,110 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	domainErrors "github.com/Ruseigha/LabukaAuth/internal/domain/errors"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase"
+	"github.com/Ruseigha/LabukaAuth/internal/usecase/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accessRequest targets the stored user on behalf of an admin
+func accessRequest(env *testEnv, name string) usecase.UserAccessRequest {
+	return usecase.UserAccessRequest{
+		ActorID: "admin-id",
+		UserID:  env.user.ID().String(),
+		Name:    name,
+	}
+}
+
+func TestUpdateUserAccess_GrantAndRevoke(t *testing.T) {
+	env := newTestEnv(t)
+	getUC := auth.NewGetUserAccessUseCase(env.userRepo)
+	updateUC := auth.NewUpdateUserAccessUseCase(env.userRepo, env.auditLog())
+	ctx := context.Background()
+
+	access, err := updateUC.Execute(ctx, accessRequest(env, "support"), auth.GrantRole)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"support"}, access.Roles)
+	assert.Equal(t, 1, env.userRepo.UpdateCalls)
+
+	access, err = updateUC.Execute(ctx, accessRequest(env, "tickets:read"), auth.GrantPermission)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tickets:read"}, access.Permissions)
+
+	access, err = updateUC.Execute(ctx, accessRequest(env, "support"), auth.RevokeRole)
+	require.NoError(t, err)
+	assert.Empty(t, access.Roles)
+
+	access, err = getUC.Execute(ctx, env.user.ID().String())
+	require.NoError(t, err)
+	assert.Empty(t, access.Roles)
+	assert.Equal(t, []string{"tickets:read"}, access.Permissions)
+
+	require.Len(t, env.auditRepo.Events, 3)
+	event := env.auditRepo.Events[0]
+	assert.Equal(t, entity.AuditActionGrantRole, event.Action())
+	assert.Equal(t, "admin-id", event.ActorID())
+	assert.Equal(t, env.user.ID().String(), event.TargetUserID())
+	assert.Equal(t, "support", event.Details()["name"])
+	assert.Equal(t, entity.AuditActionRevokeRole, env.auditRepo.Events[2].Action())
+}
+
+func TestUpdateUserAccess_Idempotent(t *testing.T) {
+	env := newTestEnv(t)
+	updateUC := auth.NewUpdateUserAccessUseCase(env.userRepo, env.auditLog())
+	_, _ = env.user.GrantRole("support")
+
+	_, err := updateUC.Execute(context.Background(), accessRequest(env, "support"), auth.GrantRole)
+	require.NoError(t, err)
+
+	_, err = updateUC.Execute(context.Background(), accessRequest(env, "billing"), auth.RevokeRole)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, env.userRepo.UpdateCalls, "unchanged grants should not be saved")
+}
+
+func TestUpdateUserAccess_InvalidName(t *testing.T) {
+	env := newTestEnv(t)
+	updateUC := auth.NewUpdateUserAccessUseCase(env.userRepo, env.auditLog())
+
+	_, err := updateUC.Execute(context.Background(), accessRequest(env, "Not Valid"), auth.GrantRole)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrInvalidInput))
+	assert.Equal(t, 0, env.userRepo.UpdateCalls)
+}
+
+func TestUpdateUserAccess_CannotRevokeOwnAdmin(t *testing.T) {
+	env := newTestEnv(t)
+	updateUC := auth.NewUpdateUserAccessUseCase(env.userRepo, env.auditLog())
+	_, _ = env.user.GrantRole(entity.RoleAdmin)
+
+	req := accessRequest(env, entity.RoleAdmin)
+	req.ActorID = env.user.ID().String()
+
+	_, err := updateUC.Execute(context.Background(), req, auth.RevokeRole)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrForbidden))
+	assert.True(t, env.user.HasRole(entity.RoleAdmin))
+}
+
+func TestUpdateUserAccess_UserNotFound(t *testing.T) {
+	env := newTestEnv(t)
+	updateUC := auth.NewUpdateUserAccessUseCase(env.userRepo, env.auditLog())
+
+	req := accessRequest(env, "support")
+	req.UserID = valueobject.NewUserID().String()
+
+	_, err := updateUC.Execute(context.Background(), req, auth.GrantRole)
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, domainErrors.ErrNotFound))
+}
@@ -0,0 +1,27 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+)
+
+// MockAuditLogRepository is an in-memory AuditLogRepository
+type MockAuditLogRepository struct {
+	AppendFunc func(ctx context.Context, event *entity.AuditEvent) error
+
+	AppendCalls int
+
+	// Events holds appended events in order when no Func override is set
+	Events []*entity.AuditEvent
+}
+
+// Append implements repository.AuditLogRepository
+func (m *MockAuditLogRepository) Append(ctx context.Context, event *entity.AuditEvent) error {
+	m.AppendCalls++
+	if m.AppendFunc != nil {
+		return m.AppendFunc(ctx, event)
+	}
+	m.Events = append(m.Events, event)
+	return nil
+}
@@ -0,0 +1,60 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// MockAuthorizationCodeRepository is an in-memory AuthorizationCodeRepository
+type MockAuthorizationCodeRepository struct {
+	CreateFunc     func(ctx context.Context, code *entity.AuthorizationCode) error
+	FindByHashFunc func(ctx context.Context, codeHash string) (*entity.AuthorizationCode, error)
+	MarkUsedFunc   func(ctx context.Context, codeHash string, familyID string) error
+
+	CreateCalls     int
+	FindByHashCalls int
+	MarkUsedCalls   int
+
+	// Codes holds stored codes by hash when no Func overrides are set
+	Codes map[string]*entity.AuthorizationCode
+}
+
+// Create implements repository.AuthorizationCodeRepository
+func (m *MockAuthorizationCodeRepository) Create(ctx context.Context, code *entity.AuthorizationCode) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, code)
+	}
+	if m.Codes == nil {
+		m.Codes = make(map[string]*entity.AuthorizationCode)
+	}
+	m.Codes[code.CodeHash()] = code
+	return nil
+}
+
+// FindByHash implements repository.AuthorizationCodeRepository
+func (m *MockAuthorizationCodeRepository) FindByHash(ctx context.Context, codeHash string) (*entity.AuthorizationCode, error) {
+	m.FindByHashCalls++
+	if m.FindByHashFunc != nil {
+		return m.FindByHashFunc(ctx, codeHash)
+	}
+	if code, ok := m.Codes[codeHash]; ok {
+		return code, nil
+	}
+	return nil, repository.ErrTokenNotFound
+}
+
+// MarkUsed implements repository.AuthorizationCodeRepository
+func (m *MockAuthorizationCodeRepository) MarkUsed(ctx context.Context, codeHash string, familyID string) error {
+	m.MarkUsedCalls++
+	if m.MarkUsedFunc != nil {
+		return m.MarkUsedFunc(ctx, codeHash, familyID)
+	}
+	code, ok := m.Codes[codeHash]
+	if !ok || code.MarkUsed(familyID) != nil {
+		return repository.ErrTokenAlreadyUsed
+	}
+	return nil
+}
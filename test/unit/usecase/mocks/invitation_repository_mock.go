@@ -0,0 +1,113 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// MockInvitationRepository is an in-memory InvitationRepository
+type MockInvitationRepository struct {
+	CreateFunc       func(ctx context.Context, invitation *entity.Invitation) error
+	FindByIDFunc     func(ctx context.Context, id string) (*entity.Invitation, error)
+	FindByHashFunc   func(ctx context.Context, tokenHash string) (*entity.Invitation, error)
+	ListByTenantFunc func(ctx context.Context, tenantID valueobject.TenantID) ([]*entity.Invitation, error)
+	MarkAcceptedFunc func(ctx context.Context, id string, userID valueobject.UserID) error
+	RevokeFunc       func(ctx context.Context, id string) error
+
+	CreateCalls       int
+	FindByIDCalls     int
+	FindByHashCalls   int
+	ListByTenantCalls int
+	MarkAcceptedCalls int
+	RevokeCalls       int
+
+	// Invitations holds stored invitations by ID when no Func overrides are set
+	Invitations map[string]*entity.Invitation
+}
+
+// Create implements repository.InvitationRepository
+func (m *MockInvitationRepository) Create(ctx context.Context, invitation *entity.Invitation) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, invitation)
+	}
+	if m.Invitations == nil {
+		m.Invitations = make(map[string]*entity.Invitation)
+	}
+	m.Invitations[invitation.ID()] = invitation
+	return nil
+}
+
+// FindByID implements repository.InvitationRepository
+func (m *MockInvitationRepository) FindByID(ctx context.Context, id string) (*entity.Invitation, error) {
+	m.FindByIDCalls++
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	if invitation, ok := m.Invitations[id]; ok {
+		return invitation, nil
+	}
+	return nil, repository.ErrInvitationNotFound
+}
+
+// FindByHash implements repository.InvitationRepository
+func (m *MockInvitationRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.Invitation, error) {
+	m.FindByHashCalls++
+	if m.FindByHashFunc != nil {
+		return m.FindByHashFunc(ctx, tokenHash)
+	}
+	for _, invitation := range m.Invitations {
+		if invitation.TokenHash() == tokenHash {
+			return invitation, nil
+		}
+	}
+	return nil, repository.ErrInvitationNotFound
+}
+
+// ListByTenant implements repository.InvitationRepository
+func (m *MockInvitationRepository) ListByTenant(ctx context.Context, tenantID valueobject.TenantID) ([]*entity.Invitation, error) {
+	m.ListByTenantCalls++
+	if m.ListByTenantFunc != nil {
+		return m.ListByTenantFunc(ctx, tenantID)
+	}
+	var invitations []*entity.Invitation
+	for _, invitation := range m.Invitations {
+		if invitation.TenantID().Equals(tenantID) {
+			invitations = append(invitations, invitation)
+		}
+	}
+	sort.Slice(invitations, func(i, j int) bool {
+		return invitations[i].CreatedAt().After(invitations[j].CreatedAt())
+	})
+	return invitations, nil
+}
+
+// MarkAccepted implements repository.InvitationRepository
+func (m *MockInvitationRepository) MarkAccepted(ctx context.Context, id string, userID valueobject.UserID) error {
+	m.MarkAcceptedCalls++
+	if m.MarkAcceptedFunc != nil {
+		return m.MarkAcceptedFunc(ctx, id, userID)
+	}
+	invitation, ok := m.Invitations[id]
+	if !ok || invitation.MarkAccepted(userID) != nil {
+		return repository.ErrTokenAlreadyUsed
+	}
+	return nil
+}
+
+// Revoke implements repository.InvitationRepository
+func (m *MockInvitationRepository) Revoke(ctx context.Context, id string) error {
+	m.RevokeCalls++
+	if m.RevokeFunc != nil {
+		return m.RevokeFunc(ctx, id)
+	}
+	invitation, ok := m.Invitations[id]
+	if !ok || invitation.Revoke() != nil {
+		return repository.ErrTokenAlreadyUsed
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"errors"
+	"time"
 
 	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
 	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/security"
@@ -9,43 +10,123 @@ import (
 
 // MockJWTGenerator is a mock implementation of JWTGenerator
 type MockJWTGenerator struct {
-	GenerateAccessTokenFunc  func(userID valueobject.UserID, email valueobject.Email) (string, error)
-	GenerateRefreshTokenFunc func(userID valueobject.UserID) (string, error)
-	ValidateTokenFunc        func(tokenString string) (*security.Claims, error)
+	GenerateAccessTokenFunc  func(userID valueobject.UserID, tenantID valueobject.TenantID, email valueobject.Email, roles, permissions []string, authTime time.Time, sessionID string) (string, error)
+	GenerateRefreshTokenFunc func(userID valueobject.UserID, tenantID valueobject.TenantID, authTime time.Time) (string, error)
+	ValidateTokenFunc        func(tokenString string, expectedUse security.TokenUse) (*security.Claims, error)
+	GenerateActionTokenFunc  func(userID valueobject.UserID, email valueobject.Email, use security.TokenUse, expiry time.Duration) (string, error)
 
-	GenerateAccessTokenCalls  int
-	GenerateRefreshTokenCalls int
-	ValidateTokenCalls        int
+	GenerateClientAccessTokenFunc  func(userID valueobject.UserID, tenantID valueobject.TenantID, email valueobject.Email, roles, permissions []string, authTime time.Time, sessionID string, grant security.ClientGrant) (string, error)
+	GenerateClientRefreshTokenFunc func(userID valueobject.UserID, tenantID valueobject.TenantID, authTime time.Time, grant security.ClientGrant) (string, error)
+	GenerateIDTokenFunc            func(idToken security.IDToken) (string, error)
+	GenerateServiceAccessTokenFunc func(clientID string, tenantID valueobject.TenantID, scopes []string) (string, error)
+
+	GenerateAccessTokenCalls        int
+	GenerateRefreshTokenCalls       int
+	ValidateTokenCalls              int
+	GenerateActionTokenCalls        int
+	GenerateClientAccessTokenCalls  int
+	GenerateClientRefreshTokenCalls int
+	GenerateIDTokenCalls            int
+	GenerateServiceAccessTokenCalls int
 }
 
 // GenerateAccessToken implements security.JWTGenerator
 func (m *MockJWTGenerator) GenerateAccessToken(
 	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
 	email valueobject.Email,
+	roles []string,
+	permissions []string,
+	authTime time.Time,
+	sessionID string,
 ) (string, error) {
 	m.GenerateAccessTokenCalls++
 	if m.GenerateAccessTokenFunc != nil {
-		return m.GenerateAccessTokenFunc(userID, email)
+		return m.GenerateAccessTokenFunc(userID, tenantID, email, roles, permissions, authTime, sessionID)
 	}
 	// Default: return predictable token
 	return "access_token_" + userID.String(), nil
 }
 
 // GenerateRefreshToken implements security.JWTGenerator
-func (m *MockJWTGenerator) GenerateRefreshToken(userID valueobject.UserID) (string, error) {
+func (m *MockJWTGenerator) GenerateRefreshToken(userID valueobject.UserID, tenantID valueobject.TenantID, authTime time.Time) (string, error) {
 	m.GenerateRefreshTokenCalls++
 	if m.GenerateRefreshTokenFunc != nil {
-		return m.GenerateRefreshTokenFunc(userID)
+		return m.GenerateRefreshTokenFunc(userID, tenantID, authTime)
 	}
 	// Default: return predictable token
 	return "refresh_token_" + userID.String(), nil
 }
 
+// GenerateClientAccessToken implements security.JWTGenerator
+func (m *MockJWTGenerator) GenerateClientAccessToken(
+	userID valueobject.UserID,
+	tenantID valueobject.TenantID,
+	email valueobject.Email,
+	roles []string,
+	permissions []string,
+	authTime time.Time,
+	sessionID string,
+	grant security.ClientGrant,
+) (string, error) {
+	m.GenerateClientAccessTokenCalls++
+	if m.GenerateClientAccessTokenFunc != nil {
+		return m.GenerateClientAccessTokenFunc(userID, tenantID, email, roles, permissions, authTime, sessionID, grant)
+	}
+	// Default: return predictable token
+	return "access_token_" + grant.ClientID + "_" + userID.String(), nil
+}
+
+// GenerateClientRefreshToken implements security.JWTGenerator
+func (m *MockJWTGenerator) GenerateClientRefreshToken(userID valueobject.UserID, tenantID valueobject.TenantID, authTime time.Time, grant security.ClientGrant) (string, error) {
+	m.GenerateClientRefreshTokenCalls++
+	if m.GenerateClientRefreshTokenFunc != nil {
+		return m.GenerateClientRefreshTokenFunc(userID, tenantID, authTime, grant)
+	}
+	// Default: return predictable token
+	return "refresh_token_" + grant.ClientID + "_" + userID.String(), nil
+}
+
+// GenerateIDToken implements security.JWTGenerator
+func (m *MockJWTGenerator) GenerateIDToken(idToken security.IDToken) (string, error) {
+	m.GenerateIDTokenCalls++
+	if m.GenerateIDTokenFunc != nil {
+		return m.GenerateIDTokenFunc(idToken)
+	}
+	// Default: return predictable token
+	return "id_token_" + idToken.ClientID + "_" + idToken.UserID.String(), nil
+}
+
+// GenerateServiceAccessToken implements security.JWTGenerator
+func (m *MockJWTGenerator) GenerateServiceAccessToken(clientID string, tenantID valueobject.TenantID, scopes []string) (string, error) {
+	m.GenerateServiceAccessTokenCalls++
+	if m.GenerateServiceAccessTokenFunc != nil {
+		return m.GenerateServiceAccessTokenFunc(clientID, tenantID, scopes)
+	}
+	// Default: return predictable token
+	return "service_token_" + clientID, nil
+}
+
+// GenerateActionToken implements security.JWTGenerator
+func (m *MockJWTGenerator) GenerateActionToken(
+	userID valueobject.UserID,
+	email valueobject.Email,
+	use security.TokenUse,
+	expiry time.Duration,
+) (string, error) {
+	m.GenerateActionTokenCalls++
+	if m.GenerateActionTokenFunc != nil {
+		return m.GenerateActionTokenFunc(userID, email, use, expiry)
+	}
+	// Default: return predictable token
+	return string(use) + "_token_" + userID.String(), nil
+}
+
 // ValidateToken implements security.JWTGenerator
-func (m *MockJWTGenerator) ValidateToken(tokenString string) (*security.Claims, error) {
+func (m *MockJWTGenerator) ValidateToken(tokenString string, expectedUse security.TokenUse) (*security.Claims, error) {
 	m.ValidateTokenCalls++
 	if m.ValidateTokenFunc != nil {
-		return m.ValidateTokenFunc(tokenString)
+		return m.ValidateTokenFunc(tokenString, expectedUse)
 	}
 	return nil, errors.New("invalid token")
 }
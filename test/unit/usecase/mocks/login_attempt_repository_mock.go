@@ -0,0 +1,95 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+// MockLoginAttemptRepository is an in-memory LoginAttemptRepository
+type MockLoginAttemptRepository struct {
+	FindByKeysFunc    func(ctx context.Context, keys []string) ([]*entity.LoginAttempt, error)
+	RecordAttemptFunc func(ctx context.Context, key string, tenantID valueobject.TenantID, email valueobject.Email, expiresAt time.Time) (*entity.LoginAttempt, error)
+	DeleteByKeysFunc  func(ctx context.Context, keys []string) error
+	DeleteByEmailFunc func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) error
+
+	FindByKeysCalls    int
+	RecordAttemptCalls int
+	DeleteByKeysCalls  int
+	DeleteByEmailCalls int
+
+	// Attempts holds counters by key when no Func overrides are set
+	Attempts map[string]*entity.LoginAttempt
+}
+
+// FindByKeys implements repository.LoginAttemptRepository
+func (m *MockLoginAttemptRepository) FindByKeys(ctx context.Context, keys []string) ([]*entity.LoginAttempt, error) {
+	m.FindByKeysCalls++
+	if m.FindByKeysFunc != nil {
+		return m.FindByKeysFunc(ctx, keys)
+	}
+
+	var attempts []*entity.LoginAttempt
+	for _, key := range keys {
+		if attempt, ok := m.Attempts[key]; ok {
+			attempts = append(attempts, attempt)
+		}
+	}
+	return attempts, nil
+}
+
+// RecordAttempt implements repository.LoginAttemptRepository
+func (m *MockLoginAttemptRepository) RecordAttempt(ctx context.Context, key string, tenantID valueobject.TenantID, email valueobject.Email, expiresAt time.Time) (*entity.LoginAttempt, error) {
+	m.RecordAttemptCalls++
+	if m.RecordAttemptFunc != nil {
+		return m.RecordAttemptFunc(ctx, key, tenantID, email, expiresAt)
+	}
+	if m.Attempts == nil {
+		m.Attempts = make(map[string]*entity.LoginAttempt)
+	}
+
+	previous := m.Attempts[key]
+	failures := 1
+	if previous != nil {
+		failures = previous.Failures() + 1
+	}
+
+	m.Attempts[key] = entity.ReconstructLoginAttempt(key, tenantID, email.String(), failures, time.Now().UTC(), expiresAt)
+	return previous, nil
+}
+
+// DeleteByKeys implements repository.LoginAttemptRepository
+func (m *MockLoginAttemptRepository) DeleteByKeys(ctx context.Context, keys []string) error {
+	m.DeleteByKeysCalls++
+	if m.DeleteByKeysFunc != nil {
+		return m.DeleteByKeysFunc(ctx, keys)
+	}
+	for _, key := range keys {
+		delete(m.Attempts, key)
+	}
+	return nil
+}
+
+// DeleteByEmail implements repository.LoginAttemptRepository
+func (m *MockLoginAttemptRepository) DeleteByEmail(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) error {
+	m.DeleteByEmailCalls++
+	if m.DeleteByEmailFunc != nil {
+		return m.DeleteByEmailFunc(ctx, tenantID, email)
+	}
+	for key, attempt := range m.Attempts {
+		if attempt.TenantID().Equals(tenantID) && attempt.Email() == email.String() {
+			delete(m.Attempts, key)
+		}
+	}
+	return nil
+}
+
+// SetFailures stores a default-tenant counter whose last failure happened at lastFailureAt
+func (m *MockLoginAttemptRepository) SetFailures(key, email string, failures int, lastFailureAt time.Time) {
+	if m.Attempts == nil {
+		m.Attempts = make(map[string]*entity.LoginAttempt)
+	}
+	m.Attempts[key] = entity.ReconstructLoginAttempt(key, valueobject.DefaultTenantID(), email, failures, lastFailureAt, lastFailureAt.Add(time.Hour))
+}
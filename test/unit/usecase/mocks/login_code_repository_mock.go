@@ -0,0 +1,64 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// MockLoginCodeRepository is an in-memory LoginCodeRepository
+type MockLoginCodeRepository struct {
+	CreateFunc         func(ctx context.Context, code *entity.LoginCode) error
+	ConsumeFunc        func(ctx context.Context, codeHash string) (*entity.LoginCode, error)
+	DeleteByUserIDFunc func(ctx context.Context, userID valueobject.UserID) error
+
+	CreateCalls         int
+	ConsumeCalls        int
+	DeleteByUserIDCalls int
+
+	// Codes holds stored codes by hash when no Func overrides are set
+	Codes map[string]*entity.LoginCode
+}
+
+// Create implements repository.LoginCodeRepository
+func (m *MockLoginCodeRepository) Create(ctx context.Context, code *entity.LoginCode) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, code)
+	}
+	if m.Codes == nil {
+		m.Codes = make(map[string]*entity.LoginCode)
+	}
+	m.Codes[code.CodeHash()] = code
+	return nil
+}
+
+// Consume implements repository.LoginCodeRepository
+func (m *MockLoginCodeRepository) Consume(ctx context.Context, codeHash string) (*entity.LoginCode, error) {
+	m.ConsumeCalls++
+	if m.ConsumeFunc != nil {
+		return m.ConsumeFunc(ctx, codeHash)
+	}
+	code, ok := m.Codes[codeHash]
+	if !ok {
+		return nil, repository.ErrTokenNotFound
+	}
+	delete(m.Codes, codeHash)
+	return code, nil
+}
+
+// DeleteByUserID implements repository.LoginCodeRepository
+func (m *MockLoginCodeRepository) DeleteByUserID(ctx context.Context, userID valueobject.UserID) error {
+	m.DeleteByUserIDCalls++
+	if m.DeleteByUserIDFunc != nil {
+		return m.DeleteByUserIDFunc(ctx, userID)
+	}
+	for hash, code := range m.Codes {
+		if code.UserID().Equals(userID) {
+			delete(m.Codes, hash)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/infrastructure/mail"
+)
+
+// MockMailer is a mock implementation of mail.Mailer
+// WHY: Default behavior records messages so tests can read links out of them
+type MockMailer struct {
+	SendFunc func(ctx context.Context, msg mail.Message) error
+
+	SendCalls int
+	Sent      []mail.Message
+}
+
+// Send implements mail.Mailer
+func (m *MockMailer) Send(ctx context.Context, msg mail.Message) error {
+	m.SendCalls++
+	if m.SendFunc != nil {
+		return m.SendFunc(ctx, msg)
+	}
+	m.Sent = append(m.Sent, msg)
+	return nil
+}
@@ -0,0 +1,45 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// MockOAuthClientRepository is an in-memory OAuthClientRepository
+type MockOAuthClientRepository struct {
+	CreateFunc   func(ctx context.Context, client *entity.OAuthClient) error
+	FindByIDFunc func(ctx context.Context, clientID string) (*entity.OAuthClient, error)
+
+	CreateCalls   int
+	FindByIDCalls int
+
+	// Clients holds stored clients by ID when no Func overrides are set
+	Clients map[string]*entity.OAuthClient
+}
+
+// Create implements repository.OAuthClientRepository
+func (m *MockOAuthClientRepository) Create(ctx context.Context, client *entity.OAuthClient) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, client)
+	}
+	if m.Clients == nil {
+		m.Clients = make(map[string]*entity.OAuthClient)
+	}
+	m.Clients[client.ID()] = client
+	return nil
+}
+
+// FindByID implements repository.OAuthClientRepository
+func (m *MockOAuthClientRepository) FindByID(ctx context.Context, clientID string) (*entity.OAuthClient, error) {
+	m.FindByIDCalls++
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, clientID)
+	}
+	if client, ok := m.Clients[clientID]; ok {
+		return client, nil
+	}
+	return nil, repository.ErrOAuthClientNotFound
+}
@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+)
+
+// MockOrganizationRepository is an OrganizationRepository where every
+// tenant exists unless a Func override says otherwise
+type MockOrganizationRepository struct {
+	CreateFunc   func(ctx context.Context, org *entity.Organization) error
+	FindByIDFunc func(ctx context.Context, id valueobject.TenantID) (*entity.Organization, error)
+
+	CreateCalls   int
+	FindByIDCalls int
+}
+
+// Create implements repository.OrganizationRepository
+func (m *MockOrganizationRepository) Create(ctx context.Context, org *entity.Organization) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, org)
+	}
+	return nil
+}
+
+// FindByID implements repository.OrganizationRepository
+func (m *MockOrganizationRepository) FindByID(ctx context.Context, id valueobject.TenantID) (*entity.Organization, error) {
+	m.FindByIDCalls++
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	now := time.Now().UTC()
+	return entity.ReconstructOrganization(id, id.String(), now, now), nil
+}
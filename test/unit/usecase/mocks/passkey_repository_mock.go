@@ -0,0 +1,139 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// MockPasskeyRepository is an in-memory PasskeyRepository
+type MockPasskeyRepository struct {
+	CreateFunc         func(ctx context.Context, passkey *entity.Passkey) error
+	FindByUserIDFunc   func(ctx context.Context, userID valueobject.UserID) ([]*entity.Passkey, error)
+	UpdateFunc         func(ctx context.Context, passkey *entity.Passkey) error
+	DeleteFunc         func(ctx context.Context, userID valueobject.UserID, credentialID []byte) error
+	DeleteByUserIDFunc func(ctx context.Context, userID valueobject.UserID) error
+
+	CreateCalls         int
+	FindByUserIDCalls   int
+	UpdateCalls         int
+	DeleteCalls         int
+	DeleteByUserIDCalls int
+
+	// Passkeys holds stored passkeys by ID when no Func overrides are set
+	Passkeys map[string]*entity.Passkey
+}
+
+// Create implements repository.PasskeyRepository
+func (m *MockPasskeyRepository) Create(ctx context.Context, passkey *entity.Passkey) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, passkey)
+	}
+	if m.Passkeys == nil {
+		m.Passkeys = make(map[string]*entity.Passkey)
+	}
+	if _, ok := m.Passkeys[passkey.ID()]; ok {
+		return repository.ErrPasskeyExists
+	}
+	m.Passkeys[passkey.ID()] = passkey
+	return nil
+}
+
+// FindByUserID implements repository.PasskeyRepository
+func (m *MockPasskeyRepository) FindByUserID(ctx context.Context, userID valueobject.UserID) ([]*entity.Passkey, error) {
+	m.FindByUserIDCalls++
+	if m.FindByUserIDFunc != nil {
+		return m.FindByUserIDFunc(ctx, userID)
+	}
+	var passkeys []*entity.Passkey
+	for _, passkey := range m.Passkeys {
+		if passkey.UserID().Equals(userID) {
+			passkeys = append(passkeys, passkey)
+		}
+	}
+	return passkeys, nil
+}
+
+// Update implements repository.PasskeyRepository
+func (m *MockPasskeyRepository) Update(ctx context.Context, passkey *entity.Passkey) error {
+	m.UpdateCalls++
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, passkey)
+	}
+	if _, ok := m.Passkeys[passkey.ID()]; !ok {
+		return repository.ErrPasskeyNotFound
+	}
+	m.Passkeys[passkey.ID()] = passkey
+	return nil
+}
+
+// Delete implements repository.PasskeyRepository
+func (m *MockPasskeyRepository) Delete(ctx context.Context, userID valueobject.UserID, credentialID []byte) error {
+	m.DeleteCalls++
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, credentialID)
+	}
+	id := entity.EncodePasskeyID(credentialID)
+	passkey, ok := m.Passkeys[id]
+	if !ok || !passkey.UserID().Equals(userID) {
+		return repository.ErrPasskeyNotFound
+	}
+	delete(m.Passkeys, id)
+	return nil
+}
+
+// DeleteByUserID implements repository.PasskeyRepository
+func (m *MockPasskeyRepository) DeleteByUserID(ctx context.Context, userID valueobject.UserID) error {
+	m.DeleteByUserIDCalls++
+	if m.DeleteByUserIDFunc != nil {
+		return m.DeleteByUserIDFunc(ctx, userID)
+	}
+	for id, passkey := range m.Passkeys {
+		if passkey.UserID().Equals(userID) {
+			delete(m.Passkeys, id)
+		}
+	}
+	return nil
+}
+
+// MockPasskeyChallengeRepository is an in-memory PasskeyChallengeRepository
+type MockPasskeyChallengeRepository struct {
+	CreateFunc  func(ctx context.Context, challenge *entity.PasskeyChallenge) error
+	ConsumeFunc func(ctx context.Context, idHash string) (*entity.PasskeyChallenge, error)
+
+	CreateCalls  int
+	ConsumeCalls int
+
+	// Challenges holds pending challenges by ID hash when no Func overrides are set
+	Challenges map[string]*entity.PasskeyChallenge
+}
+
+// Create implements repository.PasskeyChallengeRepository
+func (m *MockPasskeyChallengeRepository) Create(ctx context.Context, challenge *entity.PasskeyChallenge) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, challenge)
+	}
+	if m.Challenges == nil {
+		m.Challenges = make(map[string]*entity.PasskeyChallenge)
+	}
+	m.Challenges[challenge.IDHash()] = challenge
+	return nil
+}
+
+// Consume implements repository.PasskeyChallengeRepository
+func (m *MockPasskeyChallengeRepository) Consume(ctx context.Context, idHash string) (*entity.PasskeyChallenge, error) {
+	m.ConsumeCalls++
+	if m.ConsumeFunc != nil {
+		return m.ConsumeFunc(ctx, idHash)
+	}
+	challenge, ok := m.Challenges[idHash]
+	if !ok {
+		return nil, repository.ErrTokenNotFound
+	}
+	delete(m.Challenges, idHash)
+	return challenge, nil
+}
@@ -4,11 +4,13 @@ import "errors"
 
 // MockPasswordHasher is a mock implementation of PasswordHasher
 type MockPasswordHasher struct {
-	HashFunc    func(password string) (string, error)
-	CompareFunc func(hashedPassword, password string) error
+	HashFunc        func(password string) (string, error)
+	CompareFunc     func(hashedPassword, password string) error
+	NeedsRehashFunc func(hashedPassword string) bool
 
-	HashCalls    int
-	CompareCalls int
+	HashCalls        int
+	CompareCalls     int
+	NeedsRehashCalls int
 }
 
 // Hash implements security.PasswordHasher
@@ -35,5 +37,15 @@ func (m *MockPasswordHasher) Compare(hashedPassword, password string) error {
 	return nil
 }
 
+// NeedsRehash implements security.PasswordHasher
+func (m *MockPasswordHasher) NeedsRehash(hashedPassword string) bool {
+	m.NeedsRehashCalls++
+	if m.NeedsRehashFunc != nil {
+		return m.NeedsRehashFunc(hashedPassword)
+	}
+	// Default: hashes are current
+	return false
+}
+
 // ErrPasswordMismatch is returned when password doesn't match
 var ErrPasswordMismatch = errors.New("password mismatch")
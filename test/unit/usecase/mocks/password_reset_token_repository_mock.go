@@ -0,0 +1,77 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// MockPasswordResetTokenRepository is an in-memory PasswordResetTokenRepository
+type MockPasswordResetTokenRepository struct {
+	CreateFunc         func(ctx context.Context, token *entity.PasswordResetToken) error
+	FindByHashFunc     func(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error)
+	MarkUsedFunc       func(ctx context.Context, tokenHash string) error
+	DeleteByUserIDFunc func(ctx context.Context, userID valueobject.UserID) error
+
+	CreateCalls         int
+	FindByHashCalls     int
+	MarkUsedCalls       int
+	DeleteByUserIDCalls int
+
+	// Tokens holds stored tokens by hash when no Func overrides are set
+	Tokens map[string]*entity.PasswordResetToken
+}
+
+// Create implements repository.PasswordResetTokenRepository
+func (m *MockPasswordResetTokenRepository) Create(ctx context.Context, token *entity.PasswordResetToken) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, token)
+	}
+	if m.Tokens == nil {
+		m.Tokens = make(map[string]*entity.PasswordResetToken)
+	}
+	m.Tokens[token.TokenHash()] = token
+	return nil
+}
+
+// FindByHash implements repository.PasswordResetTokenRepository
+func (m *MockPasswordResetTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error) {
+	m.FindByHashCalls++
+	if m.FindByHashFunc != nil {
+		return m.FindByHashFunc(ctx, tokenHash)
+	}
+	if token, ok := m.Tokens[tokenHash]; ok {
+		return token, nil
+	}
+	return nil, repository.ErrTokenNotFound
+}
+
+// MarkUsed implements repository.PasswordResetTokenRepository
+func (m *MockPasswordResetTokenRepository) MarkUsed(ctx context.Context, tokenHash string) error {
+	m.MarkUsedCalls++
+	if m.MarkUsedFunc != nil {
+		return m.MarkUsedFunc(ctx, tokenHash)
+	}
+	token, ok := m.Tokens[tokenHash]
+	if !ok || token.MarkUsed() != nil {
+		return repository.ErrTokenAlreadyUsed
+	}
+	return nil
+}
+
+// DeleteByUserID implements repository.PasswordResetTokenRepository
+func (m *MockPasswordResetTokenRepository) DeleteByUserID(ctx context.Context, userID valueobject.UserID) error {
+	m.DeleteByUserIDCalls++
+	if m.DeleteByUserIDFunc != nil {
+		return m.DeleteByUserIDFunc(ctx, userID)
+	}
+	for hash, token := range m.Tokens {
+		if token.UserID().Equals(userID) {
+			delete(m.Tokens, hash)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// MockPersonalAccessTokenRepository is an in-memory PersonalAccessTokenRepository
+type MockPersonalAccessTokenRepository struct {
+	CreateFunc         func(ctx context.Context, token *entity.PersonalAccessToken) error
+	FindByHashFunc     func(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error)
+	FindByUserIDFunc   func(ctx context.Context, userID valueobject.UserID) ([]*entity.PersonalAccessToken, error)
+	DeleteFunc         func(ctx context.Context, userID valueobject.UserID, tokenID string) error
+	DeleteByUserIDFunc func(ctx context.Context, userID valueobject.UserID) error
+
+	CreateCalls         int
+	FindByHashCalls     int
+	FindByUserIDCalls   int
+	DeleteCalls         int
+	DeleteByUserIDCalls int
+
+	// Tokens holds stored tokens in creation order when no Func overrides are set
+	Tokens []*entity.PersonalAccessToken
+}
+
+// Create implements repository.PersonalAccessTokenRepository
+func (m *MockPersonalAccessTokenRepository) Create(ctx context.Context, token *entity.PersonalAccessToken) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, token)
+	}
+	m.Tokens = append(m.Tokens, token)
+	return nil
+}
+
+// FindByHash implements repository.PersonalAccessTokenRepository
+func (m *MockPersonalAccessTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error) {
+	m.FindByHashCalls++
+	if m.FindByHashFunc != nil {
+		return m.FindByHashFunc(ctx, tokenHash)
+	}
+	for _, token := range m.Tokens {
+		if token.TokenHash() == tokenHash {
+			return token, nil
+		}
+	}
+	return nil, repository.ErrTokenNotFound
+}
+
+// FindByUserID implements repository.PersonalAccessTokenRepository
+func (m *MockPersonalAccessTokenRepository) FindByUserID(ctx context.Context, userID valueobject.UserID) ([]*entity.PersonalAccessToken, error) {
+	m.FindByUserIDCalls++
+	if m.FindByUserIDFunc != nil {
+		return m.FindByUserIDFunc(ctx, userID)
+	}
+	var tokens []*entity.PersonalAccessToken
+	for _, token := range m.Tokens {
+		if token.UserID().Equals(userID) {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
+// Delete implements repository.PersonalAccessTokenRepository
+func (m *MockPersonalAccessTokenRepository) Delete(ctx context.Context, userID valueobject.UserID, tokenID string) error {
+	m.DeleteCalls++
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID, tokenID)
+	}
+	for i, token := range m.Tokens {
+		if token.ID() == tokenID && token.UserID().Equals(userID) {
+			m.Tokens = append(m.Tokens[:i], m.Tokens[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrTokenNotFound
+}
+
+// DeleteByUserID implements repository.PersonalAccessTokenRepository
+func (m *MockPersonalAccessTokenRepository) DeleteByUserID(ctx context.Context, userID valueobject.UserID) error {
+	m.DeleteByUserIDCalls++
+	if m.DeleteByUserIDFunc != nil {
+		return m.DeleteByUserIDFunc(ctx, userID)
+	}
+	kept := m.Tokens[:0]
+	for _, token := range m.Tokens {
+		if !token.UserID().Equals(userID) {
+			kept = append(kept, token)
+		}
+	}
+	m.Tokens = kept
+	return nil
+}
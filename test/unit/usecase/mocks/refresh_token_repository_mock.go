@@ -0,0 +1,103 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// MockRefreshTokenRepository is a mock implementation of RefreshTokenRepository
+type MockRefreshTokenRepository struct {
+	CreateFunc       func(ctx context.Context, token *entity.RefreshToken) error
+	FindByHashFunc   func(ctx context.Context, tokenHash string) (*entity.RefreshToken, error)
+	MarkUsedFunc     func(ctx context.Context, tokenHash, replacedBy string) error
+	RevokeFamilyFunc func(ctx context.Context, familyID string) error
+
+	RevokeAllForUserFunc func(ctx context.Context, userID valueobject.UserID) error
+
+	CreateCalls           int
+	FindByHashCalls       int
+	MarkUsedCalls         int
+	RevokeFamilyCalls     int
+	RevokeAllForUserCalls int
+
+	// Created records every token passed to Create, and is the store the
+	// other methods use when no Func overrides are set
+	Created []*entity.RefreshToken
+}
+
+// Create implements repository.RefreshTokenRepository
+func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
+	m.CreateCalls++
+	m.Created = append(m.Created, token)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, token)
+	}
+	return nil
+}
+
+// FindByHash implements repository.RefreshTokenRepository
+func (m *MockRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	m.FindByHashCalls++
+	if m.FindByHashFunc != nil {
+		return m.FindByHashFunc(ctx, tokenHash)
+	}
+	if token := m.find(tokenHash); token != nil {
+		return token, nil
+	}
+	return nil, repository.ErrTokenNotFound
+}
+
+// MarkUsed implements repository.RefreshTokenRepository
+func (m *MockRefreshTokenRepository) MarkUsed(ctx context.Context, tokenHash, replacedBy string) error {
+	m.MarkUsedCalls++
+	if m.MarkUsedFunc != nil {
+		return m.MarkUsedFunc(ctx, tokenHash, replacedBy)
+	}
+	// Like the conditional update: only an unused, unrevoked token can be used
+	token := m.find(tokenHash)
+	if token == nil || token.IsRevoked() || token.MarkUsed(replacedBy) != nil {
+		return repository.ErrTokenAlreadyUsed
+	}
+	return nil
+}
+
+// RevokeFamily implements repository.RefreshTokenRepository
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	m.RevokeFamilyCalls++
+	if m.RevokeFamilyFunc != nil {
+		return m.RevokeFamilyFunc(ctx, familyID)
+	}
+	for _, token := range m.Created {
+		if token.FamilyID() == familyID {
+			token.Revoke()
+		}
+	}
+	return nil
+}
+
+// RevokeAllForUser implements repository.RefreshTokenRepository
+func (m *MockRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID valueobject.UserID) error {
+	m.RevokeAllForUserCalls++
+	if m.RevokeAllForUserFunc != nil {
+		return m.RevokeAllForUserFunc(ctx, userID)
+	}
+	for _, token := range m.Created {
+		if token.UserID().Equals(userID) {
+			token.Revoke()
+		}
+	}
+	return nil
+}
+
+// find returns the created token with tokenHash, or nil
+func (m *MockRefreshTokenRepository) find(tokenHash string) *entity.RefreshToken {
+	for _, token := range m.Created {
+		if token.TokenHash() == tokenHash {
+			return token
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+)
+
+// MockRevokedTokenRepository is a mock implementation of RevokedTokenRepository
+// WHY: Default behavior is an in-memory denylist so revoke-then-validate works
+type MockRevokedTokenRepository struct {
+	CreateFunc    func(ctx context.Context, token *entity.RevokedToken) error
+	IsRevokedFunc func(ctx context.Context, tokenID string) (bool, error)
+
+	CreateCalls    int
+	IsRevokedCalls int
+
+	revoked map[string]*entity.RevokedToken
+}
+
+// Create implements repository.RevokedTokenRepository
+func (m *MockRevokedTokenRepository) Create(ctx context.Context, token *entity.RevokedToken) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, token)
+	}
+	if m.revoked == nil {
+		m.revoked = make(map[string]*entity.RevokedToken)
+	}
+	m.revoked[token.TokenID()] = token
+	return nil
+}
+
+// IsRevoked implements repository.RevokedTokenRepository
+func (m *MockRevokedTokenRepository) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	m.IsRevokedCalls++
+	if m.IsRevokedFunc != nil {
+		return m.IsRevokedFunc(ctx, tokenID)
+	}
+	_, ok := m.revoked[tokenID]
+	return ok, nil
+}
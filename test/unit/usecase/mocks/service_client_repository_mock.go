@@ -0,0 +1,60 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// MockServiceClientRepository is an in-memory ServiceClientRepository
+type MockServiceClientRepository struct {
+	CreateFunc   func(ctx context.Context, client *entity.ServiceClient) error
+	FindByIDFunc func(ctx context.Context, clientID string) (*entity.ServiceClient, error)
+	UpdateFunc   func(ctx context.Context, client *entity.ServiceClient) error
+
+	CreateCalls   int
+	FindByIDCalls int
+	UpdateCalls   int
+
+	// Clients holds stored clients by ID when no Func overrides are set
+	Clients map[string]*entity.ServiceClient
+}
+
+// Create implements repository.ServiceClientRepository
+func (m *MockServiceClientRepository) Create(ctx context.Context, client *entity.ServiceClient) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, client)
+	}
+	if m.Clients == nil {
+		m.Clients = make(map[string]*entity.ServiceClient)
+	}
+	m.Clients[client.ID()] = client
+	return nil
+}
+
+// FindByID implements repository.ServiceClientRepository
+func (m *MockServiceClientRepository) FindByID(ctx context.Context, clientID string) (*entity.ServiceClient, error) {
+	m.FindByIDCalls++
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, clientID)
+	}
+	if client, ok := m.Clients[clientID]; ok {
+		return client, nil
+	}
+	return nil, repository.ErrServiceClientNotFound
+}
+
+// Update implements repository.ServiceClientRepository
+func (m *MockServiceClientRepository) Update(ctx context.Context, client *entity.ServiceClient) error {
+	m.UpdateCalls++
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, client)
+	}
+	if _, ok := m.Clients[client.ID()]; !ok {
+		return repository.ErrServiceClientNotFound
+	}
+	m.Clients[client.ID()] = client
+	return nil
+}
@@ -0,0 +1,140 @@
+package mocks
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Ruseigha/LabukaAuth/internal/domain/entity"
+	"github.com/Ruseigha/LabukaAuth/internal/domain/valueobject"
+	"github.com/Ruseigha/LabukaAuth/internal/repository"
+)
+
+// MockSessionRepository is an in-memory SessionRepository
+type MockSessionRepository struct {
+	CreateFunc           func(ctx context.Context, session *entity.Session) error
+	FindByIDFunc         func(ctx context.Context, id string) (*entity.Session, error)
+	FindByFamilyIDFunc   func(ctx context.Context, familyID string) (*entity.Session, error)
+	FindByUserIDFunc     func(ctx context.Context, userID valueobject.UserID) ([]*entity.Session, error)
+	UpdateFunc           func(ctx context.Context, session *entity.Session) error
+	DeleteFunc           func(ctx context.Context, id string) error
+	DeleteByFamilyIDFunc func(ctx context.Context, familyID string) error
+	DeleteByUserIDFunc   func(ctx context.Context, userID valueobject.UserID) error
+
+	CreateCalls           int
+	FindByIDCalls         int
+	FindByFamilyIDCalls   int
+	FindByUserIDCalls     int
+	UpdateCalls           int
+	DeleteCalls           int
+	DeleteByFamilyIDCalls int
+	DeleteByUserIDCalls   int
+
+	// Sessions holds stored sessions in creation order when no Func overrides are set
+	Sessions []*entity.Session
+}
+
+// Create implements repository.SessionRepository
+func (m *MockSessionRepository) Create(ctx context.Context, session *entity.Session) error {
+	m.CreateCalls++
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, session)
+	}
+	m.Sessions = append(m.Sessions, session)
+	return nil
+}
+
+// FindByID implements repository.SessionRepository
+func (m *MockSessionRepository) FindByID(ctx context.Context, id string) (*entity.Session, error) {
+	m.FindByIDCalls++
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	for _, session := range m.Sessions {
+		if session.ID() == id {
+			return session, nil
+		}
+	}
+	return nil, repository.ErrSessionNotFound
+}
+
+// FindByFamilyID implements repository.SessionRepository
+func (m *MockSessionRepository) FindByFamilyID(ctx context.Context, familyID string) (*entity.Session, error) {
+	m.FindByFamilyIDCalls++
+	if m.FindByFamilyIDFunc != nil {
+		return m.FindByFamilyIDFunc(ctx, familyID)
+	}
+	for _, session := range m.Sessions {
+		if session.FamilyID() == familyID {
+			return session, nil
+		}
+	}
+	return nil, repository.ErrSessionNotFound
+}
+
+// FindByUserID implements repository.SessionRepository
+func (m *MockSessionRepository) FindByUserID(ctx context.Context, userID valueobject.UserID) ([]*entity.Session, error) {
+	m.FindByUserIDCalls++
+	if m.FindByUserIDFunc != nil {
+		return m.FindByUserIDFunc(ctx, userID)
+	}
+	var sessions []*entity.Session
+	for _, session := range m.Sessions {
+		if session.UserID().Equals(userID) {
+			sessions = append(sessions, session)
+		}
+	}
+	// Most recently seen first, like the real repository
+	slices.SortStableFunc(sessions, func(a, b *entity.Session) int {
+		return b.LastSeenAt().Compare(a.LastSeenAt())
+	})
+	return sessions, nil
+}
+
+// Update implements repository.SessionRepository
+func (m *MockSessionRepository) Update(ctx context.Context, session *entity.Session) error {
+	m.UpdateCalls++
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, session)
+	}
+	for i, stored := range m.Sessions {
+		if stored.ID() == session.ID() {
+			m.Sessions[i] = session
+			return nil
+		}
+	}
+	return repository.ErrSessionNotFound
+}
+
+// Delete implements repository.SessionRepository
+func (m *MockSessionRepository) Delete(ctx context.Context, id string) error {
+	m.DeleteCalls++
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	m.deleteWhere(func(s *entity.Session) bool { return s.ID() == id })
+	return nil
+}
+
+// DeleteByFamilyID implements repository.SessionRepository
+func (m *MockSessionRepository) DeleteByFamilyID(ctx context.Context, familyID string) error {
+	m.DeleteByFamilyIDCalls++
+	if m.DeleteByFamilyIDFunc != nil {
+		return m.DeleteByFamilyIDFunc(ctx, familyID)
+	}
+	m.deleteWhere(func(s *entity.Session) bool { return s.FamilyID() == familyID })
+	return nil
+}
+
+// DeleteByUserID implements repository.SessionRepository
+func (m *MockSessionRepository) DeleteByUserID(ctx context.Context, userID valueobject.UserID) error {
+	m.DeleteByUserIDCalls++
+	if m.DeleteByUserIDFunc != nil {
+		return m.DeleteByUserIDFunc(ctx, userID)
+	}
+	m.deleteWhere(func(s *entity.Session) bool { return s.UserID().Equals(userID) })
+	return nil
+}
+
+func (m *MockSessionRepository) deleteWhere(match func(*entity.Session) bool) {
+	m.Sessions = slices.DeleteFunc(m.Sessions, match)
+}
@@ -10,24 +10,28 @@ import (
 
 type MockUserRepository struct {
 	// Function fields - tests can set custom behavior
-	CreateFunc        func(ctx context.Context, user *entity.User) error
-	FindByIDFunc      func(ctx context.Context, id valueobject.UserID) (*entity.User, error)
-	FindByEmailFunc   func(ctx context.Context, email valueobject.Email) (*entity.User, error)
-	UpdateFunc        func(ctx context.Context, user *entity.User) error
-	DeleteFunc        func(ctx context.Context, id valueobject.UserID) error
-	ExistsByEmailFunc func(ctx context.Context, email valueobject.Email) (bool, error)
-	ListFunc          func(ctx context.Context, offset, limit int) ([]*entity.User, error)
-	CountFunc         func(ctx context.Context) (int64, error)
+	CreateFunc          func(ctx context.Context, user *entity.User) error
+	FindByIDFunc        func(ctx context.Context, id valueobject.UserID) (*entity.User, error)
+	FindByEmailFunc     func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (*entity.User, error)
+	UpdateFunc          func(ctx context.Context, user *entity.User) error
+	UseTOTPStepFunc     func(ctx context.Context, id valueobject.UserID, step int64) error
+	UseRecoveryCodeFunc func(ctx context.Context, id valueobject.UserID, codeHash string) error
+	DeleteFunc          func(ctx context.Context, id valueobject.UserID) error
+	ExistsByEmailFunc   func(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error)
+	ListFunc            func(ctx context.Context, tenantID valueobject.TenantID, offset, limit int) ([]*entity.User, error)
+	CountFunc           func(ctx context.Context, tenantID valueobject.TenantID) (int64, error)
 
 	// Call tracking - verify what was called
-	CreateCalls        int
-	FindByIDCalls      int
-	FindByEmailCalls   int
-	UpdateCalls        int
-	DeleteCalls        int
-	ExistsByEmailCalls int
-	ListCalls          int
-	CountCalls         int
+	CreateCalls          int
+	FindByIDCalls        int
+	FindByEmailCalls     int
+	UpdateCalls          int
+	UseTOTPStepCalls     int
+	UseRecoveryCodeCalls int
+	DeleteCalls          int
+	ExistsByEmailCalls   int
+	ListCalls            int
+	CountCalls           int
 }
 
 // Create implements repository.UserRepository
@@ -49,10 +53,10 @@ func (m *MockUserRepository) FindByID(ctx context.Context, id valueobject.UserID
 }
 
 // FindByEmail implements repository.UserRepository
-func (m *MockUserRepository) FindByEmail(ctx context.Context, email valueobject.Email) (*entity.User, error) {
+func (m *MockUserRepository) FindByEmail(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (*entity.User, error) {
 	m.FindByEmailCalls++
 	if m.FindByEmailFunc != nil {
-		return m.FindByEmailFunc(ctx, email)
+		return m.FindByEmailFunc(ctx, tenantID, email)
 	}
 	return nil, repository.ErrUserNotFound
 }
@@ -66,6 +70,24 @@ func (m *MockUserRepository) Update(ctx context.Context, user *entity.User) erro
 	return nil
 }
 
+// UseTOTPStep implements repository.UserRepository
+func (m *MockUserRepository) UseTOTPStep(ctx context.Context, id valueobject.UserID, step int64) error {
+	m.UseTOTPStepCalls++
+	if m.UseTOTPStepFunc != nil {
+		return m.UseTOTPStepFunc(ctx, id, step)
+	}
+	return nil
+}
+
+// UseRecoveryCode implements repository.UserRepository
+func (m *MockUserRepository) UseRecoveryCode(ctx context.Context, id valueobject.UserID, codeHash string) error {
+	m.UseRecoveryCodeCalls++
+	if m.UseRecoveryCodeFunc != nil {
+		return m.UseRecoveryCodeFunc(ctx, id, codeHash)
+	}
+	return nil
+}
+
 // Delete implements repository.UserRepository
 func (m *MockUserRepository) Delete(ctx context.Context, id valueobject.UserID) error {
 	m.DeleteCalls++
@@ -76,28 +98,28 @@ func (m *MockUserRepository) Delete(ctx context.Context, id valueobject.UserID)
 }
 
 // ExistsByEmail implements repository.UserRepository
-func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email valueobject.Email) (bool, error) {
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, tenantID valueobject.TenantID, email valueobject.Email) (bool, error) {
 	m.ExistsByEmailCalls++
 	if m.ExistsByEmailFunc != nil {
-		return m.ExistsByEmailFunc(ctx, email)
+		return m.ExistsByEmailFunc(ctx, tenantID, email)
 	}
 	return false, nil
 }
 
 // List implements repository.UserRepository
-func (m *MockUserRepository) List(ctx context.Context, offset, limit int) ([]*entity.User, error) {
+func (m *MockUserRepository) List(ctx context.Context, tenantID valueobject.TenantID, offset, limit int) ([]*entity.User, error) {
 	m.ListCalls++
 	if m.ListFunc != nil {
-		return m.ListFunc(ctx, offset, limit)
+		return m.ListFunc(ctx, tenantID, offset, limit)
 	}
 	return []*entity.User{}, nil
 }
 
 // Count implements repository.UserRepository
-func (m *MockUserRepository) Count(ctx context.Context) (int64, error) {
+func (m *MockUserRepository) Count(ctx context.Context, tenantID valueobject.TenantID) (int64, error) {
 	m.CountCalls++
 	if m.CountFunc != nil {
-		return m.CountFunc(ctx)
+		return m.CountFunc(ctx, tenantID)
 	}
 	return 0, nil
 }